@@ -15,6 +15,7 @@ import (
 	"database/sql"
 	"net/http/httptest"
 	"os"
+	"time"
 
 	myLog "cart/internal/observability/log"
 	"cart/internal/observability/tracer"
@@ -22,6 +23,7 @@ import (
 	pb "cart/pkg/api/cart"
 	myZap "cart/pkg/zap"
 
+	"buf.build/go/protovalidate"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -33,8 +35,14 @@ import (
 const (
 	tracingServiceName = "cart-service"
 	appLogPath         = "../app.log"
+	stockClientTimeout = 5 * time.Second
 )
 
+// noopMetrics drops the circuit breaker metrics, the tests set up the app more than once.
+type noopMetrics struct{}
+
+func (noopMetrics) SetBreakerState(string, float64) {}
+
 type testAppConfig struct {
 	DB            *sql.DB
 	Migration     *migrate.Migrate
@@ -118,11 +126,16 @@ func (t *testAppConfig) Setup(ctx context.Context) error {
 
 	trxManager := postgres.NewPgTxManager(t.DBPool)
 	cartRepo := repository.NewCartRepository(t.DBPool)
-	stockService := services.NewStockClient(t.StockClient)
-	cartUsecase := usecase.NewCartUsecase(cartRepo, trxManager, stockService, kafkaProducer, t.Logger)
+	stockService := services.NewStockClient(t.StockClient, services.ClientConfig{Timeout: stockClientTimeout, MaxAttempts: 1}, noopMetrics{}, t.Logger)
+	cartUsecase := usecase.NewCartUsecase(cartRepo, trxManager, stockService, stockService, kafkaProducer, t.Logger)
 	srv := myGrpc.NewCartServer(cartUsecase, t.Tracer.Tracer("cart-service"))
 
-	t.CartGRPC = grpc.NewServer()
+	validator, err := protovalidate.New()
+	if err != nil {
+		return err
+	}
+
+	t.CartGRPC = grpc.NewServer(grpc.ChainUnaryInterceptor(myGrpc.ErrorInterceptor(), myGrpc.ValidationInterceptor(validator)))
 	reflection.Register(t.CartGRPC)
 
 	pb.RegisterCartServiceServer(t.CartGRPC, srv)
@@ -134,7 +147,7 @@ func (t *testAppConfig) Setup(ctx context.Context) error {
 	}()
 
 	//gateway
-	mux, err := myGrpc.NewMux(ctx, grpcServerAddress)
+	mux, err := myGrpc.NewMux(ctx, grpcServerAddress, insecure.NewCredentials())
 	if err != nil {
 		return err
 	}
@@ -1,10 +1,16 @@
 package app
 
 import (
+	"cart/internal/auth"
 	"cart/internal/config"
+	"cart/internal/consumer"
+	"cart/internal/health"
+	"cart/internal/jobs"
 	"cart/internal/producer"
+	"cart/internal/ratelimit"
 	myGrpc "cart/internal/router/grpc"
 	"cart/internal/services"
+	"cart/internal/tlsconfig"
 	"errors"
 	"net"
 	"net/http"
@@ -22,9 +28,12 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
+	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
@@ -35,17 +44,37 @@ const (
 	ErrMigration         = "error migration: %v"
 	ErrMigrationUp       = "error migration up: %v"
 	ErrLoadClientTimeOut = "error loading CLIENT_TIMEOUT: %v"
+	ErrLoadClientConfig  = "error loading %s: %v"
+	ErrLoadCacheSize     = "error loading CACHE_SIZE: %v"
+	ErrStockEvents       = "error subscribing to stock events: %v"
 	ErrShutdown          = "shutdown error: %v"
 	ErrListener          = "failed to listen: %v"
 	ErrListenGRPC        = "failed to serve grpc server"
 	ErrListenGateway     = "failed to serve gateway server"
 	ErrListenMetrics     = "failed to serve metrics server"
 	ErrTracerShutdown    = "failed to shutdown tracer: %v"
+	ErrLoadCartTTL       = "error loading CART_TTL: %v"
+	ErrLoadCartExpiry    = "error loading CART_EXPIRY_INTERVAL: %v"
+	ErrLoadIdemTTL       = "error loading IDEMPOTENCY_TTL: %v"
+	ErrLoadIdemCleanup   = "error loading IDEMPOTENCY_CLEANUP_INTERVAL: %v"
+	ErrLoadAuth          = "error loading JWT keys: %v"
+	ErrLoadRateLimit     = "error loading RATE_LIMIT_DEFAULT: %v"
+	ErrLoadRateMethods   = "error loading RATE_LIMIT_METHODS: %v"
+	ErrLoadTLS           = "error loading TLS certificates: %v"
+	ErrLoadClientTLS     = "error loading stock client TLS certificates: %v"
+	ErrLoadTLSReload     = "error loading TLS_RELOAD_INTERVAL: %v"
+	ErrValidator         = "error creating request validator: %v"
+	ErrLoadHealth        = "error loading %s: %v"
+	ErrLoadDrainDelay    = "error loading SHUTDOWN_DRAIN_DELAY: %v"
+	WarnAuthDisabled     = "authentication is disabled, the user_id of the requests is trusted"
+	WarnTLSDisabled      = "TLS is disabled, the gRPC and gateway servers listen in plaintext"
+	WarnClientTLSOff     = "TLS is disabled for the stock client, stocks is dialed in plaintext"
 
 	tracingServiceName = "cart-service"
 
 	metricsTimeout           = 5 * time.Second
 	gatewayShutdownTimeout   = 5 * time.Second
+	grpcShutdownTimeout      = 10 * time.Second
 	gatewayReadHeaderTimeout = 3 * time.Second
 )
 
@@ -96,13 +125,69 @@ func RunApp(env string, logger myLog.Logger) error {
 	}
 	defer dbPool.Close()
 
+	//tls
+	tlsReloadInterval, err := time.ParseDuration(os.Getenv("TLS_RELOAD_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadTLSReload, err)
+	}
+
+	tlsConfig := tlsconfig.Config{
+		CertFile: os.Getenv("GRPC_TLS_CERT_FILE"),
+		KeyFile:  os.Getenv("GRPC_TLS_KEY_FILE"),
+		CAFile:   os.Getenv("GRPC_TLS_CA_FILE"),
+	}
+
+	var certs *tlsconfig.Reloader
+
+	if tlsConfig.Enabled() {
+		certs, err = tlsconfig.NewServerReloader(tlsConfig)
+		if err != nil {
+			return fmt.Errorf(ErrLoadTLS, err)
+		}
+
+		go certs.Watch(ctx, tlsReloadInterval, logger)
+	} else {
+		logger.Warn(WarnTLSDisabled)
+	}
+
 	//stock service
-	conn, err := grpc.NewClient(os.Getenv("CLIENT_URL"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	clientTLSConfig := tlsconfig.Config{
+		CertFile: os.Getenv("CLIENT_TLS_CERT_FILE"),
+		KeyFile:  os.Getenv("CLIENT_TLS_KEY_FILE"),
+		CAFile:   os.Getenv("CLIENT_TLS_CA_FILE"),
+	}
+
+	var clientCreds credentials.TransportCredentials = insecure.NewCredentials()
+
+	if clientTLSConfig.Enabled() {
+		clientCerts, err := tlsconfig.NewReloader(clientTLSConfig)
+		if err != nil {
+			return fmt.Errorf(ErrLoadClientTLS, err)
+		}
+
+		go clientCerts.Watch(ctx, tlsReloadInterval, logger)
+
+		clientCreds = credentials.NewTLS(clientCerts.ClientConfig(os.Getenv("CLIENT_TLS_SERVER_NAME")))
+	} else {
+		logger.Warn(WarnClientTLSOff)
+	}
+
+	conn, err := grpc.NewClient(os.Getenv("CLIENT_URL"), grpc.WithTransportCredentials(clientCreds))
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
+	stockClientConfig, err := loadStockClientConfig()
+	if err != nil {
+		return err
+	}
+
+	cacheConfig, err := loadCacheConfig()
+	if err != nil {
+		return err
+	}
+
 	//kafka
 	kafkaBrokers := os.Getenv("KAFKA_BROKERS")
 
@@ -113,6 +198,67 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	defer kafkaProducer.Close()
 
+	//cart expiry
+	cartTTL, err := time.ParseDuration(os.Getenv("CART_TTL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadCartTTL, err)
+	}
+
+	cartExpiryInterval, err := time.ParseDuration(os.Getenv("CART_EXPIRY_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadCartExpiry, err)
+	}
+
+	//idempotency keys
+	idempotencyTTL, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_TTL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadIdemTTL, err)
+	}
+
+	idempotencyCleanupInterval, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_CLEANUP_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadIdemCleanup, err)
+	}
+
+	//auth
+	authDisabled, _ := strconv.ParseBool(os.Getenv("AUTH_DISABLED"))
+
+	var verifier *auth.Verifier
+
+	if !authDisabled {
+		verifier, err = auth.NewVerifier(auth.Config{
+			JWKSFile:   os.Getenv("AUTH_JWKS_FILE"),
+			HMACSecret: os.Getenv("AUTH_HS256_SECRET"),
+			Issuer:     os.Getenv("AUTH_ISSUER"),
+			Audience:   os.Getenv("AUTH_AUDIENCE"),
+		})
+		if err != nil {
+			return fmt.Errorf(ErrLoadAuth, err)
+		}
+	}
+
+	//rate limit
+	rateLimitDefault, err := ratelimit.ParseRule(os.Getenv("RATE_LIMIT_DEFAULT"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadRateLimit, err)
+	}
+
+	rateLimitMethods, err := ratelimit.ParseRules(os.Getenv("RATE_LIMIT_METHODS"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadRateMethods, err)
+	}
+
+	//health
+	healthConfig, err := loadHealthConfig()
+	if err != nil {
+		return err
+	}
+
+	shutdownDrainDelay, err := time.ParseDuration(os.Getenv("SHUTDOWN_DRAIN_DELAY"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadDrainDelay, err)
+	}
+
 	//grpc listener
 	grpcServerAddress := fmt.Sprintf("%s:%s", os.Getenv("GRPC_HOST"), os.Getenv("GRPC_PORT"))
 
@@ -123,36 +269,115 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	cartRepo := repository.NewCartRepository(dbPool)
 	trxManager := postgres.NewPgTxManager(dbPool)
-	stockService := services.NewStockClient(conn)
-	cartUsecase := usecase.NewCartUsecase(cartRepo, trxManager, stockService, kafkaProducer, logger)
-	cartService := myGrpc.NewCartServer(cartUsecase, tracing.Tracer(tracingServiceName))
 	metric := metrics.RegisterMetrics()
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(
+	stockClient := services.NewStockClient(conn, stockClientConfig, metric, logger)
+	var stockService usecase.IStockService = stockClient
+
+	//stock items cache, only for display: the available count is checked with the client
+	if cacheConfig.Size > 0 {
+		cachedStockService := services.NewCachedStockService(stockClient, cacheConfig, metric, logger)
+		stockService = cachedStockService
+
+		if topic := os.Getenv("STOCK_EVENTS_TOPIC"); topic != "" {
+			hostname, _ := os.Hostname()
+
+			stockEvents, err := consumer.NewStockEventConsumer(kafkaBrokers, topic, os.Getenv("STOCK_EVENTS_GROUP")+"-"+hostname, cachedStockService, logger)
+			if err != nil {
+				return fmt.Errorf(ErrStockEvents, err)
+			}
+
+			go stockEvents.Run(ctx)
+		}
+	}
+
+	cartUsecase := usecase.NewCartUsecase(cartRepo, trxManager, stockService, stockClient, kafkaProducer, logger)
+	cartService := myGrpc.NewCartServer(cartUsecase, tracing.Tracer(tracingServiceName))
+	cartExpiryJob := jobs.NewCartExpiryJob(cartUsecase, cartTTL, cartExpiryInterval, logger)
+	idempotencyRepo := repository.NewIdempotencyRepository(dbPool)
+	idempotencyUsecase := usecase.NewIdempotencyUsecase(idempotencyRepo, idempotencyTTL)
+	idempotencyCleanupJob := jobs.NewIdempotencyCleanupJob(idempotencyUsecase, idempotencyCleanupInterval, logger)
+	rateLimiter := ratelimit.NewLimiter(rateLimitDefault, rateLimitMethods)
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		return fmt.Errorf(ErrValidator, err)
+	}
+
+	// the errors are converted to statuses last, so the logging interceptor still logs the internal ones
+	interceptors := []grpc.UnaryServerInterceptor{
+		myGrpc.ErrorInterceptor(),
 		myGrpc.LoggingInterceptor(
 			logger,
 			metric,
 			tracing.Tracer(tracingServiceName),
 		),
-	))
+	}
+
+	if verifier != nil {
+		interceptors = append(interceptors, myGrpc.AuthInterceptor(verifier, logger))
+	} else {
+		logger.Warn(WarnAuthDisabled)
+	}
+
+	// the rate limit and the idempotency keys are scoped by the principal, so they are checked after the authentication;
+	// invalid requests are rejected before they are stored under their idempotency key
+	interceptors = append(interceptors,
+		myGrpc.RateLimitInterceptor(rateLimiter, metric),
+		myGrpc.ValidationInterceptor(validator),
+		myGrpc.IdempotencyInterceptor(idempotencyUsecase, logger),
+	)
+
+	serverOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+
+	// the gateway dials the gRPC server with the certificate of the service, so it passes the mTLS check
+	var gatewayCreds credentials.TransportCredentials = insecure.NewCredentials()
+
+	if certs != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certs.GRPCServerConfig())))
+		gatewayCreds = credentials.NewTLS(certs.ClientConfig(os.Getenv("GRPC_TLS_SERVER_NAME")))
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 
 	//grpc register
 	reflection.Register(grpcServer)
 	pb.RegisterCartServiceServer(grpcServer, cartService)
 
+	//health checks
+	healthChecker := health.NewHealth([]string{pb.CartService_ServiceDesc.ServiceName}, healthConfig, logger)
+	healthChecker.AddChecker("postgres", health.CheckFunc(dbPool.Ping))
+	healthChecker.AddChecker("stocks", health.ConnChecker(conn))
+	healthChecker.AddChecker("kafka", health.CheckFunc(kafkaProducer.Check))
+	healthChecker.RegisterServer(grpcServer)
+
 	//gateway listener
 	gatewayAddr := fmt.Sprintf("%s:%s", os.Getenv("GATEWAY_SERVER_HOST"), os.Getenv("GATEWAY_SERVER_PORT"))
 
-	mux, err := myGrpc.NewMux(ctx, grpcServerAddress)
+	mux, err := myGrpc.NewMux(ctx, grpcServerAddress, gatewayCreds)
 	if err != nil {
 		return err
 	}
 
+	err = myGrpc.HandleProbes(mux, healthChecker)
+	if err != nil {
+		return err
+	}
+
+	var handler http.Handler = mux
+	if verifier != nil {
+		handler = myGrpc.AuthMiddleware(mux, verifier, mux)
+	}
+
 	serverConfig := &myGrpc.ServerConfig{
 		Address:             gatewayAddr,
-		Handler:             mux,
+		Handler:             handler,
 		ReaderHeaderTimeout: gatewayReadHeaderTimeout,
 	}
 
+	if certs != nil {
+		serverConfig.TLSConfig = certs.HTTPServerConfig()
+	}
+
 	gatewayServer := myGrpc.NewGatewayServer(serverConfig)
 
 	//grpc ListenAndServe
@@ -164,7 +389,7 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	//gateway ListenAndServe
 	go func() {
-		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := listenAndServeGateway(gatewayServer); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			logger.Error(ErrListenGateway, myLog.Error(err))
 		}
 	}()
@@ -176,6 +401,15 @@ func RunApp(env string, logger myLog.Logger) error {
 		}
 	}()
 
+	//health checks
+	go healthChecker.Run(ctx)
+
+	//cart expiry job
+	go cartExpiryJob.Run(ctx)
+
+	//idempotency cleanup job
+	go idempotencyCleanupJob.Run(ctx)
+
 	logger.Infof("gateway listening in %s", gatewayAddr)
 
 	//gracefull shutdown
@@ -183,18 +417,155 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	logger.Info("shutting down server  gracefully...")
 
-	grpcServer.GracefulStop()
+	// the probes and the health service report NOT_SERVING while the servers drain, the drain delay
+	// gives the load balancers time to see it and stop sending new requests
+	healthChecker.Shutdown()
+	time.Sleep(shutdownDrainDelay)
 
+	// the gateway calls the gRPC server, so it is shut down first and its running requests still finish
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), gatewayShutdownTimeout)
 	defer cancel()
 
-	if err = gatewayServer.Shutdown(shutdownCtx); err != nil {
-		return fmt.Errorf(ErrShutdown, err)
+	gatewayErr := gatewayServer.Shutdown(shutdownCtx)
+
+	gracefulStop(grpcServer, grpcShutdownTimeout)
+
+	if gatewayErr != nil {
+		return fmt.Errorf(ErrShutdown, gatewayErr)
 	}
 
 	return nil
 }
 
+// loadStockClientConfig loads the timeout, retries, hedging and circuit breaker of the stock client.
+func loadStockClientConfig() (services.ClientConfig, error) {
+	var cfg services.ClientConfig
+
+	durations := []struct {
+		env   string
+		value *time.Duration
+	}{
+		{env: "CLIENT_TIMEOUT", value: &cfg.Timeout},
+		{env: "CLIENT_RETRY_BACKOFF", value: &cfg.BackoffBase},
+		{env: "CLIENT_RETRY_MAX_BACKOFF", value: &cfg.BackoffMax},
+		{env: "CLIENT_HEDGE_DELAY", value: &cfg.HedgeDelay},
+		{env: "CLIENT_BREAKER_OPEN_TIMEOUT", value: &cfg.BreakerOpenTimeout},
+	}
+
+	for _, d := range durations {
+		value, err := time.ParseDuration(os.Getenv(d.env))
+		if err != nil {
+			return cfg, fmt.Errorf(ErrLoadClientConfig, d.env, err)
+		}
+
+		*d.value = value
+	}
+
+	ints := []struct {
+		env   string
+		value *int
+	}{
+		{env: "CLIENT_RETRY_ATTEMPTS", value: &cfg.MaxAttempts},
+		{env: "CLIENT_BREAKER_FAILURES", value: &cfg.BreakerFailures},
+	}
+
+	for _, i := range ints {
+		value, err := strconv.Atoi(os.Getenv(i.env))
+		if err != nil {
+			return cfg, fmt.Errorf(ErrLoadClientConfig, i.env, err)
+		}
+
+		*i.value = value
+	}
+
+	return cfg, nil
+}
+
+// loadCacheConfig loads the size and the expiry of the stock items cache, a zero size disables it.
+func loadCacheConfig() (services.CacheConfig, error) {
+	var cfg services.CacheConfig
+
+	size, err := strconv.Atoi(os.Getenv("CACHE_SIZE"))
+	if err != nil {
+		return cfg, fmt.Errorf(ErrLoadCacheSize, err)
+	}
+
+	cfg.Size = size
+
+	durations := []struct {
+		env   string
+		value *time.Duration
+	}{
+		{env: "CACHE_TTL", value: &cfg.TTL},
+		{env: "CACHE_STALE_WHILE_REVALIDATE", value: &cfg.StaleWhileRevalidate},
+		{env: "CACHE_STALE_IF_ERROR", value: &cfg.StaleIfError},
+	}
+
+	for _, d := range durations {
+		value, err := time.ParseDuration(os.Getenv(d.env))
+		if err != nil {
+			return cfg, fmt.Errorf(ErrLoadClientConfig, d.env, err)
+		}
+
+		*d.value = value
+	}
+
+	return cfg, nil
+}
+
+// loadHealthConfig loads how often and how long the dependencies are checked.
+func loadHealthConfig() (health.Config, error) {
+	var cfg health.Config
+
+	durations := []struct {
+		env   string
+		value *time.Duration
+	}{
+		{env: "HEALTH_CHECK_INTERVAL", value: &cfg.Interval},
+		{env: "HEALTH_CHECK_TIMEOUT", value: &cfg.Timeout},
+	}
+
+	for _, d := range durations {
+		value, err := time.ParseDuration(os.Getenv(d.env))
+		if err != nil {
+			return cfg, fmt.Errorf(ErrLoadHealth, d.env, err)
+		}
+
+		*d.value = value
+	}
+
+	return cfg, nil
+}
+
+// gracefulStop waits for the running RPCs up to the timeout and then closes the remaining ones,
+// like the Watch streams of the health service, which only end when the client cancels them.
+func gracefulStop(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C:
+		server.Stop()
+	}
+}
+
+// listenAndServeGateway serves HTTPS if the server has a TLS config, the certificates are taken from it.
+func listenAndServeGateway(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+
+	return server.ListenAndServe()
+}
+
 func migrationUp(dbConfig *postgres.PostgresConfig) error {
 	//database for migration
 	db, err := postgres.NewDB(dbConfig)
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"cart/internal/models"
+	"context"
+)
+
+// Principal is the caller of a request authenticated by its token.
+type Principal struct {
+	UserID  models.UserID
+	Subject string
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal of the request, it reports false for an unauthenticated request.
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+
+	return principal, ok
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "secret"
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kid": "rsa-1",
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(jwksFile, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewVerifier(Config{JWKSFile: jwksFile, HMACSecret: testSecret, Issuer: "auth"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := func(sub string, exp time.Duration) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   sub,
+			Issuer:    "auth",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(exp)),
+		}
+	}
+
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.RegisteredClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return signed
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantUser int64
+		wantErr  error
+	}{
+		{
+			name:     "HS256",
+			token:    sign(jwt.SigningMethodHS256, "", []byte(testSecret), claims("7", time.Hour)),
+			wantUser: 7,
+		},
+		{
+			name:     "RS256",
+			token:    sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, claims("8", time.Hour)),
+			wantUser: 8,
+		},
+		{
+			name:    "ErrorExpired",
+			token:   sign(jwt.SigningMethodHS256, "", []byte(testSecret), claims("7", -time.Hour)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "ErrorWrongSecret",
+			token:   sign(jwt.SigningMethodHS256, "", []byte("other"), claims("7", time.Hour)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "ErrorUnknownKid",
+			token:   sign(jwt.SigningMethodRS256, "rsa-2", rsaKey, claims("8", time.Hour)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "ErrorSubjectNotUserID",
+			token:   sign(jwt.SigningMethodHS256, "", []byte(testSecret), claims("admin", time.Hour)),
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := verifier.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if int64(principal.UserID) != tt.wantUser {
+				t.Errorf("wanted user: %d, respond: %d", tt.wantUser, principal.UserID)
+			}
+		})
+	}
+}
+
+func TestNewVerifierNoKeys(t *testing.T) {
+	_, err := NewVerifier(Config{Issuer: "auth"})
+	if !errors.Is(err, ErrNoKeys) {
+		t.Errorf("wanted: %v, respond: %v", ErrNoKeys, err)
+	}
+}
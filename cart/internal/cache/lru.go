@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRU is a size-bounded cache that evicts the least recently used entry when it is full and drops
+// entries older than maxAge when they are read. It keeps the time an entry was stored, so the caller
+// can tell fresh entries from stale ones.
+type LRU[K comparable, V any] struct {
+	size   int
+	maxAge time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	storedAt time.Time
+}
+
+func NewLRU[K comparable, V any](size int, maxAge time.Duration) *LRU[K, V] {
+	return &LRU[K, V]{
+		size:    size,
+		maxAge:  maxAge,
+		now:     time.Now,
+		entries: make(map[K]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+// Get returns the value of the key and the time it was stored.
+func (c *LRU[K, V]) Get(key K) (V, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+
+		return zero, time.Time{}, false
+	}
+
+	e := elem.Value.(*entry[K, V])
+
+	if c.maxAge > 0 && c.now().Sub(e.storedAt) >= c.maxAge {
+		c.remove(elem)
+
+		var zero V
+
+		return zero, time.Time{}, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return e.value, e.storedAt, true
+}
+
+// Set stores the value of the key, evicting the least recently used entry if the cache is full.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value, e.storedAt = value, c.now()
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, storedAt: c.now()})
+
+	if c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// DeleteFunc removes the entries whose key matches and returns how many were removed.
+func (c *LRU[K, V]) DeleteFunc(match func(K) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var removed int
+
+	for key, elem := range c.entries {
+		if match(key) {
+			c.remove(elem)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// SetClock replaces the clock of the stored times and the max age.
+func (c *LRU[K, V]) SetClock(now func() time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *LRU[K, V]) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[K, V]).key)
+}
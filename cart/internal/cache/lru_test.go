@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	c := NewLRU[string, int](2, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	// a is used, so b is the least recently used entry evicted by c
+	if value, _, ok := c.Get("a"); !ok || value != 1 {
+		t.Fatalf("Get(a) = %d, %v, want 1, true", value, ok)
+	}
+
+	c.Set("c", 3)
+
+	if _, _, ok := c.Get("b"); ok {
+		t.Error("Get(b) found the evicted entry")
+	}
+
+	now = now.Add(30 * time.Second)
+	c.Set("a", 10)
+
+	value, storedAt, ok := c.Get("a")
+	if !ok || value != 10 || !storedAt.Equal(now) {
+		t.Errorf("Get(a) = %d, %v, %v, want the updated entry", value, storedAt, ok)
+	}
+
+	now = now.Add(30 * time.Second)
+
+	if _, _, ok := c.Get("c"); ok {
+		t.Error("Get(c) found an entry older than the max age")
+	}
+
+	if _, _, ok := c.Get("a"); !ok {
+		t.Error("Get(a) did not find the updated entry")
+	}
+
+	if removed := c.DeleteFunc(func(key string) bool { return key == "a" }); removed != 1 || c.Len() != 0 {
+		t.Errorf("DeleteFunc() = %d, len = %d, want 1, 0", removed, c.Len())
+	}
+}
@@ -0,0 +1,101 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cart/internal/models"
+	myLog "cart/internal/observability/log"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const (
+	stockEventService = "stock"
+	sessionTimeoutMs  = 7000
+	readTimeoutMs     = 1000
+
+	errReadMessage  = "error reading stock event"
+	errParseMessage = "error parsing stock event"
+)
+
+type ISKUInvalidator interface {
+	InvalidateSKU(skuID models.SKUID)
+}
+
+// stockEvent is the part of the events of the stocks service needed to invalidate a SKU,
+// every stocks event carries the SKU it changed.
+type stockEvent struct {
+	Service string `json:"service"`
+	Payload struct {
+		SKU uint32 `json:"sku"`
+	} `json:"payload"`
+}
+
+// StockEventConsumer reads the events of the stocks service and invalidates the cached items of
+// their SKUs. The group must be unique per cart instance, so every instance reads all events, and
+// only new events are read, the cache of a started instance is empty.
+type StockEventConsumer struct {
+	consumer    *kafka.Consumer
+	invalidator ISKUInvalidator
+	logger      myLog.Logger
+}
+
+func NewStockEventConsumer(address, topic, group string, invalidator ISKUInvalidator, logger myLog.Logger) (*StockEventConsumer, error) {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  address,
+		"group.id":           group,
+		"session.timeout.ms": sessionTimeoutMs,
+		"enable.auto.commit": true,
+		"auto.offset.reset":  "latest",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = consumer.Subscribe(topic, nil); err != nil {
+		_ = consumer.Close()
+
+		return nil, err
+	}
+
+	return &StockEventConsumer{consumer: consumer, invalidator: invalidator, logger: logger}, nil
+}
+
+// Run reads the events until ctx is done and closes the consumer.
+func (c *StockEventConsumer) Run(ctx context.Context) {
+	defer c.consumer.Close()
+
+	for ctx.Err() == nil {
+		msg, err := c.consumer.ReadMessage(readTimeoutMs)
+		if err != nil {
+			var kafkaErr kafka.Error
+			if errors.As(err, &kafkaErr) && kafkaErr.Code() == kafka.ErrTimedOut {
+				continue
+			}
+
+			c.logger.Warn(errReadMessage, myLog.Error(err))
+
+			continue
+		}
+
+		c.handle(msg.Value)
+	}
+}
+
+func (c *StockEventConsumer) handle(value []byte) {
+	var event stockEvent
+
+	if err := json.Unmarshal(value, &event); err != nil {
+		c.logger.Warn(errParseMessage, myLog.Error(err))
+
+		return
+	}
+
+	if event.Service != stockEventService || event.Payload.SKU == 0 {
+		return
+	}
+
+	c.invalidator.InvalidateSKU(models.SKUID(event.Payload.SKU))
+}
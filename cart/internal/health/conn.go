@@ -0,0 +1,34 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const errConnNotReady = "connection is %s"
+
+// ConnChecker checks that the client connection is ready. An idle connection is connected first,
+// the check waits for it until the timeout of the check.
+func ConnChecker(conn *grpc.ClientConn) CheckFunc {
+	return func(ctx context.Context) error {
+		conn.Connect()
+
+		for {
+			state := conn.GetState()
+
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf(errConnNotReady, state)
+			}
+
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf(errConnNotReady, state)
+			}
+		}
+	}
+}
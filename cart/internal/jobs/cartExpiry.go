@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	myLog "cart/internal/observability/log"
+)
+
+const (
+	errExpireCarts = "failed to expire abandoned carts"
+	infoExpired    = "expired abandoned carts"
+)
+
+type ICartExpirer interface {
+	ExpireCarts(ctx context.Context, before time.Time) (int, error)
+}
+
+type CartExpiryJob struct {
+	expirer  ICartExpirer
+	ttl      time.Duration
+	interval time.Duration
+	logger   myLog.Logger
+}
+
+func NewCartExpiryJob(expirer ICartExpirer, ttl, interval time.Duration, l myLog.Logger) *CartExpiryJob {
+	return &CartExpiryJob{
+		expirer:  expirer,
+		ttl:      ttl,
+		interval: interval,
+		logger:   l,
+	}
+}
+
+// Run expires carts older than ttl every interval until ctx is done.
+func (j *CartExpiryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.expire(ctx)
+		}
+	}
+}
+
+// expire runs batches until no abandoned cart is left, so a backlog is not spread over many intervals.
+func (j *CartExpiryJob) expire(ctx context.Context) {
+	before := time.Now().Add(-j.ttl)
+
+	for ctx.Err() == nil {
+		expired, err := j.expirer.ExpireCarts(ctx, before)
+		if err != nil {
+			j.logger.Error(errExpireCarts, myLog.Error(err))
+
+			return
+		}
+
+		if expired == 0 {
+			return
+		}
+
+		j.logger.Info(infoExpired, myLog.Int("count", expired))
+	}
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS cart_user_id_updated_at_idx;
+
+ALTER TABLE cart
+DROP COLUMN IF EXISTS updated_at,
+DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,5 @@
+ALTER TABLE cart
+ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX cart_user_id_updated_at_idx ON cart (user_id, updated_at);
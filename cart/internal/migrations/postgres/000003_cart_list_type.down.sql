@@ -0,0 +1,7 @@
+DELETE FROM cart WHERE list_type <> 'cart';
+
+ALTER TABLE cart DROP CONSTRAINT IF EXISTS cart_user_id_sku_id_list_type_key;
+
+ALTER TABLE cart ADD CONSTRAINT cart_user_id_sku_id_key UNIQUE (user_id, sku_id);
+
+ALTER TABLE cart DROP COLUMN IF EXISTS list_type;
@@ -0,0 +1,7 @@
+ALTER TABLE cart
+ADD COLUMN list_type VARCHAR(16) NOT NULL DEFAULT 'cart'
+CHECK (list_type IN ('cart', 'wishlist'));
+
+ALTER TABLE cart DROP CONSTRAINT IF EXISTS cart_user_id_sku_id_key;
+
+ALTER TABLE cart ADD CONSTRAINT cart_user_id_sku_id_list_type_key UNIQUE (user_id, sku_id, list_type);
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS cart_version(
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    version BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_key(
+    key TEXT NOT NULL PRIMARY KEY,
+    method TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    response BYTEA,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idempotency_key_expires_at_idx ON idempotency_key (expires_at);
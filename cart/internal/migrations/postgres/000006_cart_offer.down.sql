@@ -0,0 +1 @@
+ALTER TABLE cart DROP COLUMN IF EXISTS offer_id;
@@ -0,0 +1 @@
+ALTER TABLE cart ADD COLUMN IF NOT EXISTS offer_id BIGINT NOT NULL DEFAULT 0;
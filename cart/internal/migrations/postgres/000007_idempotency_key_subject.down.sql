@@ -0,0 +1,8 @@
+-- the keys of different users or methods may collide, they expire soon anyway
+DELETE FROM idempotency_key;
+
+ALTER TABLE idempotency_key DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+
+ALTER TABLE idempotency_key ADD PRIMARY KEY (key);
+
+ALTER TABLE idempotency_key DROP COLUMN IF EXISTS subject;
@@ -1,13 +1,23 @@
 package models
 
+import "time"
+
 type Cart struct {
-	ID     CartID
-	UserID UserID
-	SKUID  SKUID
-	Count  uint16
+	ID      CartID
+	UserID  UserID
+	SKUID   SKUID
+	OfferID OfferID
+	Count   uint16
 }
 
 type CartItem struct {
-	SKUID SKUID
-	Count uint16
+	SKUID   SKUID
+	OfferID OfferID
+	Count   uint16
+}
+
+type AbandonedCart struct {
+	UserID    UserID
+	Items     []CartItem
+	UpdatedAt time.Time
 }
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// IdempotencyKey - key sent by a user for a method, the same key of another user or method is another key.
+type IdempotencyKey struct {
+	Subject string
+	Method  string
+	Key     string
+}
+
+type IdempotencyRecord struct {
+	Subject     string
+	Key         string
+	Method      string
+	RequestHash []byte
+	Response    []byte
+	ExpiresAt   time.Time
+}
@@ -14,6 +14,9 @@ type CartID uint32
 // UserID - type id of user.
 type UserID int64
 
+// OfferID - type id of the offer of a sku by a seller, 0 is the default offer of the sku.
+type OfferID int64
+
 func Int64ToUint32(v int64) (uint32, error) {
 	if v < 0 || v > math.MaxUint32 {
 		return 0, fmt.Errorf("%d out of uint32 range", v)
@@ -6,6 +6,9 @@ type Metrics interface {
 	IncRequest(path string)
 	ObserveLatency(path string, duration float64)
 	IncError(path string)
+	IncThrottled(path, by string)
+	SetBreakerState(name string, state float64)
+	IncCacheResult(cache, result string)
 }
 
 var _ Metrics = &AppMetrics{}
@@ -14,6 +17,9 @@ type AppMetrics struct {
 	RequestCounter  *prometheus.CounterVec
 	ResponseLatency *prometheus.HistogramVec
 	ErrorsTotal     *prometheus.CounterVec
+	ThrottledTotal  *prometheus.CounterVec
+	BreakerState    *prometheus.GaugeVec
+	CacheRequests   *prometheus.CounterVec
 }
 
 func (a *AppMetrics) IncRequest(path string) {
@@ -28,6 +34,21 @@ func (a *AppMetrics) IncError(path string) {
 	a.ErrorsTotal.With(prometheus.Labels{"path": path}).Inc()
 }
 
+// IncThrottled counts a request rejected by the rate limit, by is the key of its bucket: user or ip.
+func (a *AppMetrics) IncThrottled(path, by string) {
+	a.ThrottledTotal.With(prometheus.Labels{"path": path, "by": by}).Inc()
+}
+
+// SetBreakerState sets the state of a circuit breaker: 0 closed, 1 half-open, 2 open.
+func (a *AppMetrics) SetBreakerState(name string, state float64) {
+	a.BreakerState.With(prometheus.Labels{"name": name}).Set(state)
+}
+
+// IncCacheResult counts a read of a cache by its result: hit, miss or stale.
+func (a *AppMetrics) IncCacheResult(cache, result string) {
+	a.CacheRequests.With(prometheus.Labels{"cache": cache, "result": result}).Inc()
+}
+
 func RegisterMetrics() *AppMetrics {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -54,11 +75,38 @@ func RegisterMetrics() *AppMetrics {
 		[]string{"path"},
 	)
 
-	prometheus.MustRegister(requestCounter, responseLatency, errorCounter)
+	throttledCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_throttled_requests_total",
+			Help: "Total number of requests rejected by the rate limit",
+		},
+		[]string{"path", "by"},
+	)
+
+	breakerState := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "circuit_breaker_state",
+			Help: "State of the circuit breaker of a client: 0 closed, 1 half-open, 2 open",
+		},
+		[]string{"name"},
+	)
+
+	cacheRequests := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_requests_total",
+			Help: "Total number of cache reads by result: hit, miss or stale",
+		},
+		[]string{"cache", "result"},
+	)
+
+	prometheus.MustRegister(requestCounter, responseLatency, errorCounter, throttledCounter, breakerState, cacheRequests)
 
 	return &AppMetrics{
 		RequestCounter:  requestCounter,
 		ResponseLatency: responseLatency,
 		ErrorsTotal:     errorCounter,
+		ThrottledTotal:  throttledCounter,
+		BreakerState:    breakerState,
+		CacheRequests:   cacheRequests,
 	}
 }
@@ -14,4 +14,18 @@ type ProducerMessageDTO struct {
 	Count     uint16
 	Status    string
 	Reason    string
+
+	UserID     models.UserID
+	Items      []ItemDTO
+	TotalCount uint32
+	// TotalPrice is left 0, and omitted from the message, if the price of an item is unknown.
+	TotalPrice uint32
+}
+
+// ItemDTO - item of a cart event. The price of an item with PriceUnknown is omitted from the message.
+type ItemDTO struct {
+	SKU          models.SKUID
+	Count        uint16
+	Price        uint32
+	PriceUnknown bool
 }
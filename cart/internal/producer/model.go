@@ -1,11 +1,21 @@
 package producer
 
 type Payload struct {
-	CartID uint32 `json:"cartId"`
-	SKU    uint32 `json:"sku"`
-	Count  uint16 `json:"count"`
-	Status string `json:"status"`
-	Reason string `json:"reason,omitempty"`
+	CartID     uint32        `json:"cartId"`
+	SKU        uint32        `json:"sku"`
+	Count      uint16        `json:"count"`
+	Status     string        `json:"status"`
+	Reason     string        `json:"reason,omitempty"`
+	UserID     int64         `json:"userId,omitempty"`
+	Items      []PayloadItem `json:"items,omitempty"`
+	TotalCount uint32        `json:"totalCount,omitempty"`
+	TotalPrice uint32        `json:"totalPrice,omitempty"`
+}
+
+type PayloadItem struct {
+	SKU   uint32  `json:"sku"`
+	Count uint16  `json:"count"`
+	Price *uint32 `json:"price,omitempty"`
 }
 
 type Message struct {
@@ -1,6 +1,7 @@
 package producer
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,7 +14,8 @@ import (
 const (
 	acks = 0
 
-	flushTimeout = 5000
+	flushTimeout    = 5000
+	metadataTimeout = 5000
 
 	partitionID = 0
 
@@ -21,6 +23,7 @@ const (
 	ErrSendMsg        = "error sending message to kafka: %v"
 	ErrMarshallMsg    = "error marshaling message to json: %v"
 	ErrKafkaRespond   = "error kafka respond: %v"
+	ErrKafkaMetadata  = "error requesting kafka metadata: %v"
 )
 
 var (
@@ -46,16 +49,33 @@ func NewProducer(address string) (*Producer, error) {
 }
 
 func (p *Producer) Produce(dto ProducerMessageDTO, topic string, t time.Time) error {
+	items := make([]PayloadItem, len(dto.Items))
+
+	for i, item := range dto.Items {
+		items[i] = PayloadItem{
+			SKU:   uint32(item.SKU),
+			Count: item.Count,
+		}
+
+		if !item.PriceUnknown {
+			items[i].Price = &item.Price
+		}
+	}
+
 	message := Message{
 		Type:      dto.Type,
 		Service:   dto.Service,
 		Timestamp: dto.Timestamp.Format(time.RFC3339),
 		Payload: Payload{
-			CartID: uint32(dto.CartID),
-			SKU:    uint32(dto.SKU),
-			Count:  dto.Count,
-			Status: dto.Status,
-			Reason: dto.Reason,
+			CartID:     uint32(dto.CartID),
+			SKU:        uint32(dto.SKU),
+			Count:      dto.Count,
+			Status:     dto.Status,
+			Reason:     dto.Reason,
+			UserID:     int64(dto.UserID),
+			Items:      items,
+			TotalCount: dto.TotalCount,
+			TotalPrice: dto.TotalPrice,
 		},
 	}
 
@@ -95,6 +115,20 @@ func (p *Producer) Produce(dto ProducerMessageDTO, topic string, t time.Time) er
 	return err
 }
 
+// Check requests the metadata of the cluster, which fails while no broker is reachable.
+func (p *Producer) Check(ctx context.Context) error {
+	timeout := metadataTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = int(time.Until(deadline).Milliseconds())
+	}
+
+	if _, err := p.producer.GetMetadata(nil, false, timeout); err != nil {
+		return fmt.Errorf(ErrKafkaMetadata, err)
+	}
+
+	return nil
+}
+
 func (p *Producer) Close() {
 	p.producer.Flush(flushTimeout)
 	p.producer.Close()
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAllow(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	limiter := NewLimiter(Rule{Rate: 1, Burst: 2}, map[string]Rule{
+		"ListItem":                    {Rate: 0.5, Burst: 1},
+		"/cart.CartService/ClearCart": {},
+	})
+	limiter.now = func() time.Time { return now }
+
+	type call struct {
+		method   string
+		key      string
+		advance  time.Duration
+		want     bool
+		wantWait time.Duration
+	}
+
+	calls := []call{
+		{method: "/cart.CartService/AddItem", key: "user:1", want: true},
+		{method: "/cart.CartService/AddItem", key: "user:1", want: true},
+		{method: "/cart.CartService/AddItem", key: "user:1", want: false, wantWait: time.Second},
+		{method: "/cart.CartService/AddItem", key: "user:2", want: true},
+		{method: "/cart.CartService/AddItem", key: "user:1", advance: 500 * time.Millisecond, want: false, wantWait: 500 * time.Millisecond},
+		{method: "/cart.CartService/AddItem", key: "user:1", advance: 500 * time.Millisecond, want: true},
+		{method: "/cart.CartService/ListItem", key: "user:1", want: true},
+		{method: "/cart.CartService/ListItem", key: "user:1", want: false, wantWait: 2 * time.Second},
+		{method: "/cart.CartService/ClearCart", key: "user:1", want: true},
+		{method: "/cart.CartService/ClearCart", key: "user:1", want: true},
+	}
+
+	for i, c := range calls {
+		now = now.Add(c.advance)
+
+		got, wait := limiter.Allow(c.method, c.key)
+		if got != c.want || wait != c.wantWait {
+			t.Errorf("call %d: Allow(%s, %s) = %v, %v, want %v, %v", i, c.method, c.key, got, wait, c.want, c.wantWait)
+		}
+	}
+
+	now = now.Add(pruneInterval)
+	limiter.Allow("/cart.CartService/AddItem", "user:3")
+
+	if len(limiter.buckets) != 1 {
+		t.Errorf("buckets after prune = %d, want 1", len(limiter.buckets))
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		want    map[string]Rule
+		wantErr error
+	}{
+		{
+			name:  "rules",
+			rules: "ListItem=5:10, /cart.CartService/AddItem=2.5,ClearCart=0",
+			want: map[string]Rule{
+				"ListItem":                  {Rate: 5, Burst: 10},
+				"/cart.CartService/AddItem": {Rate: 2.5, Burst: 3},
+				"ClearCart":                 {Rate: 0, Burst: 0},
+			},
+		},
+		{name: "empty", rules: "", want: map[string]Rule{}},
+		{name: "no rule", rules: "ListItem", wantErr: ErrInvalidRule},
+		{name: "invalid rate", rules: "ListItem=fast", wantErr: ErrInvalidRule},
+		{name: "invalid burst", rules: "ListItem=5:0", wantErr: ErrInvalidRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRules(tt.rules)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseRules() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
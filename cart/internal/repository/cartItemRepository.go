@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -15,12 +16,33 @@ var (
 )
 
 const (
-	getCartIDQuery         = `SELECT id FROM cart WHERE user_id = $1 AND sku_id = $2`
-	updateItemQuery        = `UPDATE cart SET count = count + $1 WHERE id = $2`
-	addItemQuery           = `INSERT INTO cart (user_id, sku_id, count) VALUES ($1, $2, $3)`
-	deleteItemQuery        = `DELETE FROM cart WHERE user_id = $1 AND sku_id = $2`
-	getCartByUserIDQuery   = `SELECT sku_id, count FROM cart WHERE user_id = $1`
-	clearCartByUserIDQuery = `DELETE FROM cart WHERE user_id = $1`
+	getCartIDQuery   = `SELECT id FROM cart WHERE user_id = $1 AND sku_id = $2 AND list_type = 'cart'`
+	getCartItemQuery = `SELECT id, count, offer_id FROM cart WHERE user_id = $1 AND sku_id = $2 AND list_type = 'cart'`
+	updateItemQuery  = `UPDATE cart SET count = count + $1, offer_id = $3, updated_at = now() WHERE id = $2`
+	addItemQuery     = `INSERT INTO cart (user_id, sku_id, count, offer_id) VALUES ($1, $2, $3, $4)`
+	setItemQuery     = `INSERT INTO cart (user_id, sku_id, count, offer_id) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, sku_id, list_type) DO UPDATE SET count = EXCLUDED.count, offer_id = EXCLUDED.offer_id,
+		updated_at = now()`
+	deleteItemQuery        = `DELETE FROM cart WHERE user_id = $1 AND sku_id = $2 AND list_type = 'cart'`
+	getCartByUserIDQuery   = `SELECT sku_id, offer_id, count FROM cart WHERE user_id = $1 AND list_type = 'cart'`
+	clearCartByUserIDQuery = `DELETE FROM cart WHERE user_id = $1 AND list_type = 'cart'`
+	getAbandonedCartsQuery = `SELECT c.user_id, c.sku_id, c.offer_id, c.count, a.updated_at FROM cart c
+		INNER JOIN (SELECT user_id, MAX(updated_at) AS updated_at FROM cart WHERE list_type = 'cart'
+			GROUP BY user_id HAVING MAX(updated_at) < $1 ORDER BY user_id LIMIT $2) a
+		ON a.user_id = c.user_id WHERE c.list_type = 'cart' ORDER BY c.user_id, c.sku_id`
+	deleteAbandonedCartQuery = `DELETE FROM cart WHERE user_id = $1 AND list_type = 'cart'
+		AND NOT EXISTS (SELECT 1 FROM cart WHERE user_id = $1 AND list_type = 'cart' AND updated_at >= $2)`
+
+	getWishlistItemQuery = `SELECT count, offer_id FROM cart WHERE user_id = $1 AND sku_id = $2 AND list_type = 'wishlist'`
+	addWishlistItemQuery = `INSERT INTO cart (user_id, sku_id, count, offer_id, list_type) VALUES ($1, $2, $3, $4, 'wishlist')
+		ON CONFLICT (user_id, sku_id, list_type) DO UPDATE SET count = cart.count + EXCLUDED.count,
+		offer_id = EXCLUDED.offer_id, updated_at = now()`
+	deleteWishlistItemQuery  = `DELETE FROM cart WHERE user_id = $1 AND sku_id = $2 AND list_type = 'wishlist'`
+	getWishlistByUserIDQuery = `SELECT sku_id, offer_id, count FROM cart WHERE user_id = $1 AND list_type = 'wishlist'`
+
+	getVersionQuery       = `SELECT version FROM cart_version WHERE user_id = $1`
+	incrementVersionQuery = `INSERT INTO cart_version (user_id, version) VALUES ($1, 1)
+		ON CONFLICT (user_id) DO UPDATE SET version = cart_version.version + 1 RETURNING version`
 )
 
 type IDBQuery interface {
@@ -33,11 +55,21 @@ type IDBQuery interface {
 //go:generate minimock -o ./mock/ -s .go  -g
 type ICartRepo interface {
 	GetCartID(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.CartID, error)
+	GetCartItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.Cart, error)
 	UpdateItemByUserID(ctx context.Context, cart models.Cart) error
 	AddItem(ctx context.Context, cart models.Cart) error
+	SetItem(ctx context.Context, cart models.Cart) error
 	DeleteItem(ctx context.Context, userID models.UserID, skuID models.SKUID) error
 	GetCartByUserID(ctx context.Context, userID models.UserID) ([]models.CartItem, error)
 	ClearCartByUserID(ctx context.Context, userID models.UserID) error
+	GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]models.AbandonedCart, error)
+	DeleteAbandonedCart(ctx context.Context, userID models.UserID, before time.Time) error
+	GetWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.CartItem, error)
+	AddWishlistItem(ctx context.Context, cart models.Cart) error
+	DeleteWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) error
+	GetWishlistByUserID(ctx context.Context, userID models.UserID) ([]models.CartItem, error)
+	GetVersion(ctx context.Context, userID models.UserID) (uint64, error)
+	IncrementVersion(ctx context.Context, userID models.UserID) (uint64, error)
 }
 
 type CartRepo struct {
@@ -68,8 +100,38 @@ func (c *CartRepo) GetCartID(ctx context.Context, userID models.UserID, skuID mo
 	return models.CartID(cartID), nil
 }
 
+func (c *CartRepo) GetCartItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.Cart, error) {
+	var (
+		id      int64
+		count   uint16
+		offerID int64
+	)
+
+	err := c.db.QueryRow(ctx, getCartItemQuery, userID, skuID).Scan(&id, &count, &offerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Cart{}, ErrNotFound
+		}
+
+		return models.Cart{}, err
+	}
+
+	cartID, err := models.Int64ToUint32(id)
+	if err != nil {
+		return models.Cart{}, fmt.Errorf("cart_id %s", err.Error())
+	}
+
+	return models.Cart{
+		ID:      models.CartID(cartID),
+		UserID:  userID,
+		SKUID:   skuID,
+		OfferID: models.OfferID(offerID),
+		Count:   count,
+	}, nil
+}
+
 func (c *CartRepo) UpdateItemByUserID(ctx context.Context, cart models.Cart) error {
-	tag, err := c.db.Exec(ctx, updateItemQuery, cart.Count, cart.ID)
+	tag, err := c.db.Exec(ctx, updateItemQuery, cart.Count, cart.ID, cart.OfferID)
 	if err != nil {
 		return err
 	}
@@ -82,7 +144,7 @@ func (c *CartRepo) UpdateItemByUserID(ctx context.Context, cart models.Cart) err
 }
 
 func (c *CartRepo) AddItem(ctx context.Context, cart models.Cart) error {
-	_, err := c.db.Exec(ctx, addItemQuery, cart.UserID, cart.SKUID, cart.Count)
+	_, err := c.db.Exec(ctx, addItemQuery, cart.UserID, cart.SKUID, cart.Count, cart.OfferID)
 	if err != nil {
 		return err
 	}
@@ -90,6 +152,12 @@ func (c *CartRepo) AddItem(ctx context.Context, cart models.Cart) error {
 	return nil
 }
 
+func (c *CartRepo) SetItem(ctx context.Context, cart models.Cart) error {
+	_, err := c.db.Exec(ctx, setItemQuery, cart.UserID, cart.SKUID, cart.Count, cart.OfferID)
+
+	return err
+}
+
 func (c *CartRepo) DeleteItem(ctx context.Context, userID models.UserID, skuID models.SKUID) error {
 	tag, err := c.db.Exec(ctx, deleteItemQuery, userID, skuID)
 	if err != nil {
@@ -104,7 +172,11 @@ func (c *CartRepo) DeleteItem(ctx context.Context, userID models.UserID, skuID m
 }
 
 func (c *CartRepo) GetCartByUserID(ctx context.Context, userID models.UserID) ([]models.CartItem, error) {
-	rows, err := c.db.Query(ctx, getCartByUserIDQuery, userID)
+	return c.getItemsByUserID(ctx, getCartByUserIDQuery, userID)
+}
+
+func (c *CartRepo) getItemsByUserID(ctx context.Context, query string, userID models.UserID) ([]models.CartItem, error) {
+	rows, err := c.db.Query(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +187,7 @@ func (c *CartRepo) GetCartByUserID(ctx context.Context, userID models.UserID) ([
 
 	for rows.Next() {
 		var dbItem cartItemDB
-		if err := rows.Scan(&dbItem.SKUID, &dbItem.Count); err != nil {
+		if err := rows.Scan(&dbItem.SKUID, &dbItem.OfferID, &dbItem.Count); err != nil {
 			return nil, err
 		}
 
@@ -125,8 +197,9 @@ func (c *CartRepo) GetCartByUserID(ctx context.Context, userID models.UserID) ([
 		}
 
 		items = append(items, models.CartItem{
-			SKUID: models.SKUID(skuID),
-			Count: dbItem.Count,
+			SKUID:   models.SKUID(skuID),
+			OfferID: models.OfferID(dbItem.OfferID),
+			Count:   dbItem.Count,
 		})
 	}
 
@@ -149,3 +222,128 @@ func (c *CartRepo) ClearCartByUserID(ctx context.Context, userID models.UserID)
 
 	return nil
 }
+
+func (c *CartRepo) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]models.AbandonedCart, error) {
+	rows, err := c.db.Query(ctx, getAbandonedCartsQuery, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var carts []models.AbandonedCart
+
+	for rows.Next() {
+		var dbItem abandonedCartItemDB
+		if err := rows.Scan(&dbItem.UserID, &dbItem.SKUID, &dbItem.OfferID, &dbItem.Count, &dbItem.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		skuID, err := models.Int64ToUint32(dbItem.SKUID)
+		if err != nil {
+			return nil, fmt.Errorf("sku_id %s", err.Error())
+		}
+
+		userID := models.UserID(dbItem.UserID)
+
+		if len(carts) == 0 || carts[len(carts)-1].UserID != userID {
+			carts = append(carts, models.AbandonedCart{
+				UserID:    userID,
+				UpdatedAt: dbItem.UpdatedAt,
+			})
+		}
+
+		last := &carts[len(carts)-1]
+		last.Items = append(last.Items, models.CartItem{
+			SKUID:   models.SKUID(skuID),
+			OfferID: models.OfferID(dbItem.OfferID),
+			Count:   dbItem.Count,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return carts, nil
+}
+
+func (c *CartRepo) DeleteAbandonedCart(ctx context.Context, userID models.UserID, before time.Time) error {
+	tag, err := c.db.Exec(ctx, deleteAbandonedCartQuery, userID, before)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *CartRepo) GetWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.CartItem, error) {
+	var count uint16
+	var offerID int64
+
+	err := c.db.QueryRow(ctx, getWishlistItemQuery, userID, skuID).Scan(&count, &offerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.CartItem{}, ErrNotFound
+		}
+
+		return models.CartItem{}, err
+	}
+
+	return models.CartItem{SKUID: skuID, OfferID: models.OfferID(offerID), Count: count}, nil
+}
+
+func (c *CartRepo) AddWishlistItem(ctx context.Context, cart models.Cart) error {
+	_, err := c.db.Exec(ctx, addWishlistItemQuery, cart.UserID, cart.SKUID, cart.Count, cart.OfferID)
+
+	return err
+}
+
+func (c *CartRepo) DeleteWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) error {
+	tag, err := c.db.Exec(ctx, deleteWishlistItemQuery, userID, skuID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *CartRepo) GetWishlistByUserID(ctx context.Context, userID models.UserID) ([]models.CartItem, error) {
+	return c.getItemsByUserID(ctx, getWishlistByUserIDQuery, userID)
+}
+
+func (c *CartRepo) GetVersion(ctx context.Context, userID models.UserID) (uint64, error) {
+	var version int64
+
+	err := c.db.QueryRow(ctx, getVersionQuery, userID).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return uint64(version), nil
+}
+
+// IncrementVersion bumps the cart version of the user and returns the new one.
+// The version row stays locked until the end of the transaction.
+func (c *CartRepo) IncrementVersion(ctx context.Context, userID models.UserID) (uint64, error) {
+	var version int64
+
+	err := c.db.QueryRow(ctx, incrementVersionQuery, userID).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(version), nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"cart/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	reserveKeyQuery = `INSERT INTO idempotency_key (subject, method, key, request_hash, expires_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (subject, method, key) DO UPDATE SET request_hash = EXCLUDED.request_hash,
+			response = NULL, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_key.expires_at < now() RETURNING key`
+	getKeyQuery = `SELECT request_hash, response, expires_at FROM idempotency_key
+		WHERE subject = $1 AND method = $2 AND key = $3`
+	saveResponseQuery      = `UPDATE idempotency_key SET response = $4 WHERE subject = $1 AND method = $2 AND key = $3`
+	deleteKeyQuery         = `DELETE FROM idempotency_key WHERE subject = $1 AND method = $2 AND key = $3`
+	deleteExpiredKeysQuery = `DELETE FROM idempotency_key WHERE expires_at < now()`
+)
+
+type IIdempotencyRepo interface {
+	ReserveKey(ctx context.Context, record models.IdempotencyRecord) (bool, error)
+	GetKey(ctx context.Context, key models.IdempotencyKey) (models.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, key models.IdempotencyKey, response []byte) error
+	DeleteKey(ctx context.Context, key models.IdempotencyKey) error
+	DeleteExpiredKeys(ctx context.Context) (int64, error)
+}
+
+type IdempotencyRepo struct {
+	db IDBQuery
+}
+
+func NewIdempotencyRepository(db IDBQuery) *IdempotencyRepo {
+	return &IdempotencyRepo{db: db}
+}
+
+// ReserveKey stores the key without a response. It returns false if the key is already
+// taken by the user for the method and not expired yet; an expired key is taken over.
+func (r *IdempotencyRepo) ReserveKey(ctx context.Context, record models.IdempotencyRecord) (bool, error) {
+	var key string
+
+	err := r.db.QueryRow(ctx, reserveKeyQuery, record.Subject, record.Method, record.Key, record.RequestHash,
+		record.ExpiresAt).Scan(&key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *IdempotencyRepo) GetKey(ctx context.Context, key models.IdempotencyKey) (models.IdempotencyRecord, error) {
+	record := models.IdempotencyRecord{Subject: key.Subject, Key: key.Key, Method: key.Method}
+
+	err := r.db.QueryRow(ctx, getKeyQuery, key.Subject, key.Method, key.Key).
+		Scan(&record.RequestHash, &record.Response, &record.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.IdempotencyRecord{}, ErrNotFound
+		}
+
+		return models.IdempotencyRecord{}, err
+	}
+
+	return record, nil
+}
+
+func (r *IdempotencyRepo) SaveResponse(ctx context.Context, key models.IdempotencyKey, response []byte) error {
+	tag, err := r.db.Exec(ctx, saveResponseQuery, key.Subject, key.Method, key.Key, response)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepo) DeleteKey(ctx context.Context, key models.IdempotencyKey) error {
+	_, err := r.db.Exec(ctx, deleteKeyQuery, key.Subject, key.Method, key.Key)
+
+	return err
+}
+
+func (r *IdempotencyRepo) DeleteExpiredKeys(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, deleteExpiredKeysQuery)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -24,6 +25,13 @@ type ICartRepoMock struct {
 	beforeAddItemCounter uint64
 	AddItemMock          mICartRepoMockAddItem
 
+	funcAddWishlistItem          func(ctx context.Context, cart models.Cart) (err error)
+	funcAddWishlistItemOrigin    string
+	inspectFuncAddWishlistItem   func(ctx context.Context, cart models.Cart)
+	afterAddWishlistItemCounter  uint64
+	beforeAddWishlistItemCounter uint64
+	AddWishlistItemMock          mICartRepoMockAddWishlistItem
+
 	funcClearCartByUserID          func(ctx context.Context, userID models.UserID) (err error)
 	funcClearCartByUserIDOrigin    string
 	inspectFuncClearCartByUserID   func(ctx context.Context, userID models.UserID)
@@ -31,6 +39,13 @@ type ICartRepoMock struct {
 	beforeClearCartByUserIDCounter uint64
 	ClearCartByUserIDMock          mICartRepoMockClearCartByUserID
 
+	funcDeleteAbandonedCart          func(ctx context.Context, userID models.UserID, before time.Time) (err error)
+	funcDeleteAbandonedCartOrigin    string
+	inspectFuncDeleteAbandonedCart   func(ctx context.Context, userID models.UserID, before time.Time)
+	afterDeleteAbandonedCartCounter  uint64
+	beforeDeleteAbandonedCartCounter uint64
+	DeleteAbandonedCartMock          mICartRepoMockDeleteAbandonedCart
+
 	funcDeleteItem          func(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error)
 	funcDeleteItemOrigin    string
 	inspectFuncDeleteItem   func(ctx context.Context, userID models.UserID, skuID models.SKUID)
@@ -38,6 +53,20 @@ type ICartRepoMock struct {
 	beforeDeleteItemCounter uint64
 	DeleteItemMock          mICartRepoMockDeleteItem
 
+	funcDeleteWishlistItem          func(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error)
+	funcDeleteWishlistItemOrigin    string
+	inspectFuncDeleteWishlistItem   func(ctx context.Context, userID models.UserID, skuID models.SKUID)
+	afterDeleteWishlistItemCounter  uint64
+	beforeDeleteWishlistItemCounter uint64
+	DeleteWishlistItemMock          mICartRepoMockDeleteWishlistItem
+
+	funcGetAbandonedCarts          func(ctx context.Context, before time.Time, limit int) (aa1 []models.AbandonedCart, err error)
+	funcGetAbandonedCartsOrigin    string
+	inspectFuncGetAbandonedCarts   func(ctx context.Context, before time.Time, limit int)
+	afterGetAbandonedCartsCounter  uint64
+	beforeGetAbandonedCartsCounter uint64
+	GetAbandonedCartsMock          mICartRepoMockGetAbandonedCarts
+
 	funcGetCartByUserID          func(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error)
 	funcGetCartByUserIDOrigin    string
 	inspectFuncGetCartByUserID   func(ctx context.Context, userID models.UserID)
@@ -52,6 +81,48 @@ type ICartRepoMock struct {
 	beforeGetCartIDCounter uint64
 	GetCartIDMock          mICartRepoMockGetCartID
 
+	funcGetCartItem          func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.Cart, err error)
+	funcGetCartItemOrigin    string
+	inspectFuncGetCartItem   func(ctx context.Context, userID models.UserID, skuID models.SKUID)
+	afterGetCartItemCounter  uint64
+	beforeGetCartItemCounter uint64
+	GetCartItemMock          mICartRepoMockGetCartItem
+
+	funcGetVersion          func(ctx context.Context, userID models.UserID) (u1 uint64, err error)
+	funcGetVersionOrigin    string
+	inspectFuncGetVersion   func(ctx context.Context, userID models.UserID)
+	afterGetVersionCounter  uint64
+	beforeGetVersionCounter uint64
+	GetVersionMock          mICartRepoMockGetVersion
+
+	funcGetWishlistByUserID          func(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error)
+	funcGetWishlistByUserIDOrigin    string
+	inspectFuncGetWishlistByUserID   func(ctx context.Context, userID models.UserID)
+	afterGetWishlistByUserIDCounter  uint64
+	beforeGetWishlistByUserIDCounter uint64
+	GetWishlistByUserIDMock          mICartRepoMockGetWishlistByUserID
+
+	funcGetWishlistItem          func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartItem, err error)
+	funcGetWishlistItemOrigin    string
+	inspectFuncGetWishlistItem   func(ctx context.Context, userID models.UserID, skuID models.SKUID)
+	afterGetWishlistItemCounter  uint64
+	beforeGetWishlistItemCounter uint64
+	GetWishlistItemMock          mICartRepoMockGetWishlistItem
+
+	funcIncrementVersion          func(ctx context.Context, userID models.UserID) (u1 uint64, err error)
+	funcIncrementVersionOrigin    string
+	inspectFuncIncrementVersion   func(ctx context.Context, userID models.UserID)
+	afterIncrementVersionCounter  uint64
+	beforeIncrementVersionCounter uint64
+	IncrementVersionMock          mICartRepoMockIncrementVersion
+
+	funcSetItem          func(ctx context.Context, cart models.Cart) (err error)
+	funcSetItemOrigin    string
+	inspectFuncSetItem   func(ctx context.Context, cart models.Cart)
+	afterSetItemCounter  uint64
+	beforeSetItemCounter uint64
+	SetItemMock          mICartRepoMockSetItem
+
 	funcUpdateItemByUserID          func(ctx context.Context, cart models.Cart) (err error)
 	funcUpdateItemByUserIDOrigin    string
 	inspectFuncUpdateItemByUserID   func(ctx context.Context, cart models.Cart)
@@ -71,18 +142,48 @@ func NewICartRepoMock(t minimock.Tester) *ICartRepoMock {
 	m.AddItemMock = mICartRepoMockAddItem{mock: m}
 	m.AddItemMock.callArgs = []*ICartRepoMockAddItemParams{}
 
+	m.AddWishlistItemMock = mICartRepoMockAddWishlistItem{mock: m}
+	m.AddWishlistItemMock.callArgs = []*ICartRepoMockAddWishlistItemParams{}
+
 	m.ClearCartByUserIDMock = mICartRepoMockClearCartByUserID{mock: m}
 	m.ClearCartByUserIDMock.callArgs = []*ICartRepoMockClearCartByUserIDParams{}
 
+	m.DeleteAbandonedCartMock = mICartRepoMockDeleteAbandonedCart{mock: m}
+	m.DeleteAbandonedCartMock.callArgs = []*ICartRepoMockDeleteAbandonedCartParams{}
+
 	m.DeleteItemMock = mICartRepoMockDeleteItem{mock: m}
 	m.DeleteItemMock.callArgs = []*ICartRepoMockDeleteItemParams{}
 
+	m.DeleteWishlistItemMock = mICartRepoMockDeleteWishlistItem{mock: m}
+	m.DeleteWishlistItemMock.callArgs = []*ICartRepoMockDeleteWishlistItemParams{}
+
+	m.GetAbandonedCartsMock = mICartRepoMockGetAbandonedCarts{mock: m}
+	m.GetAbandonedCartsMock.callArgs = []*ICartRepoMockGetAbandonedCartsParams{}
+
 	m.GetCartByUserIDMock = mICartRepoMockGetCartByUserID{mock: m}
 	m.GetCartByUserIDMock.callArgs = []*ICartRepoMockGetCartByUserIDParams{}
 
 	m.GetCartIDMock = mICartRepoMockGetCartID{mock: m}
 	m.GetCartIDMock.callArgs = []*ICartRepoMockGetCartIDParams{}
 
+	m.GetCartItemMock = mICartRepoMockGetCartItem{mock: m}
+	m.GetCartItemMock.callArgs = []*ICartRepoMockGetCartItemParams{}
+
+	m.GetVersionMock = mICartRepoMockGetVersion{mock: m}
+	m.GetVersionMock.callArgs = []*ICartRepoMockGetVersionParams{}
+
+	m.GetWishlistByUserIDMock = mICartRepoMockGetWishlistByUserID{mock: m}
+	m.GetWishlistByUserIDMock.callArgs = []*ICartRepoMockGetWishlistByUserIDParams{}
+
+	m.GetWishlistItemMock = mICartRepoMockGetWishlistItem{mock: m}
+	m.GetWishlistItemMock.callArgs = []*ICartRepoMockGetWishlistItemParams{}
+
+	m.IncrementVersionMock = mICartRepoMockIncrementVersion{mock: m}
+	m.IncrementVersionMock.callArgs = []*ICartRepoMockIncrementVersionParams{}
+
+	m.SetItemMock = mICartRepoMockSetItem{mock: m}
+	m.SetItemMock.callArgs = []*ICartRepoMockSetItemParams{}
+
 	m.UpdateItemByUserIDMock = mICartRepoMockUpdateItemByUserID{mock: m}
 	m.UpdateItemByUserIDMock.callArgs = []*ICartRepoMockUpdateItemByUserIDParams{}
 
@@ -433,6 +534,348 @@ func (m *ICartRepoMock) MinimockAddItemInspect() {
 	}
 }
 
+type mICartRepoMockAddWishlistItem struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockAddWishlistItemExpectation
+	expectations       []*ICartRepoMockAddWishlistItemExpectation
+
+	callArgs []*ICartRepoMockAddWishlistItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockAddWishlistItemExpectation specifies expectation struct of the ICartRepo.AddWishlistItem
+type ICartRepoMockAddWishlistItemExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockAddWishlistItemParams
+	paramPtrs          *ICartRepoMockAddWishlistItemParamPtrs
+	expectationOrigins ICartRepoMockAddWishlistItemExpectationOrigins
+	results            *ICartRepoMockAddWishlistItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockAddWishlistItemParams contains parameters of the ICartRepo.AddWishlistItem
+type ICartRepoMockAddWishlistItemParams struct {
+	ctx  context.Context
+	cart models.Cart
+}
+
+// ICartRepoMockAddWishlistItemParamPtrs contains pointers to parameters of the ICartRepo.AddWishlistItem
+type ICartRepoMockAddWishlistItemParamPtrs struct {
+	ctx  *context.Context
+	cart *models.Cart
+}
+
+// ICartRepoMockAddWishlistItemResults contains results of the ICartRepo.AddWishlistItem
+type ICartRepoMockAddWishlistItemResults struct {
+	err error
+}
+
+// ICartRepoMockAddWishlistItemOrigins contains origins of expectations of the ICartRepo.AddWishlistItem
+type ICartRepoMockAddWishlistItemExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCart string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Optional() *mICartRepoMockAddWishlistItem {
+	mmAddWishlistItem.optional = true
+	return mmAddWishlistItem
+}
+
+// Expect sets up expected params for ICartRepo.AddWishlistItem
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Expect(ctx context.Context, cart models.Cart) *mICartRepoMockAddWishlistItem {
+	if mmAddWishlistItem.mock.funcAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Set")
+	}
+
+	if mmAddWishlistItem.defaultExpectation == nil {
+		mmAddWishlistItem.defaultExpectation = &ICartRepoMockAddWishlistItemExpectation{}
+	}
+
+	if mmAddWishlistItem.defaultExpectation.paramPtrs != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by ExpectParams functions")
+	}
+
+	mmAddWishlistItem.defaultExpectation.params = &ICartRepoMockAddWishlistItemParams{ctx, cart}
+	mmAddWishlistItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddWishlistItem.expectations {
+		if minimock.Equal(e.params, mmAddWishlistItem.defaultExpectation.params) {
+			mmAddWishlistItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddWishlistItem.defaultExpectation.params)
+		}
+	}
+
+	return mmAddWishlistItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.AddWishlistItem
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockAddWishlistItem {
+	if mmAddWishlistItem.mock.funcAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Set")
+	}
+
+	if mmAddWishlistItem.defaultExpectation == nil {
+		mmAddWishlistItem.defaultExpectation = &ICartRepoMockAddWishlistItemExpectation{}
+	}
+
+	if mmAddWishlistItem.defaultExpectation.params != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Expect")
+	}
+
+	if mmAddWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmAddWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockAddWishlistItemParamPtrs{}
+	}
+	mmAddWishlistItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddWishlistItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddWishlistItem
+}
+
+// ExpectCartParam2 sets up expected param cart for ICartRepo.AddWishlistItem
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) ExpectCartParam2(cart models.Cart) *mICartRepoMockAddWishlistItem {
+	if mmAddWishlistItem.mock.funcAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Set")
+	}
+
+	if mmAddWishlistItem.defaultExpectation == nil {
+		mmAddWishlistItem.defaultExpectation = &ICartRepoMockAddWishlistItemExpectation{}
+	}
+
+	if mmAddWishlistItem.defaultExpectation.params != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Expect")
+	}
+
+	if mmAddWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmAddWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockAddWishlistItemParamPtrs{}
+	}
+	mmAddWishlistItem.defaultExpectation.paramPtrs.cart = &cart
+	mmAddWishlistItem.defaultExpectation.expectationOrigins.originCart = minimock.CallerInfo(1)
+
+	return mmAddWishlistItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.AddWishlistItem
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Inspect(f func(ctx context.Context, cart models.Cart)) *mICartRepoMockAddWishlistItem {
+	if mmAddWishlistItem.mock.inspectFuncAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.AddWishlistItem")
+	}
+
+	mmAddWishlistItem.mock.inspectFuncAddWishlistItem = f
+
+	return mmAddWishlistItem
+}
+
+// Return sets up results that will be returned by ICartRepo.AddWishlistItem
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Return(err error) *ICartRepoMock {
+	if mmAddWishlistItem.mock.funcAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Set")
+	}
+
+	if mmAddWishlistItem.defaultExpectation == nil {
+		mmAddWishlistItem.defaultExpectation = &ICartRepoMockAddWishlistItemExpectation{mock: mmAddWishlistItem.mock}
+	}
+	mmAddWishlistItem.defaultExpectation.results = &ICartRepoMockAddWishlistItemResults{err}
+	mmAddWishlistItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddWishlistItem.mock
+}
+
+// Set uses given function f to mock the ICartRepo.AddWishlistItem method
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Set(f func(ctx context.Context, cart models.Cart) (err error)) *ICartRepoMock {
+	if mmAddWishlistItem.defaultExpectation != nil {
+		mmAddWishlistItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.AddWishlistItem method")
+	}
+
+	if len(mmAddWishlistItem.expectations) > 0 {
+		mmAddWishlistItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.AddWishlistItem method")
+	}
+
+	mmAddWishlistItem.mock.funcAddWishlistItem = f
+	mmAddWishlistItem.mock.funcAddWishlistItemOrigin = minimock.CallerInfo(1)
+	return mmAddWishlistItem.mock
+}
+
+// When sets expectation for the ICartRepo.AddWishlistItem which will trigger the result defined by the following
+// Then helper
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) When(ctx context.Context, cart models.Cart) *ICartRepoMockAddWishlistItemExpectation {
+	if mmAddWishlistItem.mock.funcAddWishlistItem != nil {
+		mmAddWishlistItem.mock.t.Fatalf("ICartRepoMock.AddWishlistItem mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockAddWishlistItemExpectation{
+		mock:               mmAddWishlistItem.mock,
+		params:             &ICartRepoMockAddWishlistItemParams{ctx, cart},
+		expectationOrigins: ICartRepoMockAddWishlistItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddWishlistItem.expectations = append(mmAddWishlistItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.AddWishlistItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockAddWishlistItemExpectation) Then(err error) *ICartRepoMock {
+	e.results = &ICartRepoMockAddWishlistItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.AddWishlistItem should be invoked
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Times(n uint64) *mICartRepoMockAddWishlistItem {
+	if n == 0 {
+		mmAddWishlistItem.mock.t.Fatalf("Times of ICartRepoMock.AddWishlistItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddWishlistItem.expectedInvocations, n)
+	mmAddWishlistItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddWishlistItem
+}
+
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) invocationsDone() bool {
+	if len(mmAddWishlistItem.expectations) == 0 && mmAddWishlistItem.defaultExpectation == nil && mmAddWishlistItem.mock.funcAddWishlistItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddWishlistItem.mock.afterAddWishlistItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddWishlistItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddWishlistItem implements mm_repository.ICartRepo
+func (mmAddWishlistItem *ICartRepoMock) AddWishlistItem(ctx context.Context, cart models.Cart) (err error) {
+	mm_atomic.AddUint64(&mmAddWishlistItem.beforeAddWishlistItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddWishlistItem.afterAddWishlistItemCounter, 1)
+
+	mmAddWishlistItem.t.Helper()
+
+	if mmAddWishlistItem.inspectFuncAddWishlistItem != nil {
+		mmAddWishlistItem.inspectFuncAddWishlistItem(ctx, cart)
+	}
+
+	mm_params := ICartRepoMockAddWishlistItemParams{ctx, cart}
+
+	// Record call args
+	mmAddWishlistItem.AddWishlistItemMock.mutex.Lock()
+	mmAddWishlistItem.AddWishlistItemMock.callArgs = append(mmAddWishlistItem.AddWishlistItemMock.callArgs, &mm_params)
+	mmAddWishlistItem.AddWishlistItemMock.mutex.Unlock()
+
+	for _, e := range mmAddWishlistItem.AddWishlistItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddWishlistItem.AddWishlistItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.params
+		mm_want_ptrs := mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockAddWishlistItemParams{ctx, cart}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddWishlistItem.t.Errorf("ICartRepoMock.AddWishlistItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cart != nil && !minimock.Equal(*mm_want_ptrs.cart, mm_got.cart) {
+				mmAddWishlistItem.t.Errorf("ICartRepoMock.AddWishlistItem got unexpected parameter cart, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.expectationOrigins.originCart, *mm_want_ptrs.cart, mm_got.cart, minimock.Diff(*mm_want_ptrs.cart, mm_got.cart))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddWishlistItem.t.Errorf("ICartRepoMock.AddWishlistItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddWishlistItem.AddWishlistItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddWishlistItem.t.Fatal("No results are set for the ICartRepoMock.AddWishlistItem")
+		}
+		return (*mm_results).err
+	}
+	if mmAddWishlistItem.funcAddWishlistItem != nil {
+		return mmAddWishlistItem.funcAddWishlistItem(ctx, cart)
+	}
+	mmAddWishlistItem.t.Fatalf("Unexpected call to ICartRepoMock.AddWishlistItem. %v %v", ctx, cart)
+	return
+}
+
+// AddWishlistItemAfterCounter returns a count of finished ICartRepoMock.AddWishlistItem invocations
+func (mmAddWishlistItem *ICartRepoMock) AddWishlistItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddWishlistItem.afterAddWishlistItemCounter)
+}
+
+// AddWishlistItemBeforeCounter returns a count of ICartRepoMock.AddWishlistItem invocations
+func (mmAddWishlistItem *ICartRepoMock) AddWishlistItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddWishlistItem.beforeAddWishlistItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.AddWishlistItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddWishlistItem *mICartRepoMockAddWishlistItem) Calls() []*ICartRepoMockAddWishlistItemParams {
+	mmAddWishlistItem.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockAddWishlistItemParams, len(mmAddWishlistItem.callArgs))
+	copy(argCopy, mmAddWishlistItem.callArgs)
+
+	mmAddWishlistItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddWishlistItemDone returns true if the count of the AddWishlistItem invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockAddWishlistItemDone() bool {
+	if m.AddWishlistItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddWishlistItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddWishlistItemMock.invocationsDone()
+}
+
+// MinimockAddWishlistItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockAddWishlistItemInspect() {
+	for _, e := range m.AddWishlistItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.AddWishlistItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddWishlistItemCounter := mm_atomic.LoadUint64(&m.afterAddWishlistItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddWishlistItemMock.defaultExpectation != nil && afterAddWishlistItemCounter < 1 {
+		if m.AddWishlistItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.AddWishlistItem at\n%s", m.AddWishlistItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.AddWishlistItem at\n%s with params: %#v", m.AddWishlistItemMock.defaultExpectation.expectationOrigins.origin, *m.AddWishlistItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddWishlistItem != nil && afterAddWishlistItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.AddWishlistItem at\n%s", m.funcAddWishlistItemOrigin)
+	}
+
+	if !m.AddWishlistItemMock.invocationsDone() && afterAddWishlistItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.AddWishlistItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddWishlistItemMock.expectedInvocations), m.AddWishlistItemMock.expectedInvocationsOrigin, afterAddWishlistItemCounter)
+	}
+}
+
 type mICartRepoMockClearCartByUserID struct {
 	optional           bool
 	mock               *ICartRepoMock
@@ -775,55 +1218,55 @@ func (m *ICartRepoMock) MinimockClearCartByUserIDInspect() {
 	}
 }
 
-type mICartRepoMockDeleteItem struct {
+type mICartRepoMockDeleteAbandonedCart struct {
 	optional           bool
 	mock               *ICartRepoMock
-	defaultExpectation *ICartRepoMockDeleteItemExpectation
-	expectations       []*ICartRepoMockDeleteItemExpectation
+	defaultExpectation *ICartRepoMockDeleteAbandonedCartExpectation
+	expectations       []*ICartRepoMockDeleteAbandonedCartExpectation
 
-	callArgs []*ICartRepoMockDeleteItemParams
+	callArgs []*ICartRepoMockDeleteAbandonedCartParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ICartRepoMockDeleteItemExpectation specifies expectation struct of the ICartRepo.DeleteItem
-type ICartRepoMockDeleteItemExpectation struct {
+// ICartRepoMockDeleteAbandonedCartExpectation specifies expectation struct of the ICartRepo.DeleteAbandonedCart
+type ICartRepoMockDeleteAbandonedCartExpectation struct {
 	mock               *ICartRepoMock
-	params             *ICartRepoMockDeleteItemParams
-	paramPtrs          *ICartRepoMockDeleteItemParamPtrs
-	expectationOrigins ICartRepoMockDeleteItemExpectationOrigins
-	results            *ICartRepoMockDeleteItemResults
+	params             *ICartRepoMockDeleteAbandonedCartParams
+	paramPtrs          *ICartRepoMockDeleteAbandonedCartParamPtrs
+	expectationOrigins ICartRepoMockDeleteAbandonedCartExpectationOrigins
+	results            *ICartRepoMockDeleteAbandonedCartResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ICartRepoMockDeleteItemParams contains parameters of the ICartRepo.DeleteItem
-type ICartRepoMockDeleteItemParams struct {
+// ICartRepoMockDeleteAbandonedCartParams contains parameters of the ICartRepo.DeleteAbandonedCart
+type ICartRepoMockDeleteAbandonedCartParams struct {
 	ctx    context.Context
 	userID models.UserID
-	skuID  models.SKUID
+	before time.Time
 }
 
-// ICartRepoMockDeleteItemParamPtrs contains pointers to parameters of the ICartRepo.DeleteItem
-type ICartRepoMockDeleteItemParamPtrs struct {
+// ICartRepoMockDeleteAbandonedCartParamPtrs contains pointers to parameters of the ICartRepo.DeleteAbandonedCart
+type ICartRepoMockDeleteAbandonedCartParamPtrs struct {
 	ctx    *context.Context
 	userID *models.UserID
-	skuID  *models.SKUID
+	before *time.Time
 }
 
-// ICartRepoMockDeleteItemResults contains results of the ICartRepo.DeleteItem
-type ICartRepoMockDeleteItemResults struct {
+// ICartRepoMockDeleteAbandonedCartResults contains results of the ICartRepo.DeleteAbandonedCart
+type ICartRepoMockDeleteAbandonedCartResults struct {
 	err error
 }
 
-// ICartRepoMockDeleteItemOrigins contains origins of expectations of the ICartRepo.DeleteItem
-type ICartRepoMockDeleteItemExpectationOrigins struct {
+// ICartRepoMockDeleteAbandonedCartOrigins contains origins of expectations of the ICartRepo.DeleteAbandonedCart
+type ICartRepoMockDeleteAbandonedCartExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originUserID string
-	originSkuID  string
+	originBefore string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -831,83 +1274,456 @@ type ICartRepoMockDeleteItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteItem *mICartRepoMockDeleteItem) Optional() *mICartRepoMockDeleteItem {
-	mmDeleteItem.optional = true
-	return mmDeleteItem
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Optional() *mICartRepoMockDeleteAbandonedCart {
+	mmDeleteAbandonedCart.optional = true
+	return mmDeleteAbandonedCart
 }
 
-// Expect sets up expected params for ICartRepo.DeleteItem
-func (mmDeleteItem *mICartRepoMockDeleteItem) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+// Expect sets up expected params for ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Expect(ctx context.Context, userID models.UserID, before time.Time) *mICartRepoMockDeleteAbandonedCart {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	if mmDeleteAbandonedCart.defaultExpectation == nil {
+		mmDeleteAbandonedCart.defaultExpectation = &ICartRepoMockDeleteAbandonedCartExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by ExpectParams functions")
+	if mmDeleteAbandonedCart.defaultExpectation.paramPtrs != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteItem.defaultExpectation.params = &ICartRepoMockDeleteItemParams{ctx, userID, skuID}
-	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteItem.expectations {
-		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
-			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+	mmDeleteAbandonedCart.defaultExpectation.params = &ICartRepoMockDeleteAbandonedCartParams{ctx, userID, before}
+	mmDeleteAbandonedCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAbandonedCart.expectations {
+		if minimock.Equal(e.params, mmDeleteAbandonedCart.defaultExpectation.params) {
+			mmDeleteAbandonedCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAbandonedCart.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteItem
+	return mmDeleteAbandonedCart
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ICartRepo.DeleteItem
-func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) ExpectCtxParam1(ctx context.Context) *mICartRepoMockDeleteAbandonedCart {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	if mmDeleteAbandonedCart.defaultExpectation == nil {
+		mmDeleteAbandonedCart.defaultExpectation = &ICartRepoMockDeleteAbandonedCartExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Expect")
+	if mmDeleteAbandonedCart.defaultExpectation.params != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteItemParamPtrs{}
+	if mmDeleteAbandonedCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteAbandonedCart.defaultExpectation.paramPtrs = &ICartRepoMockDeleteAbandonedCartParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteAbandonedCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAbandonedCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmDeleteAbandonedCart
 }
 
-// ExpectUserIDParam2 sets up expected param userID for ICartRepo.DeleteItem
-func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockDeleteAbandonedCart {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	if mmDeleteAbandonedCart.defaultExpectation == nil {
+		mmDeleteAbandonedCart.defaultExpectation = &ICartRepoMockDeleteAbandonedCartExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Expect")
+	if mmDeleteAbandonedCart.defaultExpectation.params != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteItemParamPtrs{}
+	if mmDeleteAbandonedCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteAbandonedCart.defaultExpectation.paramPtrs = &ICartRepoMockDeleteAbandonedCartParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmDeleteAbandonedCart.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteAbandonedCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmDeleteAbandonedCart
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.DeleteItem
+// ExpectBeforeParam3 sets up expected param before for ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) ExpectBeforeParam3(before time.Time) *mICartRepoMockDeleteAbandonedCart {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
+	}
+
+	if mmDeleteAbandonedCart.defaultExpectation == nil {
+		mmDeleteAbandonedCart.defaultExpectation = &ICartRepoMockDeleteAbandonedCartExpectation{}
+	}
+
+	if mmDeleteAbandonedCart.defaultExpectation.params != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Expect")
+	}
+
+	if mmDeleteAbandonedCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteAbandonedCart.defaultExpectation.paramPtrs = &ICartRepoMockDeleteAbandonedCartParamPtrs{}
+	}
+	mmDeleteAbandonedCart.defaultExpectation.paramPtrs.before = &before
+	mmDeleteAbandonedCart.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmDeleteAbandonedCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Inspect(f func(ctx context.Context, userID models.UserID, before time.Time)) *mICartRepoMockDeleteAbandonedCart {
+	if mmDeleteAbandonedCart.mock.inspectFuncDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.DeleteAbandonedCart")
+	}
+
+	mmDeleteAbandonedCart.mock.inspectFuncDeleteAbandonedCart = f
+
+	return mmDeleteAbandonedCart
+}
+
+// Return sets up results that will be returned by ICartRepo.DeleteAbandonedCart
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Return(err error) *ICartRepoMock {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
+	}
+
+	if mmDeleteAbandonedCart.defaultExpectation == nil {
+		mmDeleteAbandonedCart.defaultExpectation = &ICartRepoMockDeleteAbandonedCartExpectation{mock: mmDeleteAbandonedCart.mock}
+	}
+	mmDeleteAbandonedCart.defaultExpectation.results = &ICartRepoMockDeleteAbandonedCartResults{err}
+	mmDeleteAbandonedCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAbandonedCart.mock
+}
+
+// Set uses given function f to mock the ICartRepo.DeleteAbandonedCart method
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Set(f func(ctx context.Context, userID models.UserID, before time.Time) (err error)) *ICartRepoMock {
+	if mmDeleteAbandonedCart.defaultExpectation != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("Default expectation is already set for the ICartRepo.DeleteAbandonedCart method")
+	}
+
+	if len(mmDeleteAbandonedCart.expectations) > 0 {
+		mmDeleteAbandonedCart.mock.t.Fatalf("Some expectations are already set for the ICartRepo.DeleteAbandonedCart method")
+	}
+
+	mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart = f
+	mmDeleteAbandonedCart.mock.funcDeleteAbandonedCartOrigin = minimock.CallerInfo(1)
+	return mmDeleteAbandonedCart.mock
+}
+
+// When sets expectation for the ICartRepo.DeleteAbandonedCart which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) When(ctx context.Context, userID models.UserID, before time.Time) *ICartRepoMockDeleteAbandonedCartExpectation {
+	if mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.mock.t.Fatalf("ICartRepoMock.DeleteAbandonedCart mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockDeleteAbandonedCartExpectation{
+		mock:               mmDeleteAbandonedCart.mock,
+		params:             &ICartRepoMockDeleteAbandonedCartParams{ctx, userID, before},
+		expectationOrigins: ICartRepoMockDeleteAbandonedCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAbandonedCart.expectations = append(mmDeleteAbandonedCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.DeleteAbandonedCart return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockDeleteAbandonedCartExpectation) Then(err error) *ICartRepoMock {
+	e.results = &ICartRepoMockDeleteAbandonedCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.DeleteAbandonedCart should be invoked
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Times(n uint64) *mICartRepoMockDeleteAbandonedCart {
+	if n == 0 {
+		mmDeleteAbandonedCart.mock.t.Fatalf("Times of ICartRepoMock.DeleteAbandonedCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAbandonedCart.expectedInvocations, n)
+	mmDeleteAbandonedCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAbandonedCart
+}
+
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) invocationsDone() bool {
+	if len(mmDeleteAbandonedCart.expectations) == 0 && mmDeleteAbandonedCart.defaultExpectation == nil && mmDeleteAbandonedCart.mock.funcDeleteAbandonedCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAbandonedCart.mock.afterDeleteAbandonedCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAbandonedCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAbandonedCart implements mm_repository.ICartRepo
+func (mmDeleteAbandonedCart *ICartRepoMock) DeleteAbandonedCart(ctx context.Context, userID models.UserID, before time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAbandonedCart.beforeDeleteAbandonedCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAbandonedCart.afterDeleteAbandonedCartCounter, 1)
+
+	mmDeleteAbandonedCart.t.Helper()
+
+	if mmDeleteAbandonedCart.inspectFuncDeleteAbandonedCart != nil {
+		mmDeleteAbandonedCart.inspectFuncDeleteAbandonedCart(ctx, userID, before)
+	}
+
+	mm_params := ICartRepoMockDeleteAbandonedCartParams{ctx, userID, before}
+
+	// Record call args
+	mmDeleteAbandonedCart.DeleteAbandonedCartMock.mutex.Lock()
+	mmDeleteAbandonedCart.DeleteAbandonedCartMock.callArgs = append(mmDeleteAbandonedCart.DeleteAbandonedCartMock.callArgs, &mm_params)
+	mmDeleteAbandonedCart.DeleteAbandonedCartMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAbandonedCart.DeleteAbandonedCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockDeleteAbandonedCartParams{ctx, userID, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAbandonedCart.t.Errorf("ICartRepoMock.DeleteAbandonedCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteAbandonedCart.t.Errorf("ICartRepoMock.DeleteAbandonedCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteAbandonedCart.t.Errorf("ICartRepoMock.DeleteAbandonedCart got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAbandonedCart.t.Errorf("ICartRepoMock.DeleteAbandonedCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAbandonedCart.DeleteAbandonedCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAbandonedCart.t.Fatal("No results are set for the ICartRepoMock.DeleteAbandonedCart")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAbandonedCart.funcDeleteAbandonedCart != nil {
+		return mmDeleteAbandonedCart.funcDeleteAbandonedCart(ctx, userID, before)
+	}
+	mmDeleteAbandonedCart.t.Fatalf("Unexpected call to ICartRepoMock.DeleteAbandonedCart. %v %v %v", ctx, userID, before)
+	return
+}
+
+// DeleteAbandonedCartAfterCounter returns a count of finished ICartRepoMock.DeleteAbandonedCart invocations
+func (mmDeleteAbandonedCart *ICartRepoMock) DeleteAbandonedCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAbandonedCart.afterDeleteAbandonedCartCounter)
+}
+
+// DeleteAbandonedCartBeforeCounter returns a count of ICartRepoMock.DeleteAbandonedCart invocations
+func (mmDeleteAbandonedCart *ICartRepoMock) DeleteAbandonedCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAbandonedCart.beforeDeleteAbandonedCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.DeleteAbandonedCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAbandonedCart *mICartRepoMockDeleteAbandonedCart) Calls() []*ICartRepoMockDeleteAbandonedCartParams {
+	mmDeleteAbandonedCart.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockDeleteAbandonedCartParams, len(mmDeleteAbandonedCart.callArgs))
+	copy(argCopy, mmDeleteAbandonedCart.callArgs)
+
+	mmDeleteAbandonedCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAbandonedCartDone returns true if the count of the DeleteAbandonedCart invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockDeleteAbandonedCartDone() bool {
+	if m.DeleteAbandonedCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAbandonedCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAbandonedCartMock.invocationsDone()
+}
+
+// MinimockDeleteAbandonedCartInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockDeleteAbandonedCartInspect() {
+	for _, e := range m.DeleteAbandonedCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteAbandonedCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAbandonedCartCounter := mm_atomic.LoadUint64(&m.afterDeleteAbandonedCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAbandonedCartMock.defaultExpectation != nil && afterDeleteAbandonedCartCounter < 1 {
+		if m.DeleteAbandonedCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteAbandonedCart at\n%s", m.DeleteAbandonedCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteAbandonedCart at\n%s with params: %#v", m.DeleteAbandonedCartMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAbandonedCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAbandonedCart != nil && afterDeleteAbandonedCartCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.DeleteAbandonedCart at\n%s", m.funcDeleteAbandonedCartOrigin)
+	}
+
+	if !m.DeleteAbandonedCartMock.invocationsDone() && afterDeleteAbandonedCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.DeleteAbandonedCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAbandonedCartMock.expectedInvocations), m.DeleteAbandonedCartMock.expectedInvocationsOrigin, afterDeleteAbandonedCartCounter)
+	}
+}
+
+type mICartRepoMockDeleteItem struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockDeleteItemExpectation
+	expectations       []*ICartRepoMockDeleteItemExpectation
+
+	callArgs []*ICartRepoMockDeleteItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockDeleteItemExpectation specifies expectation struct of the ICartRepo.DeleteItem
+type ICartRepoMockDeleteItemExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockDeleteItemParams
+	paramPtrs          *ICartRepoMockDeleteItemParamPtrs
+	expectationOrigins ICartRepoMockDeleteItemExpectationOrigins
+	results            *ICartRepoMockDeleteItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockDeleteItemParams contains parameters of the ICartRepo.DeleteItem
+type ICartRepoMockDeleteItemParams struct {
+	ctx    context.Context
+	userID models.UserID
+	skuID  models.SKUID
+}
+
+// ICartRepoMockDeleteItemParamPtrs contains pointers to parameters of the ICartRepo.DeleteItem
+type ICartRepoMockDeleteItemParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+	skuID  *models.SKUID
+}
+
+// ICartRepoMockDeleteItemResults contains results of the ICartRepo.DeleteItem
+type ICartRepoMockDeleteItemResults struct {
+	err error
+}
+
+// ICartRepoMockDeleteItemOrigins contains origins of expectations of the ICartRepo.DeleteItem
+type ICartRepoMockDeleteItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteItem *mICartRepoMockDeleteItem) Optional() *mICartRepoMockDeleteItem {
+	mmDeleteItem.optional = true
+	return mmDeleteItem
+}
+
+// Expect sets up expected params for ICartRepo.DeleteItem
+func (mmDeleteItem *mICartRepoMockDeleteItem) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteItem.defaultExpectation.params = &ICartRepoMockDeleteItemParams{ctx, userID, skuID}
+	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteItem.expectations {
+		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
+			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.DeleteItem
+func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.DeleteItem
+func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.DeleteItem
 func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockDeleteItem {
 	if mmDeleteItem.mock.funcDeleteItem != nil {
 		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
@@ -924,274 +1740,3172 @@ func (mmDeleteItem *mICartRepoMockDeleteItem) ExpectSkuIDParam3(skuID models.SKU
 	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
 		mmDeleteItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteItemParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.skuID = &skuID
-	mmDeleteItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmDeleteItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.DeleteItem
+func (mmDeleteItem *mICartRepoMockDeleteItem) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockDeleteItem {
+	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.DeleteItem")
+	}
+
+	mmDeleteItem.mock.inspectFuncDeleteItem = f
+
+	return mmDeleteItem
+}
+
+// Return sets up results that will be returned by ICartRepo.DeleteItem
+func (mmDeleteItem *mICartRepoMockDeleteItem) Return(err error) *ICartRepoMock {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	}
+	mmDeleteItem.defaultExpectation.results = &ICartRepoMockDeleteItemResults{err}
+	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// Set uses given function f to mock the ICartRepo.DeleteItem method
+func (mmDeleteItem *mICartRepoMockDeleteItem) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error)) *ICartRepoMock {
+	if mmDeleteItem.defaultExpectation != nil {
+		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.DeleteItem method")
+	}
+
+	if len(mmDeleteItem.expectations) > 0 {
+		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.DeleteItem method")
+	}
+
+	mmDeleteItem.mock.funcDeleteItem = f
+	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// When sets expectation for the ICartRepo.DeleteItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteItem *mICartRepoMockDeleteItem) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockDeleteItemExpectation {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockDeleteItemExpectation{
+		mock:               mmDeleteItem.mock,
+		params:             &ICartRepoMockDeleteItemParams{ctx, userID, skuID},
+		expectationOrigins: ICartRepoMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.DeleteItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockDeleteItemExpectation) Then(err error) *ICartRepoMock {
+	e.results = &ICartRepoMockDeleteItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.DeleteItem should be invoked
+func (mmDeleteItem *mICartRepoMockDeleteItem) Times(n uint64) *mICartRepoMockDeleteItem {
+	if n == 0 {
+		mmDeleteItem.mock.t.Fatalf("Times of ICartRepoMock.DeleteItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
+	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem
+}
+
+func (mmDeleteItem *mICartRepoMockDeleteItem) invocationsDone() bool {
+	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteItem implements mm_repository.ICartRepo
+func (mmDeleteItem *ICartRepoMock) DeleteItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+
+	mmDeleteItem.t.Helper()
+
+	if mmDeleteItem.inspectFuncDeleteItem != nil {
+		mmDeleteItem.inspectFuncDeleteItem(ctx, userID, skuID)
+	}
+
+	mm_params := ICartRepoMockDeleteItemParams{ctx, userID, skuID}
+
+	// Record call args
+	mmDeleteItem.DeleteItemMock.mutex.Lock()
+	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
+	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockDeleteItemParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteItem.t.Fatal("No results are set for the ICartRepoMock.DeleteItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteItem.funcDeleteItem != nil {
+		return mmDeleteItem.funcDeleteItem(ctx, userID, skuID)
+	}
+	mmDeleteItem.t.Fatalf("Unexpected call to ICartRepoMock.DeleteItem. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// DeleteItemAfterCounter returns a count of finished ICartRepoMock.DeleteItem invocations
+func (mmDeleteItem *ICartRepoMock) DeleteItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+}
+
+// DeleteItemBeforeCounter returns a count of ICartRepoMock.DeleteItem invocations
+func (mmDeleteItem *ICartRepoMock) DeleteItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.DeleteItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteItem *mICartRepoMockDeleteItem) Calls() []*ICartRepoMockDeleteItemParams {
+	mmDeleteItem.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockDeleteItemParams, len(mmDeleteItem.callArgs))
+	copy(argCopy, mmDeleteItem.callArgs)
+
+	mmDeleteItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockDeleteItemDone() bool {
+	if m.DeleteItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteItemMock.invocationsDone()
+}
+
+// MinimockDeleteItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockDeleteItemInspect() {
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
+		if m.DeleteItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	}
+
+	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.DeleteItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	}
+}
+
+type mICartRepoMockDeleteWishlistItem struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockDeleteWishlistItemExpectation
+	expectations       []*ICartRepoMockDeleteWishlistItemExpectation
+
+	callArgs []*ICartRepoMockDeleteWishlistItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockDeleteWishlistItemExpectation specifies expectation struct of the ICartRepo.DeleteWishlistItem
+type ICartRepoMockDeleteWishlistItemExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockDeleteWishlistItemParams
+	paramPtrs          *ICartRepoMockDeleteWishlistItemParamPtrs
+	expectationOrigins ICartRepoMockDeleteWishlistItemExpectationOrigins
+	results            *ICartRepoMockDeleteWishlistItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockDeleteWishlistItemParams contains parameters of the ICartRepo.DeleteWishlistItem
+type ICartRepoMockDeleteWishlistItemParams struct {
+	ctx    context.Context
+	userID models.UserID
+	skuID  models.SKUID
+}
+
+// ICartRepoMockDeleteWishlistItemParamPtrs contains pointers to parameters of the ICartRepo.DeleteWishlistItem
+type ICartRepoMockDeleteWishlistItemParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+	skuID  *models.SKUID
+}
+
+// ICartRepoMockDeleteWishlistItemResults contains results of the ICartRepo.DeleteWishlistItem
+type ICartRepoMockDeleteWishlistItemResults struct {
+	err error
+}
+
+// ICartRepoMockDeleteWishlistItemOrigins contains origins of expectations of the ICartRepo.DeleteWishlistItem
+type ICartRepoMockDeleteWishlistItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Optional() *mICartRepoMockDeleteWishlistItem {
+	mmDeleteWishlistItem.optional = true
+	return mmDeleteWishlistItem
+}
+
+// Expect sets up expected params for ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockDeleteWishlistItem {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation == nil {
+		mmDeleteWishlistItem.defaultExpectation = &ICartRepoMockDeleteWishlistItemExpectation{}
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteWishlistItem.defaultExpectation.params = &ICartRepoMockDeleteWishlistItemParams{ctx, userID, skuID}
+	mmDeleteWishlistItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteWishlistItem.expectations {
+		if minimock.Equal(e.params, mmDeleteWishlistItem.defaultExpectation.params) {
+			mmDeleteWishlistItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteWishlistItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteWishlistItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockDeleteWishlistItem {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation == nil {
+		mmDeleteWishlistItem.defaultExpectation = &ICartRepoMockDeleteWishlistItemExpectation{}
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.params != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Expect")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteWishlistItemParamPtrs{}
+	}
+	mmDeleteWishlistItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteWishlistItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteWishlistItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockDeleteWishlistItem {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation == nil {
+		mmDeleteWishlistItem.defaultExpectation = &ICartRepoMockDeleteWishlistItemExpectation{}
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.params != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Expect")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteWishlistItemParamPtrs{}
+	}
+	mmDeleteWishlistItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteWishlistItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteWishlistItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockDeleteWishlistItem {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation == nil {
+		mmDeleteWishlistItem.defaultExpectation = &ICartRepoMockDeleteWishlistItemExpectation{}
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.params != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Expect")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockDeleteWishlistItemParamPtrs{}
+	}
+	mmDeleteWishlistItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteWishlistItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteWishlistItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockDeleteWishlistItem {
+	if mmDeleteWishlistItem.mock.inspectFuncDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.DeleteWishlistItem")
+	}
+
+	mmDeleteWishlistItem.mock.inspectFuncDeleteWishlistItem = f
+
+	return mmDeleteWishlistItem
+}
+
+// Return sets up results that will be returned by ICartRepo.DeleteWishlistItem
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Return(err error) *ICartRepoMock {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	if mmDeleteWishlistItem.defaultExpectation == nil {
+		mmDeleteWishlistItem.defaultExpectation = &ICartRepoMockDeleteWishlistItemExpectation{mock: mmDeleteWishlistItem.mock}
+	}
+	mmDeleteWishlistItem.defaultExpectation.results = &ICartRepoMockDeleteWishlistItemResults{err}
+	mmDeleteWishlistItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteWishlistItem.mock
+}
+
+// Set uses given function f to mock the ICartRepo.DeleteWishlistItem method
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error)) *ICartRepoMock {
+	if mmDeleteWishlistItem.defaultExpectation != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.DeleteWishlistItem method")
+	}
+
+	if len(mmDeleteWishlistItem.expectations) > 0 {
+		mmDeleteWishlistItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.DeleteWishlistItem method")
+	}
+
+	mmDeleteWishlistItem.mock.funcDeleteWishlistItem = f
+	mmDeleteWishlistItem.mock.funcDeleteWishlistItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteWishlistItem.mock
+}
+
+// When sets expectation for the ICartRepo.DeleteWishlistItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockDeleteWishlistItemExpectation {
+	if mmDeleteWishlistItem.mock.funcDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.mock.t.Fatalf("ICartRepoMock.DeleteWishlistItem mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockDeleteWishlistItemExpectation{
+		mock:               mmDeleteWishlistItem.mock,
+		params:             &ICartRepoMockDeleteWishlistItemParams{ctx, userID, skuID},
+		expectationOrigins: ICartRepoMockDeleteWishlistItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteWishlistItem.expectations = append(mmDeleteWishlistItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.DeleteWishlistItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockDeleteWishlistItemExpectation) Then(err error) *ICartRepoMock {
+	e.results = &ICartRepoMockDeleteWishlistItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.DeleteWishlistItem should be invoked
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Times(n uint64) *mICartRepoMockDeleteWishlistItem {
+	if n == 0 {
+		mmDeleteWishlistItem.mock.t.Fatalf("Times of ICartRepoMock.DeleteWishlistItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteWishlistItem.expectedInvocations, n)
+	mmDeleteWishlistItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteWishlistItem
+}
+
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) invocationsDone() bool {
+	if len(mmDeleteWishlistItem.expectations) == 0 && mmDeleteWishlistItem.defaultExpectation == nil && mmDeleteWishlistItem.mock.funcDeleteWishlistItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteWishlistItem.mock.afterDeleteWishlistItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteWishlistItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteWishlistItem implements mm_repository.ICartRepo
+func (mmDeleteWishlistItem *ICartRepoMock) DeleteWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteWishlistItem.beforeDeleteWishlistItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteWishlistItem.afterDeleteWishlistItemCounter, 1)
+
+	mmDeleteWishlistItem.t.Helper()
+
+	if mmDeleteWishlistItem.inspectFuncDeleteWishlistItem != nil {
+		mmDeleteWishlistItem.inspectFuncDeleteWishlistItem(ctx, userID, skuID)
+	}
+
+	mm_params := ICartRepoMockDeleteWishlistItemParams{ctx, userID, skuID}
+
+	// Record call args
+	mmDeleteWishlistItem.DeleteWishlistItemMock.mutex.Lock()
+	mmDeleteWishlistItem.DeleteWishlistItemMock.callArgs = append(mmDeleteWishlistItem.DeleteWishlistItemMock.callArgs, &mm_params)
+	mmDeleteWishlistItem.DeleteWishlistItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteWishlistItem.DeleteWishlistItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockDeleteWishlistItemParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteWishlistItem.t.Errorf("ICartRepoMock.DeleteWishlistItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteWishlistItem.t.Errorf("ICartRepoMock.DeleteWishlistItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteWishlistItem.t.Errorf("ICartRepoMock.DeleteWishlistItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteWishlistItem.t.Errorf("ICartRepoMock.DeleteWishlistItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteWishlistItem.DeleteWishlistItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteWishlistItem.t.Fatal("No results are set for the ICartRepoMock.DeleteWishlistItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteWishlistItem.funcDeleteWishlistItem != nil {
+		return mmDeleteWishlistItem.funcDeleteWishlistItem(ctx, userID, skuID)
+	}
+	mmDeleteWishlistItem.t.Fatalf("Unexpected call to ICartRepoMock.DeleteWishlistItem. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// DeleteWishlistItemAfterCounter returns a count of finished ICartRepoMock.DeleteWishlistItem invocations
+func (mmDeleteWishlistItem *ICartRepoMock) DeleteWishlistItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWishlistItem.afterDeleteWishlistItemCounter)
+}
+
+// DeleteWishlistItemBeforeCounter returns a count of ICartRepoMock.DeleteWishlistItem invocations
+func (mmDeleteWishlistItem *ICartRepoMock) DeleteWishlistItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWishlistItem.beforeDeleteWishlistItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.DeleteWishlistItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteWishlistItem *mICartRepoMockDeleteWishlistItem) Calls() []*ICartRepoMockDeleteWishlistItemParams {
+	mmDeleteWishlistItem.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockDeleteWishlistItemParams, len(mmDeleteWishlistItem.callArgs))
+	copy(argCopy, mmDeleteWishlistItem.callArgs)
+
+	mmDeleteWishlistItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteWishlistItemDone returns true if the count of the DeleteWishlistItem invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockDeleteWishlistItemDone() bool {
+	if m.DeleteWishlistItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteWishlistItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteWishlistItemMock.invocationsDone()
+}
+
+// MinimockDeleteWishlistItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockDeleteWishlistItemInspect() {
+	for _, e := range m.DeleteWishlistItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteWishlistItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteWishlistItemCounter := mm_atomic.LoadUint64(&m.afterDeleteWishlistItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteWishlistItemMock.defaultExpectation != nil && afterDeleteWishlistItemCounter < 1 {
+		if m.DeleteWishlistItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteWishlistItem at\n%s", m.DeleteWishlistItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.DeleteWishlistItem at\n%s with params: %#v", m.DeleteWishlistItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteWishlistItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteWishlistItem != nil && afterDeleteWishlistItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.DeleteWishlistItem at\n%s", m.funcDeleteWishlistItemOrigin)
+	}
+
+	if !m.DeleteWishlistItemMock.invocationsDone() && afterDeleteWishlistItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.DeleteWishlistItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteWishlistItemMock.expectedInvocations), m.DeleteWishlistItemMock.expectedInvocationsOrigin, afterDeleteWishlistItemCounter)
+	}
+}
+
+type mICartRepoMockGetAbandonedCarts struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetAbandonedCartsExpectation
+	expectations       []*ICartRepoMockGetAbandonedCartsExpectation
+
+	callArgs []*ICartRepoMockGetAbandonedCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetAbandonedCartsExpectation specifies expectation struct of the ICartRepo.GetAbandonedCarts
+type ICartRepoMockGetAbandonedCartsExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetAbandonedCartsParams
+	paramPtrs          *ICartRepoMockGetAbandonedCartsParamPtrs
+	expectationOrigins ICartRepoMockGetAbandonedCartsExpectationOrigins
+	results            *ICartRepoMockGetAbandonedCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetAbandonedCartsParams contains parameters of the ICartRepo.GetAbandonedCarts
+type ICartRepoMockGetAbandonedCartsParams struct {
+	ctx    context.Context
+	before time.Time
+	limit  int
+}
+
+// ICartRepoMockGetAbandonedCartsParamPtrs contains pointers to parameters of the ICartRepo.GetAbandonedCarts
+type ICartRepoMockGetAbandonedCartsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+	limit  *int
+}
+
+// ICartRepoMockGetAbandonedCartsResults contains results of the ICartRepo.GetAbandonedCarts
+type ICartRepoMockGetAbandonedCartsResults struct {
+	aa1 []models.AbandonedCart
+	err error
+}
+
+// ICartRepoMockGetAbandonedCartsOrigins contains origins of expectations of the ICartRepo.GetAbandonedCarts
+type ICartRepoMockGetAbandonedCartsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Optional() *mICartRepoMockGetAbandonedCarts {
+	mmGetAbandonedCarts.optional = true
+	return mmGetAbandonedCarts
+}
+
+// Expect sets up expected params for ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Expect(ctx context.Context, before time.Time, limit int) *mICartRepoMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &ICartRepoMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by ExpectParams functions")
+	}
+
+	mmGetAbandonedCarts.defaultExpectation.params = &ICartRepoMockGetAbandonedCartsParams{ctx, before, limit}
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAbandonedCarts.expectations {
+		if minimock.Equal(e.params, mmGetAbandonedCarts.defaultExpectation.params) {
+			mmGetAbandonedCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAbandonedCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &ICartRepoMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &ICartRepoMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectBeforeParam2 sets up expected param before for ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) ExpectBeforeParam2(before time.Time) *mICartRepoMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &ICartRepoMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &ICartRepoMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.before = &before
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectLimitParam3 sets up expected param limit for ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) ExpectLimitParam3(limit int) *mICartRepoMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &ICartRepoMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &ICartRepoMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.limit = &limit
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Inspect(f func(ctx context.Context, before time.Time, limit int)) *mICartRepoMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.inspectFuncGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetAbandonedCarts")
+	}
+
+	mmGetAbandonedCarts.mock.inspectFuncGetAbandonedCarts = f
+
+	return mmGetAbandonedCarts
+}
+
+// Return sets up results that will be returned by ICartRepo.GetAbandonedCarts
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Return(aa1 []models.AbandonedCart, err error) *ICartRepoMock {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &ICartRepoMockGetAbandonedCartsExpectation{mock: mmGetAbandonedCarts.mock}
+	}
+	mmGetAbandonedCarts.defaultExpectation.results = &ICartRepoMockGetAbandonedCartsResults{aa1, err}
+	mmGetAbandonedCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetAbandonedCarts method
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Set(f func(ctx context.Context, before time.Time, limit int) (aa1 []models.AbandonedCart, err error)) *ICartRepoMock {
+	if mmGetAbandonedCarts.defaultExpectation != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetAbandonedCarts method")
+	}
+
+	if len(mmGetAbandonedCarts.expectations) > 0 {
+		mmGetAbandonedCarts.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetAbandonedCarts method")
+	}
+
+	mmGetAbandonedCarts.mock.funcGetAbandonedCarts = f
+	mmGetAbandonedCarts.mock.funcGetAbandonedCartsOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts.mock
+}
+
+// When sets expectation for the ICartRepo.GetAbandonedCarts which will trigger the result defined by the following
+// Then helper
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) When(ctx context.Context, before time.Time, limit int) *ICartRepoMockGetAbandonedCartsExpectation {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("ICartRepoMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetAbandonedCartsExpectation{
+		mock:               mmGetAbandonedCarts.mock,
+		params:             &ICartRepoMockGetAbandonedCartsParams{ctx, before, limit},
+		expectationOrigins: ICartRepoMockGetAbandonedCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAbandonedCarts.expectations = append(mmGetAbandonedCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetAbandonedCarts return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetAbandonedCartsExpectation) Then(aa1 []models.AbandonedCart, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetAbandonedCartsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetAbandonedCarts should be invoked
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Times(n uint64) *mICartRepoMockGetAbandonedCarts {
+	if n == 0 {
+		mmGetAbandonedCarts.mock.t.Fatalf("Times of ICartRepoMock.GetAbandonedCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAbandonedCarts.expectedInvocations, n)
+	mmGetAbandonedCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts
+}
+
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) invocationsDone() bool {
+	if len(mmGetAbandonedCarts.expectations) == 0 && mmGetAbandonedCarts.defaultExpectation == nil && mmGetAbandonedCarts.mock.funcGetAbandonedCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAbandonedCarts.mock.afterGetAbandonedCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAbandonedCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAbandonedCarts implements mm_repository.ICartRepo
+func (mmGetAbandonedCarts *ICartRepoMock) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) (aa1 []models.AbandonedCart, err error) {
+	mm_atomic.AddUint64(&mmGetAbandonedCarts.beforeGetAbandonedCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAbandonedCarts.afterGetAbandonedCartsCounter, 1)
+
+	mmGetAbandonedCarts.t.Helper()
+
+	if mmGetAbandonedCarts.inspectFuncGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.inspectFuncGetAbandonedCarts(ctx, before, limit)
+	}
+
+	mm_params := ICartRepoMockGetAbandonedCartsParams{ctx, before, limit}
+
+	// Record call args
+	mmGetAbandonedCarts.GetAbandonedCartsMock.mutex.Lock()
+	mmGetAbandonedCarts.GetAbandonedCartsMock.callArgs = append(mmGetAbandonedCarts.GetAbandonedCartsMock.callArgs, &mm_params)
+	mmGetAbandonedCarts.GetAbandonedCartsMock.mutex.Unlock()
+
+	for _, e := range mmGetAbandonedCarts.GetAbandonedCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetAbandonedCartsParams{ctx, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAbandonedCarts.t.Errorf("ICartRepoMock.GetAbandonedCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmGetAbandonedCarts.t.Errorf("ICartRepoMock.GetAbandonedCarts got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetAbandonedCarts.t.Errorf("ICartRepoMock.GetAbandonedCarts got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAbandonedCarts.t.Errorf("ICartRepoMock.GetAbandonedCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAbandonedCarts.t.Fatal("No results are set for the ICartRepoMock.GetAbandonedCarts")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmGetAbandonedCarts.funcGetAbandonedCarts != nil {
+		return mmGetAbandonedCarts.funcGetAbandonedCarts(ctx, before, limit)
+	}
+	mmGetAbandonedCarts.t.Fatalf("Unexpected call to ICartRepoMock.GetAbandonedCarts. %v %v %v", ctx, before, limit)
+	return
+}
+
+// GetAbandonedCartsAfterCounter returns a count of finished ICartRepoMock.GetAbandonedCarts invocations
+func (mmGetAbandonedCarts *ICartRepoMock) GetAbandonedCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAbandonedCarts.afterGetAbandonedCartsCounter)
+}
+
+// GetAbandonedCartsBeforeCounter returns a count of ICartRepoMock.GetAbandonedCarts invocations
+func (mmGetAbandonedCarts *ICartRepoMock) GetAbandonedCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAbandonedCarts.beforeGetAbandonedCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetAbandonedCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAbandonedCarts *mICartRepoMockGetAbandonedCarts) Calls() []*ICartRepoMockGetAbandonedCartsParams {
+	mmGetAbandonedCarts.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetAbandonedCartsParams, len(mmGetAbandonedCarts.callArgs))
+	copy(argCopy, mmGetAbandonedCarts.callArgs)
+
+	mmGetAbandonedCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAbandonedCartsDone returns true if the count of the GetAbandonedCarts invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetAbandonedCartsDone() bool {
+	if m.GetAbandonedCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAbandonedCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAbandonedCartsMock.invocationsDone()
+}
+
+// MinimockGetAbandonedCartsInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetAbandonedCartsInspect() {
+	for _, e := range m.GetAbandonedCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetAbandonedCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAbandonedCartsCounter := mm_atomic.LoadUint64(&m.afterGetAbandonedCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAbandonedCartsMock.defaultExpectation != nil && afterGetAbandonedCartsCounter < 1 {
+		if m.GetAbandonedCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetAbandonedCarts at\n%s", m.GetAbandonedCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetAbandonedCarts at\n%s with params: %#v", m.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *m.GetAbandonedCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAbandonedCarts != nil && afterGetAbandonedCartsCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetAbandonedCarts at\n%s", m.funcGetAbandonedCartsOrigin)
+	}
+
+	if !m.GetAbandonedCartsMock.invocationsDone() && afterGetAbandonedCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetAbandonedCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAbandonedCartsMock.expectedInvocations), m.GetAbandonedCartsMock.expectedInvocationsOrigin, afterGetAbandonedCartsCounter)
+	}
+}
+
+type mICartRepoMockGetCartByUserID struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetCartByUserIDExpectation
+	expectations       []*ICartRepoMockGetCartByUserIDExpectation
+
+	callArgs []*ICartRepoMockGetCartByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetCartByUserIDExpectation specifies expectation struct of the ICartRepo.GetCartByUserID
+type ICartRepoMockGetCartByUserIDExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetCartByUserIDParams
+	paramPtrs          *ICartRepoMockGetCartByUserIDParamPtrs
+	expectationOrigins ICartRepoMockGetCartByUserIDExpectationOrigins
+	results            *ICartRepoMockGetCartByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetCartByUserIDParams contains parameters of the ICartRepo.GetCartByUserID
+type ICartRepoMockGetCartByUserIDParams struct {
+	ctx    context.Context
+	userID models.UserID
+}
+
+// ICartRepoMockGetCartByUserIDParamPtrs contains pointers to parameters of the ICartRepo.GetCartByUserID
+type ICartRepoMockGetCartByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+}
+
+// ICartRepoMockGetCartByUserIDResults contains results of the ICartRepo.GetCartByUserID
+type ICartRepoMockGetCartByUserIDResults struct {
+	ca1 []models.CartItem
+	err error
+}
+
+// ICartRepoMockGetCartByUserIDOrigins contains origins of expectations of the ICartRepo.GetCartByUserID
+type ICartRepoMockGetCartByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Optional() *mICartRepoMockGetCartByUserID {
+	mmGetCartByUserID.optional = true
+	return mmGetCartByUserID
+}
+
+// Expect sets up expected params for ICartRepo.GetCartByUserID
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Expect(ctx context.Context, userID models.UserID) *mICartRepoMockGetCartByUserID {
+	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+	}
+
+	if mmGetCartByUserID.defaultExpectation == nil {
+		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	}
+
+	if mmGetCartByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartByUserID.defaultExpectation.params = &ICartRepoMockGetCartByUserIDParams{ctx, userID}
+	mmGetCartByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartByUserID.expectations {
+		if minimock.Equal(e.params, mmGetCartByUserID.defaultExpectation.params) {
+			mmGetCartByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetCartByUserID
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetCartByUserID {
+	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+	}
+
+	if mmGetCartByUserID.defaultExpectation == nil {
+		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	}
+
+	if mmGetCartByUserID.defaultExpectation.params != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Expect")
+	}
+
+	if mmGetCartByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetCartByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartByUserIDParamPtrs{}
+	}
+	mmGetCartByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetCartByUserID
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetCartByUserID {
+	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+	}
+
+	if mmGetCartByUserID.defaultExpectation == nil {
+		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	}
+
+	if mmGetCartByUserID.defaultExpectation.params != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Expect")
+	}
+
+	if mmGetCartByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetCartByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartByUserIDParamPtrs{}
+	}
+	mmGetCartByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetCartByUserID
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Inspect(f func(ctx context.Context, userID models.UserID)) *mICartRepoMockGetCartByUserID {
+	if mmGetCartByUserID.mock.inspectFuncGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetCartByUserID")
+	}
+
+	mmGetCartByUserID.mock.inspectFuncGetCartByUserID = f
+
+	return mmGetCartByUserID
+}
+
+// Return sets up results that will be returned by ICartRepo.GetCartByUserID
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Return(ca1 []models.CartItem, err error) *ICartRepoMock {
+	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+	}
+
+	if mmGetCartByUserID.defaultExpectation == nil {
+		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{mock: mmGetCartByUserID.mock}
+	}
+	mmGetCartByUserID.defaultExpectation.results = &ICartRepoMockGetCartByUserIDResults{ca1, err}
+	mmGetCartByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartByUserID.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetCartByUserID method
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Set(f func(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error)) *ICartRepoMock {
+	if mmGetCartByUserID.defaultExpectation != nil {
+		mmGetCartByUserID.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetCartByUserID method")
+	}
+
+	if len(mmGetCartByUserID.expectations) > 0 {
+		mmGetCartByUserID.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetCartByUserID method")
+	}
+
+	mmGetCartByUserID.mock.funcGetCartByUserID = f
+	mmGetCartByUserID.mock.funcGetCartByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetCartByUserID.mock
+}
+
+// When sets expectation for the ICartRepo.GetCartByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) When(ctx context.Context, userID models.UserID) *ICartRepoMockGetCartByUserIDExpectation {
+	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
+		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetCartByUserIDExpectation{
+		mock:               mmGetCartByUserID.mock,
+		params:             &ICartRepoMockGetCartByUserIDParams{ctx, userID},
+		expectationOrigins: ICartRepoMockGetCartByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartByUserID.expectations = append(mmGetCartByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetCartByUserID return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetCartByUserIDExpectation) Then(ca1 []models.CartItem, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetCartByUserIDResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetCartByUserID should be invoked
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Times(n uint64) *mICartRepoMockGetCartByUserID {
+	if n == 0 {
+		mmGetCartByUserID.mock.t.Fatalf("Times of ICartRepoMock.GetCartByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartByUserID.expectedInvocations, n)
+	mmGetCartByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartByUserID
+}
+
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) invocationsDone() bool {
+	if len(mmGetCartByUserID.expectations) == 0 && mmGetCartByUserID.defaultExpectation == nil && mmGetCartByUserID.mock.funcGetCartByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartByUserID.mock.afterGetCartByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartByUserID implements mm_repository.ICartRepo
+func (mmGetCartByUserID *ICartRepoMock) GetCartByUserID(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetCartByUserID.beforeGetCartByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartByUserID.afterGetCartByUserIDCounter, 1)
+
+	mmGetCartByUserID.t.Helper()
+
+	if mmGetCartByUserID.inspectFuncGetCartByUserID != nil {
+		mmGetCartByUserID.inspectFuncGetCartByUserID(ctx, userID)
+	}
+
+	mm_params := ICartRepoMockGetCartByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmGetCartByUserID.GetCartByUserIDMock.mutex.Lock()
+	mmGetCartByUserID.GetCartByUserIDMock.callArgs = append(mmGetCartByUserID.GetCartByUserIDMock.callArgs, &mm_params)
+	mmGetCartByUserID.GetCartByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCartByUserID.GetCartByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetCartByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartByUserID.t.Fatal("No results are set for the ICartRepoMock.GetCartByUserID")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetCartByUserID.funcGetCartByUserID != nil {
+		return mmGetCartByUserID.funcGetCartByUserID(ctx, userID)
+	}
+	mmGetCartByUserID.t.Fatalf("Unexpected call to ICartRepoMock.GetCartByUserID. %v %v", ctx, userID)
+	return
+}
+
+// GetCartByUserIDAfterCounter returns a count of finished ICartRepoMock.GetCartByUserID invocations
+func (mmGetCartByUserID *ICartRepoMock) GetCartByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartByUserID.afterGetCartByUserIDCounter)
+}
+
+// GetCartByUserIDBeforeCounter returns a count of ICartRepoMock.GetCartByUserID invocations
+func (mmGetCartByUserID *ICartRepoMock) GetCartByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartByUserID.beforeGetCartByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetCartByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Calls() []*ICartRepoMockGetCartByUserIDParams {
+	mmGetCartByUserID.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetCartByUserIDParams, len(mmGetCartByUserID.callArgs))
+	copy(argCopy, mmGetCartByUserID.callArgs)
+
+	mmGetCartByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartByUserIDDone returns true if the count of the GetCartByUserID invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetCartByUserIDDone() bool {
+	if m.GetCartByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartByUserIDMock.invocationsDone()
+}
+
+// MinimockGetCartByUserIDInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetCartByUserIDInspect() {
+	for _, e := range m.GetCartByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetCartByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartByUserIDMock.defaultExpectation != nil && afterGetCartByUserIDCounter < 1 {
+		if m.GetCartByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s", m.GetCartByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s with params: %#v", m.GetCartByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCartByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartByUserID != nil && afterGetCartByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s", m.funcGetCartByUserIDOrigin)
+	}
+
+	if !m.GetCartByUserIDMock.invocationsDone() && afterGetCartByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetCartByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartByUserIDMock.expectedInvocations), m.GetCartByUserIDMock.expectedInvocationsOrigin, afterGetCartByUserIDCounter)
+	}
+}
+
+type mICartRepoMockGetCartID struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetCartIDExpectation
+	expectations       []*ICartRepoMockGetCartIDExpectation
+
+	callArgs []*ICartRepoMockGetCartIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetCartIDExpectation specifies expectation struct of the ICartRepo.GetCartID
+type ICartRepoMockGetCartIDExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetCartIDParams
+	paramPtrs          *ICartRepoMockGetCartIDParamPtrs
+	expectationOrigins ICartRepoMockGetCartIDExpectationOrigins
+	results            *ICartRepoMockGetCartIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetCartIDParams contains parameters of the ICartRepo.GetCartID
+type ICartRepoMockGetCartIDParams struct {
+	ctx    context.Context
+	userID models.UserID
+	skuID  models.SKUID
+}
+
+// ICartRepoMockGetCartIDParamPtrs contains pointers to parameters of the ICartRepo.GetCartID
+type ICartRepoMockGetCartIDParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+	skuID  *models.SKUID
+}
+
+// ICartRepoMockGetCartIDResults contains results of the ICartRepo.GetCartID
+type ICartRepoMockGetCartIDResults struct {
+	c2  models.CartID
+	err error
+}
+
+// ICartRepoMockGetCartIDOrigins contains origins of expectations of the ICartRepo.GetCartID
+type ICartRepoMockGetCartIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartID *mICartRepoMockGetCartID) Optional() *mICartRepoMockGetCartID {
+	mmGetCartID.optional = true
+	return mmGetCartID
+}
+
+// Expect sets up expected params for ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockGetCartID {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	if mmGetCartID.defaultExpectation == nil {
+		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	}
+
+	if mmGetCartID.defaultExpectation.paramPtrs != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartID.defaultExpectation.params = &ICartRepoMockGetCartIDParams{ctx, userID, skuID}
+	mmGetCartID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartID.expectations {
+		if minimock.Equal(e.params, mmGetCartID.defaultExpectation.params) {
+			mmGetCartID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetCartID {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	if mmGetCartID.defaultExpectation == nil {
+		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	}
+
+	if mmGetCartID.defaultExpectation.params != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
+	}
+
+	if mmGetCartID.defaultExpectation.paramPtrs == nil {
+		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
+	}
+	mmGetCartID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetCartID {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	if mmGetCartID.defaultExpectation == nil {
+		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	}
+
+	if mmGetCartID.defaultExpectation.params != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
+	}
+
+	if mmGetCartID.defaultExpectation.paramPtrs == nil {
+		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
+	}
+	mmGetCartID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartID
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockGetCartID {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	if mmGetCartID.defaultExpectation == nil {
+		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	}
+
+	if mmGetCartID.defaultExpectation.params != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
+	}
+
+	if mmGetCartID.defaultExpectation.paramPtrs == nil {
+		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
+	}
+	mmGetCartID.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetCartID.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetCartID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockGetCartID {
+	if mmGetCartID.mock.inspectFuncGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetCartID")
+	}
+
+	mmGetCartID.mock.inspectFuncGetCartID = f
+
+	return mmGetCartID
+}
+
+// Return sets up results that will be returned by ICartRepo.GetCartID
+func (mmGetCartID *mICartRepoMockGetCartID) Return(c2 models.CartID, err error) *ICartRepoMock {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	if mmGetCartID.defaultExpectation == nil {
+		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{mock: mmGetCartID.mock}
+	}
+	mmGetCartID.defaultExpectation.results = &ICartRepoMockGetCartIDResults{c2, err}
+	mmGetCartID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartID.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetCartID method
+func (mmGetCartID *mICartRepoMockGetCartID) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartID, err error)) *ICartRepoMock {
+	if mmGetCartID.defaultExpectation != nil {
+		mmGetCartID.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetCartID method")
+	}
+
+	if len(mmGetCartID.expectations) > 0 {
+		mmGetCartID.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetCartID method")
+	}
+
+	mmGetCartID.mock.funcGetCartID = f
+	mmGetCartID.mock.funcGetCartIDOrigin = minimock.CallerInfo(1)
+	return mmGetCartID.mock
+}
+
+// When sets expectation for the ICartRepo.GetCartID which will trigger the result defined by the following
+// Then helper
+func (mmGetCartID *mICartRepoMockGetCartID) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockGetCartIDExpectation {
+	if mmGetCartID.mock.funcGetCartID != nil {
+		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetCartIDExpectation{
+		mock:               mmGetCartID.mock,
+		params:             &ICartRepoMockGetCartIDParams{ctx, userID, skuID},
+		expectationOrigins: ICartRepoMockGetCartIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartID.expectations = append(mmGetCartID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetCartID return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetCartIDExpectation) Then(c2 models.CartID, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetCartIDResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetCartID should be invoked
+func (mmGetCartID *mICartRepoMockGetCartID) Times(n uint64) *mICartRepoMockGetCartID {
+	if n == 0 {
+		mmGetCartID.mock.t.Fatalf("Times of ICartRepoMock.GetCartID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartID.expectedInvocations, n)
+	mmGetCartID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartID
+}
+
+func (mmGetCartID *mICartRepoMockGetCartID) invocationsDone() bool {
+	if len(mmGetCartID.expectations) == 0 && mmGetCartID.defaultExpectation == nil && mmGetCartID.mock.funcGetCartID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartID.mock.afterGetCartIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartID implements mm_repository.ICartRepo
+func (mmGetCartID *ICartRepoMock) GetCartID(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartID, err error) {
+	mm_atomic.AddUint64(&mmGetCartID.beforeGetCartIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartID.afterGetCartIDCounter, 1)
+
+	mmGetCartID.t.Helper()
+
+	if mmGetCartID.inspectFuncGetCartID != nil {
+		mmGetCartID.inspectFuncGetCartID(ctx, userID, skuID)
+	}
+
+	mm_params := ICartRepoMockGetCartIDParams{ctx, userID, skuID}
+
+	// Record call args
+	mmGetCartID.GetCartIDMock.mutex.Lock()
+	mmGetCartID.GetCartIDMock.callArgs = append(mmGetCartID.GetCartIDMock.callArgs, &mm_params)
+	mmGetCartID.GetCartIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCartID.GetCartIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetCartID.GetCartIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartID.GetCartIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartID.GetCartIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartID.GetCartIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetCartIDParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartID.GetCartIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartID.t.Fatal("No results are set for the ICartRepoMock.GetCartID")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetCartID.funcGetCartID != nil {
+		return mmGetCartID.funcGetCartID(ctx, userID, skuID)
+	}
+	mmGetCartID.t.Fatalf("Unexpected call to ICartRepoMock.GetCartID. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// GetCartIDAfterCounter returns a count of finished ICartRepoMock.GetCartID invocations
+func (mmGetCartID *ICartRepoMock) GetCartIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartID.afterGetCartIDCounter)
+}
+
+// GetCartIDBeforeCounter returns a count of ICartRepoMock.GetCartID invocations
+func (mmGetCartID *ICartRepoMock) GetCartIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartID.beforeGetCartIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetCartID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartID *mICartRepoMockGetCartID) Calls() []*ICartRepoMockGetCartIDParams {
+	mmGetCartID.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetCartIDParams, len(mmGetCartID.callArgs))
+	copy(argCopy, mmGetCartID.callArgs)
+
+	mmGetCartID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartIDDone returns true if the count of the GetCartID invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetCartIDDone() bool {
+	if m.GetCartIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartIDMock.invocationsDone()
+}
+
+// MinimockGetCartIDInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetCartIDInspect() {
+	for _, e := range m.GetCartIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartIDCounter := mm_atomic.LoadUint64(&m.afterGetCartIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartIDMock.defaultExpectation != nil && afterGetCartIDCounter < 1 {
+		if m.GetCartIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s", m.GetCartIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s with params: %#v", m.GetCartIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCartIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartID != nil && afterGetCartIDCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s", m.funcGetCartIDOrigin)
+	}
+
+	if !m.GetCartIDMock.invocationsDone() && afterGetCartIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetCartID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartIDMock.expectedInvocations), m.GetCartIDMock.expectedInvocationsOrigin, afterGetCartIDCounter)
+	}
+}
+
+type mICartRepoMockGetCartItem struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetCartItemExpectation
+	expectations       []*ICartRepoMockGetCartItemExpectation
+
+	callArgs []*ICartRepoMockGetCartItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetCartItemExpectation specifies expectation struct of the ICartRepo.GetCartItem
+type ICartRepoMockGetCartItemExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetCartItemParams
+	paramPtrs          *ICartRepoMockGetCartItemParamPtrs
+	expectationOrigins ICartRepoMockGetCartItemExpectationOrigins
+	results            *ICartRepoMockGetCartItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetCartItemParams contains parameters of the ICartRepo.GetCartItem
+type ICartRepoMockGetCartItemParams struct {
+	ctx    context.Context
+	userID models.UserID
+	skuID  models.SKUID
+}
+
+// ICartRepoMockGetCartItemParamPtrs contains pointers to parameters of the ICartRepo.GetCartItem
+type ICartRepoMockGetCartItemParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+	skuID  *models.SKUID
+}
+
+// ICartRepoMockGetCartItemResults contains results of the ICartRepo.GetCartItem
+type ICartRepoMockGetCartItemResults struct {
+	c2  models.Cart
+	err error
+}
+
+// ICartRepoMockGetCartItemOrigins contains origins of expectations of the ICartRepo.GetCartItem
+type ICartRepoMockGetCartItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartItem *mICartRepoMockGetCartItem) Optional() *mICartRepoMockGetCartItem {
+	mmGetCartItem.optional = true
+	return mmGetCartItem
+}
+
+// Expect sets up expected params for ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockGetCartItem {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	if mmGetCartItem.defaultExpectation == nil {
+		mmGetCartItem.defaultExpectation = &ICartRepoMockGetCartItemExpectation{}
+	}
+
+	if mmGetCartItem.defaultExpectation.paramPtrs != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartItem.defaultExpectation.params = &ICartRepoMockGetCartItemParams{ctx, userID, skuID}
+	mmGetCartItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartItem.expectations {
+		if minimock.Equal(e.params, mmGetCartItem.defaultExpectation.params) {
+			mmGetCartItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartItem.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetCartItem {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	if mmGetCartItem.defaultExpectation == nil {
+		mmGetCartItem.defaultExpectation = &ICartRepoMockGetCartItemExpectation{}
+	}
+
+	if mmGetCartItem.defaultExpectation.params != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Expect")
+	}
+
+	if mmGetCartItem.defaultExpectation.paramPtrs == nil {
+		mmGetCartItem.defaultExpectation.paramPtrs = &ICartRepoMockGetCartItemParamPtrs{}
+	}
+	mmGetCartItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetCartItem {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	if mmGetCartItem.defaultExpectation == nil {
+		mmGetCartItem.defaultExpectation = &ICartRepoMockGetCartItemExpectation{}
+	}
+
+	if mmGetCartItem.defaultExpectation.params != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Expect")
+	}
+
+	if mmGetCartItem.defaultExpectation.paramPtrs == nil {
+		mmGetCartItem.defaultExpectation.paramPtrs = &ICartRepoMockGetCartItemParamPtrs{}
+	}
+	mmGetCartItem.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockGetCartItem {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	if mmGetCartItem.defaultExpectation == nil {
+		mmGetCartItem.defaultExpectation = &ICartRepoMockGetCartItemExpectation{}
+	}
+
+	if mmGetCartItem.defaultExpectation.params != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Expect")
+	}
+
+	if mmGetCartItem.defaultExpectation.paramPtrs == nil {
+		mmGetCartItem.defaultExpectation.paramPtrs = &ICartRepoMockGetCartItemParamPtrs{}
+	}
+	mmGetCartItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetCartItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetCartItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockGetCartItem {
+	if mmGetCartItem.mock.inspectFuncGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetCartItem")
+	}
+
+	mmGetCartItem.mock.inspectFuncGetCartItem = f
+
+	return mmGetCartItem
+}
+
+// Return sets up results that will be returned by ICartRepo.GetCartItem
+func (mmGetCartItem *mICartRepoMockGetCartItem) Return(c2 models.Cart, err error) *ICartRepoMock {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	if mmGetCartItem.defaultExpectation == nil {
+		mmGetCartItem.defaultExpectation = &ICartRepoMockGetCartItemExpectation{mock: mmGetCartItem.mock}
+	}
+	mmGetCartItem.defaultExpectation.results = &ICartRepoMockGetCartItemResults{c2, err}
+	mmGetCartItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartItem.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetCartItem method
+func (mmGetCartItem *mICartRepoMockGetCartItem) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.Cart, err error)) *ICartRepoMock {
+	if mmGetCartItem.defaultExpectation != nil {
+		mmGetCartItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetCartItem method")
+	}
+
+	if len(mmGetCartItem.expectations) > 0 {
+		mmGetCartItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetCartItem method")
+	}
+
+	mmGetCartItem.mock.funcGetCartItem = f
+	mmGetCartItem.mock.funcGetCartItemOrigin = minimock.CallerInfo(1)
+	return mmGetCartItem.mock
+}
+
+// When sets expectation for the ICartRepo.GetCartItem which will trigger the result defined by the following
+// Then helper
+func (mmGetCartItem *mICartRepoMockGetCartItem) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockGetCartItemExpectation {
+	if mmGetCartItem.mock.funcGetCartItem != nil {
+		mmGetCartItem.mock.t.Fatalf("ICartRepoMock.GetCartItem mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetCartItemExpectation{
+		mock:               mmGetCartItem.mock,
+		params:             &ICartRepoMockGetCartItemParams{ctx, userID, skuID},
+		expectationOrigins: ICartRepoMockGetCartItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartItem.expectations = append(mmGetCartItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetCartItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetCartItemExpectation) Then(c2 models.Cart, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetCartItemResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetCartItem should be invoked
+func (mmGetCartItem *mICartRepoMockGetCartItem) Times(n uint64) *mICartRepoMockGetCartItem {
+	if n == 0 {
+		mmGetCartItem.mock.t.Fatalf("Times of ICartRepoMock.GetCartItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartItem.expectedInvocations, n)
+	mmGetCartItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartItem
+}
+
+func (mmGetCartItem *mICartRepoMockGetCartItem) invocationsDone() bool {
+	if len(mmGetCartItem.expectations) == 0 && mmGetCartItem.defaultExpectation == nil && mmGetCartItem.mock.funcGetCartItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartItem.mock.afterGetCartItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartItem implements mm_repository.ICartRepo
+func (mmGetCartItem *ICartRepoMock) GetCartItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.Cart, err error) {
+	mm_atomic.AddUint64(&mmGetCartItem.beforeGetCartItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartItem.afterGetCartItemCounter, 1)
+
+	mmGetCartItem.t.Helper()
+
+	if mmGetCartItem.inspectFuncGetCartItem != nil {
+		mmGetCartItem.inspectFuncGetCartItem(ctx, userID, skuID)
+	}
+
+	mm_params := ICartRepoMockGetCartItemParams{ctx, userID, skuID}
+
+	// Record call args
+	mmGetCartItem.GetCartItemMock.mutex.Lock()
+	mmGetCartItem.GetCartItemMock.callArgs = append(mmGetCartItem.GetCartItemMock.callArgs, &mm_params)
+	mmGetCartItem.GetCartItemMock.mutex.Unlock()
+
+	for _, e := range mmGetCartItem.GetCartItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetCartItem.GetCartItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartItem.GetCartItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartItem.GetCartItemMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartItem.GetCartItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetCartItemParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartItem.t.Errorf("ICartRepoMock.GetCartItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartItem.GetCartItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartItem.t.Errorf("ICartRepoMock.GetCartItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartItem.GetCartItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetCartItem.t.Errorf("ICartRepoMock.GetCartItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartItem.GetCartItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartItem.t.Errorf("ICartRepoMock.GetCartItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartItem.GetCartItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartItem.GetCartItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartItem.t.Fatal("No results are set for the ICartRepoMock.GetCartItem")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetCartItem.funcGetCartItem != nil {
+		return mmGetCartItem.funcGetCartItem(ctx, userID, skuID)
+	}
+	mmGetCartItem.t.Fatalf("Unexpected call to ICartRepoMock.GetCartItem. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// GetCartItemAfterCounter returns a count of finished ICartRepoMock.GetCartItem invocations
+func (mmGetCartItem *ICartRepoMock) GetCartItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartItem.afterGetCartItemCounter)
+}
+
+// GetCartItemBeforeCounter returns a count of ICartRepoMock.GetCartItem invocations
+func (mmGetCartItem *ICartRepoMock) GetCartItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartItem.beforeGetCartItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetCartItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartItem *mICartRepoMockGetCartItem) Calls() []*ICartRepoMockGetCartItemParams {
+	mmGetCartItem.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetCartItemParams, len(mmGetCartItem.callArgs))
+	copy(argCopy, mmGetCartItem.callArgs)
+
+	mmGetCartItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartItemDone returns true if the count of the GetCartItem invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetCartItemDone() bool {
+	if m.GetCartItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartItemMock.invocationsDone()
+}
+
+// MinimockGetCartItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetCartItemInspect() {
+	for _, e := range m.GetCartItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartItemCounter := mm_atomic.LoadUint64(&m.afterGetCartItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartItemMock.defaultExpectation != nil && afterGetCartItemCounter < 1 {
+		if m.GetCartItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartItem at\n%s", m.GetCartItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetCartItem at\n%s with params: %#v", m.GetCartItemMock.defaultExpectation.expectationOrigins.origin, *m.GetCartItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartItem != nil && afterGetCartItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetCartItem at\n%s", m.funcGetCartItemOrigin)
+	}
+
+	if !m.GetCartItemMock.invocationsDone() && afterGetCartItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetCartItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartItemMock.expectedInvocations), m.GetCartItemMock.expectedInvocationsOrigin, afterGetCartItemCounter)
+	}
+}
+
+type mICartRepoMockGetVersion struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetVersionExpectation
+	expectations       []*ICartRepoMockGetVersionExpectation
+
+	callArgs []*ICartRepoMockGetVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetVersionExpectation specifies expectation struct of the ICartRepo.GetVersion
+type ICartRepoMockGetVersionExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetVersionParams
+	paramPtrs          *ICartRepoMockGetVersionParamPtrs
+	expectationOrigins ICartRepoMockGetVersionExpectationOrigins
+	results            *ICartRepoMockGetVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetVersionParams contains parameters of the ICartRepo.GetVersion
+type ICartRepoMockGetVersionParams struct {
+	ctx    context.Context
+	userID models.UserID
+}
+
+// ICartRepoMockGetVersionParamPtrs contains pointers to parameters of the ICartRepo.GetVersion
+type ICartRepoMockGetVersionParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+}
+
+// ICartRepoMockGetVersionResults contains results of the ICartRepo.GetVersion
+type ICartRepoMockGetVersionResults struct {
+	u1  uint64
+	err error
+}
+
+// ICartRepoMockGetVersionOrigins contains origins of expectations of the ICartRepo.GetVersion
+type ICartRepoMockGetVersionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVersion *mICartRepoMockGetVersion) Optional() *mICartRepoMockGetVersion {
+	mmGetVersion.optional = true
+	return mmGetVersion
+}
+
+// Expect sets up expected params for ICartRepo.GetVersion
+func (mmGetVersion *mICartRepoMockGetVersion) Expect(ctx context.Context, userID models.UserID) *mICartRepoMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &ICartRepoMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVersion.defaultExpectation.params = &ICartRepoMockGetVersionParams{ctx, userID}
+	mmGetVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVersion.expectations {
+		if minimock.Equal(e.params, mmGetVersion.defaultExpectation.params) {
+			mmGetVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetVersion
+func (mmGetVersion *mICartRepoMockGetVersion) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &ICartRepoMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &ICartRepoMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetVersion
+func (mmGetVersion *mICartRepoMockGetVersion) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &ICartRepoMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &ICartRepoMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmGetVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetVersion
+func (mmGetVersion *mICartRepoMockGetVersion) Inspect(f func(ctx context.Context, userID models.UserID)) *mICartRepoMockGetVersion {
+	if mmGetVersion.mock.inspectFuncGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetVersion")
+	}
+
+	mmGetVersion.mock.inspectFuncGetVersion = f
+
+	return mmGetVersion
+}
+
+// Return sets up results that will be returned by ICartRepo.GetVersion
+func (mmGetVersion *mICartRepoMockGetVersion) Return(u1 uint64, err error) *ICartRepoMock {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &ICartRepoMockGetVersionExpectation{mock: mmGetVersion.mock}
+	}
+	mmGetVersion.defaultExpectation.results = &ICartRepoMockGetVersionResults{u1, err}
+	mmGetVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetVersion method
+func (mmGetVersion *mICartRepoMockGetVersion) Set(f func(ctx context.Context, userID models.UserID) (u1 uint64, err error)) *ICartRepoMock {
+	if mmGetVersion.defaultExpectation != nil {
+		mmGetVersion.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetVersion method")
+	}
+
+	if len(mmGetVersion.expectations) > 0 {
+		mmGetVersion.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetVersion method")
+	}
+
+	mmGetVersion.mock.funcGetVersion = f
+	mmGetVersion.mock.funcGetVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// When sets expectation for the ICartRepo.GetVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVersion *mICartRepoMockGetVersion) When(ctx context.Context, userID models.UserID) *ICartRepoMockGetVersionExpectation {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("ICartRepoMock.GetVersion mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetVersionExpectation{
+		mock:               mmGetVersion.mock,
+		params:             &ICartRepoMockGetVersionParams{ctx, userID},
+		expectationOrigins: ICartRepoMockGetVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVersion.expectations = append(mmGetVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetVersion return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetVersionExpectation) Then(u1 uint64, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetVersionResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetVersion should be invoked
+func (mmGetVersion *mICartRepoMockGetVersion) Times(n uint64) *mICartRepoMockGetVersion {
+	if n == 0 {
+		mmGetVersion.mock.t.Fatalf("Times of ICartRepoMock.GetVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVersion.expectedInvocations, n)
+	mmGetVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVersion
+}
+
+func (mmGetVersion *mICartRepoMockGetVersion) invocationsDone() bool {
+	if len(mmGetVersion.expectations) == 0 && mmGetVersion.defaultExpectation == nil && mmGetVersion.mock.funcGetVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVersion.mock.afterGetVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVersion implements mm_repository.ICartRepo
+func (mmGetVersion *ICartRepoMock) GetVersion(ctx context.Context, userID models.UserID) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetVersion.beforeGetVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVersion.afterGetVersionCounter, 1)
+
+	mmGetVersion.t.Helper()
+
+	if mmGetVersion.inspectFuncGetVersion != nil {
+		mmGetVersion.inspectFuncGetVersion(ctx, userID)
+	}
+
+	mm_params := ICartRepoMockGetVersionParams{ctx, userID}
+
+	// Record call args
+	mmGetVersion.GetVersionMock.mutex.Lock()
+	mmGetVersion.GetVersionMock.callArgs = append(mmGetVersion.GetVersionMock.callArgs, &mm_params)
+	mmGetVersion.GetVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVersion.GetVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetVersion.GetVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVersion.GetVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVersion.GetVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVersion.GetVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetVersionParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVersion.t.Errorf("ICartRepoMock.GetVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetVersion.t.Errorf("ICartRepoMock.GetVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVersion.t.Errorf("ICartRepoMock.GetVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVersion.GetVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVersion.t.Fatal("No results are set for the ICartRepoMock.GetVersion")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetVersion.funcGetVersion != nil {
+		return mmGetVersion.funcGetVersion(ctx, userID)
+	}
+	mmGetVersion.t.Fatalf("Unexpected call to ICartRepoMock.GetVersion. %v %v", ctx, userID)
+	return
+}
+
+// GetVersionAfterCounter returns a count of finished ICartRepoMock.GetVersion invocations
+func (mmGetVersion *ICartRepoMock) GetVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.afterGetVersionCounter)
+}
+
+// GetVersionBeforeCounter returns a count of ICartRepoMock.GetVersion invocations
+func (mmGetVersion *ICartRepoMock) GetVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.beforeGetVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVersion *mICartRepoMockGetVersion) Calls() []*ICartRepoMockGetVersionParams {
+	mmGetVersion.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetVersionParams, len(mmGetVersion.callArgs))
+	copy(argCopy, mmGetVersion.callArgs)
+
+	mmGetVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVersionDone returns true if the count of the GetVersion invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetVersionDone() bool {
+	if m.GetVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVersionMock.invocationsDone()
+}
+
+// MinimockGetVersionInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetVersionInspect() {
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVersionCounter := mm_atomic.LoadUint64(&m.afterGetVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVersionMock.defaultExpectation != nil && afterGetVersionCounter < 1 {
+		if m.GetVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetVersion at\n%s", m.GetVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetVersion at\n%s with params: %#v", m.GetVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVersion != nil && afterGetVersionCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetVersion at\n%s", m.funcGetVersionOrigin)
+	}
+
+	if !m.GetVersionMock.invocationsDone() && afterGetVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVersionMock.expectedInvocations), m.GetVersionMock.expectedInvocationsOrigin, afterGetVersionCounter)
+	}
+}
+
+type mICartRepoMockGetWishlistByUserID struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetWishlistByUserIDExpectation
+	expectations       []*ICartRepoMockGetWishlistByUserIDExpectation
+
+	callArgs []*ICartRepoMockGetWishlistByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetWishlistByUserIDExpectation specifies expectation struct of the ICartRepo.GetWishlistByUserID
+type ICartRepoMockGetWishlistByUserIDExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetWishlistByUserIDParams
+	paramPtrs          *ICartRepoMockGetWishlistByUserIDParamPtrs
+	expectationOrigins ICartRepoMockGetWishlistByUserIDExpectationOrigins
+	results            *ICartRepoMockGetWishlistByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetWishlistByUserIDParams contains parameters of the ICartRepo.GetWishlistByUserID
+type ICartRepoMockGetWishlistByUserIDParams struct {
+	ctx    context.Context
+	userID models.UserID
+}
+
+// ICartRepoMockGetWishlistByUserIDParamPtrs contains pointers to parameters of the ICartRepo.GetWishlistByUserID
+type ICartRepoMockGetWishlistByUserIDParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+}
+
+// ICartRepoMockGetWishlistByUserIDResults contains results of the ICartRepo.GetWishlistByUserID
+type ICartRepoMockGetWishlistByUserIDResults struct {
+	ca1 []models.CartItem
+	err error
+}
+
+// ICartRepoMockGetWishlistByUserIDOrigins contains origins of expectations of the ICartRepo.GetWishlistByUserID
+type ICartRepoMockGetWishlistByUserIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Optional() *mICartRepoMockGetWishlistByUserID {
+	mmGetWishlistByUserID.optional = true
+	return mmGetWishlistByUserID
+}
+
+// Expect sets up expected params for ICartRepo.GetWishlistByUserID
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Expect(ctx context.Context, userID models.UserID) *mICartRepoMockGetWishlistByUserID {
+	if mmGetWishlistByUserID.mock.funcGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Set")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation == nil {
+		mmGetWishlistByUserID.defaultExpectation = &ICartRepoMockGetWishlistByUserIDExpectation{}
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetWishlistByUserID.defaultExpectation.params = &ICartRepoMockGetWishlistByUserIDParams{ctx, userID}
+	mmGetWishlistByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWishlistByUserID.expectations {
+		if minimock.Equal(e.params, mmGetWishlistByUserID.defaultExpectation.params) {
+			mmGetWishlistByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWishlistByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWishlistByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetWishlistByUserID
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetWishlistByUserID {
+	if mmGetWishlistByUserID.mock.funcGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Set")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation == nil {
+		mmGetWishlistByUserID.defaultExpectation = &ICartRepoMockGetWishlistByUserIDExpectation{}
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation.params != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Expect")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetWishlistByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetWishlistByUserIDParamPtrs{}
+	}
+	mmGetWishlistByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWishlistByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWishlistByUserID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetWishlistByUserID
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetWishlistByUserID {
+	if mmGetWishlistByUserID.mock.funcGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Set")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation == nil {
+		mmGetWishlistByUserID.defaultExpectation = &ICartRepoMockGetWishlistByUserIDExpectation{}
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation.params != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Expect")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetWishlistByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetWishlistByUserIDParamPtrs{}
+	}
+	mmGetWishlistByUserID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetWishlistByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetWishlistByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetWishlistByUserID
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Inspect(f func(ctx context.Context, userID models.UserID)) *mICartRepoMockGetWishlistByUserID {
+	if mmGetWishlistByUserID.mock.inspectFuncGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetWishlistByUserID")
+	}
+
+	mmGetWishlistByUserID.mock.inspectFuncGetWishlistByUserID = f
+
+	return mmGetWishlistByUserID
+}
+
+// Return sets up results that will be returned by ICartRepo.GetWishlistByUserID
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Return(ca1 []models.CartItem, err error) *ICartRepoMock {
+	if mmGetWishlistByUserID.mock.funcGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Set")
+	}
+
+	if mmGetWishlistByUserID.defaultExpectation == nil {
+		mmGetWishlistByUserID.defaultExpectation = &ICartRepoMockGetWishlistByUserIDExpectation{mock: mmGetWishlistByUserID.mock}
+	}
+	mmGetWishlistByUserID.defaultExpectation.results = &ICartRepoMockGetWishlistByUserIDResults{ca1, err}
+	mmGetWishlistByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistByUserID.mock
+}
+
+// Set uses given function f to mock the ICartRepo.GetWishlistByUserID method
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Set(f func(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error)) *ICartRepoMock {
+	if mmGetWishlistByUserID.defaultExpectation != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetWishlistByUserID method")
+	}
+
+	if len(mmGetWishlistByUserID.expectations) > 0 {
+		mmGetWishlistByUserID.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetWishlistByUserID method")
+	}
+
+	mmGetWishlistByUserID.mock.funcGetWishlistByUserID = f
+	mmGetWishlistByUserID.mock.funcGetWishlistByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistByUserID.mock
+}
+
+// When sets expectation for the ICartRepo.GetWishlistByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) When(ctx context.Context, userID models.UserID) *ICartRepoMockGetWishlistByUserIDExpectation {
+	if mmGetWishlistByUserID.mock.funcGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.mock.t.Fatalf("ICartRepoMock.GetWishlistByUserID mock is already set by Set")
+	}
+
+	expectation := &ICartRepoMockGetWishlistByUserIDExpectation{
+		mock:               mmGetWishlistByUserID.mock,
+		params:             &ICartRepoMockGetWishlistByUserIDParams{ctx, userID},
+		expectationOrigins: ICartRepoMockGetWishlistByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetWishlistByUserID.expectations = append(mmGetWishlistByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICartRepo.GetWishlistByUserID return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetWishlistByUserIDExpectation) Then(ca1 []models.CartItem, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetWishlistByUserIDResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times ICartRepo.GetWishlistByUserID should be invoked
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Times(n uint64) *mICartRepoMockGetWishlistByUserID {
+	if n == 0 {
+		mmGetWishlistByUserID.mock.t.Fatalf("Times of ICartRepoMock.GetWishlistByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWishlistByUserID.expectedInvocations, n)
+	mmGetWishlistByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistByUserID
+}
+
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) invocationsDone() bool {
+	if len(mmGetWishlistByUserID.expectations) == 0 && mmGetWishlistByUserID.defaultExpectation == nil && mmGetWishlistByUserID.mock.funcGetWishlistByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWishlistByUserID.mock.afterGetWishlistByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWishlistByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWishlistByUserID implements mm_repository.ICartRepo
+func (mmGetWishlistByUserID *ICartRepoMock) GetWishlistByUserID(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetWishlistByUserID.beforeGetWishlistByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWishlistByUserID.afterGetWishlistByUserIDCounter, 1)
+
+	mmGetWishlistByUserID.t.Helper()
+
+	if mmGetWishlistByUserID.inspectFuncGetWishlistByUserID != nil {
+		mmGetWishlistByUserID.inspectFuncGetWishlistByUserID(ctx, userID)
+	}
+
+	mm_params := ICartRepoMockGetWishlistByUserIDParams{ctx, userID}
+
+	// Record call args
+	mmGetWishlistByUserID.GetWishlistByUserIDMock.mutex.Lock()
+	mmGetWishlistByUserID.GetWishlistByUserIDMock.callArgs = append(mmGetWishlistByUserID.GetWishlistByUserIDMock.callArgs, &mm_params)
+	mmGetWishlistByUserID.GetWishlistByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetWishlistByUserID.GetWishlistByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ICartRepoMockGetWishlistByUserIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWishlistByUserID.t.Errorf("ICartRepoMock.GetWishlistByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetWishlistByUserID.t.Errorf("ICartRepoMock.GetWishlistByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWishlistByUserID.t.Errorf("ICartRepoMock.GetWishlistByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWishlistByUserID.GetWishlistByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWishlistByUserID.t.Fatal("No results are set for the ICartRepoMock.GetWishlistByUserID")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetWishlistByUserID.funcGetWishlistByUserID != nil {
+		return mmGetWishlistByUserID.funcGetWishlistByUserID(ctx, userID)
+	}
+	mmGetWishlistByUserID.t.Fatalf("Unexpected call to ICartRepoMock.GetWishlistByUserID. %v %v", ctx, userID)
+	return
+}
+
+// GetWishlistByUserIDAfterCounter returns a count of finished ICartRepoMock.GetWishlistByUserID invocations
+func (mmGetWishlistByUserID *ICartRepoMock) GetWishlistByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWishlistByUserID.afterGetWishlistByUserIDCounter)
+}
+
+// GetWishlistByUserIDBeforeCounter returns a count of ICartRepoMock.GetWishlistByUserID invocations
+func (mmGetWishlistByUserID *ICartRepoMock) GetWishlistByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWishlistByUserID.beforeGetWishlistByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetWishlistByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWishlistByUserID *mICartRepoMockGetWishlistByUserID) Calls() []*ICartRepoMockGetWishlistByUserIDParams {
+	mmGetWishlistByUserID.mutex.RLock()
+
+	argCopy := make([]*ICartRepoMockGetWishlistByUserIDParams, len(mmGetWishlistByUserID.callArgs))
+	copy(argCopy, mmGetWishlistByUserID.callArgs)
+
+	mmGetWishlistByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWishlistByUserIDDone returns true if the count of the GetWishlistByUserID invocations corresponds
+// the number of defined expectations
+func (m *ICartRepoMock) MinimockGetWishlistByUserIDDone() bool {
+	if m.GetWishlistByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWishlistByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWishlistByUserIDMock.invocationsDone()
+}
+
+// MinimockGetWishlistByUserIDInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetWishlistByUserIDInspect() {
+	for _, e := range m.GetWishlistByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetWishlistByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetWishlistByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWishlistByUserIDMock.defaultExpectation != nil && afterGetWishlistByUserIDCounter < 1 {
+		if m.GetWishlistByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistByUserID at\n%s", m.GetWishlistByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistByUserID at\n%s with params: %#v", m.GetWishlistByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetWishlistByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWishlistByUserID != nil && afterGetWishlistByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetWishlistByUserID at\n%s", m.funcGetWishlistByUserIDOrigin)
+	}
+
+	if !m.GetWishlistByUserIDMock.invocationsDone() && afterGetWishlistByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetWishlistByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWishlistByUserIDMock.expectedInvocations), m.GetWishlistByUserIDMock.expectedInvocationsOrigin, afterGetWishlistByUserIDCounter)
+	}
+}
+
+type mICartRepoMockGetWishlistItem struct {
+	optional           bool
+	mock               *ICartRepoMock
+	defaultExpectation *ICartRepoMockGetWishlistItemExpectation
+	expectations       []*ICartRepoMockGetWishlistItemExpectation
+
+	callArgs []*ICartRepoMockGetWishlistItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICartRepoMockGetWishlistItemExpectation specifies expectation struct of the ICartRepo.GetWishlistItem
+type ICartRepoMockGetWishlistItemExpectation struct {
+	mock               *ICartRepoMock
+	params             *ICartRepoMockGetWishlistItemParams
+	paramPtrs          *ICartRepoMockGetWishlistItemParamPtrs
+	expectationOrigins ICartRepoMockGetWishlistItemExpectationOrigins
+	results            *ICartRepoMockGetWishlistItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICartRepoMockGetWishlistItemParams contains parameters of the ICartRepo.GetWishlistItem
+type ICartRepoMockGetWishlistItemParams struct {
+	ctx    context.Context
+	userID models.UserID
+	skuID  models.SKUID
+}
+
+// ICartRepoMockGetWishlistItemParamPtrs contains pointers to parameters of the ICartRepo.GetWishlistItem
+type ICartRepoMockGetWishlistItemParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+	skuID  *models.SKUID
+}
+
+// ICartRepoMockGetWishlistItemResults contains results of the ICartRepo.GetWishlistItem
+type ICartRepoMockGetWishlistItemResults struct {
+	c2  models.CartItem
+	err error
+}
+
+// ICartRepoMockGetWishlistItemOrigins contains origins of expectations of the ICartRepo.GetWishlistItem
+type ICartRepoMockGetWishlistItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Optional() *mICartRepoMockGetWishlistItem {
+	mmGetWishlistItem.optional = true
+	return mmGetWishlistItem
+}
+
+// Expect sets up expected params for ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockGetWishlistItem {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
+	}
+
+	if mmGetWishlistItem.defaultExpectation == nil {
+		mmGetWishlistItem.defaultExpectation = &ICartRepoMockGetWishlistItemExpectation{}
+	}
+
+	if mmGetWishlistItem.defaultExpectation.paramPtrs != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by ExpectParams functions")
+	}
+
+	mmGetWishlistItem.defaultExpectation.params = &ICartRepoMockGetWishlistItemParams{ctx, userID, skuID}
+	mmGetWishlistItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWishlistItem.expectations {
+		if minimock.Equal(e.params, mmGetWishlistItem.defaultExpectation.params) {
+			mmGetWishlistItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWishlistItem.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWishlistItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetWishlistItem {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
+	}
+
+	if mmGetWishlistItem.defaultExpectation == nil {
+		mmGetWishlistItem.defaultExpectation = &ICartRepoMockGetWishlistItemExpectation{}
+	}
+
+	if mmGetWishlistItem.defaultExpectation.params != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Expect")
+	}
+
+	if mmGetWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmGetWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockGetWishlistItemParamPtrs{}
+	}
+	mmGetWishlistItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWishlistItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWishlistItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetWishlistItem {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
+	}
+
+	if mmGetWishlistItem.defaultExpectation == nil {
+		mmGetWishlistItem.defaultExpectation = &ICartRepoMockGetWishlistItemExpectation{}
+	}
+
+	if mmGetWishlistItem.defaultExpectation.params != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Expect")
+	}
+
+	if mmGetWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmGetWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockGetWishlistItemParamPtrs{}
+	}
+	mmGetWishlistItem.defaultExpectation.paramPtrs.userID = &userID
+	mmGetWishlistItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetWishlistItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockGetWishlistItem {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
+	}
+
+	if mmGetWishlistItem.defaultExpectation == nil {
+		mmGetWishlistItem.defaultExpectation = &ICartRepoMockGetWishlistItemExpectation{}
+	}
+
+	if mmGetWishlistItem.defaultExpectation.params != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Expect")
+	}
+
+	if mmGetWishlistItem.defaultExpectation.paramPtrs == nil {
+		mmGetWishlistItem.defaultExpectation.paramPtrs = &ICartRepoMockGetWishlistItemParamPtrs{}
+	}
+	mmGetWishlistItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetWishlistItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmGetWishlistItem
 }
 
-// Inspect accepts an inspector function that has same arguments as the ICartRepo.DeleteItem
-func (mmDeleteItem *mICartRepoMockDeleteItem) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockDeleteItem {
-	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.DeleteItem")
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockGetWishlistItem {
+	if mmGetWishlistItem.mock.inspectFuncGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetWishlistItem")
 	}
 
-	mmDeleteItem.mock.inspectFuncDeleteItem = f
+	mmGetWishlistItem.mock.inspectFuncGetWishlistItem = f
 
-	return mmDeleteItem
+	return mmGetWishlistItem
 }
 
-// Return sets up results that will be returned by ICartRepo.DeleteItem
-func (mmDeleteItem *mICartRepoMockDeleteItem) Return(err error) *ICartRepoMock {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+// Return sets up results that will be returned by ICartRepo.GetWishlistItem
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Return(c2 models.CartItem, err error) *ICartRepoMock {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ICartRepoMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	if mmGetWishlistItem.defaultExpectation == nil {
+		mmGetWishlistItem.defaultExpectation = &ICartRepoMockGetWishlistItemExpectation{mock: mmGetWishlistItem.mock}
 	}
-	mmDeleteItem.defaultExpectation.results = &ICartRepoMockDeleteItemResults{err}
-	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+	mmGetWishlistItem.defaultExpectation.results = &ICartRepoMockGetWishlistItemResults{c2, err}
+	mmGetWishlistItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistItem.mock
 }
 
-// Set uses given function f to mock the ICartRepo.DeleteItem method
-func (mmDeleteItem *mICartRepoMockDeleteItem) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error)) *ICartRepoMock {
-	if mmDeleteItem.defaultExpectation != nil {
-		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.DeleteItem method")
+// Set uses given function f to mock the ICartRepo.GetWishlistItem method
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartItem, err error)) *ICartRepoMock {
+	if mmGetWishlistItem.defaultExpectation != nil {
+		mmGetWishlistItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetWishlistItem method")
 	}
 
-	if len(mmDeleteItem.expectations) > 0 {
-		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.DeleteItem method")
+	if len(mmGetWishlistItem.expectations) > 0 {
+		mmGetWishlistItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetWishlistItem method")
 	}
 
-	mmDeleteItem.mock.funcDeleteItem = f
-	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+	mmGetWishlistItem.mock.funcGetWishlistItem = f
+	mmGetWishlistItem.mock.funcGetWishlistItemOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistItem.mock
 }
 
-// When sets expectation for the ICartRepo.DeleteItem which will trigger the result defined by the following
+// When sets expectation for the ICartRepo.GetWishlistItem which will trigger the result defined by the following
 // Then helper
-func (mmDeleteItem *mICartRepoMockDeleteItem) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockDeleteItemExpectation {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ICartRepoMock.DeleteItem mock is already set by Set")
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockGetWishlistItemExpectation {
+	if mmGetWishlistItem.mock.funcGetWishlistItem != nil {
+		mmGetWishlistItem.mock.t.Fatalf("ICartRepoMock.GetWishlistItem mock is already set by Set")
 	}
 
-	expectation := &ICartRepoMockDeleteItemExpectation{
-		mock:               mmDeleteItem.mock,
-		params:             &ICartRepoMockDeleteItemParams{ctx, userID, skuID},
-		expectationOrigins: ICartRepoMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ICartRepoMockGetWishlistItemExpectation{
+		mock:               mmGetWishlistItem.mock,
+		params:             &ICartRepoMockGetWishlistItemParams{ctx, userID, skuID},
+		expectationOrigins: ICartRepoMockGetWishlistItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	mmGetWishlistItem.expectations = append(mmGetWishlistItem.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ICartRepo.DeleteItem return parameters for the expectation previously defined by the When method
-func (e *ICartRepoMockDeleteItemExpectation) Then(err error) *ICartRepoMock {
-	e.results = &ICartRepoMockDeleteItemResults{err}
+// Then sets up ICartRepo.GetWishlistItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockGetWishlistItemExpectation) Then(c2 models.CartItem, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockGetWishlistItemResults{c2, err}
 	return e.mock
 }
 
-// Times sets number of times ICartRepo.DeleteItem should be invoked
-func (mmDeleteItem *mICartRepoMockDeleteItem) Times(n uint64) *mICartRepoMockDeleteItem {
+// Times sets number of times ICartRepo.GetWishlistItem should be invoked
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Times(n uint64) *mICartRepoMockGetWishlistItem {
 	if n == 0 {
-		mmDeleteItem.mock.t.Fatalf("Times of ICartRepoMock.DeleteItem mock can not be zero")
+		mmGetWishlistItem.mock.t.Fatalf("Times of ICartRepoMock.GetWishlistItem mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
-	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem
+	mm_atomic.StoreUint64(&mmGetWishlistItem.expectedInvocations, n)
+	mmGetWishlistItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWishlistItem
 }
 
-func (mmDeleteItem *mICartRepoMockDeleteItem) invocationsDone() bool {
-	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) invocationsDone() bool {
+	if len(mmGetWishlistItem.expectations) == 0 && mmGetWishlistItem.defaultExpectation == nil && mmGetWishlistItem.mock.funcGetWishlistItem == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWishlistItem.mock.afterGetWishlistItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWishlistItem.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteItem implements mm_repository.ICartRepo
-func (mmDeleteItem *ICartRepoMock) DeleteItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+// GetWishlistItem implements mm_repository.ICartRepo
+func (mmGetWishlistItem *ICartRepoMock) GetWishlistItem(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetWishlistItem.beforeGetWishlistItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWishlistItem.afterGetWishlistItemCounter, 1)
 
-	mmDeleteItem.t.Helper()
+	mmGetWishlistItem.t.Helper()
 
-	if mmDeleteItem.inspectFuncDeleteItem != nil {
-		mmDeleteItem.inspectFuncDeleteItem(ctx, userID, skuID)
+	if mmGetWishlistItem.inspectFuncGetWishlistItem != nil {
+		mmGetWishlistItem.inspectFuncGetWishlistItem(ctx, userID, skuID)
 	}
 
-	mm_params := ICartRepoMockDeleteItemParams{ctx, userID, skuID}
+	mm_params := ICartRepoMockGetWishlistItemParams{ctx, userID, skuID}
 
 	// Record call args
-	mmDeleteItem.DeleteItemMock.mutex.Lock()
-	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
-	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+	mmGetWishlistItem.GetWishlistItemMock.mutex.Lock()
+	mmGetWishlistItem.GetWishlistItemMock.callArgs = append(mmGetWishlistItem.GetWishlistItemMock.callArgs, &mm_params)
+	mmGetWishlistItem.GetWishlistItemMock.mutex.Unlock()
 
-	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+	for _, e := range mmGetWishlistItem.GetWishlistItemMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.c2, e.results.err
 		}
 	}
 
-	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+	if mmGetWishlistItem.GetWishlistItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.paramPtrs
 
-		mm_got := ICartRepoMockDeleteItemParams{ctx, userID, skuID}
+		mm_got := ICartRepoMockGetWishlistItemParams{ctx, userID, skuID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetWishlistItem.t.Errorf("ICartRepoMock.GetWishlistItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmGetWishlistItem.t.Errorf("ICartRepoMock.GetWishlistItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+				mmGetWishlistItem.t.Errorf("ICartRepoMock.GetWishlistItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteItem.t.Errorf("ICartRepoMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetWishlistItem.t.Errorf("ICartRepoMock.GetWishlistItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		mm_results := mmGetWishlistItem.GetWishlistItemMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteItem.t.Fatal("No results are set for the ICartRepoMock.DeleteItem")
+			mmGetWishlistItem.t.Fatal("No results are set for the ICartRepoMock.GetWishlistItem")
 		}
-		return (*mm_results).err
+		return (*mm_results).c2, (*mm_results).err
 	}
-	if mmDeleteItem.funcDeleteItem != nil {
-		return mmDeleteItem.funcDeleteItem(ctx, userID, skuID)
+	if mmGetWishlistItem.funcGetWishlistItem != nil {
+		return mmGetWishlistItem.funcGetWishlistItem(ctx, userID, skuID)
 	}
-	mmDeleteItem.t.Fatalf("Unexpected call to ICartRepoMock.DeleteItem. %v %v %v", ctx, userID, skuID)
+	mmGetWishlistItem.t.Fatalf("Unexpected call to ICartRepoMock.GetWishlistItem. %v %v %v", ctx, userID, skuID)
 	return
 }
 
-// DeleteItemAfterCounter returns a count of finished ICartRepoMock.DeleteItem invocations
-func (mmDeleteItem *ICartRepoMock) DeleteItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+// GetWishlistItemAfterCounter returns a count of finished ICartRepoMock.GetWishlistItem invocations
+func (mmGetWishlistItem *ICartRepoMock) GetWishlistItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWishlistItem.afterGetWishlistItemCounter)
 }
 
-// DeleteItemBeforeCounter returns a count of ICartRepoMock.DeleteItem invocations
-func (mmDeleteItem *ICartRepoMock) DeleteItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+// GetWishlistItemBeforeCounter returns a count of ICartRepoMock.GetWishlistItem invocations
+func (mmGetWishlistItem *ICartRepoMock) GetWishlistItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWishlistItem.beforeGetWishlistItemCounter)
 }
 
-// Calls returns a list of arguments used in each call to ICartRepoMock.DeleteItem.
+// Calls returns a list of arguments used in each call to ICartRepoMock.GetWishlistItem.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteItem *mICartRepoMockDeleteItem) Calls() []*ICartRepoMockDeleteItemParams {
-	mmDeleteItem.mutex.RLock()
+func (mmGetWishlistItem *mICartRepoMockGetWishlistItem) Calls() []*ICartRepoMockGetWishlistItemParams {
+	mmGetWishlistItem.mutex.RLock()
 
-	argCopy := make([]*ICartRepoMockDeleteItemParams, len(mmDeleteItem.callArgs))
-	copy(argCopy, mmDeleteItem.callArgs)
+	argCopy := make([]*ICartRepoMockGetWishlistItemParams, len(mmGetWishlistItem.callArgs))
+	copy(argCopy, mmGetWishlistItem.callArgs)
 
-	mmDeleteItem.mutex.RUnlock()
+	mmGetWishlistItem.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// MinimockGetWishlistItemDone returns true if the count of the GetWishlistItem invocations corresponds
 // the number of defined expectations
-func (m *ICartRepoMock) MinimockDeleteItemDone() bool {
-	if m.DeleteItemMock.optional {
+func (m *ICartRepoMock) MinimockGetWishlistItemDone() bool {
+	if m.GetWishlistItemMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteItemMock.expectations {
+	for _, e := range m.GetWishlistItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteItemMock.invocationsDone()
+	return m.GetWishlistItemMock.invocationsDone()
 }
 
-// MinimockDeleteItemInspect logs each unmet expectation
-func (m *ICartRepoMock) MinimockDeleteItemInspect() {
-	for _, e := range m.DeleteItemMock.expectations {
+// MinimockGetWishlistItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockGetWishlistItemInspect() {
+	for _, e := range m.GetWishlistItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	afterGetWishlistItemCounter := mm_atomic.LoadUint64(&m.afterGetWishlistItemCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
-		if m.DeleteItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+	if m.GetWishlistItemMock.defaultExpectation != nil && afterGetWishlistItemCounter < 1 {
+		if m.GetWishlistItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistItem at\n%s", m.GetWishlistItemMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ICartRepoMock.GetWishlistItem at\n%s with params: %#v", m.GetWishlistItemMock.defaultExpectation.expectationOrigins.origin, *m.GetWishlistItemMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
-		m.t.Errorf("Expected call to ICartRepoMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	if m.funcGetWishlistItem != nil && afterGetWishlistItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.GetWishlistItem at\n%s", m.funcGetWishlistItemOrigin)
 	}
 
-	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to ICartRepoMock.DeleteItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	if !m.GetWishlistItemMock.invocationsDone() && afterGetWishlistItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.GetWishlistItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWishlistItemMock.expectedInvocations), m.GetWishlistItemMock.expectedInvocationsOrigin, afterGetWishlistItemCounter)
 	}
 }
 
-type mICartRepoMockGetCartByUserID struct {
+type mICartRepoMockIncrementVersion struct {
 	optional           bool
 	mock               *ICartRepoMock
-	defaultExpectation *ICartRepoMockGetCartByUserIDExpectation
-	expectations       []*ICartRepoMockGetCartByUserIDExpectation
+	defaultExpectation *ICartRepoMockIncrementVersionExpectation
+	expectations       []*ICartRepoMockIncrementVersionExpectation
 
-	callArgs []*ICartRepoMockGetCartByUserIDParams
+	callArgs []*ICartRepoMockIncrementVersionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ICartRepoMockGetCartByUserIDExpectation specifies expectation struct of the ICartRepo.GetCartByUserID
-type ICartRepoMockGetCartByUserIDExpectation struct {
+// ICartRepoMockIncrementVersionExpectation specifies expectation struct of the ICartRepo.IncrementVersion
+type ICartRepoMockIncrementVersionExpectation struct {
 	mock               *ICartRepoMock
-	params             *ICartRepoMockGetCartByUserIDParams
-	paramPtrs          *ICartRepoMockGetCartByUserIDParamPtrs
-	expectationOrigins ICartRepoMockGetCartByUserIDExpectationOrigins
-	results            *ICartRepoMockGetCartByUserIDResults
+	params             *ICartRepoMockIncrementVersionParams
+	paramPtrs          *ICartRepoMockIncrementVersionParamPtrs
+	expectationOrigins ICartRepoMockIncrementVersionExpectationOrigins
+	results            *ICartRepoMockIncrementVersionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ICartRepoMockGetCartByUserIDParams contains parameters of the ICartRepo.GetCartByUserID
-type ICartRepoMockGetCartByUserIDParams struct {
+// ICartRepoMockIncrementVersionParams contains parameters of the ICartRepo.IncrementVersion
+type ICartRepoMockIncrementVersionParams struct {
 	ctx    context.Context
 	userID models.UserID
 }
 
-// ICartRepoMockGetCartByUserIDParamPtrs contains pointers to parameters of the ICartRepo.GetCartByUserID
-type ICartRepoMockGetCartByUserIDParamPtrs struct {
+// ICartRepoMockIncrementVersionParamPtrs contains pointers to parameters of the ICartRepo.IncrementVersion
+type ICartRepoMockIncrementVersionParamPtrs struct {
 	ctx    *context.Context
 	userID *models.UserID
 }
 
-// ICartRepoMockGetCartByUserIDResults contains results of the ICartRepo.GetCartByUserID
-type ICartRepoMockGetCartByUserIDResults struct {
-	ca1 []models.CartItem
+// ICartRepoMockIncrementVersionResults contains results of the ICartRepo.IncrementVersion
+type ICartRepoMockIncrementVersionResults struct {
+	u1  uint64
 	err error
 }
 
-// ICartRepoMockGetCartByUserIDOrigins contains origins of expectations of the ICartRepo.GetCartByUserID
-type ICartRepoMockGetCartByUserIDExpectationOrigins struct {
+// ICartRepoMockIncrementVersionOrigins contains origins of expectations of the ICartRepo.IncrementVersion
+type ICartRepoMockIncrementVersionExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originUserID string
@@ -1202,345 +4916,341 @@ type ICartRepoMockGetCartByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Optional() *mICartRepoMockGetCartByUserID {
-	mmGetCartByUserID.optional = true
-	return mmGetCartByUserID
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Optional() *mICartRepoMockIncrementVersion {
+	mmIncrementVersion.optional = true
+	return mmIncrementVersion
 }
 
-// Expect sets up expected params for ICartRepo.GetCartByUserID
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Expect(ctx context.Context, userID models.UserID) *mICartRepoMockGetCartByUserID {
-	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+// Expect sets up expected params for ICartRepo.IncrementVersion
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Expect(ctx context.Context, userID models.UserID) *mICartRepoMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Set")
 	}
 
-	if mmGetCartByUserID.defaultExpectation == nil {
-		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &ICartRepoMockIncrementVersionExpectation{}
 	}
 
-	if mmGetCartByUserID.defaultExpectation.paramPtrs != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by ExpectParams functions")
+	if mmIncrementVersion.defaultExpectation.paramPtrs != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by ExpectParams functions")
 	}
 
-	mmGetCartByUserID.defaultExpectation.params = &ICartRepoMockGetCartByUserIDParams{ctx, userID}
-	mmGetCartByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCartByUserID.expectations {
-		if minimock.Equal(e.params, mmGetCartByUserID.defaultExpectation.params) {
-			mmGetCartByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartByUserID.defaultExpectation.params)
+	mmIncrementVersion.defaultExpectation.params = &ICartRepoMockIncrementVersionParams{ctx, userID}
+	mmIncrementVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncrementVersion.expectations {
+		if minimock.Equal(e.params, mmIncrementVersion.defaultExpectation.params) {
+			mmIncrementVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncrementVersion.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCartByUserID
+	return mmIncrementVersion
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetCartByUserID
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetCartByUserID {
-	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.IncrementVersion
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) ExpectCtxParam1(ctx context.Context) *mICartRepoMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Set")
 	}
 
-	if mmGetCartByUserID.defaultExpectation == nil {
-		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &ICartRepoMockIncrementVersionExpectation{}
 	}
 
-	if mmGetCartByUserID.defaultExpectation.params != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Expect")
+	if mmIncrementVersion.defaultExpectation.params != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Expect")
 	}
 
-	if mmGetCartByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetCartByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartByUserIDParamPtrs{}
+	if mmIncrementVersion.defaultExpectation.paramPtrs == nil {
+		mmIncrementVersion.defaultExpectation.paramPtrs = &ICartRepoMockIncrementVersionParamPtrs{}
 	}
-	mmGetCartByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCartByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmIncrementVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncrementVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCartByUserID
+	return mmIncrementVersion
 }
 
-// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetCartByUserID
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetCartByUserID {
-	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for ICartRepo.IncrementVersion
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockIncrementVersion {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Set")
 	}
 
-	if mmGetCartByUserID.defaultExpectation == nil {
-		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{}
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &ICartRepoMockIncrementVersionExpectation{}
 	}
 
-	if mmGetCartByUserID.defaultExpectation.params != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Expect")
+	if mmIncrementVersion.defaultExpectation.params != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Expect")
 	}
 
-	if mmGetCartByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetCartByUserID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartByUserIDParamPtrs{}
+	if mmIncrementVersion.defaultExpectation.paramPtrs == nil {
+		mmIncrementVersion.defaultExpectation.paramPtrs = &ICartRepoMockIncrementVersionParamPtrs{}
 	}
-	mmGetCartByUserID.defaultExpectation.paramPtrs.userID = &userID
-	mmGetCartByUserID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmIncrementVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmIncrementVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetCartByUserID
+	return mmIncrementVersion
 }
 
-// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetCartByUserID
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Inspect(f func(ctx context.Context, userID models.UserID)) *mICartRepoMockGetCartByUserID {
-	if mmGetCartByUserID.mock.inspectFuncGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetCartByUserID")
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.IncrementVersion
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Inspect(f func(ctx context.Context, userID models.UserID)) *mICartRepoMockIncrementVersion {
+	if mmIncrementVersion.mock.inspectFuncIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.IncrementVersion")
 	}
 
-	mmGetCartByUserID.mock.inspectFuncGetCartByUserID = f
+	mmIncrementVersion.mock.inspectFuncIncrementVersion = f
 
-	return mmGetCartByUserID
+	return mmIncrementVersion
 }
 
-// Return sets up results that will be returned by ICartRepo.GetCartByUserID
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Return(ca1 []models.CartItem, err error) *ICartRepoMock {
-	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+// Return sets up results that will be returned by ICartRepo.IncrementVersion
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Return(u1 uint64, err error) *ICartRepoMock {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Set")
 	}
 
-	if mmGetCartByUserID.defaultExpectation == nil {
-		mmGetCartByUserID.defaultExpectation = &ICartRepoMockGetCartByUserIDExpectation{mock: mmGetCartByUserID.mock}
+	if mmIncrementVersion.defaultExpectation == nil {
+		mmIncrementVersion.defaultExpectation = &ICartRepoMockIncrementVersionExpectation{mock: mmIncrementVersion.mock}
 	}
-	mmGetCartByUserID.defaultExpectation.results = &ICartRepoMockGetCartByUserIDResults{ca1, err}
-	mmGetCartByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCartByUserID.mock
+	mmIncrementVersion.defaultExpectation.results = &ICartRepoMockIncrementVersionResults{u1, err}
+	mmIncrementVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion.mock
 }
 
-// Set uses given function f to mock the ICartRepo.GetCartByUserID method
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Set(f func(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error)) *ICartRepoMock {
-	if mmGetCartByUserID.defaultExpectation != nil {
-		mmGetCartByUserID.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetCartByUserID method")
+// Set uses given function f to mock the ICartRepo.IncrementVersion method
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Set(f func(ctx context.Context, userID models.UserID) (u1 uint64, err error)) *ICartRepoMock {
+	if mmIncrementVersion.defaultExpectation != nil {
+		mmIncrementVersion.mock.t.Fatalf("Default expectation is already set for the ICartRepo.IncrementVersion method")
 	}
 
-	if len(mmGetCartByUserID.expectations) > 0 {
-		mmGetCartByUserID.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetCartByUserID method")
+	if len(mmIncrementVersion.expectations) > 0 {
+		mmIncrementVersion.mock.t.Fatalf("Some expectations are already set for the ICartRepo.IncrementVersion method")
 	}
 
-	mmGetCartByUserID.mock.funcGetCartByUserID = f
-	mmGetCartByUserID.mock.funcGetCartByUserIDOrigin = minimock.CallerInfo(1)
-	return mmGetCartByUserID.mock
+	mmIncrementVersion.mock.funcIncrementVersion = f
+	mmIncrementVersion.mock.funcIncrementVersionOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion.mock
 }
 
-// When sets expectation for the ICartRepo.GetCartByUserID which will trigger the result defined by the following
+// When sets expectation for the ICartRepo.IncrementVersion which will trigger the result defined by the following
 // Then helper
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) When(ctx context.Context, userID models.UserID) *ICartRepoMockGetCartByUserIDExpectation {
-	if mmGetCartByUserID.mock.funcGetCartByUserID != nil {
-		mmGetCartByUserID.mock.t.Fatalf("ICartRepoMock.GetCartByUserID mock is already set by Set")
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) When(ctx context.Context, userID models.UserID) *ICartRepoMockIncrementVersionExpectation {
+	if mmIncrementVersion.mock.funcIncrementVersion != nil {
+		mmIncrementVersion.mock.t.Fatalf("ICartRepoMock.IncrementVersion mock is already set by Set")
 	}
 
-	expectation := &ICartRepoMockGetCartByUserIDExpectation{
-		mock:               mmGetCartByUserID.mock,
-		params:             &ICartRepoMockGetCartByUserIDParams{ctx, userID},
-		expectationOrigins: ICartRepoMockGetCartByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ICartRepoMockIncrementVersionExpectation{
+		mock:               mmIncrementVersion.mock,
+		params:             &ICartRepoMockIncrementVersionParams{ctx, userID},
+		expectationOrigins: ICartRepoMockIncrementVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetCartByUserID.expectations = append(mmGetCartByUserID.expectations, expectation)
+	mmIncrementVersion.expectations = append(mmIncrementVersion.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ICartRepo.GetCartByUserID return parameters for the expectation previously defined by the When method
-func (e *ICartRepoMockGetCartByUserIDExpectation) Then(ca1 []models.CartItem, err error) *ICartRepoMock {
-	e.results = &ICartRepoMockGetCartByUserIDResults{ca1, err}
+// Then sets up ICartRepo.IncrementVersion return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockIncrementVersionExpectation) Then(u1 uint64, err error) *ICartRepoMock {
+	e.results = &ICartRepoMockIncrementVersionResults{u1, err}
 	return e.mock
 }
 
-// Times sets number of times ICartRepo.GetCartByUserID should be invoked
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Times(n uint64) *mICartRepoMockGetCartByUserID {
+// Times sets number of times ICartRepo.IncrementVersion should be invoked
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Times(n uint64) *mICartRepoMockIncrementVersion {
 	if n == 0 {
-		mmGetCartByUserID.mock.t.Fatalf("Times of ICartRepoMock.GetCartByUserID mock can not be zero")
+		mmIncrementVersion.mock.t.Fatalf("Times of ICartRepoMock.IncrementVersion mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetCartByUserID.expectedInvocations, n)
-	mmGetCartByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCartByUserID
+	mm_atomic.StoreUint64(&mmIncrementVersion.expectedInvocations, n)
+	mmIncrementVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncrementVersion
 }
 
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) invocationsDone() bool {
-	if len(mmGetCartByUserID.expectations) == 0 && mmGetCartByUserID.defaultExpectation == nil && mmGetCartByUserID.mock.funcGetCartByUserID == nil {
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) invocationsDone() bool {
+	if len(mmIncrementVersion.expectations) == 0 && mmIncrementVersion.defaultExpectation == nil && mmIncrementVersion.mock.funcIncrementVersion == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCartByUserID.mock.afterGetCartByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmIncrementVersion.mock.afterIncrementVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncrementVersion.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetCartByUserID implements mm_repository.ICartRepo
-func (mmGetCartByUserID *ICartRepoMock) GetCartByUserID(ctx context.Context, userID models.UserID) (ca1 []models.CartItem, err error) {
-	mm_atomic.AddUint64(&mmGetCartByUserID.beforeGetCartByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCartByUserID.afterGetCartByUserIDCounter, 1)
+// IncrementVersion implements mm_repository.ICartRepo
+func (mmIncrementVersion *ICartRepoMock) IncrementVersion(ctx context.Context, userID models.UserID) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmIncrementVersion.beforeIncrementVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncrementVersion.afterIncrementVersionCounter, 1)
 
-	mmGetCartByUserID.t.Helper()
+	mmIncrementVersion.t.Helper()
 
-	if mmGetCartByUserID.inspectFuncGetCartByUserID != nil {
-		mmGetCartByUserID.inspectFuncGetCartByUserID(ctx, userID)
+	if mmIncrementVersion.inspectFuncIncrementVersion != nil {
+		mmIncrementVersion.inspectFuncIncrementVersion(ctx, userID)
 	}
 
-	mm_params := ICartRepoMockGetCartByUserIDParams{ctx, userID}
+	mm_params := ICartRepoMockIncrementVersionParams{ctx, userID}
 
 	// Record call args
-	mmGetCartByUserID.GetCartByUserIDMock.mutex.Lock()
-	mmGetCartByUserID.GetCartByUserIDMock.callArgs = append(mmGetCartByUserID.GetCartByUserIDMock.callArgs, &mm_params)
-	mmGetCartByUserID.GetCartByUserIDMock.mutex.Unlock()
+	mmIncrementVersion.IncrementVersionMock.mutex.Lock()
+	mmIncrementVersion.IncrementVersionMock.callArgs = append(mmIncrementVersion.IncrementVersionMock.callArgs, &mm_params)
+	mmIncrementVersion.IncrementVersionMock.mutex.Unlock()
 
-	for _, e := range mmGetCartByUserID.GetCartByUserIDMock.expectations {
+	for _, e := range mmIncrementVersion.IncrementVersionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ca1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
-	if mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.paramPtrs
+	if mmIncrementVersion.IncrementVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncrementVersion.IncrementVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncrementVersion.IncrementVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmIncrementVersion.IncrementVersionMock.defaultExpectation.paramPtrs
 
-		mm_got := ICartRepoMockGetCartByUserIDParams{ctx, userID}
+		mm_got := ICartRepoMockIncrementVersionParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmIncrementVersion.t.Errorf("ICartRepoMock.IncrementVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmIncrementVersion.t.Errorf("ICartRepoMock.IncrementVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCartByUserID.t.Errorf("ICartRepoMock.GetCartByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmIncrementVersion.t.Errorf("ICartRepoMock.IncrementVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncrementVersion.IncrementVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCartByUserID.GetCartByUserIDMock.defaultExpectation.results
+		mm_results := mmIncrementVersion.IncrementVersionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCartByUserID.t.Fatal("No results are set for the ICartRepoMock.GetCartByUserID")
+			mmIncrementVersion.t.Fatal("No results are set for the ICartRepoMock.IncrementVersion")
 		}
-		return (*mm_results).ca1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmGetCartByUserID.funcGetCartByUserID != nil {
-		return mmGetCartByUserID.funcGetCartByUserID(ctx, userID)
+	if mmIncrementVersion.funcIncrementVersion != nil {
+		return mmIncrementVersion.funcIncrementVersion(ctx, userID)
 	}
-	mmGetCartByUserID.t.Fatalf("Unexpected call to ICartRepoMock.GetCartByUserID. %v %v", ctx, userID)
+	mmIncrementVersion.t.Fatalf("Unexpected call to ICartRepoMock.IncrementVersion. %v %v", ctx, userID)
 	return
 }
 
-// GetCartByUserIDAfterCounter returns a count of finished ICartRepoMock.GetCartByUserID invocations
-func (mmGetCartByUserID *ICartRepoMock) GetCartByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCartByUserID.afterGetCartByUserIDCounter)
+// IncrementVersionAfterCounter returns a count of finished ICartRepoMock.IncrementVersion invocations
+func (mmIncrementVersion *ICartRepoMock) IncrementVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVersion.afterIncrementVersionCounter)
 }
 
-// GetCartByUserIDBeforeCounter returns a count of ICartRepoMock.GetCartByUserID invocations
-func (mmGetCartByUserID *ICartRepoMock) GetCartByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCartByUserID.beforeGetCartByUserIDCounter)
+// IncrementVersionBeforeCounter returns a count of ICartRepoMock.IncrementVersion invocations
+func (mmIncrementVersion *ICartRepoMock) IncrementVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncrementVersion.beforeIncrementVersionCounter)
 }
 
-// Calls returns a list of arguments used in each call to ICartRepoMock.GetCartByUserID.
+// Calls returns a list of arguments used in each call to ICartRepoMock.IncrementVersion.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCartByUserID *mICartRepoMockGetCartByUserID) Calls() []*ICartRepoMockGetCartByUserIDParams {
-	mmGetCartByUserID.mutex.RLock()
+func (mmIncrementVersion *mICartRepoMockIncrementVersion) Calls() []*ICartRepoMockIncrementVersionParams {
+	mmIncrementVersion.mutex.RLock()
 
-	argCopy := make([]*ICartRepoMockGetCartByUserIDParams, len(mmGetCartByUserID.callArgs))
-	copy(argCopy, mmGetCartByUserID.callArgs)
+	argCopy := make([]*ICartRepoMockIncrementVersionParams, len(mmIncrementVersion.callArgs))
+	copy(argCopy, mmIncrementVersion.callArgs)
 
-	mmGetCartByUserID.mutex.RUnlock()
+	mmIncrementVersion.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCartByUserIDDone returns true if the count of the GetCartByUserID invocations corresponds
+// MinimockIncrementVersionDone returns true if the count of the IncrementVersion invocations corresponds
 // the number of defined expectations
-func (m *ICartRepoMock) MinimockGetCartByUserIDDone() bool {
-	if m.GetCartByUserIDMock.optional {
+func (m *ICartRepoMock) MinimockIncrementVersionDone() bool {
+	if m.IncrementVersionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCartByUserIDMock.expectations {
+	for _, e := range m.IncrementVersionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCartByUserIDMock.invocationsDone()
+	return m.IncrementVersionMock.invocationsDone()
 }
 
-// MinimockGetCartByUserIDInspect logs each unmet expectation
-func (m *ICartRepoMock) MinimockGetCartByUserIDInspect() {
-	for _, e := range m.GetCartByUserIDMock.expectations {
+// MinimockIncrementVersionInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockIncrementVersionInspect() {
+	for _, e := range m.IncrementVersionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ICartRepoMock.IncrementVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCartByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetCartByUserIDCounter)
+	afterIncrementVersionCounter := mm_atomic.LoadUint64(&m.afterIncrementVersionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCartByUserIDMock.defaultExpectation != nil && afterGetCartByUserIDCounter < 1 {
-		if m.GetCartByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s", m.GetCartByUserIDMock.defaultExpectation.returnOrigin)
+	if m.IncrementVersionMock.defaultExpectation != nil && afterIncrementVersionCounter < 1 {
+		if m.IncrementVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.IncrementVersion at\n%s", m.IncrementVersionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s with params: %#v", m.GetCartByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCartByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ICartRepoMock.IncrementVersion at\n%s with params: %#v", m.IncrementVersionMock.defaultExpectation.expectationOrigins.origin, *m.IncrementVersionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCartByUserID != nil && afterGetCartByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to ICartRepoMock.GetCartByUserID at\n%s", m.funcGetCartByUserIDOrigin)
+	if m.funcIncrementVersion != nil && afterIncrementVersionCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.IncrementVersion at\n%s", m.funcIncrementVersionOrigin)
 	}
 
-	if !m.GetCartByUserIDMock.invocationsDone() && afterGetCartByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to ICartRepoMock.GetCartByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCartByUserIDMock.expectedInvocations), m.GetCartByUserIDMock.expectedInvocationsOrigin, afterGetCartByUserIDCounter)
+	if !m.IncrementVersionMock.invocationsDone() && afterIncrementVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.IncrementVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncrementVersionMock.expectedInvocations), m.IncrementVersionMock.expectedInvocationsOrigin, afterIncrementVersionCounter)
 	}
 }
 
-type mICartRepoMockGetCartID struct {
+type mICartRepoMockSetItem struct {
 	optional           bool
 	mock               *ICartRepoMock
-	defaultExpectation *ICartRepoMockGetCartIDExpectation
-	expectations       []*ICartRepoMockGetCartIDExpectation
+	defaultExpectation *ICartRepoMockSetItemExpectation
+	expectations       []*ICartRepoMockSetItemExpectation
 
-	callArgs []*ICartRepoMockGetCartIDParams
+	callArgs []*ICartRepoMockSetItemParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ICartRepoMockGetCartIDExpectation specifies expectation struct of the ICartRepo.GetCartID
-type ICartRepoMockGetCartIDExpectation struct {
+// ICartRepoMockSetItemExpectation specifies expectation struct of the ICartRepo.SetItem
+type ICartRepoMockSetItemExpectation struct {
 	mock               *ICartRepoMock
-	params             *ICartRepoMockGetCartIDParams
-	paramPtrs          *ICartRepoMockGetCartIDParamPtrs
-	expectationOrigins ICartRepoMockGetCartIDExpectationOrigins
-	results            *ICartRepoMockGetCartIDResults
+	params             *ICartRepoMockSetItemParams
+	paramPtrs          *ICartRepoMockSetItemParamPtrs
+	expectationOrigins ICartRepoMockSetItemExpectationOrigins
+	results            *ICartRepoMockSetItemResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ICartRepoMockGetCartIDParams contains parameters of the ICartRepo.GetCartID
-type ICartRepoMockGetCartIDParams struct {
-	ctx    context.Context
-	userID models.UserID
-	skuID  models.SKUID
+// ICartRepoMockSetItemParams contains parameters of the ICartRepo.SetItem
+type ICartRepoMockSetItemParams struct {
+	ctx  context.Context
+	cart models.Cart
 }
 
-// ICartRepoMockGetCartIDParamPtrs contains pointers to parameters of the ICartRepo.GetCartID
-type ICartRepoMockGetCartIDParamPtrs struct {
-	ctx    *context.Context
-	userID *models.UserID
-	skuID  *models.SKUID
+// ICartRepoMockSetItemParamPtrs contains pointers to parameters of the ICartRepo.SetItem
+type ICartRepoMockSetItemParamPtrs struct {
+	ctx  *context.Context
+	cart *models.Cart
 }
 
-// ICartRepoMockGetCartIDResults contains results of the ICartRepo.GetCartID
-type ICartRepoMockGetCartIDResults struct {
-	c2  models.CartID
+// ICartRepoMockSetItemResults contains results of the ICartRepo.SetItem
+type ICartRepoMockSetItemResults struct {
 	err error
 }
 
-// ICartRepoMockGetCartIDOrigins contains origins of expectations of the ICartRepo.GetCartID
-type ICartRepoMockGetCartIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+// ICartRepoMockSetItemOrigins contains origins of expectations of the ICartRepo.SetItem
+type ICartRepoMockSetItemExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCart string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1548,320 +5258,292 @@ type ICartRepoMockGetCartIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCartID *mICartRepoMockGetCartID) Optional() *mICartRepoMockGetCartID {
-	mmGetCartID.optional = true
-	return mmGetCartID
+func (mmSetItem *mICartRepoMockSetItem) Optional() *mICartRepoMockSetItem {
+	mmSetItem.optional = true
+	return mmSetItem
 }
 
-// Expect sets up expected params for ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) Expect(ctx context.Context, userID models.UserID, skuID models.SKUID) *mICartRepoMockGetCartID {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+// Expect sets up expected params for ICartRepo.SetItem
+func (mmSetItem *mICartRepoMockSetItem) Expect(ctx context.Context, cart models.Cart) *mICartRepoMockSetItem {
+	if mmSetItem.mock.funcSetItem != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Set")
 	}
 
-	if mmGetCartID.defaultExpectation == nil {
-		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	if mmSetItem.defaultExpectation == nil {
+		mmSetItem.defaultExpectation = &ICartRepoMockSetItemExpectation{}
 	}
 
-	if mmGetCartID.defaultExpectation.paramPtrs != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by ExpectParams functions")
+	if mmSetItem.defaultExpectation.paramPtrs != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by ExpectParams functions")
 	}
 
-	mmGetCartID.defaultExpectation.params = &ICartRepoMockGetCartIDParams{ctx, userID, skuID}
-	mmGetCartID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCartID.expectations {
-		if minimock.Equal(e.params, mmGetCartID.defaultExpectation.params) {
-			mmGetCartID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartID.defaultExpectation.params)
+	mmSetItem.defaultExpectation.params = &ICartRepoMockSetItemParams{ctx, cart}
+	mmSetItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetItem.expectations {
+		if minimock.Equal(e.params, mmSetItem.defaultExpectation.params) {
+			mmSetItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetItem.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCartID
-}
-
-// ExpectCtxParam1 sets up expected param ctx for ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) ExpectCtxParam1(ctx context.Context) *mICartRepoMockGetCartID {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
-	}
-
-	if mmGetCartID.defaultExpectation == nil {
-		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
-	}
-
-	if mmGetCartID.defaultExpectation.params != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
-	}
-
-	if mmGetCartID.defaultExpectation.paramPtrs == nil {
-		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
-	}
-	mmGetCartID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCartID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetCartID
+	return mmSetItem
 }
 
-// ExpectUserIDParam2 sets up expected param userID for ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) ExpectUserIDParam2(userID models.UserID) *mICartRepoMockGetCartID {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ICartRepo.SetItem
+func (mmSetItem *mICartRepoMockSetItem) ExpectCtxParam1(ctx context.Context) *mICartRepoMockSetItem {
+	if mmSetItem.mock.funcSetItem != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Set")
 	}
 
-	if mmGetCartID.defaultExpectation == nil {
-		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	if mmSetItem.defaultExpectation == nil {
+		mmSetItem.defaultExpectation = &ICartRepoMockSetItemExpectation{}
 	}
 
-	if mmGetCartID.defaultExpectation.params != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
+	if mmSetItem.defaultExpectation.params != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Expect")
 	}
 
-	if mmGetCartID.defaultExpectation.paramPtrs == nil {
-		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
+	if mmSetItem.defaultExpectation.paramPtrs == nil {
+		mmSetItem.defaultExpectation.paramPtrs = &ICartRepoMockSetItemParamPtrs{}
 	}
-	mmGetCartID.defaultExpectation.paramPtrs.userID = &userID
-	mmGetCartID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSetItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCartID
+	return mmSetItem
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) ExpectSkuIDParam3(skuID models.SKUID) *mICartRepoMockGetCartID {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+// ExpectCartParam2 sets up expected param cart for ICartRepo.SetItem
+func (mmSetItem *mICartRepoMockSetItem) ExpectCartParam2(cart models.Cart) *mICartRepoMockSetItem {
+	if mmSetItem.mock.funcSetItem != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Set")
 	}
 
-	if mmGetCartID.defaultExpectation == nil {
-		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{}
+	if mmSetItem.defaultExpectation == nil {
+		mmSetItem.defaultExpectation = &ICartRepoMockSetItemExpectation{}
 	}
 
-	if mmGetCartID.defaultExpectation.params != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Expect")
+	if mmSetItem.defaultExpectation.params != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Expect")
 	}
 
-	if mmGetCartID.defaultExpectation.paramPtrs == nil {
-		mmGetCartID.defaultExpectation.paramPtrs = &ICartRepoMockGetCartIDParamPtrs{}
+	if mmSetItem.defaultExpectation.paramPtrs == nil {
+		mmSetItem.defaultExpectation.paramPtrs = &ICartRepoMockSetItemParamPtrs{}
 	}
-	mmGetCartID.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetCartID.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmSetItem.defaultExpectation.paramPtrs.cart = &cart
+	mmSetItem.defaultExpectation.expectationOrigins.originCart = minimock.CallerInfo(1)
 
-	return mmGetCartID
+	return mmSetItem
 }
 
-// Inspect accepts an inspector function that has same arguments as the ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) Inspect(f func(ctx context.Context, userID models.UserID, skuID models.SKUID)) *mICartRepoMockGetCartID {
-	if mmGetCartID.mock.inspectFuncGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.GetCartID")
+// Inspect accepts an inspector function that has same arguments as the ICartRepo.SetItem
+func (mmSetItem *mICartRepoMockSetItem) Inspect(f func(ctx context.Context, cart models.Cart)) *mICartRepoMockSetItem {
+	if mmSetItem.mock.inspectFuncSetItem != nil {
+		mmSetItem.mock.t.Fatalf("Inspect function is already set for ICartRepoMock.SetItem")
 	}
 
-	mmGetCartID.mock.inspectFuncGetCartID = f
+	mmSetItem.mock.inspectFuncSetItem = f
 
-	return mmGetCartID
+	return mmSetItem
 }
 
-// Return sets up results that will be returned by ICartRepo.GetCartID
-func (mmGetCartID *mICartRepoMockGetCartID) Return(c2 models.CartID, err error) *ICartRepoMock {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+// Return sets up results that will be returned by ICartRepo.SetItem
+func (mmSetItem *mICartRepoMockSetItem) Return(err error) *ICartRepoMock {
+	if mmSetItem.mock.funcSetItem != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Set")
 	}
 
-	if mmGetCartID.defaultExpectation == nil {
-		mmGetCartID.defaultExpectation = &ICartRepoMockGetCartIDExpectation{mock: mmGetCartID.mock}
+	if mmSetItem.defaultExpectation == nil {
+		mmSetItem.defaultExpectation = &ICartRepoMockSetItemExpectation{mock: mmSetItem.mock}
 	}
-	mmGetCartID.defaultExpectation.results = &ICartRepoMockGetCartIDResults{c2, err}
-	mmGetCartID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCartID.mock
+	mmSetItem.defaultExpectation.results = &ICartRepoMockSetItemResults{err}
+	mmSetItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetItem.mock
 }
 
-// Set uses given function f to mock the ICartRepo.GetCartID method
-func (mmGetCartID *mICartRepoMockGetCartID) Set(f func(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartID, err error)) *ICartRepoMock {
-	if mmGetCartID.defaultExpectation != nil {
-		mmGetCartID.mock.t.Fatalf("Default expectation is already set for the ICartRepo.GetCartID method")
+// Set uses given function f to mock the ICartRepo.SetItem method
+func (mmSetItem *mICartRepoMockSetItem) Set(f func(ctx context.Context, cart models.Cart) (err error)) *ICartRepoMock {
+	if mmSetItem.defaultExpectation != nil {
+		mmSetItem.mock.t.Fatalf("Default expectation is already set for the ICartRepo.SetItem method")
 	}
 
-	if len(mmGetCartID.expectations) > 0 {
-		mmGetCartID.mock.t.Fatalf("Some expectations are already set for the ICartRepo.GetCartID method")
+	if len(mmSetItem.expectations) > 0 {
+		mmSetItem.mock.t.Fatalf("Some expectations are already set for the ICartRepo.SetItem method")
 	}
 
-	mmGetCartID.mock.funcGetCartID = f
-	mmGetCartID.mock.funcGetCartIDOrigin = minimock.CallerInfo(1)
-	return mmGetCartID.mock
+	mmSetItem.mock.funcSetItem = f
+	mmSetItem.mock.funcSetItemOrigin = minimock.CallerInfo(1)
+	return mmSetItem.mock
 }
 
-// When sets expectation for the ICartRepo.GetCartID which will trigger the result defined by the following
+// When sets expectation for the ICartRepo.SetItem which will trigger the result defined by the following
 // Then helper
-func (mmGetCartID *mICartRepoMockGetCartID) When(ctx context.Context, userID models.UserID, skuID models.SKUID) *ICartRepoMockGetCartIDExpectation {
-	if mmGetCartID.mock.funcGetCartID != nil {
-		mmGetCartID.mock.t.Fatalf("ICartRepoMock.GetCartID mock is already set by Set")
+func (mmSetItem *mICartRepoMockSetItem) When(ctx context.Context, cart models.Cart) *ICartRepoMockSetItemExpectation {
+	if mmSetItem.mock.funcSetItem != nil {
+		mmSetItem.mock.t.Fatalf("ICartRepoMock.SetItem mock is already set by Set")
 	}
 
-	expectation := &ICartRepoMockGetCartIDExpectation{
-		mock:               mmGetCartID.mock,
-		params:             &ICartRepoMockGetCartIDParams{ctx, userID, skuID},
-		expectationOrigins: ICartRepoMockGetCartIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ICartRepoMockSetItemExpectation{
+		mock:               mmSetItem.mock,
+		params:             &ICartRepoMockSetItemParams{ctx, cart},
+		expectationOrigins: ICartRepoMockSetItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetCartID.expectations = append(mmGetCartID.expectations, expectation)
+	mmSetItem.expectations = append(mmSetItem.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ICartRepo.GetCartID return parameters for the expectation previously defined by the When method
-func (e *ICartRepoMockGetCartIDExpectation) Then(c2 models.CartID, err error) *ICartRepoMock {
-	e.results = &ICartRepoMockGetCartIDResults{c2, err}
+// Then sets up ICartRepo.SetItem return parameters for the expectation previously defined by the When method
+func (e *ICartRepoMockSetItemExpectation) Then(err error) *ICartRepoMock {
+	e.results = &ICartRepoMockSetItemResults{err}
 	return e.mock
 }
 
-// Times sets number of times ICartRepo.GetCartID should be invoked
-func (mmGetCartID *mICartRepoMockGetCartID) Times(n uint64) *mICartRepoMockGetCartID {
+// Times sets number of times ICartRepo.SetItem should be invoked
+func (mmSetItem *mICartRepoMockSetItem) Times(n uint64) *mICartRepoMockSetItem {
 	if n == 0 {
-		mmGetCartID.mock.t.Fatalf("Times of ICartRepoMock.GetCartID mock can not be zero")
+		mmSetItem.mock.t.Fatalf("Times of ICartRepoMock.SetItem mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetCartID.expectedInvocations, n)
-	mmGetCartID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCartID
+	mm_atomic.StoreUint64(&mmSetItem.expectedInvocations, n)
+	mmSetItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetItem
 }
 
-func (mmGetCartID *mICartRepoMockGetCartID) invocationsDone() bool {
-	if len(mmGetCartID.expectations) == 0 && mmGetCartID.defaultExpectation == nil && mmGetCartID.mock.funcGetCartID == nil {
+func (mmSetItem *mICartRepoMockSetItem) invocationsDone() bool {
+	if len(mmSetItem.expectations) == 0 && mmSetItem.defaultExpectation == nil && mmSetItem.mock.funcSetItem == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCartID.mock.afterGetCartIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetItem.mock.afterSetItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetItem.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetCartID implements mm_repository.ICartRepo
-func (mmGetCartID *ICartRepoMock) GetCartID(ctx context.Context, userID models.UserID, skuID models.SKUID) (c2 models.CartID, err error) {
-	mm_atomic.AddUint64(&mmGetCartID.beforeGetCartIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCartID.afterGetCartIDCounter, 1)
+// SetItem implements mm_repository.ICartRepo
+func (mmSetItem *ICartRepoMock) SetItem(ctx context.Context, cart models.Cart) (err error) {
+	mm_atomic.AddUint64(&mmSetItem.beforeSetItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetItem.afterSetItemCounter, 1)
 
-	mmGetCartID.t.Helper()
+	mmSetItem.t.Helper()
 
-	if mmGetCartID.inspectFuncGetCartID != nil {
-		mmGetCartID.inspectFuncGetCartID(ctx, userID, skuID)
+	if mmSetItem.inspectFuncSetItem != nil {
+		mmSetItem.inspectFuncSetItem(ctx, cart)
 	}
 
-	mm_params := ICartRepoMockGetCartIDParams{ctx, userID, skuID}
+	mm_params := ICartRepoMockSetItemParams{ctx, cart}
 
 	// Record call args
-	mmGetCartID.GetCartIDMock.mutex.Lock()
-	mmGetCartID.GetCartIDMock.callArgs = append(mmGetCartID.GetCartIDMock.callArgs, &mm_params)
-	mmGetCartID.GetCartIDMock.mutex.Unlock()
+	mmSetItem.SetItemMock.mutex.Lock()
+	mmSetItem.SetItemMock.callArgs = append(mmSetItem.SetItemMock.callArgs, &mm_params)
+	mmSetItem.SetItemMock.mutex.Unlock()
 
-	for _, e := range mmGetCartID.GetCartIDMock.expectations {
+	for _, e := range mmSetItem.SetItemMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.c2, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetCartID.GetCartIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCartID.GetCartIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCartID.GetCartIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCartID.GetCartIDMock.defaultExpectation.paramPtrs
+	if mmSetItem.SetItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetItem.SetItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetItem.SetItemMock.defaultExpectation.params
+		mm_want_ptrs := mmSetItem.SetItemMock.defaultExpectation.paramPtrs
 
-		mm_got := ICartRepoMockGetCartIDParams{ctx, userID, skuID}
+		mm_got := ICartRepoMockSetItemParams{ctx, cart}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSetItem.t.Errorf("ICartRepoMock.SetItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetItem.SetItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.cart != nil && !minimock.Equal(*mm_want_ptrs.cart, mm_got.cart) {
+				mmSetItem.t.Errorf("ICartRepoMock.SetItem got unexpected parameter cart, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetItem.SetItemMock.defaultExpectation.expectationOrigins.originCart, *mm_want_ptrs.cart, mm_got.cart, minimock.Diff(*mm_want_ptrs.cart, mm_got.cart))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCartID.t.Errorf("ICartRepoMock.GetCartID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCartID.GetCartIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetItem.t.Errorf("ICartRepoMock.SetItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetItem.SetItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCartID.GetCartIDMock.defaultExpectation.results
+		mm_results := mmSetItem.SetItemMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCartID.t.Fatal("No results are set for the ICartRepoMock.GetCartID")
+			mmSetItem.t.Fatal("No results are set for the ICartRepoMock.SetItem")
 		}
-		return (*mm_results).c2, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetCartID.funcGetCartID != nil {
-		return mmGetCartID.funcGetCartID(ctx, userID, skuID)
+	if mmSetItem.funcSetItem != nil {
+		return mmSetItem.funcSetItem(ctx, cart)
 	}
-	mmGetCartID.t.Fatalf("Unexpected call to ICartRepoMock.GetCartID. %v %v %v", ctx, userID, skuID)
+	mmSetItem.t.Fatalf("Unexpected call to ICartRepoMock.SetItem. %v %v", ctx, cart)
 	return
 }
 
-// GetCartIDAfterCounter returns a count of finished ICartRepoMock.GetCartID invocations
-func (mmGetCartID *ICartRepoMock) GetCartIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCartID.afterGetCartIDCounter)
+// SetItemAfterCounter returns a count of finished ICartRepoMock.SetItem invocations
+func (mmSetItem *ICartRepoMock) SetItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetItem.afterSetItemCounter)
 }
 
-// GetCartIDBeforeCounter returns a count of ICartRepoMock.GetCartID invocations
-func (mmGetCartID *ICartRepoMock) GetCartIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCartID.beforeGetCartIDCounter)
+// SetItemBeforeCounter returns a count of ICartRepoMock.SetItem invocations
+func (mmSetItem *ICartRepoMock) SetItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetItem.beforeSetItemCounter)
 }
 
-// Calls returns a list of arguments used in each call to ICartRepoMock.GetCartID.
+// Calls returns a list of arguments used in each call to ICartRepoMock.SetItem.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCartID *mICartRepoMockGetCartID) Calls() []*ICartRepoMockGetCartIDParams {
-	mmGetCartID.mutex.RLock()
+func (mmSetItem *mICartRepoMockSetItem) Calls() []*ICartRepoMockSetItemParams {
+	mmSetItem.mutex.RLock()
 
-	argCopy := make([]*ICartRepoMockGetCartIDParams, len(mmGetCartID.callArgs))
-	copy(argCopy, mmGetCartID.callArgs)
+	argCopy := make([]*ICartRepoMockSetItemParams, len(mmSetItem.callArgs))
+	copy(argCopy, mmSetItem.callArgs)
 
-	mmGetCartID.mutex.RUnlock()
+	mmSetItem.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCartIDDone returns true if the count of the GetCartID invocations corresponds
+// MinimockSetItemDone returns true if the count of the SetItem invocations corresponds
 // the number of defined expectations
-func (m *ICartRepoMock) MinimockGetCartIDDone() bool {
-	if m.GetCartIDMock.optional {
+func (m *ICartRepoMock) MinimockSetItemDone() bool {
+	if m.SetItemMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCartIDMock.expectations {
+	for _, e := range m.SetItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCartIDMock.invocationsDone()
+	return m.SetItemMock.invocationsDone()
 }
 
-// MinimockGetCartIDInspect logs each unmet expectation
-func (m *ICartRepoMock) MinimockGetCartIDInspect() {
-	for _, e := range m.GetCartIDMock.expectations {
+// MinimockSetItemInspect logs each unmet expectation
+func (m *ICartRepoMock) MinimockSetItemInspect() {
+	for _, e := range m.SetItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ICartRepoMock.SetItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCartIDCounter := mm_atomic.LoadUint64(&m.afterGetCartIDCounter)
+	afterSetItemCounter := mm_atomic.LoadUint64(&m.afterSetItemCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCartIDMock.defaultExpectation != nil && afterGetCartIDCounter < 1 {
-		if m.GetCartIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s", m.GetCartIDMock.defaultExpectation.returnOrigin)
+	if m.SetItemMock.defaultExpectation != nil && afterSetItemCounter < 1 {
+		if m.SetItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICartRepoMock.SetItem at\n%s", m.SetItemMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s with params: %#v", m.GetCartIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCartIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ICartRepoMock.SetItem at\n%s with params: %#v", m.SetItemMock.defaultExpectation.expectationOrigins.origin, *m.SetItemMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCartID != nil && afterGetCartIDCounter < 1 {
-		m.t.Errorf("Expected call to ICartRepoMock.GetCartID at\n%s", m.funcGetCartIDOrigin)
+	if m.funcSetItem != nil && afterSetItemCounter < 1 {
+		m.t.Errorf("Expected call to ICartRepoMock.SetItem at\n%s", m.funcSetItemOrigin)
 	}
 
-	if !m.GetCartIDMock.invocationsDone() && afterGetCartIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to ICartRepoMock.GetCartID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCartIDMock.expectedInvocations), m.GetCartIDMock.expectedInvocationsOrigin, afterGetCartIDCounter)
+	if !m.SetItemMock.invocationsDone() && afterSetItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICartRepoMock.SetItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetItemMock.expectedInvocations), m.SetItemMock.expectedInvocationsOrigin, afterSetItemCounter)
 	}
 }
 
@@ -2213,14 +5895,34 @@ func (m *ICartRepoMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddItemInspect()
 
+			m.MinimockAddWishlistItemInspect()
+
 			m.MinimockClearCartByUserIDInspect()
 
+			m.MinimockDeleteAbandonedCartInspect()
+
 			m.MinimockDeleteItemInspect()
 
+			m.MinimockDeleteWishlistItemInspect()
+
+			m.MinimockGetAbandonedCartsInspect()
+
 			m.MinimockGetCartByUserIDInspect()
 
 			m.MinimockGetCartIDInspect()
 
+			m.MinimockGetCartItemInspect()
+
+			m.MinimockGetVersionInspect()
+
+			m.MinimockGetWishlistByUserIDInspect()
+
+			m.MinimockGetWishlistItemInspect()
+
+			m.MinimockIncrementVersionInspect()
+
+			m.MinimockSetItemInspect()
+
 			m.MinimockUpdateItemByUserIDInspect()
 		}
 	})
@@ -2246,9 +5948,19 @@ func (m *ICartRepoMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddItemDone() &&
+		m.MinimockAddWishlistItemDone() &&
 		m.MinimockClearCartByUserIDDone() &&
+		m.MinimockDeleteAbandonedCartDone() &&
 		m.MinimockDeleteItemDone() &&
+		m.MinimockDeleteWishlistItemDone() &&
+		m.MinimockGetAbandonedCartsDone() &&
 		m.MinimockGetCartByUserIDDone() &&
 		m.MinimockGetCartIDDone() &&
+		m.MinimockGetCartItemDone() &&
+		m.MinimockGetVersionDone() &&
+		m.MinimockGetWishlistByUserIDDone() &&
+		m.MinimockGetWishlistItemDone() &&
+		m.MinimockIncrementVersionDone() &&
+		m.MinimockSetItemDone() &&
 		m.MinimockUpdateItemByUserIDDone()
 }
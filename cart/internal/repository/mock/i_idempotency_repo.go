@@ -0,0 +1,1838 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+import (
+	"cart/internal/models"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IIdempotencyRepoMock implements mm_repository.IIdempotencyRepo
+type IIdempotencyRepoMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteExpiredKeys          func(ctx context.Context) (i1 int64, err error)
+	funcDeleteExpiredKeysOrigin    string
+	inspectFuncDeleteExpiredKeys   func(ctx context.Context)
+	afterDeleteExpiredKeysCounter  uint64
+	beforeDeleteExpiredKeysCounter uint64
+	DeleteExpiredKeysMock          mIIdempotencyRepoMockDeleteExpiredKeys
+
+	funcDeleteKey          func(ctx context.Context, key models.IdempotencyKey) (err error)
+	funcDeleteKeyOrigin    string
+	inspectFuncDeleteKey   func(ctx context.Context, key models.IdempotencyKey)
+	afterDeleteKeyCounter  uint64
+	beforeDeleteKeyCounter uint64
+	DeleteKeyMock          mIIdempotencyRepoMockDeleteKey
+
+	funcGetKey          func(ctx context.Context, key models.IdempotencyKey) (i1 models.IdempotencyRecord, err error)
+	funcGetKeyOrigin    string
+	inspectFuncGetKey   func(ctx context.Context, key models.IdempotencyKey)
+	afterGetKeyCounter  uint64
+	beforeGetKeyCounter uint64
+	GetKeyMock          mIIdempotencyRepoMockGetKey
+
+	funcReserveKey          func(ctx context.Context, record models.IdempotencyRecord) (b1 bool, err error)
+	funcReserveKeyOrigin    string
+	inspectFuncReserveKey   func(ctx context.Context, record models.IdempotencyRecord)
+	afterReserveKeyCounter  uint64
+	beforeReserveKeyCounter uint64
+	ReserveKeyMock          mIIdempotencyRepoMockReserveKey
+
+	funcSaveResponse          func(ctx context.Context, key models.IdempotencyKey, response []byte) (err error)
+	funcSaveResponseOrigin    string
+	inspectFuncSaveResponse   func(ctx context.Context, key models.IdempotencyKey, response []byte)
+	afterSaveResponseCounter  uint64
+	beforeSaveResponseCounter uint64
+	SaveResponseMock          mIIdempotencyRepoMockSaveResponse
+}
+
+// NewIIdempotencyRepoMock returns a mock for mm_repository.IIdempotencyRepo
+func NewIIdempotencyRepoMock(t minimock.Tester) *IIdempotencyRepoMock {
+	m := &IIdempotencyRepoMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteExpiredKeysMock = mIIdempotencyRepoMockDeleteExpiredKeys{mock: m}
+	m.DeleteExpiredKeysMock.callArgs = []*IIdempotencyRepoMockDeleteExpiredKeysParams{}
+
+	m.DeleteKeyMock = mIIdempotencyRepoMockDeleteKey{mock: m}
+	m.DeleteKeyMock.callArgs = []*IIdempotencyRepoMockDeleteKeyParams{}
+
+	m.GetKeyMock = mIIdempotencyRepoMockGetKey{mock: m}
+	m.GetKeyMock.callArgs = []*IIdempotencyRepoMockGetKeyParams{}
+
+	m.ReserveKeyMock = mIIdempotencyRepoMockReserveKey{mock: m}
+	m.ReserveKeyMock.callArgs = []*IIdempotencyRepoMockReserveKeyParams{}
+
+	m.SaveResponseMock = mIIdempotencyRepoMockSaveResponse{mock: m}
+	m.SaveResponseMock.callArgs = []*IIdempotencyRepoMockSaveResponseParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIIdempotencyRepoMockDeleteExpiredKeys struct {
+	optional           bool
+	mock               *IIdempotencyRepoMock
+	defaultExpectation *IIdempotencyRepoMockDeleteExpiredKeysExpectation
+	expectations       []*IIdempotencyRepoMockDeleteExpiredKeysExpectation
+
+	callArgs []*IIdempotencyRepoMockDeleteExpiredKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdempotencyRepoMockDeleteExpiredKeysExpectation specifies expectation struct of the IIdempotencyRepo.DeleteExpiredKeys
+type IIdempotencyRepoMockDeleteExpiredKeysExpectation struct {
+	mock               *IIdempotencyRepoMock
+	params             *IIdempotencyRepoMockDeleteExpiredKeysParams
+	paramPtrs          *IIdempotencyRepoMockDeleteExpiredKeysParamPtrs
+	expectationOrigins IIdempotencyRepoMockDeleteExpiredKeysExpectationOrigins
+	results            *IIdempotencyRepoMockDeleteExpiredKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdempotencyRepoMockDeleteExpiredKeysParams contains parameters of the IIdempotencyRepo.DeleteExpiredKeys
+type IIdempotencyRepoMockDeleteExpiredKeysParams struct {
+	ctx context.Context
+}
+
+// IIdempotencyRepoMockDeleteExpiredKeysParamPtrs contains pointers to parameters of the IIdempotencyRepo.DeleteExpiredKeys
+type IIdempotencyRepoMockDeleteExpiredKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// IIdempotencyRepoMockDeleteExpiredKeysResults contains results of the IIdempotencyRepo.DeleteExpiredKeys
+type IIdempotencyRepoMockDeleteExpiredKeysResults struct {
+	i1  int64
+	err error
+}
+
+// IIdempotencyRepoMockDeleteExpiredKeysOrigins contains origins of expectations of the IIdempotencyRepo.DeleteExpiredKeys
+type IIdempotencyRepoMockDeleteExpiredKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Optional() *mIIdempotencyRepoMockDeleteExpiredKeys {
+	mmDeleteExpiredKeys.optional = true
+	return mmDeleteExpiredKeys
+}
+
+// Expect sets up expected params for IIdempotencyRepo.DeleteExpiredKeys
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Expect(ctx context.Context) *mIIdempotencyRepoMockDeleteExpiredKeys {
+	if mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation == nil {
+		mmDeleteExpiredKeys.defaultExpectation = &IIdempotencyRepoMockDeleteExpiredKeysExpectation{}
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredKeys.defaultExpectation.params = &IIdempotencyRepoMockDeleteExpiredKeysParams{ctx}
+	mmDeleteExpiredKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredKeys.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredKeys.defaultExpectation.params) {
+			mmDeleteExpiredKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdempotencyRepo.DeleteExpiredKeys
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) ExpectCtxParam1(ctx context.Context) *mIIdempotencyRepoMockDeleteExpiredKeys {
+	if mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation == nil {
+		mmDeleteExpiredKeys.defaultExpectation = &IIdempotencyRepoMockDeleteExpiredKeysExpectation{}
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation.params != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredKeys.defaultExpectation.paramPtrs = &IIdempotencyRepoMockDeleteExpiredKeysParamPtrs{}
+	}
+	mmDeleteExpiredKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdempotencyRepo.DeleteExpiredKeys
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Inspect(f func(ctx context.Context)) *mIIdempotencyRepoMockDeleteExpiredKeys {
+	if mmDeleteExpiredKeys.mock.inspectFuncDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("Inspect function is already set for IIdempotencyRepoMock.DeleteExpiredKeys")
+	}
+
+	mmDeleteExpiredKeys.mock.inspectFuncDeleteExpiredKeys = f
+
+	return mmDeleteExpiredKeys
+}
+
+// Return sets up results that will be returned by IIdempotencyRepo.DeleteExpiredKeys
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Return(i1 int64, err error) *IIdempotencyRepoMock {
+	if mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredKeys.defaultExpectation == nil {
+		mmDeleteExpiredKeys.defaultExpectation = &IIdempotencyRepoMockDeleteExpiredKeysExpectation{mock: mmDeleteExpiredKeys.mock}
+	}
+	mmDeleteExpiredKeys.defaultExpectation.results = &IIdempotencyRepoMockDeleteExpiredKeysResults{i1, err}
+	mmDeleteExpiredKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredKeys.mock
+}
+
+// Set uses given function f to mock the IIdempotencyRepo.DeleteExpiredKeys method
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Set(f func(ctx context.Context) (i1 int64, err error)) *IIdempotencyRepoMock {
+	if mmDeleteExpiredKeys.defaultExpectation != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("Default expectation is already set for the IIdempotencyRepo.DeleteExpiredKeys method")
+	}
+
+	if len(mmDeleteExpiredKeys.expectations) > 0 {
+		mmDeleteExpiredKeys.mock.t.Fatalf("Some expectations are already set for the IIdempotencyRepo.DeleteExpiredKeys method")
+	}
+
+	mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys = f
+	mmDeleteExpiredKeys.mock.funcDeleteExpiredKeysOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredKeys.mock
+}
+
+// When sets expectation for the IIdempotencyRepo.DeleteExpiredKeys which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) When(ctx context.Context) *IIdempotencyRepoMockDeleteExpiredKeysExpectation {
+	if mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.mock.t.Fatalf("IIdempotencyRepoMock.DeleteExpiredKeys mock is already set by Set")
+	}
+
+	expectation := &IIdempotencyRepoMockDeleteExpiredKeysExpectation{
+		mock:               mmDeleteExpiredKeys.mock,
+		params:             &IIdempotencyRepoMockDeleteExpiredKeysParams{ctx},
+		expectationOrigins: IIdempotencyRepoMockDeleteExpiredKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredKeys.expectations = append(mmDeleteExpiredKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdempotencyRepo.DeleteExpiredKeys return parameters for the expectation previously defined by the When method
+func (e *IIdempotencyRepoMockDeleteExpiredKeysExpectation) Then(i1 int64, err error) *IIdempotencyRepoMock {
+	e.results = &IIdempotencyRepoMockDeleteExpiredKeysResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IIdempotencyRepo.DeleteExpiredKeys should be invoked
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Times(n uint64) *mIIdempotencyRepoMockDeleteExpiredKeys {
+	if n == 0 {
+		mmDeleteExpiredKeys.mock.t.Fatalf("Times of IIdempotencyRepoMock.DeleteExpiredKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredKeys.expectedInvocations, n)
+	mmDeleteExpiredKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredKeys
+}
+
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) invocationsDone() bool {
+	if len(mmDeleteExpiredKeys.expectations) == 0 && mmDeleteExpiredKeys.defaultExpectation == nil && mmDeleteExpiredKeys.mock.funcDeleteExpiredKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredKeys.mock.afterDeleteExpiredKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredKeys implements mm_repository.IIdempotencyRepo
+func (mmDeleteExpiredKeys *IIdempotencyRepoMock) DeleteExpiredKeys(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredKeys.beforeDeleteExpiredKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredKeys.afterDeleteExpiredKeysCounter, 1)
+
+	mmDeleteExpiredKeys.t.Helper()
+
+	if mmDeleteExpiredKeys.inspectFuncDeleteExpiredKeys != nil {
+		mmDeleteExpiredKeys.inspectFuncDeleteExpiredKeys(ctx)
+	}
+
+	mm_params := IIdempotencyRepoMockDeleteExpiredKeysParams{ctx}
+
+	// Record call args
+	mmDeleteExpiredKeys.DeleteExpiredKeysMock.mutex.Lock()
+	mmDeleteExpiredKeys.DeleteExpiredKeysMock.callArgs = append(mmDeleteExpiredKeys.DeleteExpiredKeysMock.callArgs, &mm_params)
+	mmDeleteExpiredKeys.DeleteExpiredKeysMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredKeys.DeleteExpiredKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdempotencyRepoMockDeleteExpiredKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredKeys.t.Errorf("IIdempotencyRepoMock.DeleteExpiredKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredKeys.t.Errorf("IIdempotencyRepoMock.DeleteExpiredKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredKeys.DeleteExpiredKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredKeys.t.Fatal("No results are set for the IIdempotencyRepoMock.DeleteExpiredKeys")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpiredKeys.funcDeleteExpiredKeys != nil {
+		return mmDeleteExpiredKeys.funcDeleteExpiredKeys(ctx)
+	}
+	mmDeleteExpiredKeys.t.Fatalf("Unexpected call to IIdempotencyRepoMock.DeleteExpiredKeys. %v", ctx)
+	return
+}
+
+// DeleteExpiredKeysAfterCounter returns a count of finished IIdempotencyRepoMock.DeleteExpiredKeys invocations
+func (mmDeleteExpiredKeys *IIdempotencyRepoMock) DeleteExpiredKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredKeys.afterDeleteExpiredKeysCounter)
+}
+
+// DeleteExpiredKeysBeforeCounter returns a count of IIdempotencyRepoMock.DeleteExpiredKeys invocations
+func (mmDeleteExpiredKeys *IIdempotencyRepoMock) DeleteExpiredKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredKeys.beforeDeleteExpiredKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdempotencyRepoMock.DeleteExpiredKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredKeys *mIIdempotencyRepoMockDeleteExpiredKeys) Calls() []*IIdempotencyRepoMockDeleteExpiredKeysParams {
+	mmDeleteExpiredKeys.mutex.RLock()
+
+	argCopy := make([]*IIdempotencyRepoMockDeleteExpiredKeysParams, len(mmDeleteExpiredKeys.callArgs))
+	copy(argCopy, mmDeleteExpiredKeys.callArgs)
+
+	mmDeleteExpiredKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredKeysDone returns true if the count of the DeleteExpiredKeys invocations corresponds
+// the number of defined expectations
+func (m *IIdempotencyRepoMock) MinimockDeleteExpiredKeysDone() bool {
+	if m.DeleteExpiredKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredKeysMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredKeysInspect logs each unmet expectation
+func (m *IIdempotencyRepoMock) MinimockDeleteExpiredKeysInspect() {
+	for _, e := range m.DeleteExpiredKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteExpiredKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredKeysCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredKeysMock.defaultExpectation != nil && afterDeleteExpiredKeysCounter < 1 {
+		if m.DeleteExpiredKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteExpiredKeys at\n%s", m.DeleteExpiredKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteExpiredKeys at\n%s with params: %#v", m.DeleteExpiredKeysMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredKeys != nil && afterDeleteExpiredKeysCounter < 1 {
+		m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteExpiredKeys at\n%s", m.funcDeleteExpiredKeysOrigin)
+	}
+
+	if !m.DeleteExpiredKeysMock.invocationsDone() && afterDeleteExpiredKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdempotencyRepoMock.DeleteExpiredKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredKeysMock.expectedInvocations), m.DeleteExpiredKeysMock.expectedInvocationsOrigin, afterDeleteExpiredKeysCounter)
+	}
+}
+
+type mIIdempotencyRepoMockDeleteKey struct {
+	optional           bool
+	mock               *IIdempotencyRepoMock
+	defaultExpectation *IIdempotencyRepoMockDeleteKeyExpectation
+	expectations       []*IIdempotencyRepoMockDeleteKeyExpectation
+
+	callArgs []*IIdempotencyRepoMockDeleteKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdempotencyRepoMockDeleteKeyExpectation specifies expectation struct of the IIdempotencyRepo.DeleteKey
+type IIdempotencyRepoMockDeleteKeyExpectation struct {
+	mock               *IIdempotencyRepoMock
+	params             *IIdempotencyRepoMockDeleteKeyParams
+	paramPtrs          *IIdempotencyRepoMockDeleteKeyParamPtrs
+	expectationOrigins IIdempotencyRepoMockDeleteKeyExpectationOrigins
+	results            *IIdempotencyRepoMockDeleteKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdempotencyRepoMockDeleteKeyParams contains parameters of the IIdempotencyRepo.DeleteKey
+type IIdempotencyRepoMockDeleteKeyParams struct {
+	ctx context.Context
+	key models.IdempotencyKey
+}
+
+// IIdempotencyRepoMockDeleteKeyParamPtrs contains pointers to parameters of the IIdempotencyRepo.DeleteKey
+type IIdempotencyRepoMockDeleteKeyParamPtrs struct {
+	ctx *context.Context
+	key *models.IdempotencyKey
+}
+
+// IIdempotencyRepoMockDeleteKeyResults contains results of the IIdempotencyRepo.DeleteKey
+type IIdempotencyRepoMockDeleteKeyResults struct {
+	err error
+}
+
+// IIdempotencyRepoMockDeleteKeyOrigins contains origins of expectations of the IIdempotencyRepo.DeleteKey
+type IIdempotencyRepoMockDeleteKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Optional() *mIIdempotencyRepoMockDeleteKey {
+	mmDeleteKey.optional = true
+	return mmDeleteKey
+}
+
+// Expect sets up expected params for IIdempotencyRepo.DeleteKey
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Expect(ctx context.Context, key models.IdempotencyKey) *mIIdempotencyRepoMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &IIdempotencyRepoMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteKey.defaultExpectation.params = &IIdempotencyRepoMockDeleteKeyParams{ctx, key}
+	mmDeleteKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteKey.expectations {
+		if minimock.Equal(e.params, mmDeleteKey.defaultExpectation.params) {
+			mmDeleteKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteKey.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdempotencyRepo.DeleteKey
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) ExpectCtxParam1(ctx context.Context) *mIIdempotencyRepoMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &IIdempotencyRepoMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.params != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Expect")
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockDeleteKeyParamPtrs{}
+	}
+	mmDeleteKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteKey
+}
+
+// ExpectKeyParam2 sets up expected param key for IIdempotencyRepo.DeleteKey
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) ExpectKeyParam2(key models.IdempotencyKey) *mIIdempotencyRepoMockDeleteKey {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &IIdempotencyRepoMockDeleteKeyExpectation{}
+	}
+
+	if mmDeleteKey.defaultExpectation.params != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Expect")
+	}
+
+	if mmDeleteKey.defaultExpectation.paramPtrs == nil {
+		mmDeleteKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockDeleteKeyParamPtrs{}
+	}
+	mmDeleteKey.defaultExpectation.paramPtrs.key = &key
+	mmDeleteKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDeleteKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdempotencyRepo.DeleteKey
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Inspect(f func(ctx context.Context, key models.IdempotencyKey)) *mIIdempotencyRepoMockDeleteKey {
+	if mmDeleteKey.mock.inspectFuncDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("Inspect function is already set for IIdempotencyRepoMock.DeleteKey")
+	}
+
+	mmDeleteKey.mock.inspectFuncDeleteKey = f
+
+	return mmDeleteKey
+}
+
+// Return sets up results that will be returned by IIdempotencyRepo.DeleteKey
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Return(err error) *IIdempotencyRepoMock {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Set")
+	}
+
+	if mmDeleteKey.defaultExpectation == nil {
+		mmDeleteKey.defaultExpectation = &IIdempotencyRepoMockDeleteKeyExpectation{mock: mmDeleteKey.mock}
+	}
+	mmDeleteKey.defaultExpectation.results = &IIdempotencyRepoMockDeleteKeyResults{err}
+	mmDeleteKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey.mock
+}
+
+// Set uses given function f to mock the IIdempotencyRepo.DeleteKey method
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Set(f func(ctx context.Context, key models.IdempotencyKey) (err error)) *IIdempotencyRepoMock {
+	if mmDeleteKey.defaultExpectation != nil {
+		mmDeleteKey.mock.t.Fatalf("Default expectation is already set for the IIdempotencyRepo.DeleteKey method")
+	}
+
+	if len(mmDeleteKey.expectations) > 0 {
+		mmDeleteKey.mock.t.Fatalf("Some expectations are already set for the IIdempotencyRepo.DeleteKey method")
+	}
+
+	mmDeleteKey.mock.funcDeleteKey = f
+	mmDeleteKey.mock.funcDeleteKeyOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey.mock
+}
+
+// When sets expectation for the IIdempotencyRepo.DeleteKey which will trigger the result defined by the following
+// Then helper
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) When(ctx context.Context, key models.IdempotencyKey) *IIdempotencyRepoMockDeleteKeyExpectation {
+	if mmDeleteKey.mock.funcDeleteKey != nil {
+		mmDeleteKey.mock.t.Fatalf("IIdempotencyRepoMock.DeleteKey mock is already set by Set")
+	}
+
+	expectation := &IIdempotencyRepoMockDeleteKeyExpectation{
+		mock:               mmDeleteKey.mock,
+		params:             &IIdempotencyRepoMockDeleteKeyParams{ctx, key},
+		expectationOrigins: IIdempotencyRepoMockDeleteKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteKey.expectations = append(mmDeleteKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdempotencyRepo.DeleteKey return parameters for the expectation previously defined by the When method
+func (e *IIdempotencyRepoMockDeleteKeyExpectation) Then(err error) *IIdempotencyRepoMock {
+	e.results = &IIdempotencyRepoMockDeleteKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times IIdempotencyRepo.DeleteKey should be invoked
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Times(n uint64) *mIIdempotencyRepoMockDeleteKey {
+	if n == 0 {
+		mmDeleteKey.mock.t.Fatalf("Times of IIdempotencyRepoMock.DeleteKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteKey.expectedInvocations, n)
+	mmDeleteKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteKey
+}
+
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) invocationsDone() bool {
+	if len(mmDeleteKey.expectations) == 0 && mmDeleteKey.defaultExpectation == nil && mmDeleteKey.mock.funcDeleteKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteKey.mock.afterDeleteKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteKey implements mm_repository.IIdempotencyRepo
+func (mmDeleteKey *IIdempotencyRepoMock) DeleteKey(ctx context.Context, key models.IdempotencyKey) (err error) {
+	mm_atomic.AddUint64(&mmDeleteKey.beforeDeleteKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteKey.afterDeleteKeyCounter, 1)
+
+	mmDeleteKey.t.Helper()
+
+	if mmDeleteKey.inspectFuncDeleteKey != nil {
+		mmDeleteKey.inspectFuncDeleteKey(ctx, key)
+	}
+
+	mm_params := IIdempotencyRepoMockDeleteKeyParams{ctx, key}
+
+	// Record call args
+	mmDeleteKey.DeleteKeyMock.mutex.Lock()
+	mmDeleteKey.DeleteKeyMock.callArgs = append(mmDeleteKey.DeleteKeyMock.callArgs, &mm_params)
+	mmDeleteKey.DeleteKeyMock.mutex.Unlock()
+
+	for _, e := range mmDeleteKey.DeleteKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteKey.DeleteKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteKey.DeleteKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteKey.DeleteKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteKey.DeleteKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdempotencyRepoMockDeleteKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteKey.t.Errorf("IIdempotencyRepoMock.DeleteKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDeleteKey.t.Errorf("IIdempotencyRepoMock.DeleteKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteKey.t.Errorf("IIdempotencyRepoMock.DeleteKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteKey.DeleteKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteKey.DeleteKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteKey.t.Fatal("No results are set for the IIdempotencyRepoMock.DeleteKey")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteKey.funcDeleteKey != nil {
+		return mmDeleteKey.funcDeleteKey(ctx, key)
+	}
+	mmDeleteKey.t.Fatalf("Unexpected call to IIdempotencyRepoMock.DeleteKey. %v %v", ctx, key)
+	return
+}
+
+// DeleteKeyAfterCounter returns a count of finished IIdempotencyRepoMock.DeleteKey invocations
+func (mmDeleteKey *IIdempotencyRepoMock) DeleteKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteKey.afterDeleteKeyCounter)
+}
+
+// DeleteKeyBeforeCounter returns a count of IIdempotencyRepoMock.DeleteKey invocations
+func (mmDeleteKey *IIdempotencyRepoMock) DeleteKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteKey.beforeDeleteKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdempotencyRepoMock.DeleteKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteKey *mIIdempotencyRepoMockDeleteKey) Calls() []*IIdempotencyRepoMockDeleteKeyParams {
+	mmDeleteKey.mutex.RLock()
+
+	argCopy := make([]*IIdempotencyRepoMockDeleteKeyParams, len(mmDeleteKey.callArgs))
+	copy(argCopy, mmDeleteKey.callArgs)
+
+	mmDeleteKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteKeyDone returns true if the count of the DeleteKey invocations corresponds
+// the number of defined expectations
+func (m *IIdempotencyRepoMock) MinimockDeleteKeyDone() bool {
+	if m.DeleteKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteKeyMock.invocationsDone()
+}
+
+// MinimockDeleteKeyInspect logs each unmet expectation
+func (m *IIdempotencyRepoMock) MinimockDeleteKeyInspect() {
+	for _, e := range m.DeleteKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteKeyCounter := mm_atomic.LoadUint64(&m.afterDeleteKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteKeyMock.defaultExpectation != nil && afterDeleteKeyCounter < 1 {
+		if m.DeleteKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteKey at\n%s", m.DeleteKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteKey at\n%s with params: %#v", m.DeleteKeyMock.defaultExpectation.expectationOrigins.origin, *m.DeleteKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteKey != nil && afterDeleteKeyCounter < 1 {
+		m.t.Errorf("Expected call to IIdempotencyRepoMock.DeleteKey at\n%s", m.funcDeleteKeyOrigin)
+	}
+
+	if !m.DeleteKeyMock.invocationsDone() && afterDeleteKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdempotencyRepoMock.DeleteKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteKeyMock.expectedInvocations), m.DeleteKeyMock.expectedInvocationsOrigin, afterDeleteKeyCounter)
+	}
+}
+
+type mIIdempotencyRepoMockGetKey struct {
+	optional           bool
+	mock               *IIdempotencyRepoMock
+	defaultExpectation *IIdempotencyRepoMockGetKeyExpectation
+	expectations       []*IIdempotencyRepoMockGetKeyExpectation
+
+	callArgs []*IIdempotencyRepoMockGetKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdempotencyRepoMockGetKeyExpectation specifies expectation struct of the IIdempotencyRepo.GetKey
+type IIdempotencyRepoMockGetKeyExpectation struct {
+	mock               *IIdempotencyRepoMock
+	params             *IIdempotencyRepoMockGetKeyParams
+	paramPtrs          *IIdempotencyRepoMockGetKeyParamPtrs
+	expectationOrigins IIdempotencyRepoMockGetKeyExpectationOrigins
+	results            *IIdempotencyRepoMockGetKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdempotencyRepoMockGetKeyParams contains parameters of the IIdempotencyRepo.GetKey
+type IIdempotencyRepoMockGetKeyParams struct {
+	ctx context.Context
+	key models.IdempotencyKey
+}
+
+// IIdempotencyRepoMockGetKeyParamPtrs contains pointers to parameters of the IIdempotencyRepo.GetKey
+type IIdempotencyRepoMockGetKeyParamPtrs struct {
+	ctx *context.Context
+	key *models.IdempotencyKey
+}
+
+// IIdempotencyRepoMockGetKeyResults contains results of the IIdempotencyRepo.GetKey
+type IIdempotencyRepoMockGetKeyResults struct {
+	i1  models.IdempotencyRecord
+	err error
+}
+
+// IIdempotencyRepoMockGetKeyOrigins contains origins of expectations of the IIdempotencyRepo.GetKey
+type IIdempotencyRepoMockGetKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Optional() *mIIdempotencyRepoMockGetKey {
+	mmGetKey.optional = true
+	return mmGetKey
+}
+
+// Expect sets up expected params for IIdempotencyRepo.GetKey
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Expect(ctx context.Context, key models.IdempotencyKey) *mIIdempotencyRepoMockGetKey {
+	if mmGetKey.mock.funcGetKey != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Set")
+	}
+
+	if mmGetKey.defaultExpectation == nil {
+		mmGetKey.defaultExpectation = &IIdempotencyRepoMockGetKeyExpectation{}
+	}
+
+	if mmGetKey.defaultExpectation.paramPtrs != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by ExpectParams functions")
+	}
+
+	mmGetKey.defaultExpectation.params = &IIdempotencyRepoMockGetKeyParams{ctx, key}
+	mmGetKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetKey.expectations {
+		if minimock.Equal(e.params, mmGetKey.defaultExpectation.params) {
+			mmGetKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetKey.defaultExpectation.params)
+		}
+	}
+
+	return mmGetKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdempotencyRepo.GetKey
+func (mmGetKey *mIIdempotencyRepoMockGetKey) ExpectCtxParam1(ctx context.Context) *mIIdempotencyRepoMockGetKey {
+	if mmGetKey.mock.funcGetKey != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Set")
+	}
+
+	if mmGetKey.defaultExpectation == nil {
+		mmGetKey.defaultExpectation = &IIdempotencyRepoMockGetKeyExpectation{}
+	}
+
+	if mmGetKey.defaultExpectation.params != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Expect")
+	}
+
+	if mmGetKey.defaultExpectation.paramPtrs == nil {
+		mmGetKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockGetKeyParamPtrs{}
+	}
+	mmGetKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetKey
+}
+
+// ExpectKeyParam2 sets up expected param key for IIdempotencyRepo.GetKey
+func (mmGetKey *mIIdempotencyRepoMockGetKey) ExpectKeyParam2(key models.IdempotencyKey) *mIIdempotencyRepoMockGetKey {
+	if mmGetKey.mock.funcGetKey != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Set")
+	}
+
+	if mmGetKey.defaultExpectation == nil {
+		mmGetKey.defaultExpectation = &IIdempotencyRepoMockGetKeyExpectation{}
+	}
+
+	if mmGetKey.defaultExpectation.params != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Expect")
+	}
+
+	if mmGetKey.defaultExpectation.paramPtrs == nil {
+		mmGetKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockGetKeyParamPtrs{}
+	}
+	mmGetKey.defaultExpectation.paramPtrs.key = &key
+	mmGetKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGetKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdempotencyRepo.GetKey
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Inspect(f func(ctx context.Context, key models.IdempotencyKey)) *mIIdempotencyRepoMockGetKey {
+	if mmGetKey.mock.inspectFuncGetKey != nil {
+		mmGetKey.mock.t.Fatalf("Inspect function is already set for IIdempotencyRepoMock.GetKey")
+	}
+
+	mmGetKey.mock.inspectFuncGetKey = f
+
+	return mmGetKey
+}
+
+// Return sets up results that will be returned by IIdempotencyRepo.GetKey
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Return(i1 models.IdempotencyRecord, err error) *IIdempotencyRepoMock {
+	if mmGetKey.mock.funcGetKey != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Set")
+	}
+
+	if mmGetKey.defaultExpectation == nil {
+		mmGetKey.defaultExpectation = &IIdempotencyRepoMockGetKeyExpectation{mock: mmGetKey.mock}
+	}
+	mmGetKey.defaultExpectation.results = &IIdempotencyRepoMockGetKeyResults{i1, err}
+	mmGetKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetKey.mock
+}
+
+// Set uses given function f to mock the IIdempotencyRepo.GetKey method
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Set(f func(ctx context.Context, key models.IdempotencyKey) (i1 models.IdempotencyRecord, err error)) *IIdempotencyRepoMock {
+	if mmGetKey.defaultExpectation != nil {
+		mmGetKey.mock.t.Fatalf("Default expectation is already set for the IIdempotencyRepo.GetKey method")
+	}
+
+	if len(mmGetKey.expectations) > 0 {
+		mmGetKey.mock.t.Fatalf("Some expectations are already set for the IIdempotencyRepo.GetKey method")
+	}
+
+	mmGetKey.mock.funcGetKey = f
+	mmGetKey.mock.funcGetKeyOrigin = minimock.CallerInfo(1)
+	return mmGetKey.mock
+}
+
+// When sets expectation for the IIdempotencyRepo.GetKey which will trigger the result defined by the following
+// Then helper
+func (mmGetKey *mIIdempotencyRepoMockGetKey) When(ctx context.Context, key models.IdempotencyKey) *IIdempotencyRepoMockGetKeyExpectation {
+	if mmGetKey.mock.funcGetKey != nil {
+		mmGetKey.mock.t.Fatalf("IIdempotencyRepoMock.GetKey mock is already set by Set")
+	}
+
+	expectation := &IIdempotencyRepoMockGetKeyExpectation{
+		mock:               mmGetKey.mock,
+		params:             &IIdempotencyRepoMockGetKeyParams{ctx, key},
+		expectationOrigins: IIdempotencyRepoMockGetKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetKey.expectations = append(mmGetKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdempotencyRepo.GetKey return parameters for the expectation previously defined by the When method
+func (e *IIdempotencyRepoMockGetKeyExpectation) Then(i1 models.IdempotencyRecord, err error) *IIdempotencyRepoMock {
+	e.results = &IIdempotencyRepoMockGetKeyResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IIdempotencyRepo.GetKey should be invoked
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Times(n uint64) *mIIdempotencyRepoMockGetKey {
+	if n == 0 {
+		mmGetKey.mock.t.Fatalf("Times of IIdempotencyRepoMock.GetKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetKey.expectedInvocations, n)
+	mmGetKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetKey
+}
+
+func (mmGetKey *mIIdempotencyRepoMockGetKey) invocationsDone() bool {
+	if len(mmGetKey.expectations) == 0 && mmGetKey.defaultExpectation == nil && mmGetKey.mock.funcGetKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetKey.mock.afterGetKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetKey implements mm_repository.IIdempotencyRepo
+func (mmGetKey *IIdempotencyRepoMock) GetKey(ctx context.Context, key models.IdempotencyKey) (i1 models.IdempotencyRecord, err error) {
+	mm_atomic.AddUint64(&mmGetKey.beforeGetKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetKey.afterGetKeyCounter, 1)
+
+	mmGetKey.t.Helper()
+
+	if mmGetKey.inspectFuncGetKey != nil {
+		mmGetKey.inspectFuncGetKey(ctx, key)
+	}
+
+	mm_params := IIdempotencyRepoMockGetKeyParams{ctx, key}
+
+	// Record call args
+	mmGetKey.GetKeyMock.mutex.Lock()
+	mmGetKey.GetKeyMock.callArgs = append(mmGetKey.GetKeyMock.callArgs, &mm_params)
+	mmGetKey.GetKeyMock.mutex.Unlock()
+
+	for _, e := range mmGetKey.GetKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetKey.GetKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetKey.GetKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetKey.GetKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetKey.GetKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdempotencyRepoMockGetKeyParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetKey.t.Errorf("IIdempotencyRepoMock.GetKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKey.GetKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGetKey.t.Errorf("IIdempotencyRepoMock.GetKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKey.GetKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetKey.t.Errorf("IIdempotencyRepoMock.GetKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetKey.GetKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetKey.GetKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetKey.t.Fatal("No results are set for the IIdempotencyRepoMock.GetKey")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetKey.funcGetKey != nil {
+		return mmGetKey.funcGetKey(ctx, key)
+	}
+	mmGetKey.t.Fatalf("Unexpected call to IIdempotencyRepoMock.GetKey. %v %v", ctx, key)
+	return
+}
+
+// GetKeyAfterCounter returns a count of finished IIdempotencyRepoMock.GetKey invocations
+func (mmGetKey *IIdempotencyRepoMock) GetKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKey.afterGetKeyCounter)
+}
+
+// GetKeyBeforeCounter returns a count of IIdempotencyRepoMock.GetKey invocations
+func (mmGetKey *IIdempotencyRepoMock) GetKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKey.beforeGetKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdempotencyRepoMock.GetKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetKey *mIIdempotencyRepoMockGetKey) Calls() []*IIdempotencyRepoMockGetKeyParams {
+	mmGetKey.mutex.RLock()
+
+	argCopy := make([]*IIdempotencyRepoMockGetKeyParams, len(mmGetKey.callArgs))
+	copy(argCopy, mmGetKey.callArgs)
+
+	mmGetKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetKeyDone returns true if the count of the GetKey invocations corresponds
+// the number of defined expectations
+func (m *IIdempotencyRepoMock) MinimockGetKeyDone() bool {
+	if m.GetKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetKeyMock.invocationsDone()
+}
+
+// MinimockGetKeyInspect logs each unmet expectation
+func (m *IIdempotencyRepoMock) MinimockGetKeyInspect() {
+	for _, e := range m.GetKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.GetKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetKeyCounter := mm_atomic.LoadUint64(&m.afterGetKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetKeyMock.defaultExpectation != nil && afterGetKeyCounter < 1 {
+		if m.GetKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.GetKey at\n%s", m.GetKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.GetKey at\n%s with params: %#v", m.GetKeyMock.defaultExpectation.expectationOrigins.origin, *m.GetKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetKey != nil && afterGetKeyCounter < 1 {
+		m.t.Errorf("Expected call to IIdempotencyRepoMock.GetKey at\n%s", m.funcGetKeyOrigin)
+	}
+
+	if !m.GetKeyMock.invocationsDone() && afterGetKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdempotencyRepoMock.GetKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetKeyMock.expectedInvocations), m.GetKeyMock.expectedInvocationsOrigin, afterGetKeyCounter)
+	}
+}
+
+type mIIdempotencyRepoMockReserveKey struct {
+	optional           bool
+	mock               *IIdempotencyRepoMock
+	defaultExpectation *IIdempotencyRepoMockReserveKeyExpectation
+	expectations       []*IIdempotencyRepoMockReserveKeyExpectation
+
+	callArgs []*IIdempotencyRepoMockReserveKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdempotencyRepoMockReserveKeyExpectation specifies expectation struct of the IIdempotencyRepo.ReserveKey
+type IIdempotencyRepoMockReserveKeyExpectation struct {
+	mock               *IIdempotencyRepoMock
+	params             *IIdempotencyRepoMockReserveKeyParams
+	paramPtrs          *IIdempotencyRepoMockReserveKeyParamPtrs
+	expectationOrigins IIdempotencyRepoMockReserveKeyExpectationOrigins
+	results            *IIdempotencyRepoMockReserveKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdempotencyRepoMockReserveKeyParams contains parameters of the IIdempotencyRepo.ReserveKey
+type IIdempotencyRepoMockReserveKeyParams struct {
+	ctx    context.Context
+	record models.IdempotencyRecord
+}
+
+// IIdempotencyRepoMockReserveKeyParamPtrs contains pointers to parameters of the IIdempotencyRepo.ReserveKey
+type IIdempotencyRepoMockReserveKeyParamPtrs struct {
+	ctx    *context.Context
+	record *models.IdempotencyRecord
+}
+
+// IIdempotencyRepoMockReserveKeyResults contains results of the IIdempotencyRepo.ReserveKey
+type IIdempotencyRepoMockReserveKeyResults struct {
+	b1  bool
+	err error
+}
+
+// IIdempotencyRepoMockReserveKeyOrigins contains origins of expectations of the IIdempotencyRepo.ReserveKey
+type IIdempotencyRepoMockReserveKeyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRecord string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Optional() *mIIdempotencyRepoMockReserveKey {
+	mmReserveKey.optional = true
+	return mmReserveKey
+}
+
+// Expect sets up expected params for IIdempotencyRepo.ReserveKey
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Expect(ctx context.Context, record models.IdempotencyRecord) *mIIdempotencyRepoMockReserveKey {
+	if mmReserveKey.mock.funcReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Set")
+	}
+
+	if mmReserveKey.defaultExpectation == nil {
+		mmReserveKey.defaultExpectation = &IIdempotencyRepoMockReserveKeyExpectation{}
+	}
+
+	if mmReserveKey.defaultExpectation.paramPtrs != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by ExpectParams functions")
+	}
+
+	mmReserveKey.defaultExpectation.params = &IIdempotencyRepoMockReserveKeyParams{ctx, record}
+	mmReserveKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserveKey.expectations {
+		if minimock.Equal(e.params, mmReserveKey.defaultExpectation.params) {
+			mmReserveKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReserveKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdempotencyRepo.ReserveKey
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) ExpectCtxParam1(ctx context.Context) *mIIdempotencyRepoMockReserveKey {
+	if mmReserveKey.mock.funcReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Set")
+	}
+
+	if mmReserveKey.defaultExpectation == nil {
+		mmReserveKey.defaultExpectation = &IIdempotencyRepoMockReserveKeyExpectation{}
+	}
+
+	if mmReserveKey.defaultExpectation.params != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Expect")
+	}
+
+	if mmReserveKey.defaultExpectation.paramPtrs == nil {
+		mmReserveKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockReserveKeyParamPtrs{}
+	}
+	mmReserveKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserveKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserveKey
+}
+
+// ExpectRecordParam2 sets up expected param record for IIdempotencyRepo.ReserveKey
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) ExpectRecordParam2(record models.IdempotencyRecord) *mIIdempotencyRepoMockReserveKey {
+	if mmReserveKey.mock.funcReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Set")
+	}
+
+	if mmReserveKey.defaultExpectation == nil {
+		mmReserveKey.defaultExpectation = &IIdempotencyRepoMockReserveKeyExpectation{}
+	}
+
+	if mmReserveKey.defaultExpectation.params != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Expect")
+	}
+
+	if mmReserveKey.defaultExpectation.paramPtrs == nil {
+		mmReserveKey.defaultExpectation.paramPtrs = &IIdempotencyRepoMockReserveKeyParamPtrs{}
+	}
+	mmReserveKey.defaultExpectation.paramPtrs.record = &record
+	mmReserveKey.defaultExpectation.expectationOrigins.originRecord = minimock.CallerInfo(1)
+
+	return mmReserveKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdempotencyRepo.ReserveKey
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Inspect(f func(ctx context.Context, record models.IdempotencyRecord)) *mIIdempotencyRepoMockReserveKey {
+	if mmReserveKey.mock.inspectFuncReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("Inspect function is already set for IIdempotencyRepoMock.ReserveKey")
+	}
+
+	mmReserveKey.mock.inspectFuncReserveKey = f
+
+	return mmReserveKey
+}
+
+// Return sets up results that will be returned by IIdempotencyRepo.ReserveKey
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Return(b1 bool, err error) *IIdempotencyRepoMock {
+	if mmReserveKey.mock.funcReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Set")
+	}
+
+	if mmReserveKey.defaultExpectation == nil {
+		mmReserveKey.defaultExpectation = &IIdempotencyRepoMockReserveKeyExpectation{mock: mmReserveKey.mock}
+	}
+	mmReserveKey.defaultExpectation.results = &IIdempotencyRepoMockReserveKeyResults{b1, err}
+	mmReserveKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserveKey.mock
+}
+
+// Set uses given function f to mock the IIdempotencyRepo.ReserveKey method
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Set(f func(ctx context.Context, record models.IdempotencyRecord) (b1 bool, err error)) *IIdempotencyRepoMock {
+	if mmReserveKey.defaultExpectation != nil {
+		mmReserveKey.mock.t.Fatalf("Default expectation is already set for the IIdempotencyRepo.ReserveKey method")
+	}
+
+	if len(mmReserveKey.expectations) > 0 {
+		mmReserveKey.mock.t.Fatalf("Some expectations are already set for the IIdempotencyRepo.ReserveKey method")
+	}
+
+	mmReserveKey.mock.funcReserveKey = f
+	mmReserveKey.mock.funcReserveKeyOrigin = minimock.CallerInfo(1)
+	return mmReserveKey.mock
+}
+
+// When sets expectation for the IIdempotencyRepo.ReserveKey which will trigger the result defined by the following
+// Then helper
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) When(ctx context.Context, record models.IdempotencyRecord) *IIdempotencyRepoMockReserveKeyExpectation {
+	if mmReserveKey.mock.funcReserveKey != nil {
+		mmReserveKey.mock.t.Fatalf("IIdempotencyRepoMock.ReserveKey mock is already set by Set")
+	}
+
+	expectation := &IIdempotencyRepoMockReserveKeyExpectation{
+		mock:               mmReserveKey.mock,
+		params:             &IIdempotencyRepoMockReserveKeyParams{ctx, record},
+		expectationOrigins: IIdempotencyRepoMockReserveKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserveKey.expectations = append(mmReserveKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdempotencyRepo.ReserveKey return parameters for the expectation previously defined by the When method
+func (e *IIdempotencyRepoMockReserveKeyExpectation) Then(b1 bool, err error) *IIdempotencyRepoMock {
+	e.results = &IIdempotencyRepoMockReserveKeyResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times IIdempotencyRepo.ReserveKey should be invoked
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Times(n uint64) *mIIdempotencyRepoMockReserveKey {
+	if n == 0 {
+		mmReserveKey.mock.t.Fatalf("Times of IIdempotencyRepoMock.ReserveKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserveKey.expectedInvocations, n)
+	mmReserveKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserveKey
+}
+
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) invocationsDone() bool {
+	if len(mmReserveKey.expectations) == 0 && mmReserveKey.defaultExpectation == nil && mmReserveKey.mock.funcReserveKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserveKey.mock.afterReserveKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserveKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReserveKey implements mm_repository.IIdempotencyRepo
+func (mmReserveKey *IIdempotencyRepoMock) ReserveKey(ctx context.Context, record models.IdempotencyRecord) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmReserveKey.beforeReserveKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserveKey.afterReserveKeyCounter, 1)
+
+	mmReserveKey.t.Helper()
+
+	if mmReserveKey.inspectFuncReserveKey != nil {
+		mmReserveKey.inspectFuncReserveKey(ctx, record)
+	}
+
+	mm_params := IIdempotencyRepoMockReserveKeyParams{ctx, record}
+
+	// Record call args
+	mmReserveKey.ReserveKeyMock.mutex.Lock()
+	mmReserveKey.ReserveKeyMock.callArgs = append(mmReserveKey.ReserveKeyMock.callArgs, &mm_params)
+	mmReserveKey.ReserveKeyMock.mutex.Unlock()
+
+	for _, e := range mmReserveKey.ReserveKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmReserveKey.ReserveKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserveKey.ReserveKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserveKey.ReserveKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReserveKey.ReserveKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdempotencyRepoMockReserveKeyParams{ctx, record}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserveKey.t.Errorf("IIdempotencyRepoMock.ReserveKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveKey.ReserveKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.record != nil && !minimock.Equal(*mm_want_ptrs.record, mm_got.record) {
+				mmReserveKey.t.Errorf("IIdempotencyRepoMock.ReserveKey got unexpected parameter record, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveKey.ReserveKeyMock.defaultExpectation.expectationOrigins.originRecord, *mm_want_ptrs.record, mm_got.record, minimock.Diff(*mm_want_ptrs.record, mm_got.record))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserveKey.t.Errorf("IIdempotencyRepoMock.ReserveKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserveKey.ReserveKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserveKey.ReserveKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserveKey.t.Fatal("No results are set for the IIdempotencyRepoMock.ReserveKey")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmReserveKey.funcReserveKey != nil {
+		return mmReserveKey.funcReserveKey(ctx, record)
+	}
+	mmReserveKey.t.Fatalf("Unexpected call to IIdempotencyRepoMock.ReserveKey. %v %v", ctx, record)
+	return
+}
+
+// ReserveKeyAfterCounter returns a count of finished IIdempotencyRepoMock.ReserveKey invocations
+func (mmReserveKey *IIdempotencyRepoMock) ReserveKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveKey.afterReserveKeyCounter)
+}
+
+// ReserveKeyBeforeCounter returns a count of IIdempotencyRepoMock.ReserveKey invocations
+func (mmReserveKey *IIdempotencyRepoMock) ReserveKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveKey.beforeReserveKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdempotencyRepoMock.ReserveKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserveKey *mIIdempotencyRepoMockReserveKey) Calls() []*IIdempotencyRepoMockReserveKeyParams {
+	mmReserveKey.mutex.RLock()
+
+	argCopy := make([]*IIdempotencyRepoMockReserveKeyParams, len(mmReserveKey.callArgs))
+	copy(argCopy, mmReserveKey.callArgs)
+
+	mmReserveKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveKeyDone returns true if the count of the ReserveKey invocations corresponds
+// the number of defined expectations
+func (m *IIdempotencyRepoMock) MinimockReserveKeyDone() bool {
+	if m.ReserveKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveKeyMock.invocationsDone()
+}
+
+// MinimockReserveKeyInspect logs each unmet expectation
+func (m *IIdempotencyRepoMock) MinimockReserveKeyInspect() {
+	for _, e := range m.ReserveKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.ReserveKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveKeyCounter := mm_atomic.LoadUint64(&m.afterReserveKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveKeyMock.defaultExpectation != nil && afterReserveKeyCounter < 1 {
+		if m.ReserveKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.ReserveKey at\n%s", m.ReserveKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.ReserveKey at\n%s with params: %#v", m.ReserveKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReserveKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveKey != nil && afterReserveKeyCounter < 1 {
+		m.t.Errorf("Expected call to IIdempotencyRepoMock.ReserveKey at\n%s", m.funcReserveKeyOrigin)
+	}
+
+	if !m.ReserveKeyMock.invocationsDone() && afterReserveKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdempotencyRepoMock.ReserveKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveKeyMock.expectedInvocations), m.ReserveKeyMock.expectedInvocationsOrigin, afterReserveKeyCounter)
+	}
+}
+
+type mIIdempotencyRepoMockSaveResponse struct {
+	optional           bool
+	mock               *IIdempotencyRepoMock
+	defaultExpectation *IIdempotencyRepoMockSaveResponseExpectation
+	expectations       []*IIdempotencyRepoMockSaveResponseExpectation
+
+	callArgs []*IIdempotencyRepoMockSaveResponseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdempotencyRepoMockSaveResponseExpectation specifies expectation struct of the IIdempotencyRepo.SaveResponse
+type IIdempotencyRepoMockSaveResponseExpectation struct {
+	mock               *IIdempotencyRepoMock
+	params             *IIdempotencyRepoMockSaveResponseParams
+	paramPtrs          *IIdempotencyRepoMockSaveResponseParamPtrs
+	expectationOrigins IIdempotencyRepoMockSaveResponseExpectationOrigins
+	results            *IIdempotencyRepoMockSaveResponseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdempotencyRepoMockSaveResponseParams contains parameters of the IIdempotencyRepo.SaveResponse
+type IIdempotencyRepoMockSaveResponseParams struct {
+	ctx      context.Context
+	key      models.IdempotencyKey
+	response []byte
+}
+
+// IIdempotencyRepoMockSaveResponseParamPtrs contains pointers to parameters of the IIdempotencyRepo.SaveResponse
+type IIdempotencyRepoMockSaveResponseParamPtrs struct {
+	ctx      *context.Context
+	key      *models.IdempotencyKey
+	response *[]byte
+}
+
+// IIdempotencyRepoMockSaveResponseResults contains results of the IIdempotencyRepo.SaveResponse
+type IIdempotencyRepoMockSaveResponseResults struct {
+	err error
+}
+
+// IIdempotencyRepoMockSaveResponseOrigins contains origins of expectations of the IIdempotencyRepo.SaveResponse
+type IIdempotencyRepoMockSaveResponseExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originKey      string
+	originResponse string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Optional() *mIIdempotencyRepoMockSaveResponse {
+	mmSaveResponse.optional = true
+	return mmSaveResponse
+}
+
+// Expect sets up expected params for IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Expect(ctx context.Context, key models.IdempotencyKey, response []byte) *mIIdempotencyRepoMockSaveResponse {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	if mmSaveResponse.defaultExpectation == nil {
+		mmSaveResponse.defaultExpectation = &IIdempotencyRepoMockSaveResponseExpectation{}
+	}
+
+	if mmSaveResponse.defaultExpectation.paramPtrs != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by ExpectParams functions")
+	}
+
+	mmSaveResponse.defaultExpectation.params = &IIdempotencyRepoMockSaveResponseParams{ctx, key, response}
+	mmSaveResponse.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveResponse.expectations {
+		if minimock.Equal(e.params, mmSaveResponse.defaultExpectation.params) {
+			mmSaveResponse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveResponse.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveResponse
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) ExpectCtxParam1(ctx context.Context) *mIIdempotencyRepoMockSaveResponse {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	if mmSaveResponse.defaultExpectation == nil {
+		mmSaveResponse.defaultExpectation = &IIdempotencyRepoMockSaveResponseExpectation{}
+	}
+
+	if mmSaveResponse.defaultExpectation.params != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Expect")
+	}
+
+	if mmSaveResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveResponse.defaultExpectation.paramPtrs = &IIdempotencyRepoMockSaveResponseParamPtrs{}
+	}
+	mmSaveResponse.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveResponse.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveResponse
+}
+
+// ExpectKeyParam2 sets up expected param key for IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) ExpectKeyParam2(key models.IdempotencyKey) *mIIdempotencyRepoMockSaveResponse {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	if mmSaveResponse.defaultExpectation == nil {
+		mmSaveResponse.defaultExpectation = &IIdempotencyRepoMockSaveResponseExpectation{}
+	}
+
+	if mmSaveResponse.defaultExpectation.params != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Expect")
+	}
+
+	if mmSaveResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveResponse.defaultExpectation.paramPtrs = &IIdempotencyRepoMockSaveResponseParamPtrs{}
+	}
+	mmSaveResponse.defaultExpectation.paramPtrs.key = &key
+	mmSaveResponse.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSaveResponse
+}
+
+// ExpectResponseParam3 sets up expected param response for IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) ExpectResponseParam3(response []byte) *mIIdempotencyRepoMockSaveResponse {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	if mmSaveResponse.defaultExpectation == nil {
+		mmSaveResponse.defaultExpectation = &IIdempotencyRepoMockSaveResponseExpectation{}
+	}
+
+	if mmSaveResponse.defaultExpectation.params != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Expect")
+	}
+
+	if mmSaveResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveResponse.defaultExpectation.paramPtrs = &IIdempotencyRepoMockSaveResponseParamPtrs{}
+	}
+	mmSaveResponse.defaultExpectation.paramPtrs.response = &response
+	mmSaveResponse.defaultExpectation.expectationOrigins.originResponse = minimock.CallerInfo(1)
+
+	return mmSaveResponse
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Inspect(f func(ctx context.Context, key models.IdempotencyKey, response []byte)) *mIIdempotencyRepoMockSaveResponse {
+	if mmSaveResponse.mock.inspectFuncSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("Inspect function is already set for IIdempotencyRepoMock.SaveResponse")
+	}
+
+	mmSaveResponse.mock.inspectFuncSaveResponse = f
+
+	return mmSaveResponse
+}
+
+// Return sets up results that will be returned by IIdempotencyRepo.SaveResponse
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Return(err error) *IIdempotencyRepoMock {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	if mmSaveResponse.defaultExpectation == nil {
+		mmSaveResponse.defaultExpectation = &IIdempotencyRepoMockSaveResponseExpectation{mock: mmSaveResponse.mock}
+	}
+	mmSaveResponse.defaultExpectation.results = &IIdempotencyRepoMockSaveResponseResults{err}
+	mmSaveResponse.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveResponse.mock
+}
+
+// Set uses given function f to mock the IIdempotencyRepo.SaveResponse method
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Set(f func(ctx context.Context, key models.IdempotencyKey, response []byte) (err error)) *IIdempotencyRepoMock {
+	if mmSaveResponse.defaultExpectation != nil {
+		mmSaveResponse.mock.t.Fatalf("Default expectation is already set for the IIdempotencyRepo.SaveResponse method")
+	}
+
+	if len(mmSaveResponse.expectations) > 0 {
+		mmSaveResponse.mock.t.Fatalf("Some expectations are already set for the IIdempotencyRepo.SaveResponse method")
+	}
+
+	mmSaveResponse.mock.funcSaveResponse = f
+	mmSaveResponse.mock.funcSaveResponseOrigin = minimock.CallerInfo(1)
+	return mmSaveResponse.mock
+}
+
+// When sets expectation for the IIdempotencyRepo.SaveResponse which will trigger the result defined by the following
+// Then helper
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) When(ctx context.Context, key models.IdempotencyKey, response []byte) *IIdempotencyRepoMockSaveResponseExpectation {
+	if mmSaveResponse.mock.funcSaveResponse != nil {
+		mmSaveResponse.mock.t.Fatalf("IIdempotencyRepoMock.SaveResponse mock is already set by Set")
+	}
+
+	expectation := &IIdempotencyRepoMockSaveResponseExpectation{
+		mock:               mmSaveResponse.mock,
+		params:             &IIdempotencyRepoMockSaveResponseParams{ctx, key, response},
+		expectationOrigins: IIdempotencyRepoMockSaveResponseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveResponse.expectations = append(mmSaveResponse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdempotencyRepo.SaveResponse return parameters for the expectation previously defined by the When method
+func (e *IIdempotencyRepoMockSaveResponseExpectation) Then(err error) *IIdempotencyRepoMock {
+	e.results = &IIdempotencyRepoMockSaveResponseResults{err}
+	return e.mock
+}
+
+// Times sets number of times IIdempotencyRepo.SaveResponse should be invoked
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Times(n uint64) *mIIdempotencyRepoMockSaveResponse {
+	if n == 0 {
+		mmSaveResponse.mock.t.Fatalf("Times of IIdempotencyRepoMock.SaveResponse mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveResponse.expectedInvocations, n)
+	mmSaveResponse.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveResponse
+}
+
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) invocationsDone() bool {
+	if len(mmSaveResponse.expectations) == 0 && mmSaveResponse.defaultExpectation == nil && mmSaveResponse.mock.funcSaveResponse == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveResponse.mock.afterSaveResponseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveResponse.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveResponse implements mm_repository.IIdempotencyRepo
+func (mmSaveResponse *IIdempotencyRepoMock) SaveResponse(ctx context.Context, key models.IdempotencyKey, response []byte) (err error) {
+	mm_atomic.AddUint64(&mmSaveResponse.beforeSaveResponseCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveResponse.afterSaveResponseCounter, 1)
+
+	mmSaveResponse.t.Helper()
+
+	if mmSaveResponse.inspectFuncSaveResponse != nil {
+		mmSaveResponse.inspectFuncSaveResponse(ctx, key, response)
+	}
+
+	mm_params := IIdempotencyRepoMockSaveResponseParams{ctx, key, response}
+
+	// Record call args
+	mmSaveResponse.SaveResponseMock.mutex.Lock()
+	mmSaveResponse.SaveResponseMock.callArgs = append(mmSaveResponse.SaveResponseMock.callArgs, &mm_params)
+	mmSaveResponse.SaveResponseMock.mutex.Unlock()
+
+	for _, e := range mmSaveResponse.SaveResponseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveResponse.SaveResponseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveResponse.SaveResponseMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveResponse.SaveResponseMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveResponse.SaveResponseMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdempotencyRepoMockSaveResponseParams{ctx, key, response}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveResponse.t.Errorf("IIdempotencyRepoMock.SaveResponse got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveResponse.SaveResponseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSaveResponse.t.Errorf("IIdempotencyRepoMock.SaveResponse got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveResponse.SaveResponseMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.response != nil && !minimock.Equal(*mm_want_ptrs.response, mm_got.response) {
+				mmSaveResponse.t.Errorf("IIdempotencyRepoMock.SaveResponse got unexpected parameter response, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveResponse.SaveResponseMock.defaultExpectation.expectationOrigins.originResponse, *mm_want_ptrs.response, mm_got.response, minimock.Diff(*mm_want_ptrs.response, mm_got.response))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveResponse.t.Errorf("IIdempotencyRepoMock.SaveResponse got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveResponse.SaveResponseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveResponse.SaveResponseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveResponse.t.Fatal("No results are set for the IIdempotencyRepoMock.SaveResponse")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveResponse.funcSaveResponse != nil {
+		return mmSaveResponse.funcSaveResponse(ctx, key, response)
+	}
+	mmSaveResponse.t.Fatalf("Unexpected call to IIdempotencyRepoMock.SaveResponse. %v %v %v", ctx, key, response)
+	return
+}
+
+// SaveResponseAfterCounter returns a count of finished IIdempotencyRepoMock.SaveResponse invocations
+func (mmSaveResponse *IIdempotencyRepoMock) SaveResponseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveResponse.afterSaveResponseCounter)
+}
+
+// SaveResponseBeforeCounter returns a count of IIdempotencyRepoMock.SaveResponse invocations
+func (mmSaveResponse *IIdempotencyRepoMock) SaveResponseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveResponse.beforeSaveResponseCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdempotencyRepoMock.SaveResponse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveResponse *mIIdempotencyRepoMockSaveResponse) Calls() []*IIdempotencyRepoMockSaveResponseParams {
+	mmSaveResponse.mutex.RLock()
+
+	argCopy := make([]*IIdempotencyRepoMockSaveResponseParams, len(mmSaveResponse.callArgs))
+	copy(argCopy, mmSaveResponse.callArgs)
+
+	mmSaveResponse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveResponseDone returns true if the count of the SaveResponse invocations corresponds
+// the number of defined expectations
+func (m *IIdempotencyRepoMock) MinimockSaveResponseDone() bool {
+	if m.SaveResponseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveResponseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveResponseMock.invocationsDone()
+}
+
+// MinimockSaveResponseInspect logs each unmet expectation
+func (m *IIdempotencyRepoMock) MinimockSaveResponseInspect() {
+	for _, e := range m.SaveResponseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.SaveResponse at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveResponseCounter := mm_atomic.LoadUint64(&m.afterSaveResponseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveResponseMock.defaultExpectation != nil && afterSaveResponseCounter < 1 {
+		if m.SaveResponseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.SaveResponse at\n%s", m.SaveResponseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdempotencyRepoMock.SaveResponse at\n%s with params: %#v", m.SaveResponseMock.defaultExpectation.expectationOrigins.origin, *m.SaveResponseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveResponse != nil && afterSaveResponseCounter < 1 {
+		m.t.Errorf("Expected call to IIdempotencyRepoMock.SaveResponse at\n%s", m.funcSaveResponseOrigin)
+	}
+
+	if !m.SaveResponseMock.invocationsDone() && afterSaveResponseCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdempotencyRepoMock.SaveResponse at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveResponseMock.expectedInvocations), m.SaveResponseMock.expectedInvocationsOrigin, afterSaveResponseCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IIdempotencyRepoMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteExpiredKeysInspect()
+
+			m.MinimockDeleteKeyInspect()
+
+			m.MinimockGetKeyInspect()
+
+			m.MinimockReserveKeyInspect()
+
+			m.MinimockSaveResponseInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IIdempotencyRepoMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IIdempotencyRepoMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteExpiredKeysDone() &&
+		m.MinimockDeleteKeyDone() &&
+		m.MinimockGetKeyDone() &&
+		m.MinimockReserveKeyDone() &&
+		m.MinimockSaveResponseDone()
+}
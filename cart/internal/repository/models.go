@@ -1,6 +1,17 @@
 package repository
 
+import "time"
+
 type cartItemDB struct {
-	SKUID int64
-	Count uint16
+	SKUID   int64
+	OfferID int64
+	Count   uint16
+}
+
+type abandonedCartItemDB struct {
+	UserID    int64
+	SKUID     int64
+	OfferID   int64
+	Count     uint16
+	UpdatedAt time.Time
 }
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"cart/internal/auth"
+	"cart/internal/models"
+	myLog "cart/internal/observability/log"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+
+	errAuthFailed = "authentication failed"
+)
+
+var errUserMismatch error = errors.New("user_id does not match the authenticated user")
+
+// publicMethods can be called without a token, so the orchestrator and the load balancers can check
+// the health of the service. A token sent with them is still verified.
+var publicMethods = map[string]struct{}{
+	healthpb.Health_Check_FullMethodName: {},
+}
+
+type IVerifier interface {
+	Verify(token string) (auth.Principal, error)
+}
+
+// AuthInterceptor verifies the bearer token of the authorization metadata and puts its principal
+// in the context. Every cart belongs to a user, so requests without a valid token are rejected
+// with UNAUTHENTICATED, except for the public methods.
+func AuthInterceptor(verifier IVerifier, logger myLog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		values := md.Get(authorizationMetadataKey)
+		if len(values) == 0 {
+			if _, public := publicMethods[info.FullMethod]; public {
+				return handler(ctx, req)
+			}
+
+			return nil, status.Error(codes.Unauthenticated, auth.ErrNoToken.Error())
+		}
+
+		token, err := auth.BearerToken(values[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		principal, err := verifier.Verify(token)
+		if err != nil {
+			logger.Warn(errAuthFailed, myLog.String("method", info.FullMethod), myLog.Error(err))
+
+			return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+		}
+
+		return handler(auth.WithPrincipal(ctx, principal), req)
+	}
+}
+
+// requestUser returns the authenticated user of the request. The deprecated user_id of the request
+// is only used if the server runs without authentication, otherwise it must be unset or match.
+func requestUser(ctx context.Context, deprecatedUserID int64) (models.UserID, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return models.UserID(deprecatedUserID), nil
+	}
+
+	if deprecatedUserID != 0 && models.UserID(deprecatedUserID) != principal.UserID {
+		return 0, status.Error(codes.PermissionDenied, errUserMismatch.Error())
+	}
+
+	return principal.UserID, nil
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"cart/internal/usecase"
+)
+
+const (
+	// errorDomain is the domain of the google.rpc.ErrorInfo of the errors of the service.
+	errorDomain = "cart"
+
+	reasonInvalidArgument = "INVALID_ARGUMENT"
+	errInternal           = "internal error"
+)
+
+var errorCodes = map[usecase.ErrorKind]codes.Code{
+	usecase.KindInternal:           codes.Internal,
+	usecase.KindInvalidArgument:    codes.InvalidArgument,
+	usecase.KindNotFound:           codes.NotFound,
+	usecase.KindAlreadyExists:      codes.AlreadyExists,
+	usecase.KindFailedPrecondition: codes.FailedPrecondition,
+	usecase.KindAborted:            codes.Aborted,
+	usecase.KindUnavailable:        codes.Unavailable,
+}
+
+// ErrorInterceptor converts the errors returned by the handlers and the inner interceptors to statuses.
+// It should be the first interceptor, so the others still log the original errors.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// statusError maps a domain error to its status code with google.rpc.ErrorInfo and, for an invalid field,
+// google.rpc.BadRequest details. Statuses are returned as is, other errors are internal and their
+// message is not sent to the client.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	domainErr, ok := usecase.AsError(err)
+	if !ok || domainErr.Kind == usecase.KindInternal {
+		return status.Error(codes.Internal, errInternal)
+	}
+
+	if domainErr.Field == "" {
+		return detailedError(errorCodes[domainErr.Kind], domainErr.Reason, err.Error())
+	}
+
+	return detailedError(errorCodes[domainErr.Kind], domainErr.Reason, err.Error(), fieldViolation(domainErr.Field, err.Error()))
+}
+
+// invalidArgument is the error of a request field that the handler fails to convert.
+func invalidArgument(field string, err error) error {
+	return detailedError(codes.InvalidArgument, reasonInvalidArgument, err.Error(), fieldViolation(field, err.Error()))
+}
+
+// detailedError is a status with google.rpc.ErrorInfo and, with field violations, google.rpc.BadRequest details.
+func detailedError(c codes.Code, reason, message string, violations ...*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(c, message)
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}}
+	if len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+func fieldViolation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+// errorBody is the JSON form of a google.rpc.Status used by the Google APIs, with the HTTP status code.
+type errorBody struct {
+	Error errorStatus `json:"error"`
+}
+
+type errorStatus struct {
+	Code    int               `json:"code"`
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// errorHandler renders the gateway errors as an errorBody. The headers, trailers and the HTTP status code
+// are set by the default handler.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler,
+	w http.ResponseWriter, r *http.Request, err error,
+) {
+	runtime.DefaultHTTPErrorHandler(ctx, mux, errorMarshaler{Marshaler: marshaler}, w, r, err)
+}
+
+// errorMarshaler marshals the status of an error as an errorBody, its details with the outbound marshaler.
+type errorMarshaler struct {
+	runtime.Marshaler
+}
+
+func (m errorMarshaler) ContentType(_ any) string {
+	return "application/json"
+}
+
+func (m errorMarshaler) Marshal(v any) ([]byte, error) {
+	st, ok := v.(*spb.Status)
+	if !ok {
+		return m.Marshaler.Marshal(v)
+	}
+
+	body := errorBody{Error: errorStatus{
+		Code:    runtime.HTTPStatusFromCode(codes.Code(st.GetCode())),
+		Status:  code.Code(st.GetCode()).String(),
+		Message: st.GetMessage(),
+	}}
+
+	for _, detail := range st.GetDetails() {
+		data, err := m.Marshaler.Marshal(detail)
+		if err != nil {
+			return nil, err
+		}
+
+		body.Error.Details = append(body.Error.Details, data)
+	}
+
+	return json.Marshal(body)
+}
@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"cart/internal/usecase"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := ErrorInterceptor()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(errorHandler))
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantHTTP   int
+		wantStatus string
+		wantReason string
+		wantField  string
+	}{
+		{
+			name:       "VersionMismatch",
+			err:        usecase.ErrVersionMismatch,
+			wantCode:   codes.FailedPrecondition,
+			wantHTTP:   http.StatusBadRequest,
+			wantStatus: "FAILED_PRECONDITION",
+			wantReason: "VERSION_MISMATCH",
+		},
+		{
+			name:       "NotEnoughStock",
+			err:        fmt.Errorf("add item: %w", usecase.ErrNotEnoughStock),
+			wantCode:   codes.Aborted,
+			wantHTTP:   http.StatusConflict,
+			wantStatus: "ABORTED",
+			wantReason: "NOT_ENOUGH_STOCK",
+		},
+		{
+			name:       "IdempotencyKeyReused",
+			err:        usecase.ErrIdempotencyKeyReused,
+			wantCode:   codes.InvalidArgument,
+			wantHTTP:   http.StatusBadRequest,
+			wantStatus: "INVALID_ARGUMENT",
+			wantReason: "IDEMPOTENCY_KEY_REUSED",
+		},
+		{
+			name:       "InvalidOperation",
+			err:        usecase.ErrInvalidOperation,
+			wantCode:   codes.InvalidArgument,
+			wantHTTP:   http.StatusBadRequest,
+			wantStatus: "INVALID_ARGUMENT",
+			wantReason: "INVALID_OPERATION",
+			wantField:  "operations",
+		},
+		{
+			name:       "Internal",
+			err:        errors.New("sql error"),
+			wantCode:   codes.Internal,
+			wantHTTP:   http.StatusInternalServerError,
+			wantStatus: "INTERNAL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			}
+
+			_, err := interceptor(t.Context(), nil, &grpc.UnaryServerInfo{}, handler)
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("wanted: %v, respond: %v", tt.wantCode, st.Code())
+			}
+
+			var reason string
+			var fields []string
+
+			for _, detail := range st.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.ErrorInfo:
+					reason = detail.GetReason()
+
+					if detail.GetDomain() != errorDomain {
+						t.Errorf("wanted domain: %v, respond: %v", errorDomain, detail.GetDomain())
+					}
+				case *errdetails.BadRequest:
+					for _, violation := range detail.GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				}
+			}
+
+			if reason != tt.wantReason {
+				t.Errorf("wanted reason: %v, respond: %v", tt.wantReason, reason)
+			}
+
+			if tt.wantField != "" && (len(fields) != 1 || fields[0] != tt.wantField) {
+				t.Errorf("wanted violation of: %v, respond: %v", tt.wantField, fields)
+			}
+
+			if tt.wantCode == codes.Internal && st.Message() != errInternal {
+				t.Errorf("wanted message: %v, respond: %v", errInternal, st.Message())
+			}
+
+			// the gateway renders the status as an errorBody
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodPost, "/cart/item/add", nil)
+
+			ctx := runtime.NewServerMetadataContext(t.Context(), runtime.ServerMetadata{})
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, r, err)
+
+			if w.Code != tt.wantHTTP {
+				t.Errorf("wanted HTTP: %d, respond: %d", tt.wantHTTP, w.Code)
+			}
+
+			var body struct {
+				Error struct {
+					Code    int    `json:"code"`
+					Status  string `json:"status"`
+					Message string `json:"message"`
+					Details []struct {
+						Type   string `json:"@type"`
+						Reason string `json:"reason"`
+					} `json:"details"`
+				} `json:"error"`
+			}
+
+			if err = json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode %s: %v", w.Body.String(), err)
+			}
+
+			if body.Error.Code != tt.wantHTTP || body.Error.Status != tt.wantStatus {
+				t.Errorf("wanted: %d %v, respond: %d %v", tt.wantHTTP, tt.wantStatus, body.Error.Code, body.Error.Status)
+			}
+
+			if body.Error.Message != st.Message() {
+				t.Errorf("wanted message: %v, respond: %v", st.Message(), body.Error.Message)
+			}
+
+			if tt.wantReason == "" {
+				if len(body.Error.Details) != 0 {
+					t.Errorf("wanted no details, respond: %v", body.Error.Details)
+				}
+
+				return
+			}
+
+			if len(body.Error.Details) == 0 || body.Error.Details[0].Type != "type.googleapis.com/google.rpc.ErrorInfo" ||
+				body.Error.Details[0].Reason != tt.wantReason {
+				t.Errorf("wanted ErrorInfo with reason: %v, respond: %v", tt.wantReason, body.Error.Details)
+			}
+		})
+	}
+}
@@ -2,20 +2,35 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"cart/internal/auth"
 	pb "cart/pkg/api/cart"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// openAPIPath, livenessPath and readinessPath are the gateway routes that are not RPCs.
+const (
+	openAPIPath   = "/openapi.json"
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
 )
 
 type ServerConfig struct {
 	Address             string
 	Handler             http.Handler
 	ReaderHeaderTimeout time.Duration
+	TLSConfig           *tls.Config
 }
 
 func NewGatewayServer(serverConfig *ServerConfig) *http.Server {
@@ -23,19 +38,118 @@ func NewGatewayServer(serverConfig *ServerConfig) *http.Server {
 		Addr:              serverConfig.Address,
 		Handler:           serverConfig.Handler,
 		ReadHeaderTimeout: serverConfig.ReaderHeaderTimeout,
+		TLSConfig:         serverConfig.TLSConfig,
 	}
 
 	return server
 }
 
-func NewMux(ctx context.Context, grpcAddress string) (*runtime.ServeMux, error) {
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+// NewMux registers the gateway handlers, which dial the gRPC server with the given transport credentials.
+func NewMux(ctx context.Context, grpcAddress string, creds credentials.TransportCredentials) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 
 	err := pb.RegisterCartServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	err = mux.HandlePath(http.MethodGet, openAPIPath, serveOpenAPI)
+	if err != nil {
+		return nil, err
+	}
+
 	return mux, nil
 }
+
+// IProbes are the HTTP handlers of the liveness and the readiness probes.
+type IProbes interface {
+	LivenessHandler() http.Handler
+	ReadinessHandler() http.Handler
+}
+
+// HandleProbes serves the liveness probe at /healthz and the readiness probe at /readyz.
+func HandleProbes(mux *runtime.ServeMux, probes IProbes) error {
+	handlers := map[string]http.Handler{
+		livenessPath:  probes.LivenessHandler(),
+		readinessPath: probes.ReadinessHandler(),
+	}
+
+	for path, handler := range handlers {
+		err := mux.HandlePath(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			handler.ServeHTTP(w, r)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serveOpenAPI publishes the generated OpenAPI document of the v1 and v2 routes.
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(pb.OpenAPI)
+}
+
+// headerMatcher forwards the Idempotency-Key header as is, in addition to the default headers.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, idempotencyKeyMetadataKey) {
+		return idempotencyKeyMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher sends the retry-after metadata of a rate limited request as the Retry-After
+// header of its 429 response, the other metadata keeps the default Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == retryAfterMetadataKey {
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// setETag exposes the cart version of a list response, so it can be sent back in If-Match.
+func setETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if list, ok := resp.(*pb.CartListItemResponse); ok {
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, list.GetVersion()))
+	}
+
+	return nil
+}
+
+// AuthMiddleware rejects a request with an invalid bearer token before it is forwarded. The Authorization
+// header is forwarded as the authorization metadata, so the gRPC server verifies the token again and
+// rejects the requests without it.
+func AuthMiddleware(mux *runtime.ServeMux, verifier IVerifier, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		token, err := auth.BearerToken(header)
+		if err == nil {
+			_, err = verifier.Verify(token)
+		}
+
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, &runtime.JSONPb{}, w, r,
+				status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error()))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -3,10 +3,11 @@ package grpc
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/otel/trace"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"cart/internal/models"
@@ -18,9 +19,21 @@ type ICartUsecase interface {
 	AddItem(ctx context.Context, addItem usecase.AddItemDTO) error
 	DeleteItem(ctx context.Context, delItem usecase.DeleteItemDTO) error
 	GetItemsByUserID(ctx context.Context, userID models.UserID) (usecase.ListItemsDTO, error)
-	ClearCartByUserID(ctx context.Context, userID models.UserID) error
+	ClearCartByUserID(ctx context.Context, clearCart usecase.ClearCartDTO) error
+	MoveToWishlist(ctx context.Context, moveItem usecase.MoveItemDTO) error
+	MoveToCart(ctx context.Context, moveItem usecase.MoveItemDTO) error
+	ListWishlist(ctx context.Context, userID models.UserID) (usecase.WishlistDTO, error)
+	BulkUpdate(ctx context.Context, bulk usecase.BulkUpdateDTO) (usecase.BulkUpdateResultDTO, error)
 }
 
+// ifMatchMetadataKey is the metadata key the gateway forwards the If-Match header with.
+const (
+	ifMatchMetadataKey = "grpcgateway-if-match"
+	ifMatchAny         = "*"
+)
+
+var ErrInvalidIfMatch error = errors.New("invalid If-Match header, want a cart version")
+
 type CartServer struct {
 	cartUsecase ICartUsecase
 	tracer      trace.Tracer
@@ -32,49 +45,69 @@ func NewCartServer(us ICartUsecase, tracer trace.Tracer) *CartServer {
 }
 
 func (c *CartServer) AddItem(ctx context.Context, req *pb.CartAddItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	count, err := models.Uint32ToUint16(req.Count)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidArgument("count", err)
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
 	}
 
 	addItemDTO := usecase.AddItemDTO{
-		UserID: models.UserID(req.UserId),
-		SKUID:  models.SKUID(req.Sku),
-		Count:  count,
+		UserID:          userID,
+		SKUID:           models.SKUID(req.Sku),
+		OfferID:         models.OfferID(req.OfferId),
+		Count:           count,
+		ExpectedVersion: version,
 	}
 
 	if err = c.cartUsecase.AddItem(ctx, addItemDTO); err != nil {
-		if errors.Is(err, usecase.ErrNotEnoughStock) {
-			return nil, status.Error(codes.Aborted, err.Error())
-		}
-
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (c *CartServer) DeleteItem(ctx context.Context, req *pb.CartDeleteItemRequest) (*emptypb.Empty, error) {
-	deleteItemDTO := usecase.DeleteItemDTO{
-		UserID: models.UserID(req.UserId),
-		SKUID:  models.SKUID(req.Sku),
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := c.cartUsecase.DeleteItem(ctx, deleteItemDTO); err != nil {
-		if errors.Is(err, usecase.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
+	}
 
-		return nil, status.Error(codes.Unknown, err.Error())
+	deleteItemDTO := usecase.DeleteItemDTO{
+		UserID:          userID,
+		SKUID:           models.SKUID(req.Sku),
+		ExpectedVersion: version,
+	}
+
+	if err = c.cartUsecase.DeleteItem(ctx, deleteItemDTO); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (c *CartServer) ListItem(ctx context.Context, req *pb.CartUserIDRequest) (*pb.CartListItemResponse, error) {
-	listDTO, err := c.cartUsecase.GetItemsByUserID(ctx, models.UserID(req.UserId))
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	listDTO, err := c.cartUsecase.GetItemsByUserID(ctx, userID)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 
 	respList := make([]*pb.CartItem, len(listDTO.Items))
@@ -86,21 +119,216 @@ func (c *CartServer) ListItem(ctx context.Context, req *pb.CartUserIDRequest) (*
 		respItem.Name = item.Name
 		respItem.Count = uint32(item.Count)
 		respItem.Price = item.Price
+		respItem.OfferId = int64(item.OfferID)
+		respItem.SellerId = int64(item.UserID)
 
 		respList[i] = &respItem
 	}
 
-	return &pb.CartListItemResponse{Items: respList, TotalPrice: listDTO.TotalPrice}, nil
+	return &pb.CartListItemResponse{Items: respList, TotalPrice: listDTO.TotalPrice, Version: listDTO.Version}, nil
 }
 
-func (c *CartServer) ClearCart(ctx context.Context, req *pb.CartUserIDRequest) (*emptypb.Empty, error) {
-	if err := c.cartUsecase.ClearCartByUserID(ctx, models.UserID(req.UserId)); err != nil {
-		if errors.Is(err, usecase.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
+func (c *CartServer) ClearCart(ctx context.Context, req *pb.CartClearRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
+	}
+
+	clearCartDTO := usecase.ClearCartDTO{
+		UserID:          userID,
+		ExpectedVersion: version,
+	}
+
+	if err = c.cartUsecase.ClearCartByUserID(ctx, clearCartDTO); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *CartServer) MoveToWishlist(ctx context.Context, req *pb.CartMoveItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
+	}
+
+	moveItemDTO := usecase.MoveItemDTO{
+		UserID:          userID,
+		SKUID:           models.SKUID(req.Sku),
+		ExpectedVersion: version,
+	}
+
+	if err = c.cartUsecase.MoveToWishlist(ctx, moveItemDTO); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (c *CartServer) MoveToCart(ctx context.Context, req *pb.CartMoveItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
+	}
+
+	moveItemDTO := usecase.MoveItemDTO{
+		UserID:          userID,
+		SKUID:           models.SKUID(req.Sku),
+		ExpectedVersion: version,
+	}
 
-		return nil, status.Error(codes.Unknown, err.Error())
+	if err = c.cartUsecase.MoveToCart(ctx, moveItemDTO); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (c *CartServer) ListWishlist(ctx context.Context, req *pb.CartUserIDRequest) (*pb.CartWishlistResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistDTO, err := c.cartUsecase.ListWishlist(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	respList := make([]*pb.CartWishlistItem, len(wishlistDTO.Items))
+
+	for i, item := range wishlistDTO.Items {
+		respList[i] = &pb.CartWishlistItem{
+			Sku:       uint32(item.SKUID),
+			Count:     uint32(item.Count),
+			Name:      item.Name,
+			Price:     item.Price,
+			Available: uint32(item.Available),
+			InStock:   item.InStock,
+			OfferId:   int64(item.OfferID),
+		}
+	}
+
+	return &pb.CartWishlistResponse{Items: respList}, nil
+}
+
+func (c *CartServer) BulkUpdate(ctx context.Context, req *pb.CartBulkUpdateRequest) (*pb.CartBulkUpdateResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, invalidArgument("expected_version", err)
+	}
+
+	bulkDTO := usecase.BulkUpdateDTO{
+		UserID:          userID,
+		Operations:      make([]usecase.BulkOperationDTO, len(req.Operations)),
+		ExpectedVersion: version,
+	}
+
+	for i, op := range req.Operations {
+		count, err := models.Uint32ToUint16(op.Count)
+		if err != nil {
+			return nil, invalidArgument("operations["+strconv.Itoa(i)+"].count", err)
+		}
+
+		bulkDTO.Operations[i] = usecase.BulkOperationDTO{
+			Type:    bulkOperationTypes[op.Type],
+			SKUID:   models.SKUID(op.Sku),
+			OfferID: models.OfferID(op.OfferId),
+			Count:   count,
+		}
+	}
+
+	result, err := c.cartUsecase.BulkUpdate(ctx, bulkDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	respResults := make([]*pb.CartBulkOperationResult, len(result.Results))
+
+	for i, opResult := range result.Results {
+		respResult := &pb.CartBulkOperationResult{
+			Sku:    uint32(opResult.SKUID),
+			Status: bulkOperationStatus(opResult.Err),
+		}
+
+		if opResult.Err != nil {
+			respResult.Error = opResult.Err.Error()
+		}
+
+		respResults[i] = respResult
+	}
+
+	return &pb.CartBulkUpdateResponse{Applied: result.Applied, Results: respResults}, nil
+}
+
+var bulkOperationTypes = map[pb.CartBulkOperationType]usecase.BulkOperationType{
+	pb.CartBulkOperationType_CART_BULK_OPERATION_TYPE_ADD:    usecase.BulkOperationAdd,
+	pb.CartBulkOperationType_CART_BULK_OPERATION_TYPE_SET:    usecase.BulkOperationSet,
+	pb.CartBulkOperationType_CART_BULK_OPERATION_TYPE_DELETE: usecase.BulkOperationDelete,
+}
+
+func bulkOperationStatus(err error) pb.CartBulkOperationStatus {
+	switch {
+	case err == nil:
+		return pb.CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_OK
+	case errors.Is(err, usecase.ErrNotFound):
+		return pb.CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_NOT_FOUND
+	case errors.Is(err, usecase.ErrNotEnoughStock):
+		return pb.CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_NOT_ENOUGH_STOCK
+	case errors.Is(err, usecase.ErrInvalidOperation), errors.Is(err, usecase.ErrOfferMismatch):
+		return pb.CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_INVALID_ARGUMENT
+	default:
+		return pb.CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_ABORTED
+	}
+}
+
+// expectedVersion returns the cart version the request expects. If the request does not set it,
+// the If-Match header forwarded by the gateway is used, where "*" matches any version.
+func expectedVersion(ctx context.Context, field *uint64) (*uint64, error) {
+	if field != nil {
+		return field, nil
+	}
+
+	var version *uint64
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(ifMatchMetadataKey)
+	if len(values) == 0 {
+		return version, nil
+	}
+
+	ifMatch := strings.TrimSpace(values[0])
+	if ifMatch == ifMatchAny {
+		return version, nil
+	}
+
+	ifMatch = strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+
+	parsed, err := strconv.ParseUint(ifMatch, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidIfMatch
+	}
+
+	return &parsed, nil
+}
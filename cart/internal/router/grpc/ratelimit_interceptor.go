@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"cart/internal/auth"
+	"cart/internal/observability/metrics"
+)
+
+const (
+	retryAfterMetadataKey   = "retry-after"
+	forwardedForMetadataKey = "x-forwarded-for"
+
+	rateLimitByUser = "user"
+	rateLimitByIP   = "ip"
+)
+
+var errRateLimited error = errors.New("rate limit exceeded, retry later")
+
+type IRateLimiter interface {
+	Allow(method, key string) (bool, time.Duration)
+}
+
+// RateLimitInterceptor takes a token of the bucket of the method and the user of the request, or its
+// client address without a token. A request over the limit is rejected with RESOURCE_EXHAUSTED and the
+// seconds to wait in the retry-after metadata.
+func RateLimitInterceptor(limiter IRateLimiter, metric metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := rateLimit(ctx, limiter, metric, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func rateLimit(ctx context.Context, limiter IRateLimiter, metric metrics.Metrics, method string) error {
+	by, key := rateLimitKey(ctx)
+
+	allowed, wait := limiter.Allow(method, by+":"+key)
+	if allowed {
+		return nil
+	}
+
+	metric.IncThrottled(method, by)
+
+	seconds := int(math.Ceil(wait.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterMetadataKey, strconv.Itoa(max(seconds, 1))))
+
+	st, err := status.New(codes.ResourceExhausted, errRateLimited.Error()).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, errRateLimited.Error())
+	}
+
+	return st.Err()
+}
+
+// rateLimitKey returns the user of the request, or the client address of a request without a token.
+// The gateway dials the server over loopback and appends the client address to x-forwarded-for, so
+// the last forwarded address is the client of a request from a loopback peer.
+func rateLimitKey(ctx context.Context) (string, string) {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return rateLimitByUser, principal.Subject
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return rateLimitByIP, ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		if values := md.Get(forwardedForMetadataKey); len(values) > 0 {
+			addrs := strings.Split(values[len(values)-1], ",")
+			host = strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+
+	return rateLimitByIP, host
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	pb "cart/pkg/api/cart"
+	"context"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func bulkRequest(operations int) *pb.CartBulkUpdateRequest {
+	req := &pb.CartBulkUpdateRequest{}
+
+	for i := range operations {
+		req.Operations = append(req.Operations, &pb.CartBulkOperation{
+			Type:  pb.CartBulkOperationType_CART_BULK_OPERATION_TYPE_ADD,
+			Sku:   uint32(1001 + i),
+			Count: 1,
+		})
+	}
+
+	return req
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interceptor := ValidationInterceptor(validator)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.CartService_BulkUpdate_FullMethodName}
+
+	tests := []struct {
+		name      string
+		req       *pb.CartBulkUpdateRequest
+		wantCode  codes.Code
+		wantField string
+	}{
+		{
+			name:     "MaxOperations",
+			req:      bulkRequest(100),
+			wantCode: codes.OK,
+		},
+		{
+			name:      "ErrorTooManyOperations",
+			req:       bulkRequest(101),
+			wantCode:  codes.InvalidArgument,
+			wantField: "operations",
+		},
+		{
+			name:      "ErrorNoOperations",
+			req:       bulkRequest(0),
+			wantCode:  codes.InvalidArgument,
+			wantField: "operations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return req, nil
+			}
+
+			_, err := interceptor(t.Context(), tt.req, info, handler)
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("wanted: %v, respond: %v", tt.wantCode, err)
+			}
+
+			if tt.wantField == "" {
+				return
+			}
+
+			var fields []string
+
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, violation := range badRequest.GetFieldViolations() {
+						fields = append(fields, violation.GetField())
+					}
+				}
+			}
+
+			if len(fields) != 1 || fields[0] != tt.wantField {
+				t.Errorf("wanted violation of: %v, respond: %v", tt.wantField, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	myLog "cart/internal/observability/log"
+)
+
+const infoBreakerState = "stock client circuit breaker changed state"
+
+var ErrCircuitOpen error = errors.New("stocks service is unavailable, circuit breaker is open")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerHalfOpen:
+		return "half-open"
+	case breakerOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+type IBreakerMetrics interface {
+	SetBreakerState(name string, state float64)
+}
+
+// circuitBreaker opens after a number of consecutive failures and rejects the calls while it is open.
+// After the open timeout it is half-open: a single probe call is let through, which closes it again
+// if it succeeds and opens it for another timeout if it fails.
+type circuitBreaker struct {
+	name        string
+	maxFailures int
+	openTimeout time.Duration
+	metric      IBreakerMetrics
+	logger      myLog.Logger
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(name string, maxFailures int, openTimeout time.Duration, metric IBreakerMetrics, logger myLog.Logger) *circuitBreaker {
+	b := &circuitBreaker{
+		name:        name,
+		maxFailures: maxFailures,
+		openTimeout: openTimeout,
+		metric:      metric,
+		logger:      logger,
+		now:         time.Now,
+	}
+
+	metric.SetBreakerState(name, float64(breakerClosed))
+
+	return b
+}
+
+// allow reports whether a call can be made, every allowed call must be reported to done.
+func (b *circuitBreaker) allow() bool {
+	if b.maxFailures <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+
+		b.setState(breakerHalfOpen)
+		b.probing = true
+
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+
+		b.probing = true
+
+		return true
+	default:
+		return true
+	}
+}
+
+// done records the result of an allowed call. Canceled calls, like the losing call of a hedged
+// request, are neither a success nor a failure.
+func (b *circuitBreaker) done(result callResult) {
+	if b.maxFailures <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.probing = false
+	}
+
+	switch result {
+	case callSucceeded:
+		b.failures = 0
+
+		if b.state != breakerClosed {
+			b.setState(breakerClosed)
+		}
+	case callFailed:
+		b.failures++
+
+		if b.state == breakerHalfOpen || (b.state == breakerClosed && b.failures >= b.maxFailures) {
+			b.openedAt = b.now()
+			b.setState(breakerOpen)
+		}
+	}
+}
+
+func (b *circuitBreaker) setState(state breakerState) {
+	b.state = state
+	b.metric.SetBreakerState(b.name, float64(state))
+	b.logger.Info(infoBreakerState, myLog.String("breaker", b.name), myLog.String("state", state.String()))
+}
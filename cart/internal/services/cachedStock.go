@@ -0,0 +1,259 @@
+package services
+
+import (
+	"cart/internal/cache"
+	"cart/internal/models"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	myLog "cart/internal/observability/log"
+)
+
+const (
+	stockCacheName    = "stock_items"
+	revalidateTimeout = 5 * time.Second
+
+	cacheHit   = "hit"
+	cacheMiss  = "miss"
+	cacheStale = "stale"
+
+	errRevalidateItem = "failed to revalidate cached stock item"
+)
+
+// CacheConfig configures the stock item cache. A fresh item is served for TTL. After it, the stale
+// item is served for StaleWhileRevalidate while it is fetched again in the background, and for
+// StaleIfError if stocks is unavailable.
+type CacheConfig struct {
+	Size                 int
+	TTL                  time.Duration
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+}
+
+type IStockReader interface {
+	GetItemInfo(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (ItemDTO, error)
+	GetItemsInfo(ctx context.Context, skuIDs []models.SKUID) (map[models.SKUID]ItemDTO, error)
+}
+
+type ICacheMetrics interface {
+	IncCacheResult(cache, result string)
+}
+
+type itemKey struct {
+	skuID   models.SKUID
+	offerID models.OfferID
+}
+
+// CachedStockService is a read-through cache of the items of a stock client. Items of the batch
+// reads are the default offers, so they are cached with the offer 0 of the single reads.
+type CachedStockService struct {
+	stocks IStockReader
+	cfg    CacheConfig
+	items  *cache.LRU[itemKey, ItemDTO]
+	metric ICacheMetrics
+	logger myLog.Logger
+	now    func() time.Time
+
+	// invalidations counts the invalidated SKUs, a read that raced with one is not stored
+	invalidations atomic.Uint64
+
+	mu           sync.Mutex
+	revalidating map[itemKey]struct{}
+}
+
+func NewCachedStockService(stocks IStockReader, cfg CacheConfig, metric ICacheMetrics, logger myLog.Logger) *CachedStockService {
+	maxAge := cfg.TTL + max(cfg.StaleWhileRevalidate, cfg.StaleIfError)
+
+	return &CachedStockService{
+		stocks:       stocks,
+		cfg:          cfg,
+		items:        cache.NewLRU[itemKey, ItemDTO](cfg.Size, maxAge),
+		metric:       metric,
+		logger:       logger,
+		now:          time.Now,
+		revalidating: make(map[itemKey]struct{}),
+	}
+}
+
+func (c *CachedStockService) GetItemInfo(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (ItemDTO, error) {
+	key := itemKey{skuID: skuID, offerID: offerID}
+
+	item, age, cached := c.get(key)
+	if cached {
+		switch {
+		case age < c.cfg.TTL:
+			c.metric.IncCacheResult(stockCacheName, cacheHit)
+
+			return item, nil
+		case age < c.cfg.TTL+c.cfg.StaleWhileRevalidate:
+			c.metric.IncCacheResult(stockCacheName, cacheStale)
+			c.revalidate(key)
+
+			return item, nil
+		}
+	}
+
+	invalidations := c.invalidations.Load()
+
+	fresh, err := c.stocks.GetItemInfo(ctx, skuID, offerID)
+	if err != nil {
+		if cached && age < c.cfg.TTL+c.cfg.StaleIfError && unavailable(err) {
+			c.metric.IncCacheResult(stockCacheName, cacheStale)
+
+			return item, nil
+		}
+
+		return ItemDTO{}, err
+	}
+
+	c.metric.IncCacheResult(stockCacheName, cacheMiss)
+	c.store(key, fresh, invalidations)
+
+	return fresh, nil
+}
+
+// GetItemsInfo serves the cached SKUs and fetches the others in a single batch read.
+func (c *CachedStockService) GetItemsInfo(ctx context.Context, skuIDs []models.SKUID) (map[models.SKUID]ItemDTO, error) {
+	items := make(map[models.SKUID]ItemDTO, len(skuIDs))
+	stale := make(map[models.SKUID]ItemDTO)
+
+	var missing []models.SKUID
+
+	for _, skuID := range skuIDs {
+		key := itemKey{skuID: skuID}
+
+		item, age, cached := c.get(key)
+
+		switch {
+		case cached && age < c.cfg.TTL:
+			c.metric.IncCacheResult(stockCacheName, cacheHit)
+			items[skuID] = item
+		case cached && age < c.cfg.TTL+c.cfg.StaleWhileRevalidate:
+			c.metric.IncCacheResult(stockCacheName, cacheStale)
+			c.revalidate(key)
+			items[skuID] = item
+		default:
+			if cached && age < c.cfg.TTL+c.cfg.StaleIfError {
+				stale[skuID] = item
+			}
+
+			missing = append(missing, skuID)
+		}
+	}
+
+	if len(missing) == 0 {
+		return items, nil
+	}
+
+	invalidations := c.invalidations.Load()
+
+	fetched, err := c.stocks.GetItemsInfo(ctx, missing)
+	if err != nil {
+		if !unavailable(err) {
+			return nil, err
+		}
+
+		for _, skuID := range missing {
+			item, ok := stale[skuID]
+			if !ok {
+				return nil, err
+			}
+
+			c.metric.IncCacheResult(stockCacheName, cacheStale)
+			items[skuID] = item
+		}
+
+		return items, nil
+	}
+
+	for _, skuID := range missing {
+		c.metric.IncCacheResult(stockCacheName, cacheMiss)
+
+		if item, ok := fetched[skuID]; ok {
+			items[skuID] = item
+			c.store(itemKey{skuID: skuID}, item, invalidations)
+		}
+	}
+
+	return items, nil
+}
+
+// InvalidateSKU removes the items of all offers of the SKU, so they are fetched again on the next read.
+func (c *CachedStockService) InvalidateSKU(skuID models.SKUID) {
+	c.invalidations.Add(1)
+	c.items.DeleteFunc(func(key itemKey) bool { return key.skuID == skuID })
+}
+
+// get returns the cached item and its age.
+func (c *CachedStockService) get(key itemKey) (ItemDTO, time.Duration, bool) {
+	item, storedAt, ok := c.items.Get(key)
+	if !ok {
+		return ItemDTO{}, 0, false
+	}
+
+	return item, c.now().Sub(storedAt), true
+}
+
+// store caches an item read after the given count of invalidations, unless a SKU was invalidated
+// during the read and the item may be older than the invalidation.
+func (c *CachedStockService) store(key itemKey, item ItemDTO, invalidations uint64) {
+	if c.invalidations.Load() != invalidations {
+		return
+	}
+
+	c.items.Set(key, item)
+}
+
+// revalidate fetches a stale item in the background, once at a time per item.
+func (c *CachedStockService) revalidate(key itemKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.revalidating[key]; ok {
+		return
+	}
+
+	c.revalidating[key] = struct{}{}
+
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.revalidating, key)
+			c.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), revalidateTimeout)
+		defer cancel()
+
+		invalidations := c.invalidations.Load()
+
+		item, err := c.stocks.GetItemInfo(ctx, key.skuID, key.offerID)
+		if err != nil {
+			c.logger.Warn(errRevalidateItem, myLog.Int64("sku", int64(key.skuID)), myLog.Error(err))
+
+			return
+		}
+
+		c.store(key, item, invalidations)
+	}()
+}
+
+// unavailable reports whether stocks failed to answer, in which case a stale item is better than an error.
+func unavailable(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"cart/internal/models"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	logMock "cart/internal/observability/log/mock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stockReaderStub returns the price of a SKU from prices, or err if it is set, and records the requested SKUs.
+type stockReaderStub struct {
+	mu     sync.Mutex
+	prices map[models.SKUID]uint32
+	err    error
+	reads  [][]models.SKUID
+}
+
+func (s *stockReaderStub) GetItemInfo(_ context.Context, skuID models.SKUID, offerID models.OfferID) (ItemDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reads = append(s.reads, []models.SKUID{skuID})
+
+	if s.err != nil {
+		return ItemDTO{}, s.err
+	}
+
+	return ItemDTO{SKUID: skuID, OfferID: offerID, Price: s.prices[skuID]}, nil
+}
+
+func (s *stockReaderStub) GetItemsInfo(_ context.Context, skuIDs []models.SKUID) (map[models.SKUID]ItemDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reads = append(s.reads, skuIDs)
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	items := make(map[models.SKUID]ItemDTO, len(skuIDs))
+	for _, skuID := range skuIDs {
+		items[skuID] = ItemDTO{SKUID: skuID, Price: s.prices[skuID]}
+	}
+
+	return items, nil
+}
+
+func (s *stockReaderStub) set(prices map[models.SKUID]uint32, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prices, s.err, s.reads = prices, err, nil
+}
+
+func (s *stockReaderStub) readCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.reads)
+}
+
+type cacheMetricsStub struct {
+	mu      sync.Mutex
+	results map[string]int
+}
+
+func (m *cacheMetricsStub) IncCacheResult(_, result string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.results[result]++
+}
+
+func newTestCache(t *testing.T, stocks IStockReader) (*CachedStockService, *time.Time, *cacheMetricsStub) {
+	logger := logMock.NewLoggerMock(t)
+	logger.WarnMock.Optional().Return()
+
+	now := time.Now()
+	metric := &cacheMetricsStub{results: make(map[string]int)}
+
+	c := NewCachedStockService(stocks, CacheConfig{
+		Size:                 10,
+		TTL:                  time.Minute,
+		StaleWhileRevalidate: time.Minute,
+		StaleIfError:         time.Hour,
+	}, metric, logger)
+	c.now = func() time.Time { return now }
+	c.items.SetClock(func() time.Time { return now })
+
+	return c, &now, metric
+}
+
+func TestCachedGetItemInfo(t *testing.T) {
+	stocks := &stockReaderStub{prices: map[models.SKUID]uint32{1: 100}}
+	c, now, metric := newTestCache(t, stocks)
+	ctx := context.Background()
+
+	for range 2 {
+		if item, err := c.GetItemInfo(ctx, 1, 0); err != nil || item.Price != 100 {
+			t.Fatalf("GetItemInfo() = %v, %v", item, err)
+		}
+	}
+
+	if stocks.readCount() != 1 || metric.results[cacheHit] != 1 || metric.results[cacheMiss] != 1 {
+		t.Fatalf("reads = %d, results = %v, want a miss and a hit", stocks.readCount(), metric.results)
+	}
+
+	// an expired item is served while it is revalidated in the background
+	stocks.set(map[models.SKUID]uint32{1: 200}, nil)
+	*now = now.Add(90 * time.Second)
+
+	if item, _ := c.GetItemInfo(ctx, 1, 0); item.Price != 100 {
+		t.Fatalf("GetItemInfo() of a stale item = %v, want the stale price", item)
+	}
+
+	waitFor(t, func() bool { item, _, _ := c.items.Get(itemKey{skuID: 1}); return item.Price == 200 })
+
+	// after the revalidation window the stale item is only served if stocks is unavailable
+	unavailableErr := status.Error(codes.Unavailable, "unavailable")
+	stocks.set(nil, unavailableErr)
+	*now = now.Add(10 * time.Minute)
+
+	if item, err := c.GetItemInfo(ctx, 1, 0); err != nil || item.Price != 200 {
+		t.Errorf("GetItemInfo() during an outage = %v, %v, want the stale item", item, err)
+	}
+
+	notFound := status.Error(codes.NotFound, "not found")
+	stocks.set(nil, notFound)
+
+	if _, err := c.GetItemInfo(ctx, 1, 0); !errors.Is(err, notFound) {
+		t.Errorf("GetItemInfo() error = %v, want %v", err, notFound)
+	}
+
+	stocks.set(nil, unavailableErr)
+	c.InvalidateSKU(1)
+
+	if _, err := c.GetItemInfo(ctx, 1, 0); !errors.Is(err, unavailableErr) {
+		t.Errorf("GetItemInfo() of an invalidated item error = %v, want %v", err, unavailableErr)
+	}
+}
+
+func TestCachedGetItemsInfo(t *testing.T) {
+	stocks := &stockReaderStub{prices: map[models.SKUID]uint32{1: 100, 2: 200, 3: 300}}
+	c, _, _ := newTestCache(t, stocks)
+	ctx := context.Background()
+
+	if _, err := c.GetItemInfo(ctx, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	stocks.set(map[models.SKUID]uint32{1: 100, 2: 200, 3: 300}, nil)
+
+	items, err := c.GetItemsInfo(ctx, []models.SKUID{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[models.SKUID]ItemDTO{1: {SKUID: 1, Price: 100}, 2: {SKUID: 2, Price: 200}, 3: {SKUID: 3, Price: 300}}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("GetItemsInfo() = %v, want %v", items, want)
+	}
+
+	if !reflect.DeepEqual(stocks.reads, [][]models.SKUID{{2, 3}}) {
+		t.Errorf("reads = %v, want only the missing SKUs", stocks.reads)
+	}
+}
+
+func waitFor(t *testing.T, done func() bool) {
+	t.Helper()
+
+	for range 100 {
+		if done() {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("condition not met")
+}
@@ -4,6 +4,7 @@ import "cart/internal/models"
 
 type ItemDTO struct {
 	SKUID    models.SKUID
+	OfferID  models.OfferID
 	Name     string
 	Type     string
 	Count    uint16
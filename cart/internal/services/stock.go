@@ -3,43 +3,264 @@ package services
 import (
 	"cart/internal/models"
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"math/rand/v2"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	myLog "cart/internal/observability/log"
 	pb "cart/pkg/api/stock"
 )
 
 const (
-	ctxTimeout             = 5
+	stockBreakerName       = "stocks"
 	errorConvertStockCount = "failed to convert stock count: %w"
+	errStockCallFailed     = "stocks call failed"
 )
 
+// ErrItemNotFound and ErrStocksUnavailable wrap the status errors of the stocks service the cart handles.
+var (
+	ErrItemNotFound      error = errors.New("item not found in stocks")
+	ErrStocksUnavailable error = errors.New("stocks service is unavailable")
+)
+
+type callResult int
+
+const (
+	callSucceeded callResult = iota
+	callFailed
+	callCanceled
+)
+
+// ClientConfig configures the calls of the stock client. The reads are idempotent, so a failed read
+// is retried with an exponential backoff and, with a hedge delay, a second read is sent if the first
+// is slower than the delay. A zero MaxAttempts, HedgeDelay or BreakerFailures disables the feature.
+type ClientConfig struct {
+	Timeout            time.Duration
+	MaxAttempts        int
+	BackoffBase        time.Duration
+	BackoffMax         time.Duration
+	HedgeDelay         time.Duration
+	BreakerFailures    int
+	BreakerOpenTimeout time.Duration
+}
+
 type StockService struct {
-	client *grpc.ClientConn
+	client  pb.StockServiceClient
+	cfg     ClientConfig
+	breaker *circuitBreaker
+	logger  myLog.Logger
 }
 
-func NewStockClient(cl *grpc.ClientConn) *StockService {
+func NewStockClient(cl grpc.ClientConnInterface, cfg ClientConfig, metric IBreakerMetrics, logger myLog.Logger) *StockService {
 	return &StockService{
-		client: cl,
+		client:  pb.NewStockServiceClient(cl),
+		cfg:     cfg,
+		breaker: newCircuitBreaker(stockBreakerName, cfg.BreakerFailures, cfg.BreakerOpenTimeout, metric, logger),
+		logger:  logger,
 	}
 }
 
-func (s *StockService) GetItemInfo(ctx context.Context, skuID models.SKUID) (ItemDTO, error) {
-	client := pb.NewStockServiceClient(s.client)
-	req := pb.StockGetItemRequest{Sku: uint32(skuID)}
+// GetItemInfo fetches the SKU with the stock of the offer, or of its default offer if offerID is 0.
+func (s *StockService) GetItemInfo(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (ItemDTO, error) {
+	req := pb.StockGetItemRequest{Sku: uint32(skuID), OfferId: int64(offerID)}
 
-	grpcCtx, cancel := context.WithTimeout(ctx, ctxTimeout*time.Second)
-	defer cancel()
+	resp, err := call(ctx, s, func(ctx context.Context) (*pb.StockItemResponse, error) {
+		return s.client.GetItem(ctx, &req)
+	})
+	if err != nil {
+		return ItemDTO{}, stockError(err)
+	}
+
+	return itemFromResponse(resp)
+}
 
-	resp, err := client.GetItem(grpcCtx, &req)
+// GetItemsInfo fetches all SKUs with their default offers in a single request.
+// SKUs unknown to the stocks service are absent from the result.
+func (s *StockService) GetItemsInfo(ctx context.Context, skuIDs []models.SKUID) (map[models.SKUID]ItemDTO, error) {
+	req := pb.StockGetItemsRequest{Skus: make([]uint32, len(skuIDs))}
+
+	for i, skuID := range skuIDs {
+		req.Skus[i] = uint32(skuID)
+	}
+
+	resp, err := call(ctx, s, func(ctx context.Context) (*pb.StockGetItemsResponse, error) {
+		return s.client.GetItems(ctx, &req)
+	})
 	if err != nil {
-		log.Println(err)
-		return ItemDTO{}, err
+		return nil, stockError(err)
+	}
+
+	items := make(map[models.SKUID]ItemDTO, len(resp.Items))
+
+	for _, respItem := range resp.Items {
+		item, err := itemFromResponse(respItem)
+		if err != nil {
+			return nil, err
+		}
+
+		items[item.SKUID] = item
+	}
+
+	return items, nil
+}
+
+// call makes an idempotent read, retrying it while it fails with a transient error.
+func call[T any](ctx context.Context, s *StockService, read func(context.Context) (T, error)) (T, error) {
+	var (
+		resp T
+		err  error
+	)
+
+	for attempt := 1; ; attempt++ {
+		resp, err = hedge(ctx, s, read)
+		if err == nil {
+			return resp, nil
+		}
+
+		s.logger.Warn(errStockCallFailed, myLog.Int("attempt", attempt), myLog.Error(err))
+
+		if attempt >= s.cfg.MaxAttempts || !retryable(err) {
+			return resp, err
+		}
+
+		timer := time.NewTimer(s.backoff(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return resp, err
+		case <-timer.C:
+		}
+	}
+}
+
+// hedge sends a second read if the first has not returned within the hedge delay and returns the
+// first successful response, the other read is canceled.
+func hedge[T any](ctx context.Context, s *StockService, read func(context.Context) (T, error)) (T, error) {
+	if s.cfg.HedgeDelay <= 0 {
+		return attempt(ctx, s, read)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		resp T
+		err  error
 	}
 
+	results := make(chan result, 2)
+	send := func() {
+		resp, err := attempt(ctx, s, read)
+		results <- result{resp: resp, err: err}
+	}
+
+	go send()
+
+	timer := time.NewTimer(s.cfg.HedgeDelay)
+	defer timer.Stop()
+
+	inFlight := 1
+
+	for {
+		select {
+		case <-timer.C:
+			inFlight++
+
+			go send()
+		case r := <-results:
+			inFlight--
+
+			if r.err == nil || inFlight == 0 {
+				return r.resp, r.err
+			}
+		}
+	}
+}
+
+// attempt makes a single read with its own timeout, if the circuit breaker lets it through.
+func attempt[T any](ctx context.Context, s *StockService, read func(context.Context) (T, error)) (T, error) {
+	if !s.breaker.allow() {
+		var zero T
+
+		return zero, ErrCircuitOpen
+	}
+
+	attemptCtx := ctx
+
+	if s.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		attemptCtx, cancel = context.WithTimeout(ctx, s.cfg.Timeout)
+		defer cancel()
+	}
+
+	resp, err := read(attemptCtx)
+
+	s.breaker.done(classify(ctx, err))
+
+	return resp, err
+}
+
+// backoff returns a random wait of up to BackoffBase doubled for every previous attempt, capped at BackoffMax.
+func (s *StockService) backoff(attempt int) time.Duration {
+	limit := s.cfg.BackoffBase << (attempt - 1)
+	if limit <= 0 || limit > s.cfg.BackoffMax {
+		limit = s.cfg.BackoffMax
+	}
+
+	if limit <= 0 {
+		return 0
+	}
+
+	return rand.N(limit) + 1
+}
+
+// classify tells the circuit breaker whether the stocks service failed the call. Errors of the request,
+// like an unknown SKU, are answers of a healthy service.
+func classify(ctx context.Context, err error) callResult {
+	switch {
+	case err == nil:
+		return callSucceeded
+	case ctx.Err() != nil:
+		return callCanceled
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return callFailed
+	case codes.Canceled:
+		return callCanceled
+	default:
+		return callSucceeded
+	}
+}
+
+// retryable reports whether a failed read is worth another attempt, an open breaker fails immediately.
+func retryable(err error) bool {
+	return !errors.Is(err, ErrCircuitOpen) && unavailable(err)
+}
+
+// stockError wraps a status error of an unknown item or of an unavailable stocks service
+// with its sentinel, keeping the status.
+func stockError(err error) error {
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return fmt.Errorf("%w: %w", ErrItemNotFound, err)
+	case unavailable(err):
+		return fmt.Errorf("%w: %w", ErrStocksUnavailable, err)
+	default:
+		return err
+	}
+}
+
+func itemFromResponse(resp *pb.StockItemResponse) (ItemDTO, error) {
 	count, err := models.Uint32ToUint16(resp.Count)
 	if err != nil {
 		return ItemDTO{}, fmt.Errorf(errorConvertStockCount, err)
@@ -47,8 +268,9 @@ func (s *StockService) GetItemInfo(ctx context.Context, skuID models.SKUID) (Ite
 
 	return ItemDTO{
 		SKUID:    models.SKUID(resp.Sku),
+		OfferID:  models.OfferID(resp.OfferId),
 		Name:     resp.Name,
-		Type:     resp.Type,
+		Type:     resp.GetCategory().GetName(),
 		Count:    count,
 		Price:    resp.Price,
 		Location: resp.Location,
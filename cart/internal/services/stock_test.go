@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	logMock "cart/internal/observability/log/mock"
+	pb "cart/pkg/api/stock"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stockClientStub answers GetItem with the function of the call, counted from 1.
+type stockClientStub struct {
+	pb.StockServiceClient
+	calls   atomic.Int32
+	getItem func(ctx context.Context, call int32) (*pb.StockItemResponse, error)
+}
+
+func (s *stockClientStub) GetItem(ctx context.Context, _ *pb.StockGetItemRequest, _ ...grpc.CallOption) (*pb.StockItemResponse, error) {
+	return s.getItem(ctx, s.calls.Add(1))
+}
+
+type breakerMetricsStub struct {
+	state float64
+}
+
+func (m *breakerMetricsStub) SetBreakerState(_ string, state float64) {
+	m.state = state
+}
+
+func newTestStockService(t *testing.T, cfg ClientConfig, stub *stockClientStub, metric IBreakerMetrics) *StockService {
+	logger := logMock.NewLoggerMock(t)
+	logger.WarnMock.Optional().Return()
+	logger.InfoMock.Optional().Return()
+
+	return &StockService{
+		client:  stub,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(stockBreakerName, cfg.BreakerFailures, cfg.BreakerOpenTimeout, metric, logger),
+		logger:  logger,
+	}
+}
+
+func TestGetItemInfoRetries(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	notFound := status.Error(codes.NotFound, "not found")
+
+	tests := []struct {
+		name      string
+		failures  int32
+		err       error
+		wantErr   error
+		wantCalls int32
+	}{
+		{name: "success", wantCalls: 1},
+		{name: "retried until success", failures: 2, err: unavailable, wantCalls: 3},
+		{name: "attempts exhausted", failures: 3, err: unavailable, wantErr: ErrStocksUnavailable, wantCalls: 3},
+		{name: "not retried", failures: 1, err: notFound, wantErr: ErrItemNotFound, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stockClientStub{getItem: func(_ context.Context, call int32) (*pb.StockItemResponse, error) {
+				if call <= tt.failures {
+					return nil, tt.err
+				}
+
+				return &pb.StockItemResponse{Sku: 1, Count: 5}, nil
+			}}
+
+			cfg := ClientConfig{Timeout: time.Second, MaxAttempts: 3, BackoffBase: time.Millisecond, BackoffMax: 5 * time.Millisecond}
+			service := newTestStockService(t, cfg, stub, &breakerMetricsStub{})
+
+			_, err := service.GetItemInfo(context.Background(), 1, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetItemInfo() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got := stub.calls.Load(); got != tt.wantCalls {
+				t.Errorf("GetItem calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetItemInfoCircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+
+	stub := &stockClientStub{getItem: func(context.Context, int32) (*pb.StockItemResponse, error) {
+		if !healthy.Load() {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+
+		return &pb.StockItemResponse{Sku: 1}, nil
+	}}
+
+	metric := &breakerMetricsStub{}
+	cfg := ClientConfig{Timeout: time.Second, MaxAttempts: 1, BreakerFailures: 2, BreakerOpenTimeout: time.Minute}
+	service := newTestStockService(t, cfg, stub, metric)
+
+	now := time.Now()
+	service.breaker.now = func() time.Time { return now }
+
+	for range 2 {
+		if _, err := service.GetItemInfo(context.Background(), 1, 0); status.Code(err) != codes.Unavailable {
+			t.Fatalf("GetItemInfo() error = %v, want Unavailable", err)
+		}
+	}
+
+	if _, err := service.GetItemInfo(context.Background(), 1, 0); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("GetItemInfo() of an open breaker error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	if metric.state != float64(breakerOpen) || stub.calls.Load() != 2 {
+		t.Fatalf("open breaker state = %v, calls = %d", metric.state, stub.calls.Load())
+	}
+
+	// a failed probe opens the breaker again, a successful one closes it
+	now = now.Add(time.Minute)
+
+	if _, err := service.GetItemInfo(context.Background(), 1, 0); status.Code(err) != codes.Unavailable {
+		t.Fatalf("GetItemInfo() of the failed probe error = %v", err)
+	}
+
+	if _, err := service.GetItemInfo(context.Background(), 1, 0); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("GetItemInfo() after a failed probe error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	now = now.Add(time.Minute)
+	healthy.Store(true)
+
+	if _, err := service.GetItemInfo(context.Background(), 1, 0); err != nil {
+		t.Fatalf("GetItemInfo() of the probe error = %v", err)
+	}
+
+	if metric.state != float64(breakerClosed) {
+		t.Errorf("breaker state after the probe = %v, want closed", metric.state)
+	}
+}
+
+func TestGetItemInfoHedging(t *testing.T) {
+	stub := &stockClientStub{getItem: func(ctx context.Context, call int32) (*pb.StockItemResponse, error) {
+		if call == 1 {
+			<-ctx.Done()
+
+			return nil, status.Error(codes.Canceled, ctx.Err().Error())
+		}
+
+		return &pb.StockItemResponse{Sku: 2}, nil
+	}}
+
+	metric := &breakerMetricsStub{}
+	cfg := ClientConfig{Timeout: time.Second, MaxAttempts: 1, HedgeDelay: 10 * time.Millisecond, BreakerFailures: 1, BreakerOpenTimeout: time.Minute}
+	service := newTestStockService(t, cfg, stub, metric)
+
+	item, err := service.GetItemInfo(context.Background(), 2, 0)
+	if err != nil {
+		t.Fatalf("GetItemInfo() error = %v", err)
+	}
+
+	if item.SKUID != 2 || stub.calls.Load() != 2 {
+		t.Errorf("GetItemInfo() = %v with %d calls, want the hedged response", item, stub.calls.Load())
+	}
+
+	if metric.state != float64(breakerClosed) {
+		t.Errorf("breaker state = %v, the canceled read must not open it", metric.state)
+	}
+}
@@ -0,0 +1,260 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	myLog "cart/internal/observability/log"
+)
+
+const (
+	errReloadCertificates = "failed to reload TLS certificates, the previous ones are kept"
+	infoReloadedCerts     = "reloaded TLS certificates"
+)
+
+var (
+	ErrNoCertificates       error = errors.New("CA file or certificate and key files are required")
+	ErrNoServerCertificates error = errors.New("certificate and key files are required for a server")
+)
+
+// Config holds the PEM files of a TLS endpoint. CAFile is the CA of the peers: a server with a CA
+// requires and verifies client certificates (mTLS), a client verifies the server with it.
+type Config struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// Enabled reports whether TLS is configured, TLS is disabled without any file.
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// Reloader keeps the certificate and the CA pool of the files of a Config and loads them again
+// when a file changes, so rotated certificates are used by new connections without a restart.
+type Reloader struct {
+	cfg Config
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+func NewReloader(cfg Config) (*Reloader, error) {
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") || (cfg.CertFile == "" && cfg.CAFile == "") {
+		return nil, ErrNoCertificates
+	}
+
+	r := &Reloader{cfg: cfg}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// NewServerReloader is NewReloader for a server, which needs a certificate even if it has a CA file.
+func NewServerReloader(cfg Config) (*Reloader, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, ErrNoServerCertificates
+	}
+
+	return NewReloader(cfg)
+}
+
+// Watch checks the files every interval and reloads them if one has changed, until ctx is done.
+// Files that can not be loaded are logged and the previous certificates are kept.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration, logger myLog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+
+			if err := r.load(); err != nil {
+				logger.Error(errReloadCertificates, myLog.Error(err))
+
+				continue
+			}
+
+			logger.Info(infoReloadedCerts, myLog.String("cert", r.cfg.CertFile), myLog.String("ca", r.cfg.CAFile))
+		}
+	}
+}
+
+// GRPCServerConfig returns the TLS config of a gRPC server, which requires client certificates
+// signed by the CA if the Config has a CA file.
+func (r *Reloader) GRPCServerConfig() *tls.Config {
+	return r.serverConfig(r.cfg.CAFile != "", "h2")
+}
+
+// HTTPServerConfig returns the TLS config of a gateway server, which does not ask for client certificates.
+func (r *Reloader) HTTPServerConfig() *tls.Config {
+	return r.serverConfig(false, "h2", "http/1.1")
+}
+
+// ClientConfig returns the TLS config of a client that verifies the server against the CA of the
+// Config, or the system roots without a CA file, and sends its certificate if it has one.
+// The server name is verified instead of the host of the dialed address if it is set.
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+
+	if r.cfg.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+
+			return cert, nil
+		}
+	}
+
+	if r.cfg.CAFile != "" {
+		// the chain is verified in VerifyConnection, so a reloaded CA is used without a new config
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = r.verifyServer
+	}
+
+	return cfg
+}
+
+func (r *Reloader) serverConfig(mutual bool, nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.current()
+			if cert == nil {
+				return nil, ErrNoServerCertificates
+			}
+
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   nextProtos,
+			}
+
+			if mutual {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+			}
+
+			return cfg, nil
+		},
+	}
+}
+
+func (r *Reloader) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server sent no certificate")
+	}
+
+	_, pool := r.current()
+
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+
+	return err
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.cert, r.pool
+}
+
+func (r *Reloader) load() error {
+	modTimes := make(map[string]time.Time)
+
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		modTimes[path] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+
+	if r.cfg.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("error loading certificate: %w", err)
+		}
+
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+
+	if r.cfg.CAFile != "" {
+		pem, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("error reading CA file: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in CA file %s", r.cfg.CAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+
+	return nil
+}
+
+// changed reports whether a file was modified, replaced or removed since it was loaded.
+func (r *Reloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for path, modTime := range r.modTimes {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Reloader) files() []string {
+	var files []string
+
+	for _, path := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if path != "" {
+			files = append(files, path)
+		}
+	}
+
+	return files
+}
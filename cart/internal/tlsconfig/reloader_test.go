@@ -0,0 +1,271 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// writeFiles writes a certificate for localhost signed by the CA, its key and the CA of the peers.
+func (ca testCA) writeFiles(t *testing.T, dir string, peers testCA) Config {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Config{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+		CAFile:   filepath.Join(dir, "ca.pem"),
+	}
+
+	files := map[string][]byte{
+		cfg.CertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		cfg.KeyFile:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cfg.CAFile:   peers.pem,
+	}
+
+	for path, data := range files {
+		if err = os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return cfg
+}
+
+func newTestReloader(t *testing.T, cfg Config) *Reloader {
+	t.Helper()
+
+	r, err := NewReloader(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+// handshake runs a TLS handshake between a server and a client config and returns the error of the client.
+func handshake(t *testing.T, server, client *tls.Config) error {
+	t.Helper()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.(*tls.Conn).Handshake()
+		// the client reads the alert of a rejected certificate
+		_, _ = conn.Read(make([]byte, 1))
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// with TLS 1.3 the server verifies the client certificate after the client handshake is done
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = conn.Read(make([]byte, 1))
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return nil
+	}
+
+	return err
+}
+
+func TestHandshake(t *testing.T) {
+	serverCA, clientCA, otherCA := newTestCA(t, "server"), newTestCA(t, "client"), newTestCA(t, "other")
+
+	server := newTestReloader(t, serverCA.writeFiles(t, t.TempDir(), clientCA))
+	client := newTestReloader(t, clientCA.writeFiles(t, t.TempDir(), serverCA))
+	other := newTestReloader(t, otherCA.writeFiles(t, t.TempDir(), serverCA))
+	noCert := newTestReloader(t, Config{CAFile: client.cfg.CAFile})
+	untrusted := newTestReloader(t, clientCA.writeFiles(t, t.TempDir(), otherCA))
+	caOnly := newTestReloader(t, Config{CAFile: server.cfg.CAFile})
+
+	tests := []struct {
+		name    string
+		server  *tls.Config
+		client  *tls.Config
+		wantErr bool
+	}{
+		{name: "mtls", server: server.GRPCServerConfig(), client: client.ClientConfig("localhost")},
+		{name: "no client certificate", server: server.GRPCServerConfig(), client: noCert.ClientConfig("localhost"), wantErr: true},
+		{name: "client certificate of another CA", server: server.GRPCServerConfig(), client: other.ClientConfig("localhost"), wantErr: true},
+		{name: "server of another CA", server: server.GRPCServerConfig(), client: untrusted.ClientConfig("localhost"), wantErr: true},
+		{name: "wrong server name", server: server.GRPCServerConfig(), client: client.ClientConfig("stocks"), wantErr: true},
+		{name: "http without client certificate", server: server.HTTPServerConfig(), client: noCert.ClientConfig("localhost")},
+		{name: "server without certificate", server: caOnly.GRPCServerConfig(), client: client.ClientConfig("localhost"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handshake(t, tt.server, tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("handshake() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	oldCA, newCA, clientCA := newTestCA(t, "old"), newTestCA(t, "new"), newTestCA(t, "client")
+
+	dir := t.TempDir()
+	server := newTestReloader(t, oldCA.writeFiles(t, dir, clientCA))
+	trustsOld := newTestReloader(t, clientCA.writeFiles(t, t.TempDir(), oldCA))
+	trustsNew := newTestReloader(t, clientCA.writeFiles(t, t.TempDir(), newCA))
+
+	if server.changed() {
+		t.Fatal("changed() = true before the files were written")
+	}
+
+	cfg := newCA.writeFiles(t, dir, clientCA)
+
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{cfg.CertFile, cfg.KeyFile, cfg.CAFile} {
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !server.changed() {
+		t.Fatal("changed() = false after the files were written")
+	}
+
+	if err := server.load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handshake(t, server.GRPCServerConfig(), trustsOld.ClientConfig("localhost")); err == nil {
+		t.Error("handshake() with the old CA succeeded after the reload")
+	}
+
+	if err := handshake(t, server.GRPCServerConfig(), trustsNew.ClientConfig("localhost")); err != nil {
+		t.Errorf("handshake() with the new CA error = %v", err)
+	}
+}
+
+func TestNewReloader(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{name: "no files", cfg: Config{}},
+		{name: "certificate without key", cfg: Config{CertFile: "cert.pem", CAFile: "ca.pem"}},
+		{name: "missing file", cfg: Config{CAFile: filepath.Join(t.TempDir(), "ca.pem")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewReloader(tt.cfg); err == nil {
+				t.Error("NewReloader() error = nil")
+			}
+		})
+	}
+}
+
+func TestNewServerReloader(t *testing.T) {
+	serverCA, clientCA := newTestCA(t, "server"), newTestCA(t, "client")
+	cfg := serverCA.writeFiles(t, t.TempDir(), clientCA)
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{name: "certificate and CA", cfg: cfg},
+		{name: "CA only", cfg: Config{CAFile: cfg.CAFile}, wantErr: ErrNoServerCertificates},
+		{name: "key without certificate", cfg: Config{KeyFile: cfg.KeyFile, CAFile: cfg.CAFile}, wantErr: ErrNoServerCertificates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewServerReloader(tt.cfg); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewServerReloader() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
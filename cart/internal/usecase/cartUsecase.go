@@ -15,8 +15,10 @@ import (
 )
 
 const (
-	eventSuccessType = "cart_item_added"
-	eventFailedType  = "cart_item_failed"
+	eventSuccessType   = "cart_item_added"
+	eventFailedType    = "cart_item_failed"
+	eventAbandonedType = "cart_abandoned"
+	eventBulkType      = "cart_bulk_updated"
 
 	eventStatusOk     = "success"
 	eventStatusFailed = "failed"
@@ -25,18 +27,33 @@ const (
 
 	topic = "metrics"
 
-	warnCartCountMore = "Warning: user requested %d of SKU %d, but only %d in stock. Adjusting."
+	warnCartCountMore     = "Warning: user requested %d of SKU %d, but only %d in stock. Adjusting."
+	warnAbandonedSKUPrice = "Warning: failed to get price of SKU %d for abandoned cart of user %d: %v"
+
+	expireBatchSize = 100
 
 	tracingServiceName = "cart-service"
 	addSpanName        = "cart-add-usecase"
 	delSpanName        = "cart-del-usecase"
 	listSpanName       = "cart-list-usecase"
 	clearSpanName      = "cart-clear-usecase"
+	expireSpanName     = "cart-expire-usecase"
+	toWishlistSpanName = "cart-to-wishlist-usecase"
+	toCartSpanName     = "cart-to-cart-usecase"
+	wishlistSpanName   = "cart-wishlist-usecase"
+	bulkSpanName       = "cart-bulk-usecase"
 )
 
 var (
-	ErrNotFound       error = errors.New("not found")
-	ErrNotEnoughStock error = errors.New("not enough stock")
+	ErrNotFound         error = newError(KindNotFound, "NOT_FOUND", "not found")
+	ErrNotEnoughStock   error = newError(KindAborted, "NOT_ENOUGH_STOCK", "not enough stock")
+	ErrInvalidOperation error = newFieldError("operations", "INVALID_OPERATION", "invalid operation")
+	ErrBulkAborted      error = newError(KindAborted, "BULK_ABORTED", "aborted because another operation failed")
+	ErrVersionMismatch  error = newError(KindFailedPrecondition, "VERSION_MISMATCH", "cart version mismatch")
+	ErrOfferMismatch    error = newFieldError("operations", "OFFER_MISMATCH", "the cart line has another offer")
+
+	ErrSKUNotFound       error = newError(KindNotFound, "SKU_NOT_FOUND", "sku not found")
+	ErrStocksUnavailable error = newError(KindUnavailable, "STOCKS_UNAVAILABLE", "stocks service is unavailable")
 )
 
 //go:generate mkdir -p mock
@@ -46,7 +63,8 @@ type IPgTxManager interface {
 }
 
 type IStockService interface {
-	GetItemInfo(ctx context.Context, skuID models.SKUID) (services.ItemDTO, error)
+	GetItemInfo(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error)
+	GetItemsInfo(ctx context.Context, skuIDs []models.SKUID) (map[models.SKUID]services.ItemDTO, error)
 }
 
 type IProducer interface {
@@ -55,6 +73,7 @@ type IProducer interface {
 
 type CartUsecase struct {
 	skuService    IStockService
+	stockChecker  IStockService // reads without a cache, so the available count is never stale
 	cartRepo      repository.ICartRepo
 	trManager     IPgTxManager
 	kafkaProducer IProducer
@@ -64,6 +83,7 @@ type CartUsecase struct {
 func NewCartUsecase(cartRepo repository.ICartRepo,
 	trManager IPgTxManager,
 	service IStockService,
+	checker IStockService,
 	kafkaPr IProducer,
 	l myLog.Logger,
 ) *CartUsecase {
@@ -71,6 +91,7 @@ func NewCartUsecase(cartRepo repository.ICartRepo,
 		cartRepo:      cartRepo,
 		trManager:     trManager,
 		skuService:    service,
+		stockChecker:  checker,
 		kafkaProducer: kafkaPr,
 		logger:        l,
 	}
@@ -80,9 +101,16 @@ func (u *CartUsecase) AddItem(ctx context.Context, addItem AddItemDTO) error {
 	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, addSpanName)
 	defer span.End()
 
-	item, err := u.skuService.GetItemInfo(ctx, addItem.SKUID)
+	return u.addItem(ctx, addItem, nil)
+}
+
+// addItem checks the stock of the offer of the item and adds it to the cart. The cart line references
+// the checked offer, the default offer of the SKU if none is chosen.
+// If inTx is set, it is called in the same transaction before the cart is changed.
+func (u *CartUsecase) addItem(ctx context.Context, addItem AddItemDTO, inTx func(repo repository.ICartRepo) error) error {
+	item, err := u.stockChecker.GetItemInfo(ctx, addItem.SKUID, addItem.OfferID)
 	if err != nil {
-		return err
+		return stockError(err)
 	}
 
 	id, err := u.cartRepo.GetCartID(ctx, addItem.UserID, addItem.SKUID)
@@ -111,11 +139,22 @@ func (u *CartUsecase) AddItem(ctx context.Context, addItem AddItemDTO) error {
 	}
 
 	if err = u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
+		if err := incrementVersion(ctx, repo, addItem.UserID, addItem.ExpectedVersion); err != nil {
+			return err
+		}
+
+		if inTx != nil {
+			if err := inTx(repo); err != nil {
+				return err
+			}
+		}
+
 		cart := models.Cart{
-			ID:     id,
-			UserID: addItem.UserID,
-			SKUID:  addItem.SKUID,
-			Count:  addItem.Count,
+			ID:      id,
+			UserID:  addItem.UserID,
+			SKUID:   addItem.SKUID,
+			OfferID: item.OfferID,
+			Count:   addItem.Count,
 		}
 
 		if id > 0 {
@@ -141,7 +180,13 @@ func (u *CartUsecase) DeleteItem(ctx context.Context, delItem DeleteItemDTO) err
 	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, delSpanName)
 	defer span.End()
 
-	err := u.cartRepo.DeleteItem(ctx, delItem.UserID, delItem.SKUID)
+	err := u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
+		if err := incrementVersion(ctx, repo, delItem.UserID, delItem.ExpectedVersion); err != nil {
+			return err
+		}
+
+		return repo.DeleteItem(ctx, delItem.UserID, delItem.SKUID)
+	})
 	if errors.Is(err, repository.ErrNotFound) {
 		return ErrNotFound
 	}
@@ -153,17 +198,25 @@ func (u *CartUsecase) GetItemsByUserID(ctx context.Context, userID models.UserID
 	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, listSpanName)
 	defer span.End()
 
-	var list ListItemsDTO
+	var (
+		list ListItemsDTO
+		err  error
+	)
+
+	list.Version, err = u.cartRepo.GetVersion(ctx, userID)
+	if err != nil {
+		return ListItemsDTO{}, err
+	}
 
 	carts, err := u.cartRepo.GetCartByUserID(ctx, userID)
 	if err != nil {
-		return list, err
+		return ListItemsDTO{}, err
 	}
 
 	for _, cart := range carts {
-		sku, err := u.skuService.GetItemInfo(ctx, cart.SKUID)
+		sku, err := u.skuService.GetItemInfo(ctx, cart.SKUID, cart.OfferID)
 		if err != nil {
-			return ListItemsDTO{}, err
+			return ListItemsDTO{}, stockError(err)
 		}
 
 		realCount := cart.Count
@@ -180,16 +233,512 @@ func (u *CartUsecase) GetItemsByUserID(ctx context.Context, userID models.UserID
 	return list, nil
 }
 
-func (u *CartUsecase) ClearCartByUserID(ctx context.Context, userID models.UserID) error {
+func (u *CartUsecase) ClearCartByUserID(ctx context.Context, clearCart ClearCartDTO) error {
 	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, clearSpanName)
 	defer span.End()
 
 	return u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
-		err := repo.ClearCartByUserID(ctx, userID)
+		if err := incrementVersion(ctx, repo, clearCart.UserID, clearCart.ExpectedVersion); err != nil {
+			return err
+		}
+
+		err := repo.ClearCartByUserID(ctx, clearCart.UserID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return err
+	})
+}
+
+func (u *CartUsecase) MoveToWishlist(ctx context.Context, moveItem MoveItemDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, toWishlistSpanName)
+	defer span.End()
+
+	err := u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
+		if err := incrementVersion(ctx, repo, moveItem.UserID, moveItem.ExpectedVersion); err != nil {
+			return err
+		}
+
+		cart, err := repo.GetCartItem(ctx, moveItem.UserID, moveItem.SKUID)
+		if err != nil {
+			return err
+		}
+
+		if err = repo.DeleteItem(ctx, moveItem.UserID, moveItem.SKUID); err != nil {
+			return err
+		}
+
+		return repo.AddWishlistItem(ctx, cart)
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func (u *CartUsecase) MoveToCart(ctx context.Context, moveItem MoveItemDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, toCartSpanName)
+	defer span.End()
+
+	wishItem, err := u.cartRepo.GetWishlistItem(ctx, moveItem.UserID, moveItem.SKUID)
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrNotFound
 		}
 
 		return err
+	}
+
+	addItemDTO := AddItemDTO{
+		UserID:          moveItem.UserID,
+		SKUID:           moveItem.SKUID,
+		OfferID:         wishItem.OfferID,
+		Count:           wishItem.Count,
+		ExpectedVersion: moveItem.ExpectedVersion,
+	}
+
+	err = u.addItem(ctx, addItemDTO, func(repo repository.ICartRepo) error {
+		return repo.DeleteWishlistItem(ctx, moveItem.UserID, moveItem.SKUID)
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func (u *CartUsecase) ListWishlist(ctx context.Context, userID models.UserID) (WishlistDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, wishlistSpanName)
+	defer span.End()
+
+	var list WishlistDTO
+
+	items, err := u.cartRepo.GetWishlistByUserID(ctx, userID)
+	if err != nil {
+		return list, err
+	}
+
+	for _, item := range items {
+		sku, err := u.skuService.GetItemInfo(ctx, item.SKUID, item.OfferID)
+		if err != nil {
+			return WishlistDTO{}, stockError(err)
+		}
+
+		list.Items = append(list.Items, WishlistItemDTO{
+			SKUID:     item.SKUID,
+			OfferID:   sku.OfferID,
+			Name:      sku.Name,
+			Count:     item.Count,
+			Price:     sku.Price,
+			Available: sku.Count,
+			InStock:   sku.Count >= item.Count,
+		})
+	}
+
+	return list, nil
+}
+
+// BulkUpdate applies all operations in one transaction. Every operation is validated against the stock
+// of its offer, the offer of the request or of the cart line, and SKUs of the default offer are looked up
+// in a single request. The stock is checked for the count the cart line reaches after the operation. Nothing is applied if any operation fails; the failure is reported in its
+// result and the other operations are marked with ErrBulkAborted.
+func (u *CartUsecase) BulkUpdate(ctx context.Context, bulk BulkUpdateDTO) (BulkUpdateResultDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, bulkSpanName)
+	defer span.End()
+
+	result := BulkUpdateResultDTO{Results: make([]BulkOperationResultDTO, len(bulk.Operations))}
+
+	cartItems, err := u.cartRepo.GetCartByUserID(ctx, bulk.UserID)
+	if err != nil {
+		return BulkUpdateResultDTO{}, err
+	}
+
+	lines := make(map[models.SKUID]models.CartItem, len(cartItems))
+	for _, item := range cartItems {
+		lines[item.SKUID] = item
+	}
+
+	offers, errs := bulkOffers(bulk.Operations, lines)
+
+	items, err := u.bulkItems(ctx, bulk.Operations, offers, errs)
+	if err != nil {
+		return BulkUpdateResultDTO{}, err
+	}
+
+	validateBulkStock(bulk.Operations, items, lines, errs)
+
+	var failed bool
+
+	for i, op := range bulk.Operations {
+		result.Results[i] = BulkOperationResultDTO{SKUID: op.SKUID, Err: errs[i]}
+
+		if errs[i] != nil {
+			failed = true
+		}
+	}
+
+	if failed {
+		abortBulkResults(result.Results)
+
+		return result, nil
+	}
+
+	err = u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
+		if err := incrementVersion(ctx, repo, bulk.UserID, bulk.ExpectedVersion); err != nil {
+			return err
+		}
+
+		for i, op := range bulk.Operations {
+			if err := applyBulkOperation(ctx, repo, bulk.UserID, op, items[i]); err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					result.Results[i].Err = ErrNotFound
+
+					return ErrBulkAborted
+				}
+
+				return err
+			}
+		}
+
+		return nil
 	})
+	if errors.Is(err, ErrBulkAborted) {
+		abortBulkResults(result.Results)
+
+		return result, nil
+	}
+
+	if err != nil {
+		return BulkUpdateResultDTO{}, err
+	}
+
+	result.Applied = true
+
+	messageDTO := producer.ProducerMessageDTO{
+		Type:      eventBulkType,
+		Service:   eventService,
+		Timestamp: time.Now(),
+		Status:    eventStatusOk,
+		UserID:    bulk.UserID,
+	}
+
+	for i, op := range bulk.Operations {
+		if op.Type == BulkOperationDelete {
+			continue
+		}
+
+		messageDTO.Items = append(messageDTO.Items, producer.ItemDTO{
+			SKU:   op.SKUID,
+			Count: op.Count,
+			Price: items[i].Price,
+		})
+		messageDTO.TotalCount += uint32(op.Count)
+		messageDTO.TotalPrice += uint32(op.Count) * items[i].Price
+	}
+
+	u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+
+	return result, nil
+}
+
+// bulkOffers returns the offer every operation is applied to, 0 for the default offer, and fails the
+// invalid operations. The offer is the one of the request, else the one of the cart line of the SKU
+// as left by the previous operations. An add only increases the count of a line, its offer is changed by a set.
+func bulkOffers(ops []BulkOperationDTO, lines map[models.SKUID]models.CartItem) ([]models.OfferID, []error) {
+	offers := make([]models.OfferID, len(ops))
+	errs := make([]error, len(ops))
+	lineOffers := make(map[models.SKUID]models.OfferID, len(lines))
+
+	for skuID, line := range lines {
+		lineOffers[skuID] = line.OfferID
+	}
+
+	for i, op := range ops {
+		switch op.Type {
+		case BulkOperationDelete:
+			delete(lineOffers, op.SKUID)
+
+			continue
+		case BulkOperationAdd, BulkOperationSet:
+			if op.Count == 0 {
+				errs[i] = ErrInvalidOperation
+
+				continue
+			}
+		default:
+			errs[i] = ErrInvalidOperation
+
+			continue
+		}
+
+		lineOffer, ok := lineOffers[op.SKUID]
+		if ok && op.Type == BulkOperationAdd && op.OfferID != 0 && op.OfferID != lineOffer {
+			errs[i] = ErrOfferMismatch
+
+			continue
+		}
+
+		offers[i] = lineOffer
+		if op.OfferID != 0 {
+			offers[i] = op.OfferID
+		}
+
+		lineOffers[op.SKUID] = offers[i]
+	}
+
+	return offers, errs
+}
+
+// bulkItems looks up the stock of the offer of every valid add and set operation, the SKUs of the default
+// offer in a single request. The item of an operation is nil if its SKU or offer is unknown to the stocks
+// service, and of the deletes and failed operations.
+func (u *CartUsecase) bulkItems(ctx context.Context, ops []BulkOperationDTO, offers []models.OfferID,
+	errs []error) ([]*services.ItemDTO, error) {
+	items := make([]*services.ItemDTO, len(ops))
+	skuIDs := make([]models.SKUID, 0, len(ops))
+
+	for i, op := range ops {
+		if op.Type != BulkOperationDelete && errs[i] == nil && offers[i] == 0 {
+			skuIDs = append(skuIDs, op.SKUID)
+		}
+	}
+
+	var defaults map[models.SKUID]services.ItemDTO
+
+	if len(skuIDs) > 0 {
+		var err error
+
+		defaults, err = u.stockChecker.GetItemsInfo(ctx, skuIDs)
+		if err != nil {
+			return nil, stockError(err)
+		}
+	}
+
+	for i, op := range ops {
+		if op.Type == BulkOperationDelete || errs[i] != nil {
+			continue
+		}
+
+		if offers[i] == 0 {
+			if item, ok := defaults[op.SKUID]; ok {
+				items[i] = &item
+			}
+
+			continue
+		}
+
+		item, err := u.stockChecker.GetItemInfo(ctx, op.SKUID, offers[i])
+		if errors.Is(err, services.ErrItemNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, stockError(err)
+		}
+
+		items[i] = &item
+	}
+
+	return items, nil
+}
+
+// validateBulkStock fails the add and set operations whose SKU is unknown or whose cart line would exceed
+// the stock of the offer. The count of a line is the count in the cart changed by all previous operations,
+// so repeated adds of a SKU are checked together with the count already in the cart.
+func validateBulkStock(ops []BulkOperationDTO, items []*services.ItemDTO, lines map[models.SKUID]models.CartItem,
+	errs []error) {
+	counts := make(map[models.SKUID]uint32, len(lines))
+
+	for skuID, line := range lines {
+		counts[skuID] = uint32(line.Count)
+	}
+
+	for i, op := range ops {
+		if errs[i] != nil {
+			continue
+		}
+
+		switch op.Type {
+		case BulkOperationDelete:
+			delete(counts, op.SKUID)
+
+			continue
+		case BulkOperationAdd:
+			counts[op.SKUID] += uint32(op.Count)
+		case BulkOperationSet:
+			counts[op.SKUID] = uint32(op.Count)
+		}
+
+		if items[i] == nil {
+			errs[i] = ErrNotFound
+
+			continue
+		}
+
+		if uint32(items[i].Count) < counts[op.SKUID] {
+			errs[i] = ErrNotEnoughStock
+		}
+	}
+}
+
+// applyBulkOperation applies the operation, an added or set line references the offer of the validated item.
+func applyBulkOperation(ctx context.Context, repo repository.ICartRepo, userID models.UserID, op BulkOperationDTO,
+	item *services.ItemDTO) error {
+	cart := models.Cart{
+		UserID: userID,
+		SKUID:  op.SKUID,
+		Count:  op.Count,
+	}
+
+	if item != nil {
+		cart.OfferID = item.OfferID
+	}
+
+	switch op.Type {
+	case BulkOperationAdd:
+		existing, err := repo.GetCartItem(ctx, userID, op.SKUID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return repo.AddItem(ctx, cart)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// the line keeps its offer, it may have been read before another request changed it
+		cart.ID = existing.ID
+		cart.OfferID = existing.OfferID
+
+		return repo.UpdateItemByUserID(ctx, cart)
+	case BulkOperationSet:
+		return repo.SetItem(ctx, cart)
+	case BulkOperationDelete:
+		return repo.DeleteItem(ctx, userID, op.SKUID)
+	default:
+		return ErrInvalidOperation
+	}
+}
+
+// incrementVersion bumps the cart version in the transaction of a mutation. If expected is set
+// and the cart was changed since that version, ErrVersionMismatch rolls the mutation back.
+func incrementVersion(ctx context.Context, repo repository.ICartRepo, userID models.UserID, expected *uint64) error {
+	version, err := repo.IncrementVersion(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if expected != nil && version-1 != *expected {
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func abortBulkResults(results []BulkOperationResultDTO) {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Err = ErrBulkAborted
+		}
+	}
+}
+
+// ExpireCarts deletes up to one batch of carts that were not changed since before, the expiry job
+// calls it until no cart is expired.
+// A cart_abandoned event with the item and value summary is emitted for every cart
+// before its deletion is committed. It returns the number of expired carts.
+func (u *CartUsecase) ExpireCarts(ctx context.Context, before time.Time) (int, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, expireSpanName)
+	defer span.End()
+
+	carts, err := u.cartRepo.GetAbandonedCarts(ctx, before, expireBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var expired int
+
+	for _, cart := range carts {
+		messageDTO := u.abandonedCartMessage(ctx, cart)
+
+		err = u.trManager.WithTx(ctx, func(repo repository.ICartRepo) error {
+			if err := repo.DeleteAbandonedCart(ctx, cart.UserID, before); err != nil {
+				return err
+			}
+
+			if err := incrementVersion(ctx, repo, cart.UserID, nil); err != nil {
+				return err
+			}
+
+			return u.kafkaProducer.Produce(messageDTO, topic, time.Now())
+		})
+		if errors.Is(err, repository.ErrNotFound) {
+			// the cart was changed after it was selected, so it is not abandoned anymore
+			continue
+		}
+
+		if err != nil {
+			return expired, err
+		}
+
+		expired++
+	}
+
+	return expired, nil
+}
+
+// abandonedCartMessage returns the cart_abandoned event of the cart with the current prices. An item whose
+// price can not be looked up is sent without a price and the total price is left out, rather than
+// reporting a price of 0.
+func (u *CartUsecase) abandonedCartMessage(ctx context.Context, cart models.AbandonedCart) producer.ProducerMessageDTO {
+	messageDTO := producer.ProducerMessageDTO{
+		Type:      eventAbandonedType,
+		Service:   eventService,
+		Timestamp: time.Now(),
+		Status:    eventStatusOk,
+		UserID:    cart.UserID,
+		Items:     make([]producer.ItemDTO, 0, len(cart.Items)),
+	}
+
+	var priceUnknown bool
+
+	for _, item := range cart.Items {
+		itemDTO := producer.ItemDTO{
+			SKU:   item.SKUID,
+			Count: item.Count,
+		}
+
+		sku, err := u.skuService.GetItemInfo(ctx, item.SKUID, item.OfferID)
+		if err != nil {
+			u.logger.Warnf(warnAbandonedSKUPrice, item.SKUID, cart.UserID, err)
+
+			itemDTO.PriceUnknown = true
+			priceUnknown = true
+		} else {
+			itemDTO.Price = sku.Price
+			messageDTO.TotalPrice += uint32(item.Count) * sku.Price
+		}
+
+		messageDTO.Items = append(messageDTO.Items, itemDTO)
+		messageDTO.TotalCount += uint32(item.Count)
+	}
+
+	if priceUnknown {
+		messageDTO.TotalPrice = 0
+	}
+
+	return messageDTO
+}
+
+// stockError maps the errors of the stocks service the cart handles to domain errors.
+func stockError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrItemNotFound):
+		return ErrSKUNotFound
+	case errors.Is(err, services.ErrStocksUnavailable):
+		return ErrStocksUnavailable
+	default:
+		return err
+	}
 }
@@ -2,12 +2,16 @@ package usecase
 
 import (
 	"cart/internal/models"
+	"cart/internal/producer"
 	"cart/internal/repository"
 	repoMock "cart/internal/repository/mock"
 	"cart/internal/services"
 	"cart/internal/usecase/mock"
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"time"
 
 	logMock "cart/internal/observability/log/mock"
 
@@ -27,6 +31,8 @@ func TestAddItem(t *testing.T) {
 	t.Parallel()
 
 	serviceMock := mock.NewIStockServiceMock(t)
+	// the cache must not be read to check the stock, any call to it fails the test
+	cachedMock := mock.NewIStockServiceMock(t)
 	repoMock := repoMock.NewICartRepoMock(t)
 	trxMock := mock.NewIPgTxManagerMock(t)
 	kafkaMock := mock.NewIProducerMock(t)
@@ -42,9 +48,11 @@ func TestAddItem(t *testing.T) {
 
 	repoMock.GetCartIDMock.Return(1, nil)
 
-	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID) (services.ItemDTO, error) {
-		if skuID < 1001 {
-			return services.ItemDTO{}, ErrNotFound
+	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error) {
+		if skuID == 999 {
+			return services.ItemDTO{}, services.ErrStocksUnavailable
+		} else if skuID < 1001 {
+			return services.ItemDTO{}, services.ErrItemNotFound
 		} else if skuID > 1001 {
 			return services.ItemDTO{Count: 1}, nil
 		}
@@ -58,10 +66,15 @@ func TestAddItem(t *testing.T) {
 		return fn(repoMock)
 	})
 
+	repoMock.IncrementVersionMock.Return(1, nil)
+
 	logger.InfoMock.Return()
 	logger.WarnfMock.Return()
 
-	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, kafkaMock, logger)
+	cartUsecase := NewCartUsecase(repoMock, trxMock, cachedMock, serviceMock, kafkaMock, logger)
+
+	// IncrementVersion returns 1, so the cart was at version 0 before the change
+	currentVersion, staleVersion := uint64(0), uint64(5)
 
 	tests := []struct {
 		name    string
@@ -84,7 +97,16 @@ func TestAddItem(t *testing.T) {
 				SKUID:  1000,
 				Count:  5,
 			},
-			wantErr: ErrNotFound,
+			wantErr: ErrSKUNotFound,
+		},
+		{
+			name: "ErrorStocksUnavailable",
+			body: AddItemDTO{
+				UserID: 1,
+				SKUID:  999,
+				Count:  5,
+			},
+			wantErr: ErrStocksUnavailable,
 		},
 		{
 			name: "ErrorNotEnoughStock",
@@ -95,6 +117,26 @@ func TestAddItem(t *testing.T) {
 			},
 			wantErr: ErrNotEnoughStock,
 		},
+		{
+			name: "ExpectedVersion",
+			body: AddItemDTO{
+				UserID:          1,
+				SKUID:           1001,
+				Count:           5,
+				ExpectedVersion: &currentVersion,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "ErrorVersionMismatch",
+			body: AddItemDTO{
+				UserID:          1,
+				SKUID:           1001,
+				Count:           5,
+				ExpectedVersion: &staleVersion,
+			},
+			wantErr: ErrVersionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,7 +174,13 @@ func TestDeleteItem(t *testing.T) {
 		return nil
 	})
 
-	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, kafkaMock, logger)
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.IncrementVersionMock.Return(1, nil)
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
 
 	tests := []struct {
 		name    string
@@ -192,10 +240,12 @@ func TestGetItemsByUserID(t *testing.T) {
 		return []models.CartItem{{SKUID: models.SKUID(1001), Count: 10}}, nil
 	})
 
+	repoMock.GetVersionMock.Return(3, nil)
+
 	serviceMock.GetItemInfoMock.Return(services.ItemDTO{}, nil)
 
 	logger.WarnfMock.Return()
-	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, kafkaMock, logger)
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
 
 	tests := []struct {
 		name    string
@@ -206,7 +256,7 @@ func TestGetItemsByUserID(t *testing.T) {
 		{
 			name:    testNotFoundName,
 			body:    1,
-			want:    ListItemsDTO{},
+			want:    ListItemsDTO{Version: 3},
 			wantErr: nil,
 		},
 		{
@@ -226,7 +276,7 @@ func TestGetItemsByUserID(t *testing.T) {
 				}
 			}
 
-			if items.TotalPrice != tt.want.TotalPrice {
+			if items.TotalPrice != tt.want.TotalPrice || items.Version != tt.want.Version {
 				t.Error("want body != return body")
 			}
 		})
@@ -258,22 +308,24 @@ func TestClearCartByUserID(t *testing.T) {
 
 	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) { return fn(repoMock) })
 
+	repoMock.IncrementVersionMock.Return(1, nil)
+
 	// logger.InfoMock.Return()
-	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, kafkaMock, logger)
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
 
 	tests := []struct {
 		name    string
-		body    models.UserID
+		body    ClearCartDTO
 		wantErr error
 	}{
 		{
 			name:    testSuccesName,
-			body:    1,
+			body:    ClearCartDTO{UserID: 1},
 			wantErr: nil,
 		},
 		{
 			name:    testNotFoundName,
-			body:    2,
+			body:    ClearCartDTO{UserID: 2},
 			wantErr: ErrNotFound,
 		},
 	}
@@ -289,3 +341,473 @@ func TestClearCartByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestExpireCarts(t *testing.T) {
+	t.Parallel()
+
+	serviceMock := mock.NewIStockServiceMock(t)
+	repoMock := repoMock.NewICartRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+		serviceMock.MinimockFinish()
+	})
+
+	repoMock.GetAbandonedCartsMock.Set(func(ctx context.Context, before time.Time, limit int) ([]models.AbandonedCart, error) {
+		if before.IsZero() {
+			return nil, errSql
+		}
+
+		return []models.AbandonedCart{
+			{UserID: 1, Items: []models.CartItem{{SKUID: 1001, Count: 2}, {SKUID: 1000, Count: 1}}},
+			{UserID: 2, Items: []models.CartItem{{SKUID: 1001, Count: 1}}},
+		}, nil
+	})
+
+	repoMock.DeleteAbandonedCartMock.Set(func(ctx context.Context, userID models.UserID, before time.Time) error {
+		if userID != 1 {
+			return repository.ErrNotFound
+		}
+
+		return nil
+	})
+
+	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error) {
+		if skuID != 1001 {
+			return services.ItemDTO{}, services.ErrItemNotFound
+		}
+
+		return services.ItemDTO{Price: 10}, nil
+	})
+
+	// the price of SKU 1000 is unknown, so it is sent without a price and the total price is left out
+	wantItems := []producer.ItemDTO{{SKU: 1001, Count: 2, Price: 10}, {SKU: 1000, Count: 1, PriceUnknown: true}}
+
+	kafkaMock.ProduceMock.Set(func(messageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		if messageDTO.Type != eventAbandonedType || messageDTO.TotalCount != 3 || messageDTO.TotalPrice != 0 ||
+			!reflect.DeepEqual(messageDTO.Items, wantItems) {
+			return fmt.Errorf("unexpected abandoned cart event: %+v", messageDTO)
+		}
+
+		return nil
+	})
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.IncrementVersionMock.Return(1, nil)
+
+	logger.WarnfMock.Return()
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
+
+	tests := []struct {
+		name        string
+		before      time.Time
+		wantExpired int
+		wantErr     error
+	}{
+		{
+			name:        testSuccesName,
+			before:      time.Now(),
+			wantExpired: 1,
+			wantErr:     nil,
+		},
+		{
+			name:        "SqlError",
+			before:      time.Time{},
+			wantExpired: 0,
+			wantErr:     errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expired, err := cartUsecase.ExpireCarts(t.Context(), tt.before)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if expired != tt.wantExpired {
+				t.Errorf("wanted expired: %d, respond: %d", tt.wantExpired, expired)
+			}
+		})
+	}
+}
+
+func TestMoveToWishlist(t *testing.T) {
+	t.Parallel()
+
+	serviceMock := mock.NewIStockServiceMock(t)
+	repoMock := repoMock.NewICartRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+		serviceMock.MinimockFinish()
+	})
+
+	repoMock.GetCartItemMock.Set(func(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.Cart, error) {
+		if skuID != 1001 {
+			return models.Cart{}, repository.ErrNotFound
+		}
+
+		return models.Cart{ID: 1, UserID: userID, SKUID: skuID, Count: 2}, nil
+	})
+
+	repoMock.DeleteItemMock.Return(nil)
+	repoMock.AddWishlistItemMock.Return(nil)
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.IncrementVersionMock.Return(1, nil)
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    MoveItemDTO
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    MoveItemDTO{UserID: 1, SKUID: 1001},
+			wantErr: nil,
+		},
+		{
+			name:    testNotFoundName,
+			body:    MoveItemDTO{UserID: 1, SKUID: 1},
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cartUsecase.MoveToWishlist(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMoveToCart(t *testing.T) {
+	t.Parallel()
+
+	serviceMock := mock.NewIStockServiceMock(t)
+	repoMock := repoMock.NewICartRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+		serviceMock.MinimockFinish()
+	})
+
+	repoMock.GetWishlistItemMock.Set(func(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.CartItem, error) {
+		if skuID == 1 {
+			return models.CartItem{}, repository.ErrNotFound
+		}
+
+		return models.CartItem{SKUID: skuID, Count: 5}, nil
+	})
+
+	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error) {
+		if skuID > 1001 {
+			return services.ItemDTO{Count: 1}, nil
+		}
+
+		return services.ItemDTO{Count: 10}, nil
+	})
+
+	repoMock.GetCartIDMock.Return(0, nil)
+	repoMock.DeleteWishlistItemMock.Return(nil)
+	repoMock.AddItemMock.Return(nil)
+	kafkaMock.ProduceMock.Return(nil)
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.IncrementVersionMock.Return(1, nil)
+
+	logger.InfoMock.Return()
+	logger.WarnfMock.Return()
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    MoveItemDTO
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    MoveItemDTO{UserID: 1, SKUID: 1001},
+			wantErr: nil,
+		},
+		{
+			name:    testNotFoundName,
+			body:    MoveItemDTO{UserID: 1, SKUID: 1},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "ErrorNotEnoughStock",
+			body:    MoveItemDTO{UserID: 1, SKUID: 1002},
+			wantErr: ErrNotEnoughStock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cartUsecase.MoveToCart(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestListWishlist(t *testing.T) {
+	t.Parallel()
+
+	serviceMock := mock.NewIStockServiceMock(t)
+	repoMock := repoMock.NewICartRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+		serviceMock.MinimockFinish()
+	})
+
+	repoMock.GetWishlistByUserIDMock.Set(func(ctx context.Context, userID models.UserID) ([]models.CartItem, error) {
+		if userID > 1 {
+			return nil, errSql
+		}
+
+		return []models.CartItem{{SKUID: 1001, Count: 5}, {SKUID: 1002, Count: 5}}, nil
+	})
+
+	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error) {
+		if skuID > 1001 {
+			return services.ItemDTO{Count: 1}, nil
+		}
+
+		return services.ItemDTO{Count: 10}, nil
+	})
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, serviceMock, serviceMock, kafkaMock, logger)
+
+	tests := []struct {
+		name        string
+		body        models.UserID
+		wantInStock []bool
+		wantErr     error
+	}{
+		{
+			name:        testSuccesName,
+			body:        1,
+			wantInStock: []bool{true, false},
+			wantErr:     nil,
+		},
+		{
+			name:    "SqlError",
+			body:    2,
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := cartUsecase.ListWishlist(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if len(list.Items) != len(tt.wantInStock) {
+				t.Fatalf("wanted %d items, respond: %d", len(tt.wantInStock), len(list.Items))
+			}
+
+			for i, item := range list.Items {
+				if item.InStock != tt.wantInStock[i] {
+					t.Errorf("item %d in stock: %v, want: %v", item.SKUID, item.InStock, tt.wantInStock[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBulkUpdate(t *testing.T) {
+	t.Parallel()
+
+	serviceMock := mock.NewIStockServiceMock(t)
+	// the cache must not be read to check the stock, any call to it fails the test
+	cachedMock := mock.NewIStockServiceMock(t)
+	repoMock := repoMock.NewICartRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+		serviceMock.MinimockFinish()
+	})
+
+	serviceMock.GetItemsInfoMock.Set(func(ctx context.Context, skuIDs []models.SKUID) (map[models.SKUID]services.ItemDTO, error) {
+		return map[models.SKUID]services.ItemDTO{
+			1001: {SKUID: 1001, OfferID: 1, Count: 10, Price: 5},
+			1002: {SKUID: 1002, OfferID: 2, Count: 1, Price: 5},
+		}, nil
+	})
+	serviceMock.GetItemInfoMock.Set(func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (services.ItemDTO, error) {
+		switch {
+		case skuID == 1001 && offerID == 5:
+			return services.ItemDTO{SKUID: 1001, OfferID: 5, Count: 10, Price: 4}, nil
+		case skuID == 1003 && offerID == 7:
+			return services.ItemDTO{SKUID: 1003, OfferID: 7, Count: 3, Price: 9}, nil
+		default:
+			return services.ItemDTO{}, services.ErrItemNotFound
+		}
+	})
+
+	// the cart has a line of SKU 1003 for the offer 7 of another seller than the default one
+	repoMock.GetCartByUserIDMock.Return([]models.CartItem{{SKUID: 1003, OfferID: 7, Count: 1}}, nil)
+	repoMock.GetCartItemMock.Set(func(ctx context.Context, userID models.UserID, skuID models.SKUID) (models.Cart, error) {
+		if skuID == 1003 {
+			return models.Cart{ID: 3, UserID: userID, SKUID: 1003, OfferID: 7, Count: 1}, nil
+		}
+
+		return models.Cart{}, repository.ErrNotFound
+	})
+	repoMock.AddItemMock.Return(nil)
+	repoMock.UpdateItemByUserIDMock.Set(func(ctx context.Context, cart models.Cart) error {
+		if cart.ID != 3 || cart.OfferID != 7 {
+			return fmt.Errorf("line %d updated with offer %d, want line 3 with offer 7", cart.ID, cart.OfferID)
+		}
+
+		return nil
+	})
+	repoMock.SetItemMock.Return(nil)
+	repoMock.DeleteItemMock.Set(func(ctx context.Context, userID models.UserID, skuID models.SKUID) error {
+		if skuID != 1001 {
+			return repository.ErrNotFound
+		}
+
+		return nil
+	})
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.ICartRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.IncrementVersionMock.Return(1, nil)
+
+	kafkaMock.ProduceMock.Return(nil)
+	logger.InfoMock.Return()
+
+	cartUsecase := NewCartUsecase(repoMock, trxMock, cachedMock, serviceMock, kafkaMock, logger)
+
+	tests := []struct {
+		name        string
+		body        BulkUpdateDTO
+		wantApplied bool
+		wantErrs    []error
+	}{
+		{
+			name: testSuccesName,
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationAdd, SKUID: 1001, Count: 2},
+				{Type: BulkOperationSet, SKUID: 1002, Count: 1},
+				{Type: BulkOperationDelete, SKUID: 1001},
+				{Type: BulkOperationAdd, SKUID: 1003, Count: 2},
+			}},
+			wantApplied: true,
+			wantErrs:    []error{nil, nil, nil, nil},
+		},
+		{
+			name: "SuccessOffer",
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationSet, SKUID: 1001, OfferID: 5, Count: 3},
+				{Type: BulkOperationAdd, SKUID: 1003, OfferID: 7, Count: 1},
+			}},
+			wantApplied: true,
+			wantErrs:    []error{nil, nil},
+		},
+		{
+			name: "ErrorValidation",
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationAdd, SKUID: 1001, Count: 2},
+				{Type: BulkOperationAdd, SKUID: 1002, Count: 5},
+				{Type: BulkOperationSet, SKUID: 3033, Count: 1},
+				{Type: BulkOperationSet, SKUID: 1001, Count: 0},
+				{Type: BulkOperationAdd, SKUID: 1003, OfferID: 8, Count: 1},
+				{Type: BulkOperationSet, SKUID: 1001, OfferID: 9, Count: 1},
+			}},
+			wantApplied: false,
+			wantErrs:    []error{ErrBulkAborted, ErrNotEnoughStock, ErrNotFound, ErrInvalidOperation, ErrOfferMismatch, ErrNotFound},
+		},
+		{
+			name: "SuccessSetThenAdd",
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationSet, SKUID: 1003, Count: 2},
+				{Type: BulkOperationAdd, SKUID: 1003, Count: 1},
+			}},
+			wantApplied: true,
+			wantErrs:    []error{nil, nil},
+		},
+		{
+			name: "ErrorRepeatedSKU",
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationAdd, SKUID: 1001, Count: 6},
+				{Type: BulkOperationAdd, SKUID: 1001, Count: 5},
+				{Type: BulkOperationAdd, SKUID: 1003, Count: 3},
+			}},
+			wantApplied: false,
+			wantErrs:    []error{ErrBulkAborted, ErrNotEnoughStock, ErrNotEnoughStock},
+		},
+		{
+			name: "ErrorDeleteNotFound",
+			body: BulkUpdateDTO{UserID: 1, Operations: []BulkOperationDTO{
+				{Type: BulkOperationAdd, SKUID: 1001, Count: 2},
+				{Type: BulkOperationDelete, SKUID: 1002},
+			}},
+			wantApplied: false,
+			wantErrs:    []error{ErrBulkAborted, ErrNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := cartUsecase.BulkUpdate(t.Context(), tt.body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Applied != tt.wantApplied {
+				t.Errorf("wanted applied: %v, respond: %v", tt.wantApplied, result.Applied)
+			}
+
+			for i, opResult := range result.Results {
+				if !errors.Is(opResult.Err, tt.wantErrs[i]) {
+					t.Errorf("operation %d wanted: %v, respond: %v", i, tt.wantErrs[i], opResult.Err)
+				}
+			}
+		})
+	}
+}
@@ -6,17 +6,89 @@ import (
 )
 
 type AddItemDTO struct {
-	UserID models.UserID
-	SKUID  models.SKUID
-	Count  uint16
+	UserID          models.UserID
+	SKUID           models.SKUID
+	OfferID         models.OfferID
+	Count           uint16
+	ExpectedVersion *uint64
 }
 
 type DeleteItemDTO struct {
-	UserID models.UserID
-	SKUID  models.SKUID
+	UserID          models.UserID
+	SKUID           models.SKUID
+	ExpectedVersion *uint64
+}
+
+type ClearCartDTO struct {
+	UserID          models.UserID
+	ExpectedVersion *uint64
 }
 
 type ListItemsDTO struct {
 	Items      []services.ItemDTO
 	TotalPrice uint32
+	Version    uint64
+}
+
+type MoveItemDTO struct {
+	UserID          models.UserID
+	SKUID           models.SKUID
+	ExpectedVersion *uint64
+}
+
+type WishlistItemDTO struct {
+	SKUID     models.SKUID
+	OfferID   models.OfferID
+	Name      string
+	Count     uint16
+	Price     uint32
+	Available uint16
+	InStock   bool
+}
+
+type WishlistDTO struct {
+	Items []WishlistItemDTO
+}
+
+type BulkOperationType int
+
+const (
+	BulkOperationAdd BulkOperationType = iota + 1
+	BulkOperationSet
+	BulkOperationDelete
+)
+
+type BulkOperationDTO struct {
+	Type    BulkOperationType
+	SKUID   models.SKUID
+	OfferID models.OfferID
+	Count   uint16
+}
+
+type BulkUpdateDTO struct {
+	UserID          models.UserID
+	Operations      []BulkOperationDTO
+	ExpectedVersion *uint64
+}
+
+type BulkOperationResultDTO struct {
+	SKUID models.SKUID
+	Err   error
+}
+
+type BulkUpdateResultDTO struct {
+	Applied bool
+	Results []BulkOperationResultDTO
+}
+
+type IdempotentRequestDTO struct {
+	Subject     string
+	Key         string
+	Method      string
+	RequestHash []byte
+}
+
+type IdempotentResultDTO struct {
+	Reserved bool
+	Response []byte
 }
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"cart/internal/models"
+	"cart/internal/repository"
+	repoMock "cart/internal/repository/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyBegin(t *testing.T) {
+	t.Parallel()
+
+	repoMock := repoMock.NewIIdempotencyRepoMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	stored := map[models.IdempotencyKey]models.IdempotencyRecord{
+		{Subject: "alice", Method: "add", Key: "done"}:    {RequestHash: []byte("hash"), Response: []byte("resp")},
+		{Subject: "alice", Method: "add", Key: "running"}: {RequestHash: []byte("hash")},
+	}
+
+	repoMock.ReserveKeyMock.Set(func(ctx context.Context, record models.IdempotencyRecord) (bool, error) {
+		switch record.Key {
+		case "sql":
+			return false, errSql
+		case "released":
+			return false, nil
+		}
+
+		_, ok := stored[models.IdempotencyKey{Subject: record.Subject, Method: record.Method, Key: record.Key}]
+
+		return !ok, nil
+	})
+
+	repoMock.GetKeyMock.Set(func(ctx context.Context, key models.IdempotencyKey) (models.IdempotencyRecord, error) {
+		record, ok := stored[key]
+		if !ok {
+			return models.IdempotencyRecord{}, repository.ErrNotFound
+		}
+
+		return record, nil
+	})
+
+	idempotencyUsecase := NewIdempotencyUsecase(repoMock, time.Hour)
+
+	tests := []struct {
+		name    string
+		body    IdempotentRequestDTO
+		want    IdempotentResultDTO
+		wantErr error
+	}{
+		{
+			name:    "Reserved",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "new", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{Reserved: true},
+			wantErr: nil,
+		},
+		{
+			name:    "Replay",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "done", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{Response: []byte("resp")},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorKeyReused",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "done", Method: "add", RequestHash: []byte("other")},
+			want:    IdempotentResultDTO{},
+			wantErr: ErrIdempotencyKeyReused,
+		},
+		{
+			name:    "ReservedOtherMethod",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "done", Method: "delete", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{Reserved: true},
+			wantErr: nil,
+		},
+		{
+			name:    "ReservedOtherUser",
+			body:    IdempotentRequestDTO{Subject: "bob", Key: "done", Method: "add", RequestHash: []byte("other")},
+			want:    IdempotentResultDTO{Reserved: true},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorInProgress",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "running", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{},
+			wantErr: ErrIdempotencyInProgress,
+		},
+		{
+			name:    "ErrorReleased",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "released", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{},
+			wantErr: ErrIdempotencyInProgress,
+		},
+		{
+			name:    "ErrorEmptyKey",
+			body:    IdempotentRequestDTO{Subject: "alice", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{},
+			wantErr: ErrIdempotencyEmptyRequest,
+		},
+		{
+			name:    "SqlError",
+			body:    IdempotentRequestDTO{Subject: "alice", Key: "sql", Method: "add", RequestHash: []byte("hash")},
+			want:    IdempotentResultDTO{},
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := idempotencyUsecase.Begin(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if result.Reserved != tt.want.Reserved || string(result.Response) != string(tt.want.Response) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, result)
+			}
+		})
+	}
+}
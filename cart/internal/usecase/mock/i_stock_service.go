@@ -18,12 +18,19 @@ type IStockServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcGetItemInfo          func(ctx context.Context, skuID models.SKUID) (i1 services.ItemDTO, err error)
+	funcGetItemInfo          func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (i1 services.ItemDTO, err error)
 	funcGetItemInfoOrigin    string
-	inspectFuncGetItemInfo   func(ctx context.Context, skuID models.SKUID)
+	inspectFuncGetItemInfo   func(ctx context.Context, skuID models.SKUID, offerID models.OfferID)
 	afterGetItemInfoCounter  uint64
 	beforeGetItemInfoCounter uint64
 	GetItemInfoMock          mIStockServiceMockGetItemInfo
+
+	funcGetItemsInfo          func(ctx context.Context, skuIDs []models.SKUID) (m1 map[models.SKUID]services.ItemDTO, err error)
+	funcGetItemsInfoOrigin    string
+	inspectFuncGetItemsInfo   func(ctx context.Context, skuIDs []models.SKUID)
+	afterGetItemsInfoCounter  uint64
+	beforeGetItemsInfoCounter uint64
+	GetItemsInfoMock          mIStockServiceMockGetItemsInfo
 }
 
 // NewIStockServiceMock returns a mock for mm_usecase.IStockService
@@ -37,6 +44,9 @@ func NewIStockServiceMock(t minimock.Tester) *IStockServiceMock {
 	m.GetItemInfoMock = mIStockServiceMockGetItemInfo{mock: m}
 	m.GetItemInfoMock.callArgs = []*IStockServiceMockGetItemInfoParams{}
 
+	m.GetItemsInfoMock = mIStockServiceMockGetItemsInfo{mock: m}
+	m.GetItemsInfoMock.callArgs = []*IStockServiceMockGetItemsInfoParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -68,14 +78,16 @@ type IStockServiceMockGetItemInfoExpectation struct {
 
 // IStockServiceMockGetItemInfoParams contains parameters of the IStockService.GetItemInfo
 type IStockServiceMockGetItemInfoParams struct {
-	ctx   context.Context
-	skuID models.SKUID
+	ctx     context.Context
+	skuID   models.SKUID
+	offerID models.OfferID
 }
 
 // IStockServiceMockGetItemInfoParamPtrs contains pointers to parameters of the IStockService.GetItemInfo
 type IStockServiceMockGetItemInfoParamPtrs struct {
-	ctx   *context.Context
-	skuID *models.SKUID
+	ctx     *context.Context
+	skuID   *models.SKUID
+	offerID *models.OfferID
 }
 
 // IStockServiceMockGetItemInfoResults contains results of the IStockService.GetItemInfo
@@ -86,9 +98,10 @@ type IStockServiceMockGetItemInfoResults struct {
 
 // IStockServiceMockGetItemInfoOrigins contains origins of expectations of the IStockService.GetItemInfo
 type IStockServiceMockGetItemInfoExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originSkuID string
+	origin        string
+	originCtx     string
+	originSkuID   string
+	originOfferID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -102,7 +115,7 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Optional() *mIStockServiceMo
 }
 
 // Expect sets up expected params for IStockService.GetItemInfo
-func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Expect(ctx context.Context, skuID models.SKUID) *mIStockServiceMockGetItemInfo {
+func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Expect(ctx context.Context, skuID models.SKUID, offerID models.OfferID) *mIStockServiceMockGetItemInfo {
 	if mmGetItemInfo.mock.funcGetItemInfo != nil {
 		mmGetItemInfo.mock.t.Fatalf("IStockServiceMock.GetItemInfo mock is already set by Set")
 	}
@@ -115,7 +128,7 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Expect(ctx context.Context,
 		mmGetItemInfo.mock.t.Fatalf("IStockServiceMock.GetItemInfo mock is already set by ExpectParams functions")
 	}
 
-	mmGetItemInfo.defaultExpectation.params = &IStockServiceMockGetItemInfoParams{ctx, skuID}
+	mmGetItemInfo.defaultExpectation.params = &IStockServiceMockGetItemInfoParams{ctx, skuID, offerID}
 	mmGetItemInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetItemInfo.expectations {
 		if minimock.Equal(e.params, mmGetItemInfo.defaultExpectation.params) {
@@ -172,8 +185,31 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) ExpectSkuIDParam2(skuID mode
 	return mmGetItemInfo
 }
 
+// ExpectOfferIDParam3 sets up expected param offerID for IStockService.GetItemInfo
+func (mmGetItemInfo *mIStockServiceMockGetItemInfo) ExpectOfferIDParam3(offerID models.OfferID) *mIStockServiceMockGetItemInfo {
+	if mmGetItemInfo.mock.funcGetItemInfo != nil {
+		mmGetItemInfo.mock.t.Fatalf("IStockServiceMock.GetItemInfo mock is already set by Set")
+	}
+
+	if mmGetItemInfo.defaultExpectation == nil {
+		mmGetItemInfo.defaultExpectation = &IStockServiceMockGetItemInfoExpectation{}
+	}
+
+	if mmGetItemInfo.defaultExpectation.params != nil {
+		mmGetItemInfo.mock.t.Fatalf("IStockServiceMock.GetItemInfo mock is already set by Expect")
+	}
+
+	if mmGetItemInfo.defaultExpectation.paramPtrs == nil {
+		mmGetItemInfo.defaultExpectation.paramPtrs = &IStockServiceMockGetItemInfoParamPtrs{}
+	}
+	mmGetItemInfo.defaultExpectation.paramPtrs.offerID = &offerID
+	mmGetItemInfo.defaultExpectation.expectationOrigins.originOfferID = minimock.CallerInfo(1)
+
+	return mmGetItemInfo
+}
+
 // Inspect accepts an inspector function that has same arguments as the IStockService.GetItemInfo
-func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mIStockServiceMockGetItemInfo {
+func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Inspect(f func(ctx context.Context, skuID models.SKUID, offerID models.OfferID)) *mIStockServiceMockGetItemInfo {
 	if mmGetItemInfo.mock.inspectFuncGetItemInfo != nil {
 		mmGetItemInfo.mock.t.Fatalf("Inspect function is already set for IStockServiceMock.GetItemInfo")
 	}
@@ -198,7 +234,7 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Return(i1 services.ItemDTO,
 }
 
 // Set uses given function f to mock the IStockService.GetItemInfo method
-func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Set(f func(ctx context.Context, skuID models.SKUID) (i1 services.ItemDTO, err error)) *IStockServiceMock {
+func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Set(f func(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (i1 services.ItemDTO, err error)) *IStockServiceMock {
 	if mmGetItemInfo.defaultExpectation != nil {
 		mmGetItemInfo.mock.t.Fatalf("Default expectation is already set for the IStockService.GetItemInfo method")
 	}
@@ -214,14 +250,14 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) Set(f func(ctx context.Conte
 
 // When sets expectation for the IStockService.GetItemInfo which will trigger the result defined by the following
 // Then helper
-func (mmGetItemInfo *mIStockServiceMockGetItemInfo) When(ctx context.Context, skuID models.SKUID) *IStockServiceMockGetItemInfoExpectation {
+func (mmGetItemInfo *mIStockServiceMockGetItemInfo) When(ctx context.Context, skuID models.SKUID, offerID models.OfferID) *IStockServiceMockGetItemInfoExpectation {
 	if mmGetItemInfo.mock.funcGetItemInfo != nil {
 		mmGetItemInfo.mock.t.Fatalf("IStockServiceMock.GetItemInfo mock is already set by Set")
 	}
 
 	expectation := &IStockServiceMockGetItemInfoExpectation{
 		mock:               mmGetItemInfo.mock,
-		params:             &IStockServiceMockGetItemInfoParams{ctx, skuID},
+		params:             &IStockServiceMockGetItemInfoParams{ctx, skuID, offerID},
 		expectationOrigins: IStockServiceMockGetItemInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetItemInfo.expectations = append(mmGetItemInfo.expectations, expectation)
@@ -256,17 +292,17 @@ func (mmGetItemInfo *mIStockServiceMockGetItemInfo) invocationsDone() bool {
 }
 
 // GetItemInfo implements mm_usecase.IStockService
-func (mmGetItemInfo *IStockServiceMock) GetItemInfo(ctx context.Context, skuID models.SKUID) (i1 services.ItemDTO, err error) {
+func (mmGetItemInfo *IStockServiceMock) GetItemInfo(ctx context.Context, skuID models.SKUID, offerID models.OfferID) (i1 services.ItemDTO, err error) {
 	mm_atomic.AddUint64(&mmGetItemInfo.beforeGetItemInfoCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetItemInfo.afterGetItemInfoCounter, 1)
 
 	mmGetItemInfo.t.Helper()
 
 	if mmGetItemInfo.inspectFuncGetItemInfo != nil {
-		mmGetItemInfo.inspectFuncGetItemInfo(ctx, skuID)
+		mmGetItemInfo.inspectFuncGetItemInfo(ctx, skuID, offerID)
 	}
 
-	mm_params := IStockServiceMockGetItemInfoParams{ctx, skuID}
+	mm_params := IStockServiceMockGetItemInfoParams{ctx, skuID, offerID}
 
 	// Record call args
 	mmGetItemInfo.GetItemInfoMock.mutex.Lock()
@@ -285,7 +321,7 @@ func (mmGetItemInfo *IStockServiceMock) GetItemInfo(ctx context.Context, skuID m
 		mm_want := mmGetItemInfo.GetItemInfoMock.defaultExpectation.params
 		mm_want_ptrs := mmGetItemInfo.GetItemInfoMock.defaultExpectation.paramPtrs
 
-		mm_got := IStockServiceMockGetItemInfoParams{ctx, skuID}
+		mm_got := IStockServiceMockGetItemInfoParams{ctx, skuID, offerID}
 
 		if mm_want_ptrs != nil {
 
@@ -299,6 +335,11 @@ func (mmGetItemInfo *IStockServiceMock) GetItemInfo(ctx context.Context, skuID m
 					mmGetItemInfo.GetItemInfoMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
 			}
 
+			if mm_want_ptrs.offerID != nil && !minimock.Equal(*mm_want_ptrs.offerID, mm_got.offerID) {
+				mmGetItemInfo.t.Errorf("IStockServiceMock.GetItemInfo got unexpected parameter offerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemInfo.GetItemInfoMock.defaultExpectation.expectationOrigins.originOfferID, *mm_want_ptrs.offerID, mm_got.offerID, minimock.Diff(*mm_want_ptrs.offerID, mm_got.offerID))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetItemInfo.t.Errorf("IStockServiceMock.GetItemInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGetItemInfo.GetItemInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -311,9 +352,9 @@ func (mmGetItemInfo *IStockServiceMock) GetItemInfo(ctx context.Context, skuID m
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmGetItemInfo.funcGetItemInfo != nil {
-		return mmGetItemInfo.funcGetItemInfo(ctx, skuID)
+		return mmGetItemInfo.funcGetItemInfo(ctx, skuID, offerID)
 	}
-	mmGetItemInfo.t.Fatalf("Unexpected call to IStockServiceMock.GetItemInfo. %v %v", ctx, skuID)
+	mmGetItemInfo.t.Fatalf("Unexpected call to IStockServiceMock.GetItemInfo. %v %v %v", ctx, skuID, offerID)
 	return
 }
 
@@ -385,11 +426,356 @@ func (m *IStockServiceMock) MinimockGetItemInfoInspect() {
 	}
 }
 
+type mIStockServiceMockGetItemsInfo struct {
+	optional           bool
+	mock               *IStockServiceMock
+	defaultExpectation *IStockServiceMockGetItemsInfoExpectation
+	expectations       []*IStockServiceMockGetItemsInfoExpectation
+
+	callArgs []*IStockServiceMockGetItemsInfoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockServiceMockGetItemsInfoExpectation specifies expectation struct of the IStockService.GetItemsInfo
+type IStockServiceMockGetItemsInfoExpectation struct {
+	mock               *IStockServiceMock
+	params             *IStockServiceMockGetItemsInfoParams
+	paramPtrs          *IStockServiceMockGetItemsInfoParamPtrs
+	expectationOrigins IStockServiceMockGetItemsInfoExpectationOrigins
+	results            *IStockServiceMockGetItemsInfoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockServiceMockGetItemsInfoParams contains parameters of the IStockService.GetItemsInfo
+type IStockServiceMockGetItemsInfoParams struct {
+	ctx    context.Context
+	skuIDs []models.SKUID
+}
+
+// IStockServiceMockGetItemsInfoParamPtrs contains pointers to parameters of the IStockService.GetItemsInfo
+type IStockServiceMockGetItemsInfoParamPtrs struct {
+	ctx    *context.Context
+	skuIDs *[]models.SKUID
+}
+
+// IStockServiceMockGetItemsInfoResults contains results of the IStockService.GetItemsInfo
+type IStockServiceMockGetItemsInfoResults struct {
+	m1  map[models.SKUID]services.ItemDTO
+	err error
+}
+
+// IStockServiceMockGetItemsInfoOrigins contains origins of expectations of the IStockService.GetItemsInfo
+type IStockServiceMockGetItemsInfoExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Optional() *mIStockServiceMockGetItemsInfo {
+	mmGetItemsInfo.optional = true
+	return mmGetItemsInfo
+}
+
+// Expect sets up expected params for IStockService.GetItemsInfo
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Expect(ctx context.Context, skuIDs []models.SKUID) *mIStockServiceMockGetItemsInfo {
+	if mmGetItemsInfo.mock.funcGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Set")
+	}
+
+	if mmGetItemsInfo.defaultExpectation == nil {
+		mmGetItemsInfo.defaultExpectation = &IStockServiceMockGetItemsInfoExpectation{}
+	}
+
+	if mmGetItemsInfo.defaultExpectation.paramPtrs != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemsInfo.defaultExpectation.params = &IStockServiceMockGetItemsInfoParams{ctx, skuIDs}
+	mmGetItemsInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemsInfo.expectations {
+		if minimock.Equal(e.params, mmGetItemsInfo.defaultExpectation.params) {
+			mmGetItemsInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsInfo.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemsInfo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockService.GetItemsInfo
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) ExpectCtxParam1(ctx context.Context) *mIStockServiceMockGetItemsInfo {
+	if mmGetItemsInfo.mock.funcGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Set")
+	}
+
+	if mmGetItemsInfo.defaultExpectation == nil {
+		mmGetItemsInfo.defaultExpectation = &IStockServiceMockGetItemsInfoExpectation{}
+	}
+
+	if mmGetItemsInfo.defaultExpectation.params != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Expect")
+	}
+
+	if mmGetItemsInfo.defaultExpectation.paramPtrs == nil {
+		mmGetItemsInfo.defaultExpectation.paramPtrs = &IStockServiceMockGetItemsInfoParamPtrs{}
+	}
+	mmGetItemsInfo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemsInfo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemsInfo
+}
+
+// ExpectSkuIDsParam2 sets up expected param skuIDs for IStockService.GetItemsInfo
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) ExpectSkuIDsParam2(skuIDs []models.SKUID) *mIStockServiceMockGetItemsInfo {
+	if mmGetItemsInfo.mock.funcGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Set")
+	}
+
+	if mmGetItemsInfo.defaultExpectation == nil {
+		mmGetItemsInfo.defaultExpectation = &IStockServiceMockGetItemsInfoExpectation{}
+	}
+
+	if mmGetItemsInfo.defaultExpectation.params != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Expect")
+	}
+
+	if mmGetItemsInfo.defaultExpectation.paramPtrs == nil {
+		mmGetItemsInfo.defaultExpectation.paramPtrs = &IStockServiceMockGetItemsInfoParamPtrs{}
+	}
+	mmGetItemsInfo.defaultExpectation.paramPtrs.skuIDs = &skuIDs
+	mmGetItemsInfo.defaultExpectation.expectationOrigins.originSkuIDs = minimock.CallerInfo(1)
+
+	return mmGetItemsInfo
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockService.GetItemsInfo
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Inspect(f func(ctx context.Context, skuIDs []models.SKUID)) *mIStockServiceMockGetItemsInfo {
+	if mmGetItemsInfo.mock.inspectFuncGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("Inspect function is already set for IStockServiceMock.GetItemsInfo")
+	}
+
+	mmGetItemsInfo.mock.inspectFuncGetItemsInfo = f
+
+	return mmGetItemsInfo
+}
+
+// Return sets up results that will be returned by IStockService.GetItemsInfo
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Return(m1 map[models.SKUID]services.ItemDTO, err error) *IStockServiceMock {
+	if mmGetItemsInfo.mock.funcGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Set")
+	}
+
+	if mmGetItemsInfo.defaultExpectation == nil {
+		mmGetItemsInfo.defaultExpectation = &IStockServiceMockGetItemsInfoExpectation{mock: mmGetItemsInfo.mock}
+	}
+	mmGetItemsInfo.defaultExpectation.results = &IStockServiceMockGetItemsInfoResults{m1, err}
+	mmGetItemsInfo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemsInfo.mock
+}
+
+// Set uses given function f to mock the IStockService.GetItemsInfo method
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Set(f func(ctx context.Context, skuIDs []models.SKUID) (m1 map[models.SKUID]services.ItemDTO, err error)) *IStockServiceMock {
+	if mmGetItemsInfo.defaultExpectation != nil {
+		mmGetItemsInfo.mock.t.Fatalf("Default expectation is already set for the IStockService.GetItemsInfo method")
+	}
+
+	if len(mmGetItemsInfo.expectations) > 0 {
+		mmGetItemsInfo.mock.t.Fatalf("Some expectations are already set for the IStockService.GetItemsInfo method")
+	}
+
+	mmGetItemsInfo.mock.funcGetItemsInfo = f
+	mmGetItemsInfo.mock.funcGetItemsInfoOrigin = minimock.CallerInfo(1)
+	return mmGetItemsInfo.mock
+}
+
+// When sets expectation for the IStockService.GetItemsInfo which will trigger the result defined by the following
+// Then helper
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) When(ctx context.Context, skuIDs []models.SKUID) *IStockServiceMockGetItemsInfoExpectation {
+	if mmGetItemsInfo.mock.funcGetItemsInfo != nil {
+		mmGetItemsInfo.mock.t.Fatalf("IStockServiceMock.GetItemsInfo mock is already set by Set")
+	}
+
+	expectation := &IStockServiceMockGetItemsInfoExpectation{
+		mock:               mmGetItemsInfo.mock,
+		params:             &IStockServiceMockGetItemsInfoParams{ctx, skuIDs},
+		expectationOrigins: IStockServiceMockGetItemsInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemsInfo.expectations = append(mmGetItemsInfo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockService.GetItemsInfo return parameters for the expectation previously defined by the When method
+func (e *IStockServiceMockGetItemsInfoExpectation) Then(m1 map[models.SKUID]services.ItemDTO, err error) *IStockServiceMock {
+	e.results = &IStockServiceMockGetItemsInfoResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockService.GetItemsInfo should be invoked
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Times(n uint64) *mIStockServiceMockGetItemsInfo {
+	if n == 0 {
+		mmGetItemsInfo.mock.t.Fatalf("Times of IStockServiceMock.GetItemsInfo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemsInfo.expectedInvocations, n)
+	mmGetItemsInfo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsInfo
+}
+
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) invocationsDone() bool {
+	if len(mmGetItemsInfo.expectations) == 0 && mmGetItemsInfo.defaultExpectation == nil && mmGetItemsInfo.mock.funcGetItemsInfo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsInfo.mock.afterGetItemsInfoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsInfo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemsInfo implements mm_usecase.IStockService
+func (mmGetItemsInfo *IStockServiceMock) GetItemsInfo(ctx context.Context, skuIDs []models.SKUID) (m1 map[models.SKUID]services.ItemDTO, err error) {
+	mm_atomic.AddUint64(&mmGetItemsInfo.beforeGetItemsInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemsInfo.afterGetItemsInfoCounter, 1)
+
+	mmGetItemsInfo.t.Helper()
+
+	if mmGetItemsInfo.inspectFuncGetItemsInfo != nil {
+		mmGetItemsInfo.inspectFuncGetItemsInfo(ctx, skuIDs)
+	}
+
+	mm_params := IStockServiceMockGetItemsInfoParams{ctx, skuIDs}
+
+	// Record call args
+	mmGetItemsInfo.GetItemsInfoMock.mutex.Lock()
+	mmGetItemsInfo.GetItemsInfoMock.callArgs = append(mmGetItemsInfo.GetItemsInfoMock.callArgs, &mm_params)
+	mmGetItemsInfo.GetItemsInfoMock.mutex.Unlock()
+
+	for _, e := range mmGetItemsInfo.GetItemsInfoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetItemsInfo.GetItemsInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockServiceMockGetItemsInfoParams{ctx, skuIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemsInfo.t.Errorf("IStockServiceMock.GetItemsInfo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuIDs != nil && !minimock.Equal(*mm_want_ptrs.skuIDs, mm_got.skuIDs) {
+				mmGetItemsInfo.t.Errorf("IStockServiceMock.GetItemsInfo got unexpected parameter skuIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.expectationOrigins.originSkuIDs, *mm_want_ptrs.skuIDs, mm_got.skuIDs, minimock.Diff(*mm_want_ptrs.skuIDs, mm_got.skuIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemsInfo.t.Errorf("IStockServiceMock.GetItemsInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemsInfo.GetItemsInfoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemsInfo.t.Fatal("No results are set for the IStockServiceMock.GetItemsInfo")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetItemsInfo.funcGetItemsInfo != nil {
+		return mmGetItemsInfo.funcGetItemsInfo(ctx, skuIDs)
+	}
+	mmGetItemsInfo.t.Fatalf("Unexpected call to IStockServiceMock.GetItemsInfo. %v %v", ctx, skuIDs)
+	return
+}
+
+// GetItemsInfoAfterCounter returns a count of finished IStockServiceMock.GetItemsInfo invocations
+func (mmGetItemsInfo *IStockServiceMock) GetItemsInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsInfo.afterGetItemsInfoCounter)
+}
+
+// GetItemsInfoBeforeCounter returns a count of IStockServiceMock.GetItemsInfo invocations
+func (mmGetItemsInfo *IStockServiceMock) GetItemsInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsInfo.beforeGetItemsInfoCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockServiceMock.GetItemsInfo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemsInfo *mIStockServiceMockGetItemsInfo) Calls() []*IStockServiceMockGetItemsInfoParams {
+	mmGetItemsInfo.mutex.RLock()
+
+	argCopy := make([]*IStockServiceMockGetItemsInfoParams, len(mmGetItemsInfo.callArgs))
+	copy(argCopy, mmGetItemsInfo.callArgs)
+
+	mmGetItemsInfo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemsInfoDone returns true if the count of the GetItemsInfo invocations corresponds
+// the number of defined expectations
+func (m *IStockServiceMock) MinimockGetItemsInfoDone() bool {
+	if m.GetItemsInfoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemsInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemsInfoMock.invocationsDone()
+}
+
+// MinimockGetItemsInfoInspect logs each unmet expectation
+func (m *IStockServiceMock) MinimockGetItemsInfoInspect() {
+	for _, e := range m.GetItemsInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockServiceMock.GetItemsInfo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemsInfoCounter := mm_atomic.LoadUint64(&m.afterGetItemsInfoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemsInfoMock.defaultExpectation != nil && afterGetItemsInfoCounter < 1 {
+		if m.GetItemsInfoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockServiceMock.GetItemsInfo at\n%s", m.GetItemsInfoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockServiceMock.GetItemsInfo at\n%s with params: %#v", m.GetItemsInfoMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsInfoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemsInfo != nil && afterGetItemsInfoCounter < 1 {
+		m.t.Errorf("Expected call to IStockServiceMock.GetItemsInfo at\n%s", m.funcGetItemsInfoOrigin)
+	}
+
+	if !m.GetItemsInfoMock.invocationsDone() && afterGetItemsInfoCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockServiceMock.GetItemsInfo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemsInfoMock.expectedInvocations), m.GetItemsInfoMock.expectedInvocationsOrigin, afterGetItemsInfoCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *IStockServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockGetItemInfoInspect()
+
+			m.MinimockGetItemsInfoInspect()
 		}
 	})
 }
@@ -413,5 +799,6 @@ func (m *IStockServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *IStockServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetItemInfoDone()
+		m.MinimockGetItemInfoDone() &&
+		m.MinimockGetItemsInfoDone()
 }
@@ -7,6 +7,7 @@
 package cart
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,11 +24,127 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CartBulkOperationType int32
+
+const (
+	CartBulkOperationType_CART_BULK_OPERATION_TYPE_UNSPECIFIED CartBulkOperationType = 0
+	CartBulkOperationType_CART_BULK_OPERATION_TYPE_ADD         CartBulkOperationType = 1
+	CartBulkOperationType_CART_BULK_OPERATION_TYPE_SET         CartBulkOperationType = 2
+	CartBulkOperationType_CART_BULK_OPERATION_TYPE_DELETE      CartBulkOperationType = 3
+)
+
+// Enum value maps for CartBulkOperationType.
+var (
+	CartBulkOperationType_name = map[int32]string{
+		0: "CART_BULK_OPERATION_TYPE_UNSPECIFIED",
+		1: "CART_BULK_OPERATION_TYPE_ADD",
+		2: "CART_BULK_OPERATION_TYPE_SET",
+		3: "CART_BULK_OPERATION_TYPE_DELETE",
+	}
+	CartBulkOperationType_value = map[string]int32{
+		"CART_BULK_OPERATION_TYPE_UNSPECIFIED": 0,
+		"CART_BULK_OPERATION_TYPE_ADD":         1,
+		"CART_BULK_OPERATION_TYPE_SET":         2,
+		"CART_BULK_OPERATION_TYPE_DELETE":      3,
+	}
+)
+
+func (x CartBulkOperationType) Enum() *CartBulkOperationType {
+	p := new(CartBulkOperationType)
+	*p = x
+	return p
+}
+
+func (x CartBulkOperationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CartBulkOperationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cart_proto_enumTypes[0].Descriptor()
+}
+
+func (CartBulkOperationType) Type() protoreflect.EnumType {
+	return &file_cart_proto_enumTypes[0]
+}
+
+func (x CartBulkOperationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CartBulkOperationType.Descriptor instead.
+func (CartBulkOperationType) EnumDescriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{0}
+}
+
+type CartBulkOperationStatus int32
+
+const (
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_UNSPECIFIED      CartBulkOperationStatus = 0
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_OK               CartBulkOperationStatus = 1
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_NOT_FOUND        CartBulkOperationStatus = 2
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_NOT_ENOUGH_STOCK CartBulkOperationStatus = 3
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_INVALID_ARGUMENT CartBulkOperationStatus = 4
+	CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_ABORTED          CartBulkOperationStatus = 5
+)
+
+// Enum value maps for CartBulkOperationStatus.
+var (
+	CartBulkOperationStatus_name = map[int32]string{
+		0: "CART_BULK_OPERATION_STATUS_UNSPECIFIED",
+		1: "CART_BULK_OPERATION_STATUS_OK",
+		2: "CART_BULK_OPERATION_STATUS_NOT_FOUND",
+		3: "CART_BULK_OPERATION_STATUS_NOT_ENOUGH_STOCK",
+		4: "CART_BULK_OPERATION_STATUS_INVALID_ARGUMENT",
+		5: "CART_BULK_OPERATION_STATUS_ABORTED",
+	}
+	CartBulkOperationStatus_value = map[string]int32{
+		"CART_BULK_OPERATION_STATUS_UNSPECIFIED":      0,
+		"CART_BULK_OPERATION_STATUS_OK":               1,
+		"CART_BULK_OPERATION_STATUS_NOT_FOUND":        2,
+		"CART_BULK_OPERATION_STATUS_NOT_ENOUGH_STOCK": 3,
+		"CART_BULK_OPERATION_STATUS_INVALID_ARGUMENT": 4,
+		"CART_BULK_OPERATION_STATUS_ABORTED":          5,
+	}
+)
+
+func (x CartBulkOperationStatus) Enum() *CartBulkOperationStatus {
+	p := new(CartBulkOperationStatus)
+	*p = x
+	return p
+}
+
+func (x CartBulkOperationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CartBulkOperationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cart_proto_enumTypes[1].Descriptor()
+}
+
+func (CartBulkOperationStatus) Type() protoreflect.EnumType {
+	return &file_cart_proto_enumTypes[1]
+}
+
+func (x CartBulkOperationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CartBulkOperationStatus.Descriptor instead.
+func (CartBulkOperationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{1}
+}
+
 type CartAddItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId          int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku             uint32  `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count           uint32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	ExpectedVersion *uint64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// offer_id is the chosen offer of a seller of the SKU, by default the first offer of the SKU.
+	OfferId       int64 `protobuf:"varint,5,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -62,6 +179,7 @@ func (*CartAddItemRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in cart.proto.
 func (x *CartAddItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -83,12 +201,30 @@ func (x *CartAddItemRequest) GetCount() uint32 {
 	return 0
 }
 
+func (x *CartAddItemRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *CartAddItemRequest) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
 type CartDeleteItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId          int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku             uint32  `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ExpectedVersion *uint64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CartDeleteItemRequest) Reset() {
@@ -121,6 +257,7 @@ func (*CartDeleteItemRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{1}
 }
 
+// Deprecated: Marked as deprecated in cart.proto.
 func (x *CartDeleteItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -135,16 +272,82 @@ func (x *CartDeleteItemRequest) GetSku() uint32 {
 	return 0
 }
 
+func (x *CartDeleteItemRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type CartClearRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId          int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion *uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CartClearRequest) Reset() {
+	*x = CartClearRequest{}
+	mi := &file_cart_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartClearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartClearRequest) ProtoMessage() {}
+
+func (x *CartClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartClearRequest.ProtoReflect.Descriptor instead.
+func (*CartClearRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{2}
+}
+
+// Deprecated: Marked as deprecated in cart.proto.
+func (x *CartClearRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartClearRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type CartUserIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartUserIDRequest) Reset() {
 	*x = CartUserIDRequest{}
-	mi := &file_cart_proto_msgTypes[2]
+	mi := &file_cart_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +359,7 @@ func (x *CartUserIDRequest) String() string {
 func (*CartUserIDRequest) ProtoMessage() {}
 
 func (x *CartUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[2]
+	mi := &file_cart_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,9 +372,10 @@ func (x *CartUserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartUserIDRequest.ProtoReflect.Descriptor instead.
 func (*CartUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{2}
+	return file_cart_proto_rawDescGZIP(), []int{3}
 }
 
+// Deprecated: Marked as deprecated in cart.proto.
 func (x *CartUserIDRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -183,13 +387,14 @@ type CartListItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	TotalPrice    uint32                 `protobuf:"varint,2,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Version       uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartListItemResponse) Reset() {
 	*x = CartListItemResponse{}
-	mi := &file_cart_proto_msgTypes[3]
+	mi := &file_cart_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +406,7 @@ func (x *CartListItemResponse) String() string {
 func (*CartListItemResponse) ProtoMessage() {}
 
 func (x *CartListItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[3]
+	mi := &file_cart_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +419,7 @@ func (x *CartListItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartListItemResponse.ProtoReflect.Descriptor instead.
 func (*CartListItemResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{3}
+	return file_cart_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CartListItemResponse) GetItems() []*CartItem {
@@ -231,19 +436,28 @@ func (x *CartListItemResponse) GetTotalPrice() uint32 {
 	return 0
 }
 
+func (x *CartListItemResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
 	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	OfferId       int64                  `protobuf:"varint,5,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	SellerId      int64                  `protobuf:"varint,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_cart_proto_msgTypes[4]
+	mi := &file_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +469,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[4]
+	mi := &file_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +482,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{4}
+	return file_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CartItem) GetSku() uint32 {
@@ -299,38 +513,565 @@ func (x *CartItem) GetPrice() uint32 {
 	return 0
 }
 
+func (x *CartItem) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *CartItem) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+type CartMoveItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId          int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku             uint32  `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ExpectedVersion *uint64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CartMoveItemRequest) Reset() {
+	*x = CartMoveItemRequest{}
+	mi := &file_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartMoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartMoveItemRequest) ProtoMessage() {}
+
+func (x *CartMoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartMoveItemRequest.ProtoReflect.Descriptor instead.
+func (*CartMoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{6}
+}
+
+// Deprecated: Marked as deprecated in cart.proto.
+func (x *CartMoveItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartMoveItemRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartMoveItemRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type CartWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CartWishlistItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartWishlistResponse) Reset() {
+	*x = CartWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartWishlistResponse) ProtoMessage() {}
+
+func (x *CartWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartWishlistResponse.ProtoReflect.Descriptor instead.
+func (*CartWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CartWishlistResponse) GetItems() []*CartWishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CartWishlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Available     uint32                 `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	InStock       bool                   `protobuf:"varint,6,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	OfferId       int64                  `protobuf:"varint,7,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartWishlistItem) Reset() {
+	*x = CartWishlistItem{}
+	mi := &file_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartWishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartWishlistItem) ProtoMessage() {}
+
+func (x *CartWishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartWishlistItem.ProtoReflect.Descriptor instead.
+func (*CartWishlistItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CartWishlistItem) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartWishlistItem) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CartWishlistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartWishlistItem) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartWishlistItem) GetAvailable() uint32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *CartWishlistItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *CartWishlistItem) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type CartBulkUpdateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it.
+	//
+	// Deprecated: Marked as deprecated in cart.proto.
+	UserId          int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Operations      []*CartBulkOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	ExpectedVersion *uint64              `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CartBulkUpdateRequest) Reset() {
+	*x = CartBulkUpdateRequest{}
+	mi := &file_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBulkUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBulkUpdateRequest) ProtoMessage() {}
+
+func (x *CartBulkUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBulkUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CartBulkUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{9}
+}
+
+// Deprecated: Marked as deprecated in cart.proto.
+func (x *CartBulkUpdateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartBulkUpdateRequest) GetOperations() []*CartBulkOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *CartBulkUpdateRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type CartBulkOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  CartBulkOperationType  `protobuf:"varint,1,opt,name=type,proto3,enum=api.CartBulkOperationType" json:"type,omitempty"`
+	Sku   uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// count of 0 or an unspecified type fails the operation, not the request.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// offer_id of 0 keeps the offer of the cart line, or takes the default offer for a new line.
+	// An add to a line of another offer fails the operation.
+	OfferId       int64 `protobuf:"varint,4,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBulkOperation) Reset() {
+	*x = CartBulkOperation{}
+	mi := &file_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBulkOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBulkOperation) ProtoMessage() {}
+
+func (x *CartBulkOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBulkOperation.ProtoReflect.Descriptor instead.
+func (*CartBulkOperation) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CartBulkOperation) GetType() CartBulkOperationType {
+	if x != nil {
+		return x.Type
+	}
+	return CartBulkOperationType_CART_BULK_OPERATION_TYPE_UNSPECIFIED
+}
+
+func (x *CartBulkOperation) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartBulkOperation) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CartBulkOperation) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type CartBulkUpdateResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Applied       bool                       `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Results       []*CartBulkOperationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBulkUpdateResponse) Reset() {
+	*x = CartBulkUpdateResponse{}
+	mi := &file_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBulkUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBulkUpdateResponse) ProtoMessage() {}
+
+func (x *CartBulkUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBulkUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CartBulkUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CartBulkUpdateResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *CartBulkUpdateResponse) GetResults() []*CartBulkOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CartBulkOperationResult struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sku           uint32                  `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Status        CartBulkOperationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.CartBulkOperationStatus" json:"status,omitempty"`
+	Error         string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBulkOperationResult) Reset() {
+	*x = CartBulkOperationResult{}
+	mi := &file_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBulkOperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBulkOperationResult) ProtoMessage() {}
+
+func (x *CartBulkOperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBulkOperationResult.ProtoReflect.Descriptor instead.
+func (*CartBulkOperationResult) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CartBulkOperationResult) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartBulkOperationResult) GetStatus() CartBulkOperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CartBulkOperationStatus_CART_BULK_OPERATION_STATUS_UNSPECIFIED
+}
+
+func (x *CartBulkOperationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_cart_proto protoreflect.FileDescriptor
 
 const file_cart_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"cart.proto\x12\x03api\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"U\n" +
-	"\x12CartAddItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
-	"\x03sku\x18\x02 \x01(\rR\x03sku\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\"B\n" +
-	"\x15CartDeleteItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
-	"\x03sku\x18\x02 \x01(\rR\x03sku\",\n" +
-	"\x11CartUserIDRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"[\n" +
+	"cart.proto\x12\x03api\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd8\x01\n" +
+	"\x12CartAddItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12!\n" +
+	"\x05count\x18\x03 \x01(\rB\v\xbaH\b*\x06\x18\xff\xff\x03 \x00R\x05count\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\"\n" +
+	"\boffer_id\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aofferIdB\x13\n" +
+	"\x11_expected_version\"\x94\x01\n" +
+	"\x15CartDeleteItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"t\n" +
+	"\x10CartClearRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"0\n" +
+	"\x11CartUserIDRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\"u\n" +
 	"\x14CartListItemResponse\x12#\n" +
 	"\x05items\x18\x01 \x03(\v2\r.api.CartItemR\x05items\x12\x1e\n" +
 	"\n" +
 	"totalPrice\x18\x02 \x01(\rR\n" +
-	"totalPrice\"\\\n" +
+	"totalPrice\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\x94\x01\n" +
 	"\bCartItem\x12\x10\n" +
 	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\rR\x05count\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\rR\x05price2\xef\x02\n" +
-	"\vCartService\x12U\n" +
-	"\aAddItem\x12\x17.api.CartAddItemRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/cart/item/add\x12^\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12\x19\n" +
+	"\boffer_id\x18\x05 \x01(\x03R\aofferId\x12\x1b\n" +
+	"\tseller_id\x18\x06 \x01(\x03R\bsellerId\"\x92\x01\n" +
+	"\x13CartMoveItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"C\n" +
+	"\x14CartWishlistResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.api.CartWishlistItemR\x05items\"\xb8\x01\n" +
+	"\x10CartWishlistItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\rR\tavailable\x12\x19\n" +
+	"\bin_stock\x18\x06 \x01(\bR\ainStock\x12\x19\n" +
+	"\boffer_id\x18\a \x01(\x03R\aofferId\"\xbd\x01\n" +
+	"\x15CartBulkUpdateRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12B\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\v2\x16.api.CartBulkOperationB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\n" +
+	"operations\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\xa3\x01\n" +
+	"\x11CartBulkOperation\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.api.CartBulkOperationTypeR\x04type\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\x1f\n" +
+	"\x05count\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\xff\xff\x03R\x05count\x12\"\n" +
+	"\boffer_id\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aofferId\"j\n" +
+	"\x16CartBulkUpdateResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x126\n" +
+	"\aresults\x18\x02 \x03(\v2\x1c.api.CartBulkOperationResultR\aresults\"w\n" +
+	"\x17CartBulkOperationResult\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\rR\x03sku\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.api.CartBulkOperationStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error*\xaa\x01\n" +
+	"\x15CartBulkOperationType\x12(\n" +
+	"$CART_BULK_OPERATION_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCART_BULK_OPERATION_TYPE_ADD\x10\x01\x12 \n" +
+	"\x1cCART_BULK_OPERATION_TYPE_SET\x10\x02\x12#\n" +
+	"\x1fCART_BULK_OPERATION_TYPE_DELETE\x10\x03*\x9c\x02\n" +
+	"\x17CartBulkOperationStatus\x12*\n" +
+	"&CART_BULK_OPERATION_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCART_BULK_OPERATION_STATUS_OK\x10\x01\x12(\n" +
+	"$CART_BULK_OPERATION_STATUS_NOT_FOUND\x10\x02\x12/\n" +
+	"+CART_BULK_OPERATION_STATUS_NOT_ENOUGH_STOCK\x10\x03\x12/\n" +
+	"+CART_BULK_OPERATION_STATUS_INVALID_ARGUMENT\x10\x04\x12&\n" +
+	"\"CART_BULK_OPERATION_STATUS_ABORTED\x10\x052\xc0\b\n" +
+	"\vCartService\x12z\n" +
+	"\aAddItem\x12\x17.api.CartAddItemRequest\x1a\x16.google.protobuf.Empty\">\x82\xd3\xe4\x93\x028:\x01*Z#:\x01*\"\x1e/v2/users/{user_id}/cart/items\"\x0e/cart/item/add\x12\x86\x01\n" +
+	"\n" +
+	"DeleteItem\x12\x1a.api.CartDeleteItemRequest\x1a\x16.google.protobuf.Empty\"D\x82\xd3\xe4\x93\x02>:\x01*Z&*$/v2/users/{user_id}/cart/items/{sku}\"\x11/cart/item/delete\x12p\n" +
+	"\bListItem\x12\x16.api.CartUserIDRequest\x1a\x19.api.CartListItemResponse\"1\x82\xd3\xe4\x93\x02+:\x01*Z\x1a\x12\x18/v2/users/{user_id}/cart\"\n" +
+	"/cart/list\x12n\n" +
+	"\tClearCart\x12\x15.api.CartClearRequest\x1a\x16.google.protobuf.Empty\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x1a*\x18/v2/users/{user_id}/cart\"\v/cart/clear\x12\x9c\x01\n" +
+	"\x0eMoveToWishlist\x12\x18.api.CartMoveItemRequest\x1a\x16.google.protobuf.Empty\"X\x82\xd3\xe4\x93\x02R:\x01*Z8:\x01*\"3/v2/users/{user_id}/cart/items/{sku}:moveToWishlist\"\x13/cart/item/wishlist\x12\x9d\x01\n" +
+	"\n" +
+	"MoveToCart\x12\x18.api.CartMoveItemRequest\x1a\x16.google.protobuf.Empty\"]\x82\xd3\xe4\x93\x02W:\x01*Z8:\x01*\"3/v2/users/{user_id}/wishlist/items/{sku}:moveToCart\"\x18/cart/wishlist/item/cart\x12\x81\x01\n" +
+	"\fListWishlist\x12\x16.api.CartUserIDRequest\x1a\x19.api.CartWishlistResponse\">\x82\xd3\xe4\x93\x028:\x01*Z\x1e\x12\x1c/v2/users/{user_id}/wishlist\"\x13/cart/wishlist/list\x12\x86\x01\n" +
 	"\n" +
-	"DeleteItem\x12\x1a.api.CartDeleteItemRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/cart/item/delete\x12T\n" +
-	"\bListItem\x12\x16.api.CartUserIDRequest\x1a\x19.api.CartListItemResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/cart/list\x12S\n" +
-	"\tClearCart\x12\x16.api.CartUserIDRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/cart/clearB?Z=github.com/just-umyt/homework_all-just-umyt/cart/pkg/api/cartb\x06proto3"
+	"BulkUpdate\x12\x1a.api.CartBulkUpdateRequest\x1a\x1b.api.CartBulkUpdateResponse\"?\x82\xd3\xe4\x93\x029:\x01*Z(:\x01*\"#/v2/users/{user_id}/cart:bulkUpdate\"\n" +
+	"/cart/bulkB?Z=github.com/just-umyt/homework_all-just-umyt/cart/pkg/api/cartb\x06proto3"
 
 var (
 	file_cart_proto_rawDescOnce sync.Once
@@ -344,30 +1085,54 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cart_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cart_proto_goTypes = []any{
-	(*CartAddItemRequest)(nil),    // 0: api.CartAddItemRequest
-	(*CartDeleteItemRequest)(nil), // 1: api.CartDeleteItemRequest
-	(*CartUserIDRequest)(nil),     // 2: api.CartUserIDRequest
-	(*CartListItemResponse)(nil),  // 3: api.CartListItemResponse
-	(*CartItem)(nil),              // 4: api.CartItem
-	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+	(CartBulkOperationType)(0),      // 0: api.CartBulkOperationType
+	(CartBulkOperationStatus)(0),    // 1: api.CartBulkOperationStatus
+	(*CartAddItemRequest)(nil),      // 2: api.CartAddItemRequest
+	(*CartDeleteItemRequest)(nil),   // 3: api.CartDeleteItemRequest
+	(*CartClearRequest)(nil),        // 4: api.CartClearRequest
+	(*CartUserIDRequest)(nil),       // 5: api.CartUserIDRequest
+	(*CartListItemResponse)(nil),    // 6: api.CartListItemResponse
+	(*CartItem)(nil),                // 7: api.CartItem
+	(*CartMoveItemRequest)(nil),     // 8: api.CartMoveItemRequest
+	(*CartWishlistResponse)(nil),    // 9: api.CartWishlistResponse
+	(*CartWishlistItem)(nil),        // 10: api.CartWishlistItem
+	(*CartBulkUpdateRequest)(nil),   // 11: api.CartBulkUpdateRequest
+	(*CartBulkOperation)(nil),       // 12: api.CartBulkOperation
+	(*CartBulkUpdateResponse)(nil),  // 13: api.CartBulkUpdateResponse
+	(*CartBulkOperationResult)(nil), // 14: api.CartBulkOperationResult
+	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
 }
 var file_cart_proto_depIdxs = []int32{
-	4, // 0: api.CartListItemResponse.items:type_name -> api.CartItem
-	0, // 1: api.CartService.AddItem:input_type -> api.CartAddItemRequest
-	1, // 2: api.CartService.DeleteItem:input_type -> api.CartDeleteItemRequest
-	2, // 3: api.CartService.ListItem:input_type -> api.CartUserIDRequest
-	2, // 4: api.CartService.ClearCart:input_type -> api.CartUserIDRequest
-	5, // 5: api.CartService.AddItem:output_type -> google.protobuf.Empty
-	5, // 6: api.CartService.DeleteItem:output_type -> google.protobuf.Empty
-	3, // 7: api.CartService.ListItem:output_type -> api.CartListItemResponse
-	5, // 8: api.CartService.ClearCart:output_type -> google.protobuf.Empty
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: api.CartListItemResponse.items:type_name -> api.CartItem
+	10, // 1: api.CartWishlistResponse.items:type_name -> api.CartWishlistItem
+	12, // 2: api.CartBulkUpdateRequest.operations:type_name -> api.CartBulkOperation
+	0,  // 3: api.CartBulkOperation.type:type_name -> api.CartBulkOperationType
+	14, // 4: api.CartBulkUpdateResponse.results:type_name -> api.CartBulkOperationResult
+	1,  // 5: api.CartBulkOperationResult.status:type_name -> api.CartBulkOperationStatus
+	2,  // 6: api.CartService.AddItem:input_type -> api.CartAddItemRequest
+	3,  // 7: api.CartService.DeleteItem:input_type -> api.CartDeleteItemRequest
+	5,  // 8: api.CartService.ListItem:input_type -> api.CartUserIDRequest
+	4,  // 9: api.CartService.ClearCart:input_type -> api.CartClearRequest
+	8,  // 10: api.CartService.MoveToWishlist:input_type -> api.CartMoveItemRequest
+	8,  // 11: api.CartService.MoveToCart:input_type -> api.CartMoveItemRequest
+	5,  // 12: api.CartService.ListWishlist:input_type -> api.CartUserIDRequest
+	11, // 13: api.CartService.BulkUpdate:input_type -> api.CartBulkUpdateRequest
+	15, // 14: api.CartService.AddItem:output_type -> google.protobuf.Empty
+	15, // 15: api.CartService.DeleteItem:output_type -> google.protobuf.Empty
+	6,  // 16: api.CartService.ListItem:output_type -> api.CartListItemResponse
+	15, // 17: api.CartService.ClearCart:output_type -> google.protobuf.Empty
+	15, // 18: api.CartService.MoveToWishlist:output_type -> google.protobuf.Empty
+	15, // 19: api.CartService.MoveToCart:output_type -> google.protobuf.Empty
+	9,  // 20: api.CartService.ListWishlist:output_type -> api.CartWishlistResponse
+	13, // 21: api.CartService.BulkUpdate:output_type -> api.CartBulkUpdateResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -375,18 +1140,24 @@ func file_cart_proto_init() {
 	if File_cart_proto != nil {
 		return
 	}
+	file_cart_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cart_proto_msgTypes[1].OneofWrappers = []any{}
+	file_cart_proto_msgTypes[2].OneofWrappers = []any{}
+	file_cart_proto_msgTypes[6].OneofWrappers = []any{}
+	file_cart_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cart_proto_goTypes,
 		DependencyIndexes: file_cart_proto_depIdxs,
+		EnumInfos:         file_cart_proto_enumTypes,
 		MessageInfos:      file_cart_proto_msgTypes,
 	}.Build()
 	File_cart_proto = out.File
@@ -20,10 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_AddItem_FullMethodName    = "/api.CartService/AddItem"
-	CartService_DeleteItem_FullMethodName = "/api.CartService/DeleteItem"
-	CartService_ListItem_FullMethodName   = "/api.CartService/ListItem"
-	CartService_ClearCart_FullMethodName  = "/api.CartService/ClearCart"
+	CartService_AddItem_FullMethodName        = "/api.CartService/AddItem"
+	CartService_DeleteItem_FullMethodName     = "/api.CartService/DeleteItem"
+	CartService_ListItem_FullMethodName       = "/api.CartService/ListItem"
+	CartService_ClearCart_FullMethodName      = "/api.CartService/ClearCart"
+	CartService_MoveToWishlist_FullMethodName = "/api.CartService/MoveToWishlist"
+	CartService_MoveToCart_FullMethodName     = "/api.CartService/MoveToCart"
+	CartService_ListWishlist_FullMethodName   = "/api.CartService/ListWishlist"
+	CartService_BulkUpdate_FullMethodName     = "/api.CartService/BulkUpdate"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -33,7 +37,11 @@ type CartServiceClient interface {
 	AddItem(ctx context.Context, in *CartAddItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteItem(ctx context.Context, in *CartDeleteItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListItem(ctx context.Context, in *CartUserIDRequest, opts ...grpc.CallOption) (*CartListItemResponse, error)
-	ClearCart(ctx context.Context, in *CartUserIDRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ClearCart(ctx context.Context, in *CartClearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveToWishlist(ctx context.Context, in *CartMoveItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveToCart(ctx context.Context, in *CartMoveItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWishlist(ctx context.Context, in *CartUserIDRequest, opts ...grpc.CallOption) (*CartWishlistResponse, error)
+	BulkUpdate(ctx context.Context, in *CartBulkUpdateRequest, opts ...grpc.CallOption) (*CartBulkUpdateResponse, error)
 }
 
 type cartServiceClient struct {
@@ -74,7 +82,7 @@ func (c *cartServiceClient) ListItem(ctx context.Context, in *CartUserIDRequest,
 	return out, nil
 }
 
-func (c *cartServiceClient) ClearCart(ctx context.Context, in *CartUserIDRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *cartServiceClient) ClearCart(ctx context.Context, in *CartClearRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, CartService_ClearCart_FullMethodName, in, out, cOpts...)
@@ -84,6 +92,46 @@ func (c *cartServiceClient) ClearCart(ctx context.Context, in *CartUserIDRequest
 	return out, nil
 }
 
+func (c *cartServiceClient) MoveToWishlist(ctx context.Context, in *CartMoveItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CartService_MoveToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MoveToCart(ctx context.Context, in *CartMoveItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CartService_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListWishlist(ctx context.Context, in *CartUserIDRequest, opts ...grpc.CallOption) (*CartWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartWishlistResponse)
+	err := c.cc.Invoke(ctx, CartService_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) BulkUpdate(ctx context.Context, in *CartBulkUpdateRequest, opts ...grpc.CallOption) (*CartBulkUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartBulkUpdateResponse)
+	err := c.cc.Invoke(ctx, CartService_BulkUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -91,7 +139,11 @@ type CartServiceServer interface {
 	AddItem(context.Context, *CartAddItemRequest) (*emptypb.Empty, error)
 	DeleteItem(context.Context, *CartDeleteItemRequest) (*emptypb.Empty, error)
 	ListItem(context.Context, *CartUserIDRequest) (*CartListItemResponse, error)
-	ClearCart(context.Context, *CartUserIDRequest) (*emptypb.Empty, error)
+	ClearCart(context.Context, *CartClearRequest) (*emptypb.Empty, error)
+	MoveToWishlist(context.Context, *CartMoveItemRequest) (*emptypb.Empty, error)
+	MoveToCart(context.Context, *CartMoveItemRequest) (*emptypb.Empty, error)
+	ListWishlist(context.Context, *CartUserIDRequest) (*CartWishlistResponse, error)
+	BulkUpdate(context.Context, *CartBulkUpdateRequest) (*CartBulkUpdateResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -111,9 +163,21 @@ func (UnimplementedCartServiceServer) DeleteItem(context.Context, *CartDeleteIte
 func (UnimplementedCartServiceServer) ListItem(context.Context, *CartUserIDRequest) (*CartListItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListItem not implemented")
 }
-func (UnimplementedCartServiceServer) ClearCart(context.Context, *CartUserIDRequest) (*emptypb.Empty, error) {
+func (UnimplementedCartServiceServer) ClearCart(context.Context, *CartClearRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
 }
+func (UnimplementedCartServiceServer) MoveToWishlist(context.Context, *CartMoveItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToWishlist not implemented")
+}
+func (UnimplementedCartServiceServer) MoveToCart(context.Context, *CartMoveItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCartServiceServer) ListWishlist(context.Context, *CartUserIDRequest) (*CartWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedCartServiceServer) BulkUpdate(context.Context, *CartBulkUpdateRequest) (*CartBulkUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdate not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -190,7 +254,7 @@ func _CartService_ListItem_Handler(srv interface{}, ctx context.Context, dec fun
 }
 
 func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CartUserIDRequest)
+	in := new(CartClearRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -202,7 +266,79 @@ func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: CartService_ClearCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CartServiceServer).ClearCart(ctx, req.(*CartUserIDRequest))
+		return srv.(CartServiceServer).ClearCart(ctx, req.(*CartClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartMoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToWishlist(ctx, req.(*CartMoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartMoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToCart(ctx, req.(*CartMoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListWishlist(ctx, req.(*CartUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_BulkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartBulkUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).BulkUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_BulkUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).BulkUpdate(ctx, req.(*CartBulkUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -230,6 +366,22 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearCart",
 			Handler:    _CartService_ClearCart_Handler,
 		},
+		{
+			MethodName: "MoveToWishlist",
+			Handler:    _CartService_MoveToWishlist_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _CartService_MoveToCart_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _CartService_ListWishlist_Handler,
+		},
+		{
+			MethodName: "BulkUpdate",
+			Handler:    _CartService_BulkUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart.proto",
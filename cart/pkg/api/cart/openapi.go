@@ -0,0 +1,8 @@
+package cart
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI v2 document of the gateway routes, generated from cart.proto by make protoc.
+//
+//go:embed cart.swagger.json
+var OpenAPI []byte
@@ -7,10 +7,13 @@
 package stock
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,13 +26,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StockSearchSort int32
+
+const (
+	// STOCK_SEARCH_SORT_UNSPECIFIED sorts by relevance.
+	StockSearchSort_STOCK_SEARCH_SORT_UNSPECIFIED StockSearchSort = 0
+	StockSearchSort_STOCK_SEARCH_SORT_RELEVANCE   StockSearchSort = 1
+	StockSearchSort_STOCK_SEARCH_SORT_PRICE       StockSearchSort = 2
+	StockSearchSort_STOCK_SEARCH_SORT_NAME        StockSearchSort = 3
+	StockSearchSort_STOCK_SEARCH_SORT_COUNT       StockSearchSort = 4
+)
+
+// Enum value maps for StockSearchSort.
+var (
+	StockSearchSort_name = map[int32]string{
+		0: "STOCK_SEARCH_SORT_UNSPECIFIED",
+		1: "STOCK_SEARCH_SORT_RELEVANCE",
+		2: "STOCK_SEARCH_SORT_PRICE",
+		3: "STOCK_SEARCH_SORT_NAME",
+		4: "STOCK_SEARCH_SORT_COUNT",
+	}
+	StockSearchSort_value = map[string]int32{
+		"STOCK_SEARCH_SORT_UNSPECIFIED": 0,
+		"STOCK_SEARCH_SORT_RELEVANCE":   1,
+		"STOCK_SEARCH_SORT_PRICE":       2,
+		"STOCK_SEARCH_SORT_NAME":        3,
+		"STOCK_SEARCH_SORT_COUNT":       4,
+	}
+)
+
+func (x StockSearchSort) Enum() *StockSearchSort {
+	p := new(StockSearchSort)
+	*p = x
+	return p
+}
+
+func (x StockSearchSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockSearchSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_stock_proto_enumTypes[0].Descriptor()
+}
+
+func (StockSearchSort) Type() protoreflect.EnumType {
+	return &file_stock_proto_enumTypes[0]
+}
+
+func (x StockSearchSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockSearchSort.Descriptor instead.
+func (StockSearchSort) EnumDescriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{0}
+}
+
+type StockPriceSource int32
+
+const (
+	StockPriceSource_STOCK_PRICE_SOURCE_UNSPECIFIED StockPriceSource = 0
+	// INITIAL is the price the stock had when the history was started.
+	StockPriceSource_STOCK_PRICE_SOURCE_INITIAL  StockPriceSource = 1
+	StockPriceSource_STOCK_PRICE_SOURCE_UPDATE   StockPriceSource = 2
+	StockPriceSource_STOCK_PRICE_SOURCE_IMPORT   StockPriceSource = 3
+	StockPriceSource_STOCK_PRICE_SOURCE_SCHEDULE StockPriceSource = 4
+)
+
+// Enum value maps for StockPriceSource.
+var (
+	StockPriceSource_name = map[int32]string{
+		0: "STOCK_PRICE_SOURCE_UNSPECIFIED",
+		1: "STOCK_PRICE_SOURCE_INITIAL",
+		2: "STOCK_PRICE_SOURCE_UPDATE",
+		3: "STOCK_PRICE_SOURCE_IMPORT",
+		4: "STOCK_PRICE_SOURCE_SCHEDULE",
+	}
+	StockPriceSource_value = map[string]int32{
+		"STOCK_PRICE_SOURCE_UNSPECIFIED": 0,
+		"STOCK_PRICE_SOURCE_INITIAL":     1,
+		"STOCK_PRICE_SOURCE_UPDATE":      2,
+		"STOCK_PRICE_SOURCE_IMPORT":      3,
+		"STOCK_PRICE_SOURCE_SCHEDULE":    4,
+	}
+)
+
+func (x StockPriceSource) Enum() *StockPriceSource {
+	p := new(StockPriceSource)
+	*p = x
+	return p
+}
+
+func (x StockPriceSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockPriceSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_stock_proto_enumTypes[1].Descriptor()
+}
+
+func (StockPriceSource) Type() protoreflect.EnumType {
+	return &file_stock_proto_enumTypes[1]
+}
+
+func (x StockPriceSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockPriceSource.Descriptor instead.
+func (StockPriceSource) EnumDescriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{1}
+}
+
 type StockAddItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku           uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count         uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Price         uint32 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Location      string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -64,6 +182,7 @@ func (*StockAddItemRequest) Descriptor() ([]byte, []int) {
 	return file_stock_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in stock.proto.
 func (x *StockAddItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -100,9 +219,12 @@ func (x *StockAddItemRequest) GetLocation() string {
 }
 
 type StockDeleteItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku           uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -137,6 +259,7 @@ func (*StockDeleteItemRequest) Descriptor() ([]byte, []int) {
 	return file_stock_proto_rawDescGZIP(), []int{1}
 }
 
+// Deprecated: Marked as deprecated in stock.proto.
 func (x *StockDeleteItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -151,19 +274,166 @@ func (x *StockDeleteItemRequest) GetSku() uint32 {
 	return 0
 }
 
+type StockRestoreItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku           uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockRestoreItemRequest) Reset() {
+	*x = StockRestoreItemRequest{}
+	mi := &file_stock_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockRestoreItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockRestoreItemRequest) ProtoMessage() {}
+
+func (x *StockRestoreItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockRestoreItemRequest.ProtoReflect.Descriptor instead.
+func (*StockRestoreItemRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{2}
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockRestoreItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockRestoreItemRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type StockTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sku           uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	FromLocation  string `protobuf:"bytes,3,opt,name=from_location,json=fromLocation,proto3" json:"from_location,omitempty"`
+	ToLocation    string `protobuf:"bytes,4,opt,name=to_location,json=toLocation,proto3" json:"to_location,omitempty"`
+	Count         uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockTransferRequest) Reset() {
+	*x = StockTransferRequest{}
+	mi := &file_stock_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockTransferRequest) ProtoMessage() {}
+
+func (x *StockTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockTransferRequest.ProtoReflect.Descriptor instead.
+func (*StockTransferRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{3}
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockTransferRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockTransferRequest) GetFromLocation() string {
+	if x != nil {
+		return x.FromLocation
+	}
+	return ""
+}
+
+func (x *StockTransferRequest) GetToLocation() string {
+	if x != nil {
+		return x.ToLocation
+	}
+	return ""
+}
+
+func (x *StockTransferRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type StockListItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	PageSize      int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CurrentPage   int64                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// page_size must be from 1 to 100.
+	PageSize int64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// current_page is only used without page_token, prefer page_token.
+	CurrentPage int64 `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	// category_id also matches the items of its subcategories.
+	CategoryId int64 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// attributes match the items containing all given attribute values.
+	Attributes *structpb.Struct `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// page_token is the next_page_token of the previous page.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StockListItemRequest) Reset() {
 	*x = StockListItemRequest{}
-	mi := &file_stock_proto_msgTypes[2]
+	mi := &file_stock_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +445,7 @@ func (x *StockListItemRequest) String() string {
 func (*StockListItemRequest) ProtoMessage() {}
 
 func (x *StockListItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[2]
+	mi := &file_stock_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,9 +458,10 @@ func (x *StockListItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockListItemRequest.ProtoReflect.Descriptor instead.
 func (*StockListItemRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{2}
+	return file_stock_proto_rawDescGZIP(), []int{4}
 }
 
+// Deprecated: Marked as deprecated in stock.proto.
 func (x *StockListItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
@@ -219,16 +490,39 @@ func (x *StockListItemRequest) GetCurrentPage() int64 {
 	return 0
 }
 
+func (x *StockListItemRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *StockListItemRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StockListItemRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type StockGetItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// offer_id selects the offer of a seller, by default the first offer of the SKU is returned.
+	OfferId       int64 `protobuf:"varint,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StockGetItemRequest) Reset() {
 	*x = StockGetItemRequest{}
-	mi := &file_stock_proto_msgTypes[3]
+	mi := &file_stock_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +534,7 @@ func (x *StockGetItemRequest) String() string {
 func (*StockGetItemRequest) ProtoMessage() {}
 
 func (x *StockGetItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[3]
+	mi := &file_stock_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +547,7 @@ func (x *StockGetItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockGetItemRequest.ProtoReflect.Descriptor instead.
 func (*StockGetItemRequest) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{3}
+	return file_stock_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StockGetItemRequest) GetSku() uint32 {
@@ -263,18 +557,72 @@ func (x *StockGetItemRequest) GetSku() uint32 {
 	return 0
 }
 
-type StockListItemResponse struct {
+func (x *StockGetItemRequest) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type StockGetItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	PageNumber    int64                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	Skus          []uint32               `protobuf:"varint,1,rep,packed,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockGetItemsRequest) Reset() {
+	*x = StockGetItemsRequest{}
+	mi := &file_stock_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockGetItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockGetItemsRequest) ProtoMessage() {}
+
+func (x *StockGetItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockGetItemsRequest.ProtoReflect.Descriptor instead.
+func (*StockGetItemsRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StockGetItemsRequest) GetSkus() []uint32 {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type StockListItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// total_count is the number of all items matching the filters.
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageNumber int64 `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	// next_page_token is empty on the last page.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StockListItemResponse) Reset() {
 	*x = StockListItemResponse{}
-	mi := &file_stock_proto_msgTypes[4]
+	mi := &file_stock_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +634,7 @@ func (x *StockListItemResponse) String() string {
 func (*StockListItemResponse) ProtoMessage() {}
 
 func (x *StockListItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[4]
+	mi := &file_stock_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +647,7 @@ func (x *StockListItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockListItemResponse.ProtoReflect.Descriptor instead.
 func (*StockListItemResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{4}
+	return file_stock_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StockListItemResponse) GetItems() []*StockItemResponse {
@@ -323,22 +671,37 @@ func (x *StockListItemResponse) GetPageNumber() int64 {
 	return 0
 }
 
+func (x *StockListItemResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type StockItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint32                 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	UserId        int64                  `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the name of the category, use category instead.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	Type        string           `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Count       uint32           `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Price       uint32           `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Location    string           `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	UserId      int64            `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Description string           `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Category    *StockCategory   `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	Attributes  *structpb.Struct `protobuf:"bytes,10,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// offer_id is the ID of the offer of the seller user_id.
+	OfferId       int64 `protobuf:"varint,11,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StockItemResponse) Reset() {
 	*x = StockItemResponse{}
-	mi := &file_stock_proto_msgTypes[5]
+	mi := &file_stock_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +713,7 @@ func (x *StockItemResponse) String() string {
 func (*StockItemResponse) ProtoMessage() {}
 
 func (x *StockItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stock_proto_msgTypes[5]
+	mi := &file_stock_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +726,7 @@ func (x *StockItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockItemResponse.ProtoReflect.Descriptor instead.
 func (*StockItemResponse) Descriptor() ([]byte, []int) {
-	return file_stock_proto_rawDescGZIP(), []int{5}
+	return file_stock_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StockItemResponse) GetSku() uint32 {
@@ -380,6 +743,7 @@ func (x *StockItemResponse) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in stock.proto.
 func (x *StockItemResponse) GetType() string {
 	if x != nil {
 		return x.Type
@@ -415,47 +779,2254 @@ func (x *StockItemResponse) GetUserId() int64 {
 	return 0
 }
 
-var File_stock_proto protoreflect.FileDescriptor
+func (x *StockItemResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
 
-const file_stock_proto_rawDesc = "" +
-	"\n" +
-	"\vstock.proto\x12\x03api\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x88\x01\n" +
-	"\x13StockAddItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
-	"\x03sku\x18\x02 \x01(\rR\x03sku\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\rR\x05price\x12\x1a\n" +
-	"\blocation\x18\x05 \x01(\tR\blocation\"C\n" +
-	"\x16StockDeleteItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x10\n" +
-	"\x03sku\x18\x02 \x01(\rR\x03sku\"\x8b\x01\n" +
-	"\x14StockListItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
-	"\blocation\x18\x02 \x01(\tR\blocation\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12!\n" +
-	"\fcurrent_page\x18\x04 \x01(\x03R\vcurrentPage\"'\n" +
-	"\x13StockGetItemRequest\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\rR\x03sku\"\x87\x01\n" +
-	"\x15StockListItemResponse\x12,\n" +
-	"\x05items\x18\x01 \x03(\v2\x16.api.StockItemResponseR\x05items\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\x12\x1f\n" +
-	"\vpage_number\x18\x03 \x01(\x03R\n" +
-	"pageNumber\"\xae\x01\n" +
-	"\x11StockItemResponse\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\rR\x05count\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\rR\x05price\x12\x1a\n" +
-	"\blocation\x18\x06 \x01(\tR\blocation\x12\x17\n" +
-	"\auser_id\x18\a \x01(\x03R\x06userId2\xfc\x02\n" +
-	"\fStockService\x12X\n" +
-	"\aAddItem\x12\x18.api.StockAddItemRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/item/add\x12a\n" +
+func (x *StockItemResponse) GetCategory() *StockCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type StockGetItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockGetItemsResponse) Reset() {
+	*x = StockGetItemsResponse{}
+	mi := &file_stock_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockGetItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockGetItemsResponse) ProtoMessage() {}
+
+func (x *StockGetItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockGetItemsResponse.ProtoReflect.Descriptor instead.
+func (*StockGetItemsResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StockGetItemsResponse) GetItems() []*StockItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StockCreateSKURequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockCreateSKURequest) Reset() {
+	*x = StockCreateSKURequest{}
+	mi := &file_stock_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockCreateSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockCreateSKURequest) ProtoMessage() {}
+
+func (x *StockCreateSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockCreateSKURequest.ProtoReflect.Descriptor instead.
+func (*StockCreateSKURequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StockCreateSKURequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StockCreateSKURequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StockCreateSKURequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *StockCreateSKURequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type StockUpdateSKURequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Sku         uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CategoryId  *int64                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// attributes replace all attributes of the sku if set.
+	Attributes    *structpb.Struct `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockUpdateSKURequest) Reset() {
+	*x = StockUpdateSKURequest{}
+	mi := &file_stock_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockUpdateSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockUpdateSKURequest) ProtoMessage() {}
+
+func (x *StockUpdateSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockUpdateSKURequest.ProtoReflect.Descriptor instead.
+func (*StockUpdateSKURequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StockUpdateSKURequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockUpdateSKURequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *StockUpdateSKURequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *StockUpdateSKURequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *StockUpdateSKURequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type StockSKURequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSKURequest) Reset() {
+	*x = StockSKURequest{}
+	mi := &file_stock_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSKURequest) ProtoMessage() {}
+
+func (x *StockSKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSKURequest.ProtoReflect.Descriptor instead.
+func (*StockSKURequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StockSKURequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type StockListSKUsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	PageSize        int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage     int64                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	CategoryId      int64                  `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockListSKUsRequest) Reset() {
+	*x = StockListSKUsRequest{}
+	mi := &file_stock_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListSKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListSKUsRequest) ProtoMessage() {}
+
+func (x *StockListSKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListSKUsRequest.ProtoReflect.Descriptor instead.
+func (*StockListSKUsRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StockListSKUsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *StockListSKUsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StockListSKUsRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *StockListSKUsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type StockSKUResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Category      *StockCategory         `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSKUResponse) Reset() {
+	*x = StockSKUResponse{}
+	mi := &file_stock_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSKUResponse) ProtoMessage() {}
+
+func (x *StockSKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSKUResponse.ProtoReflect.Descriptor instead.
+func (*StockSKUResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StockSKUResponse) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockSKUResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StockSKUResponse) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *StockSKUResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StockSKUResponse) GetCategory() *StockCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *StockSKUResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type StockListSKUsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []*StockSKUResponse    `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListSKUsResponse) Reset() {
+	*x = StockListSKUsResponse{}
+	mi := &file_stock_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListSKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListSKUsResponse) ProtoMessage() {}
+
+func (x *StockListSKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListSKUsResponse.ProtoReflect.Descriptor instead.
+func (*StockListSKUsResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StockListSKUsResponse) GetSkus() []*StockSKUResponse {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *StockListSKUsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *StockListSKUsResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type StockCategory struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// attribute_schema maps the attribute names to their type: string, number or boolean.
+	AttributeSchema map[string]string `protobuf:"bytes,4,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockCategory) Reset() {
+	*x = StockCategory{}
+	mi := &file_stock_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockCategory) ProtoMessage() {}
+
+func (x *StockCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockCategory.ProtoReflect.Descriptor instead.
+func (*StockCategory) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StockCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockCategory) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *StockCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StockCategory) GetAttributeSchema() map[string]string {
+	if x != nil {
+		return x.AttributeSchema
+	}
+	return nil
+}
+
+type StockCreateCategoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParentId        int64                  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AttributeSchema map[string]string      `protobuf:"bytes,3,rep,name=attribute_schema,json=attributeSchema,proto3" json:"attribute_schema,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockCreateCategoryRequest) Reset() {
+	*x = StockCreateCategoryRequest{}
+	mi := &file_stock_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockCreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockCreateCategoryRequest) ProtoMessage() {}
+
+func (x *StockCreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockCreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*StockCreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StockCreateCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *StockCreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StockCreateCategoryRequest) GetAttributeSchema() map[string]string {
+	if x != nil {
+		return x.AttributeSchema
+	}
+	return nil
+}
+
+type StockListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*StockCategory       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListCategoriesResponse) Reset() {
+	*x = StockListCategoriesResponse{}
+	mi := &file_stock_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListCategoriesResponse) ProtoMessage() {}
+
+func (x *StockListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*StockListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StockListCategoriesResponse) GetCategories() []*StockCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type StockSearchItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query matches the words of the name and description by prefix.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// category_id also matches the items of its subcategories.
+	CategoryId int64 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// type matches the category name.
+	Type        string          `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MinPrice    *uint32         `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice    *uint32         `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	MinCount    *uint32         `protobuf:"varint,6,opt,name=min_count,json=minCount,proto3,oneof" json:"min_count,omitempty"`
+	MaxCount    *uint32         `protobuf:"varint,7,opt,name=max_count,json=maxCount,proto3,oneof" json:"max_count,omitempty"`
+	InStockOnly bool            `protobuf:"varint,8,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	Location    string          `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Sort        StockSearchSort `protobuf:"varint,10,opt,name=sort,proto3,enum=api.StockSearchSort" json:"sort,omitempty"`
+	Descending  bool            `protobuf:"varint,11,opt,name=descending,proto3" json:"descending,omitempty"`
+	// the results are paginated by current_page only, next_page_token of the response is never set.
+	PageSize      int64 `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64 `protobuf:"varint,13,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSearchItemsRequest) Reset() {
+	*x = StockSearchItemsRequest{}
+	mi := &file_stock_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSearchItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSearchItemsRequest) ProtoMessage() {}
+
+func (x *StockSearchItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSearchItemsRequest.ProtoReflect.Descriptor instead.
+func (*StockSearchItemsRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StockSearchItemsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *StockSearchItemsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockSearchItemsRequest) GetMinPrice() uint32 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetMaxPrice() uint32 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetMinCount() uint32 {
+	if x != nil && x.MinCount != nil {
+		return *x.MinCount
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetMaxCount() uint32 {
+	if x != nil && x.MaxCount != nil {
+		return *x.MaxCount
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *StockSearchItemsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockSearchItemsRequest) GetSort() StockSearchSort {
+	if x != nil {
+		return x.Sort
+	}
+	return StockSearchSort_STOCK_SEARCH_SORT_UNSPECIFIED
+}
+
+func (x *StockSearchItemsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *StockSearchItemsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StockSearchItemsRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type StockImportRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line is the line of the row in the imported file, used in the report.
+	Line int64  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Sku  uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Count         uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Price         uint32 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Location      string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockImportRow) Reset() {
+	*x = StockImportRow{}
+	mi := &file_stock_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockImportRow) ProtoMessage() {}
+
+func (x *StockImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockImportRow.ProtoReflect.Descriptor instead.
+func (*StockImportRow) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StockImportRow) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *StockImportRow) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockImportRow) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockImportRow) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StockImportRow) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockImportRow) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type StockImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run validates the rows without applying them, it must be the same in every message.
+	DryRun        bool              `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Rows          []*StockImportRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockImportRequest) Reset() {
+	*x = StockImportRequest{}
+	mi := &file_stock_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockImportRequest) ProtoMessage() {}
+
+func (x *StockImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockImportRequest.ProtoReflect.Descriptor instead.
+func (*StockImportRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StockImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *StockImportRequest) GetRows() []*StockImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type StockImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockImportRowError) Reset() {
+	*x = StockImportRowError{}
+	mi := &file_stock_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockImportRowError) ProtoMessage() {}
+
+func (x *StockImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockImportRowError.ProtoReflect.Descriptor instead.
+func (*StockImportRowError) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StockImportRowError) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *StockImportRowError) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StockImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int64                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ImportedRows  int64                  `protobuf:"varint,2,opt,name=imported_rows,json=importedRows,proto3" json:"imported_rows,omitempty"`
+	FailedRows    int64                  `protobuf:"varint,3,opt,name=failed_rows,json=failedRows,proto3" json:"failed_rows,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Errors        []*StockImportRowError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockImportResponse) Reset() {
+	*x = StockImportResponse{}
+	mi := &file_stock_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockImportResponse) ProtoMessage() {}
+
+func (x *StockImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockImportResponse.ProtoReflect.Descriptor instead.
+func (*StockImportResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StockImportResponse) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *StockImportResponse) GetImportedRows() int64 {
+	if x != nil {
+		return x.ImportedRows
+	}
+	return 0
+}
+
+func (x *StockImportResponse) GetFailedRows() int64 {
+	if x != nil {
+		return x.FailedRows
+	}
+	return 0
+}
+
+func (x *StockImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *StockImportResponse) GetErrors() []*StockImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StockSetThresholdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// location of the threshold, an empty location sets it for all locations of the SKU.
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// threshold of 0 removes the threshold.
+	Threshold     uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSetThresholdRequest) Reset() {
+	*x = StockSetThresholdRequest{}
+	mi := &file_stock_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSetThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSetThresholdRequest) ProtoMessage() {}
+
+func (x *StockSetThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSetThresholdRequest.ProtoReflect.Descriptor instead.
+func (*StockSetThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StockSetThresholdRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockSetThresholdRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockSetThresholdRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type StockListLowStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location      string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize      int64  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListLowStockRequest) Reset() {
+	*x = StockListLowStockRequest{}
+	mi := &file_stock_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListLowStockRequest) ProtoMessage() {}
+
+func (x *StockListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*StockListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{25}
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockListLowStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockListLowStockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockListLowStockRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StockListLowStockRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type StockLowStockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *StockItemResponse     `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Threshold     uint32                 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLowStockItem) Reset() {
+	*x = StockLowStockItem{}
+	mi := &file_stock_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLowStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLowStockItem) ProtoMessage() {}
+
+func (x *StockLowStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLowStockItem.ProtoReflect.Descriptor instead.
+func (*StockLowStockItem) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StockLowStockItem) GetItem() *StockItemResponse {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *StockLowStockItem) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type StockListLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockLowStockItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListLowStockResponse) Reset() {
+	*x = StockListLowStockResponse{}
+	mi := &file_stock_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListLowStockResponse) ProtoMessage() {}
+
+func (x *StockListLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListLowStockResponse.ProtoReflect.Descriptor instead.
+func (*StockListLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StockListLowStockResponse) GetItems() []*StockLowStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockListLowStockResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type StockSchedulePriceChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// price of all stock of the SKU of the seller user_id from effective_at on.
+	Price         uint32                 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSchedulePriceChangeRequest) Reset() {
+	*x = StockSchedulePriceChangeRequest{}
+	mi := &file_stock_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *StockSchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*StockSchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StockSchedulePriceChangeRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockSchedulePriceChangeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockSchedulePriceChangeRequest) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockSchedulePriceChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+type StockScheduledPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price         uint32                 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockScheduledPrice) Reset() {
+	*x = StockScheduledPrice{}
+	mi := &file_stock_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockScheduledPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockScheduledPrice) ProtoMessage() {}
+
+func (x *StockScheduledPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockScheduledPrice.ProtoReflect.Descriptor instead.
+func (*StockScheduledPrice) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StockScheduledPrice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockScheduledPrice) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockScheduledPrice) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockScheduledPrice) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *StockScheduledPrice) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type StockGetPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	// location filters the changes, an empty location returns the changes of all locations.
+	Location      string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize      int64  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockGetPriceHistoryRequest) Reset() {
+	*x = StockGetPriceHistoryRequest{}
+	mi := &file_stock_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockGetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockGetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *StockGetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockGetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*StockGetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StockGetPriceHistoryRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockGetPriceHistoryRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockGetPriceHistoryRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StockGetPriceHistoryRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type StockPriceChange struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sku      uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Location string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// old_price of a new stock is 0.
+	OldPrice      uint32                 `protobuf:"varint,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Source        StockPriceSource       `protobuf:"varint,5,opt,name=source,proto3,enum=api.StockPriceSource" json:"source,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	UserId        int64                  `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockPriceChange) Reset() {
+	*x = StockPriceChange{}
+	mi := &file_stock_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockPriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockPriceChange) ProtoMessage() {}
+
+func (x *StockPriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockPriceChange.ProtoReflect.Descriptor instead.
+func (*StockPriceChange) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StockPriceChange) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockPriceChange) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockPriceChange) GetOldPrice() uint32 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *StockPriceChange) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockPriceChange) GetSource() StockPriceSource {
+	if x != nil {
+		return x.Source
+	}
+	return StockPriceSource_STOCK_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (x *StockPriceChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *StockPriceChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type StockGetPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes are ordered from the latest.
+	Changes []*StockPriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// scheduled are the changes that are not applied yet.
+	Scheduled     []*StockScheduledPrice `protobuf:"bytes,2,rep,name=scheduled,proto3" json:"scheduled,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockGetPriceHistoryResponse) Reset() {
+	*x = StockGetPriceHistoryResponse{}
+	mi := &file_stock_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockGetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockGetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *StockGetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockGetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StockGetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StockGetPriceHistoryResponse) GetChanges() []*StockPriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *StockGetPriceHistoryResponse) GetScheduled() []*StockScheduledPrice {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *StockGetPriceHistoryResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type StockListOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint32                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	InStockOnly   bool                   `protobuf:"varint,2,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListOffersRequest) Reset() {
+	*x = StockListOffersRequest{}
+	mi := &file_stock_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListOffersRequest) ProtoMessage() {}
+
+func (x *StockListOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListOffersRequest.ProtoReflect.Descriptor instead.
+func (*StockListOffersRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StockListOffersRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockListOffersRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+type StockSeller struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockSeller) Reset() {
+	*x = StockSeller{}
+	mi := &file_stock_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSeller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSeller) ProtoMessage() {}
+
+func (x *StockSeller) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSeller.ProtoReflect.Descriptor instead.
+func (*StockSeller) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StockSeller) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockSeller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StockOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       int64                  `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	Sku           uint32                 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Seller        *StockSeller           `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Count         uint32                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockOffer) Reset() {
+	*x = StockOffer{}
+	mi := &file_stock_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockOffer) ProtoMessage() {}
+
+func (x *StockOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockOffer.ProtoReflect.Descriptor instead.
+func (*StockOffer) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StockOffer) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *StockOffer) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockOffer) GetSeller() *StockSeller {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *StockOffer) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockOffer) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StockOffer) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type StockListOffersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// offers are ordered from the cheapest.
+	Offers        []*StockOffer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockListOffersResponse) Reset() {
+	*x = StockListOffersResponse{}
+	mi := &file_stock_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockListOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockListOffersResponse) ProtoMessage() {}
+
+func (x *StockListOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockListOffersResponse.ProtoReflect.Descriptor instead.
+func (*StockListOffersResponse) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StockListOffersResponse) GetOffers() []*StockOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type StockRegisterSellerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id is taken from the token of the request, it must be unset or match it unless the token is an admin token.
+	//
+	// Deprecated: Marked as deprecated in stock.proto.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockRegisterSellerRequest) Reset() {
+	*x = StockRegisterSellerRequest{}
+	mi := &file_stock_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockRegisterSellerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockRegisterSellerRequest) ProtoMessage() {}
+
+func (x *StockRegisterSellerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stock_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockRegisterSellerRequest.ProtoReflect.Descriptor instead.
+func (*StockRegisterSellerRequest) Descriptor() ([]byte, []int) {
+	return file_stock_proto_rawDescGZIP(), []int{37}
+}
+
+// Deprecated: Marked as deprecated in stock.proto.
+func (x *StockRegisterSellerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockRegisterSellerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_stock_proto protoreflect.FileDescriptor
+
+const file_stock_proto_rawDesc = "" +
+	"\n" +
+	"\vstock.proto\x12\x03api\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x01\n" +
+	"\x13StockAddItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12!\n" +
+	"\x05count\x18\x03 \x01(\rB\v\xbaH\b*\x06\x18\xff\xff\x03 \x00R\x05count\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12#\n" +
+	"\blocation\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\blocation\"P\n" +
+	"\x16StockDeleteItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\"Q\n" +
+	"\x17StockRestoreItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\"\xc9\x01\n" +
+	"\x14StockTransferRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x19\n" +
+	"\x03sku\x18\x02 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12,\n" +
+	"\rfrom_location\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\ffromLocation\x12(\n" +
+	"\vto_location\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"toLocation\x12!\n" +
+	"\x05count\x18\x05 \x01(\rB\v\xbaH\b*\x06\x18\xff\xff\x03 \x00R\x05count\"\xa5\x02\n" +
+	"\x14StockListItemRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12#\n" +
+	"\blocation\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\blocation\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x01R\bpageSize\x12*\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vcurrentPage\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"T\n" +
+	"\x13StockGetItemRequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\"\n" +
+	"\boffer_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\aofferId\":\n" +
+	"\x14StockGetItemsRequest\x12\"\n" +
+	"\x04skus\x18\x01 \x03(\rB\x0e\xbaH\v\x92\x01\b\b\x01\"\x04*\x02 \x00R\x04skus\"\xaf\x01\n" +
+	"\x15StockListItemResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.api.StockItemResponseR\x05items\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x03R\n" +
+	"pageNumber\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xd8\x02\n" +
+	"\x11StockItemResponse\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x04type\x18\x03 \x01(\tB\x02\x18\x01R\x04type\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\rR\x05count\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\rR\x05price\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x17\n" +
+	"\auser_id\x18\a \x01(\x03R\x06userId\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12.\n" +
+	"\bcategory\x18\t \x01(\v2\x12.api.StockCategoryR\bcategory\x127\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x19\n" +
+	"\boffer_id\x18\v \x01(\x03R\aofferId\"E\n" +
+	"\x15StockGetItemsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.api.StockItemResponseR\x05items\"\xbc\x01\n" +
+	"\x15StockCreateSKURequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x127\n" +
+	"\n" +
+	"attributes\x18\x05 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesJ\x04\b\x02\x10\x03R\x04type\"\x86\x02\n" +
+	"\x15StockUpdateSKURequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x127\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_category_idJ\x04\b\x03\x10\x04R\x04type\",\n" +
+	"\x0fStockSKURequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\"\xc2\x01\n" +
+	"\x14StockListSKUsRequest\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x01R\bpageSize\x12*\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01R\vcurrentPage\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\x03R\n" +
+	"categoryIdJ\x04\b\x01\x10\x02R\x04type\"\xeb\x01\n" +
+	"\x10StockSKUResponse\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12.\n" +
+	"\bcategory\x18\x06 \x01(\v2\x12.api.StockCategoryR\bcategory\x127\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributesJ\x04\b\x03\x10\x04R\x04type\"\x84\x01\n" +
+	"\x15StockListSKUsResponse\x12)\n" +
+	"\x04skus\x18\x01 \x03(\v2\x15.api.StockSKUResponseR\x04skus\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x03R\n" +
+	"pageNumber\"\xe8\x01\n" +
+	"\rStockCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12R\n" +
+	"\x10attribute_schema\x18\x04 \x03(\v2'.api.StockCategory.AttributeSchemaEntryR\x0fattributeSchema\x1aB\n" +
+	"\x14AttributeSchemaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfb\x01\n" +
+	"\x1aStockCreateCategoryRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x03R\bparentId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12_\n" +
+	"\x10attribute_schema\x18\x03 \x03(\v24.api.StockCreateCategoryRequest.AttributeSchemaEntryR\x0fattributeSchema\x1aB\n" +
+	"\x14AttributeSchemaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x1bStockListCategoriesResponse\x122\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x12.api.StockCategoryR\n" +
+	"categories\"\x82\x04\n" +
+	"\x17StockSearchItemsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\tmin_price\x18\x04 \x01(\rH\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x05 \x01(\rH\x01R\bmaxPrice\x88\x01\x01\x12 \n" +
+	"\tmin_count\x18\x06 \x01(\rH\x02R\bminCount\x88\x01\x01\x12 \n" +
+	"\tmax_count\x18\a \x01(\rH\x03R\bmaxCount\x88\x01\x01\x12\"\n" +
+	"\rin_stock_only\x18\b \x01(\bR\vinStockOnly\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x12(\n" +
+	"\x04sort\x18\n" +
+	" \x01(\x0e2\x14.api.StockSearchSortR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\v \x01(\bR\n" +
+	"descending\x12&\n" +
+	"\tpage_size\x18\f \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x01R\bpageSize\x12*\n" +
+	"\fcurrent_page\x18\r \x01(\x03B\a\xbaH\x04\"\x02(\x01R\vcurrentPageB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\f\n" +
+	"\n" +
+	"_min_countB\f\n" +
+	"\n" +
+	"_max_count\"\x9b\x01\n" +
+	"\x0eStockImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\rR\x03sku\x12\x1b\n" +
+	"\auser_id\x18\x03 \x01(\x03B\x02\x18\x01R\x06userId\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\rR\x05count\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\rR\x05price\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\"V\n" +
+	"\x12StockImportRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12'\n" +
+	"\x04rows\x18\x02 \x03(\v2\x13.api.StockImportRowR\x04rows\"Q\n" +
+	"\x13StockImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\rR\x03sku\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc5\x01\n" +
+	"\x13StockImportResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x03R\ttotalRows\x12#\n" +
+	"\rimported_rows\x18\x02 \x01(\x03R\fimportedRows\x12\x1f\n" +
+	"\vfailed_rows\x18\x03 \x01(\x03R\n" +
+	"failedRows\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x120\n" +
+	"\x06errors\x18\x05 \x03(\v2\x18.api.StockImportRowErrorR\x06errors\"z\n" +
+	"\x18StockSetThresholdRequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12'\n" +
+	"\tthreshold\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\xff\xff\x03R\tthreshold\"\xa7\x01\n" +
+	"\x18StockListLowStockRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x01R\bpageSize\x12*\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01R\vcurrentPage\"]\n" +
+	"\x11StockLowStockItem\x12*\n" +
+	"\x04item\x18\x01 \x01(\v2\x16.api.StockItemResponseR\x04item\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\rR\tthreshold\"j\n" +
+	"\x19StockListLowStockResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.api.StockLowStockItemR\x05items\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\x03R\n" +
+	"pageNumber\"\xb6\x01\n" +
+	"\x1fStockSchedulePriceChangeRequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\x1b\n" +
+	"\auser_id\x18\x02 \x01(\x03B\x02\x18\x01R\x06userId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\rR\x05price\x12E\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\veffectiveAt\"\xa5\x01\n" +
+	"\x13StockScheduledPrice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\rR\x03sku\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\rR\x05price\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\"\xa8\x01\n" +
+	"\x1bStockGetPriceHistoryRequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x01R\bpageSize\x12*\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01R\vcurrentPage\"\xf6\x01\n" +
+	"\x10StockPriceChange\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\rR\x03sku\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x1b\n" +
+	"\told_price\x18\x03 \x01(\rR\boldPrice\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12-\n" +
+	"\x06source\x18\x05 \x01(\x0e2\x15.api.StockPriceSourceR\x06source\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x17\n" +
+	"\auser_id\x18\a \x01(\x03R\x06userId\"\xa8\x01\n" +
+	"\x1cStockGetPriceHistoryResponse\x12/\n" +
+	"\achanges\x18\x01 \x03(\v2\x15.api.StockPriceChangeR\achanges\x126\n" +
+	"\tscheduled\x18\x02 \x03(\v2\x18.api.StockScheduledPriceR\tscheduled\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x03R\n" +
+	"pageNumber\"W\n" +
+	"\x16StockListOffersRequest\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03sku\x12\"\n" +
+	"\rin_stock_only\x18\x02 \x01(\bR\vinStockOnly\"1\n" +
+	"\vStockSeller\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xab\x01\n" +
+	"\n" +
+	"StockOffer\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\x03R\aofferId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\rR\x03sku\x12(\n" +
+	"\x06seller\x18\x03 \x01(\v2\x10.api.StockSellerR\x06seller\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\rR\x05price\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\rR\x05count\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\"B\n" +
+	"\x17StockListOffersResponse\x12'\n" +
+	"\x06offers\x18\x01 \x03(\v2\x0f.api.StockOfferR\x06offers\"V\n" +
+	"\x1aStockRegisterSellerRequest\x12\x1b\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x02\x18\x01R\x06userId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name*\xab\x01\n" +
+	"\x0fStockSearchSort\x12!\n" +
+	"\x1dSTOCK_SEARCH_SORT_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSTOCK_SEARCH_SORT_RELEVANCE\x10\x01\x12\x1b\n" +
+	"\x17STOCK_SEARCH_SORT_PRICE\x10\x02\x12\x1a\n" +
+	"\x16STOCK_SEARCH_SORT_NAME\x10\x03\x12\x1b\n" +
+	"\x17STOCK_SEARCH_SORT_COUNT\x10\x04*\xb5\x01\n" +
+	"\x10StockPriceSource\x12\"\n" +
+	"\x1eSTOCK_PRICE_SOURCE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSTOCK_PRICE_SOURCE_INITIAL\x10\x01\x12\x1d\n" +
+	"\x19STOCK_PRICE_SOURCE_UPDATE\x10\x02\x12\x1d\n" +
+	"\x19STOCK_PRICE_SOURCE_IMPORT\x10\x03\x12\x1f\n" +
+	"\x1bSTOCK_PRICE_SOURCE_SCHEDULE\x10\x042\xd9\x15\n" +
+	"\fStockService\x12h\n" +
+	"\aAddItem\x12\x18.api.StockAddItemRequest\x1a\x16.google.protobuf.Empty\"+\x82\xd3\xe4\x93\x02%:\x01*Z\x0e:\x01*\"\t/v2/items\"\x10/stocks/item/add\x12t\n" +
+	"\n" +
+	"DeleteItem\x12\x1b.api.StockDeleteItemRequest\x1a\x16.google.protobuf.Empty\"1\x82\xd3\xe4\x93\x02+:\x01*Z\x11*\x0f/v2/items/{sku}\"\x13/stocks/item/delete\x12\x82\x01\n" +
+	"\vRestoreItem\x12\x1c.api.StockRestoreItemRequest\x1a\x16.google.protobuf.Empty\"=\x82\xd3\xe4\x93\x027:\x01*Z\x1c:\x01*\"\x17/v2/items/{sku}:restore\"\x14/stocks/item/restore\x12\x83\x01\n" +
+	"\rTransferStock\x12\x19.api.StockTransferRequest\x1a\x16.google.protobuf.Empty\"?\x82\xd3\xe4\x93\x029:\x01*Z\x1d:\x01*\"\x18/v2/items/{sku}:transfer\"\x15/stocks/item/transfer\x12g\n" +
+	"\bListItem\x12\x19.api.StockListItemRequest\x1a\x1a.api.StockListItemResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*Z\v\x12\t/v2/items\"\f/stocks/list\x12f\n" +
+	"\aGetItem\x12\x18.api.StockGetItemRequest\x1a\x16.api.StockItemResponse\")\x82\xd3\xe4\x93\x02#:\x01*Z\x11\x12\x0f/v2/items/{sku}\"\v/stocks/get\x12u\n" +
+	"\bGetItems\x12\x19.api.StockGetItemsRequest\x1a\x1a.api.StockGetItemsResponse\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x14\x12\x12/v2/items:batchGet\"\x11/stocks/get/batch\x12l\n" +
+	"\tCreateSKU\x12\x1a.api.StockCreateSKURequest\x1a\x15.api.StockSKUResponse\",\x82\xd3\xe4\x93\x02&:\x01*Z\r:\x01*\"\b/v2/skus\"\x12/stocks/sku/create\x12r\n" +
+	"\tUpdateSKU\x12\x1a.api.StockUpdateSKURequest\x1a\x15.api.StockSKUResponse\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x13:\x01*2\x0e/v2/skus/{sku}\"\x12/stocks/sku/update\x12w\n" +
+	"\n" +
+	"ArchiveSKU\x12\x14.api.StockSKURequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025:\x01*Z\x1b:\x01*\"\x16/v2/skus/{sku}:archive\"\x13/stocks/sku/archive\x12c\n" +
+	"\x06GetSKU\x12\x14.api.StockSKURequest\x1a\x15.api.StockSKUResponse\",\x82\xd3\xe4\x93\x02&:\x01*Z\x10\x12\x0e/v2/skus/{sku}\"\x0f/stocks/sku/get\x12j\n" +
+	"\bListSKUs\x12\x19.api.StockListSKUsRequest\x1a\x1a.api.StockListSKUsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*Z\n" +
+	"\x12\b/v2/skus\"\x10/stocks/sku/list\x12~\n" +
+	"\x0eCreateCategory\x12\x1f.api.StockCreateCategoryRequest\x1a\x12.api.StockCategory\"7\x82\xd3\xe4\x93\x021:\x01*Z\x13:\x01*\"\x0e/v2/categories\"\x17/stocks/category/create\x12~\n" +
+	"\x0eListCategories\x12\x16.google.protobuf.Empty\x1a .api.StockListCategoriesResponse\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x10\x12\x0e/v2/categories\"\x15/stocks/category/list\x12v\n" +
+	"\vSearchItems\x12\x1c.api.StockSearchItemsRequest\x1a\x1a.api.StockListItemResponse\"-\x82\xd3\xe4\x93\x02':\x01*Z\x12\x12\x10/v2/items:search\"\x0e/stocks/search\x12\x86\x01\n" +
+	"\fSetThreshold\x12\x1d.api.StockSetThresholdRequest\x1a\x16.google.protobuf.Empty\"?\x82\xd3\xe4\x93\x029:\x01*Z\x1d:\x01*\x1a\x18/v2/skus/{sku}/threshold\"\x15/stocks/threshold/set\x12\x85\x01\n" +
+	"\fListLowStock\x12\x1d.api.StockListLowStockRequest\x1a\x1e.api.StockListLowStockResponse\"6\x82\xd3\xe4\x93\x020:\x01*Z\x14\x12\x12/v2/items:lowStock\"\x15/stocks/threshold/low\x12\x9e\x01\n" +
+	"\x13SchedulePriceChange\x12$.api.StockSchedulePriceChangeRequest\x1a\x18.api.StockScheduledPrice\"G\x82\xd3\xe4\x93\x02A:\x01*Z$:\x01*\"\x1f/v2/skus/{sku}/scheduled-prices\"\x16/stocks/price/schedule\x12\x91\x01\n" +
+	"\x0fGetPriceHistory\x12 .api.StockGetPriceHistoryRequest\x1a!.api.StockGetPriceHistoryResponse\"9\x82\xd3\xe4\x93\x023:\x01*Z\x17\x12\x15/v2/skus/{sku}/prices\"\x15/stocks/price/history\x12{\n" +
 	"\n" +
-	"DeleteItem\x12\x1b.api.StockDeleteItemRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stocks/item/delete\x12Z\n" +
-	"\bListItem\x12\x19.api.StockListItemRequest\x1a\x1a.api.StockListItemResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/stocks/list\x12S\n" +
-	"\aGetItem\x12\x18.api.StockGetItemRequest\x1a\x16.api.StockItemResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/stocks/getB\x0fZ\rpkg/api/stockb\x06proto3"
+	"ListOffers\x12\x1b.api.StockListOffersRequest\x1a\x1c.api.StockListOffersResponse\"2\x82\xd3\xe4\x93\x02,:\x01*Z\x17\x12\x15/v2/skus/{sku}/offers\"\x0e/stocks/offers\x12y\n" +
+	"\x0eRegisterSeller\x12\x1f.api.StockRegisterSellerRequest\x1a\x10.api.StockSeller\"4\x82\xd3\xe4\x93\x02.:\x01*Z\x10:\x01*\"\v/v2/sellers\"\x17/stocks/seller/register\x12D\n" +
+	"\vImportStock\x12\x17.api.StockImportRequest\x1a\x18.api.StockImportResponse\"\x00(\x01\x12n\n" +
+	"\vExportStock\x12\x16.google.protobuf.Empty\x1a\x16.api.StockItemResponse\"-\x82\xd3\xe4\x93\x02':\x01*Z\x12\x12\x10/v2/items:export\"\x0e/stocks/export0\x01B\x10Z\x0epkg/api/stock/b\x06proto3"
 
 var (
 	file_stock_proto_rawDescOnce sync.Once
@@ -469,31 +3040,133 @@ func file_stock_proto_rawDescGZIP() []byte {
 	return file_stock_proto_rawDescData
 }
 
-var file_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_stock_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_stock_proto_goTypes = []any{
-	(*StockAddItemRequest)(nil),    // 0: api.StockAddItemRequest
-	(*StockDeleteItemRequest)(nil), // 1: api.StockDeleteItemRequest
-	(*StockListItemRequest)(nil),   // 2: api.StockListItemRequest
-	(*StockGetItemRequest)(nil),    // 3: api.StockGetItemRequest
-	(*StockListItemResponse)(nil),  // 4: api.StockListItemResponse
-	(*StockItemResponse)(nil),      // 5: api.StockItemResponse
-	(*emptypb.Empty)(nil),          // 6: google.protobuf.Empty
+	(StockSearchSort)(0),                    // 0: api.StockSearchSort
+	(StockPriceSource)(0),                   // 1: api.StockPriceSource
+	(*StockAddItemRequest)(nil),             // 2: api.StockAddItemRequest
+	(*StockDeleteItemRequest)(nil),          // 3: api.StockDeleteItemRequest
+	(*StockRestoreItemRequest)(nil),         // 4: api.StockRestoreItemRequest
+	(*StockTransferRequest)(nil),            // 5: api.StockTransferRequest
+	(*StockListItemRequest)(nil),            // 6: api.StockListItemRequest
+	(*StockGetItemRequest)(nil),             // 7: api.StockGetItemRequest
+	(*StockGetItemsRequest)(nil),            // 8: api.StockGetItemsRequest
+	(*StockListItemResponse)(nil),           // 9: api.StockListItemResponse
+	(*StockItemResponse)(nil),               // 10: api.StockItemResponse
+	(*StockGetItemsResponse)(nil),           // 11: api.StockGetItemsResponse
+	(*StockCreateSKURequest)(nil),           // 12: api.StockCreateSKURequest
+	(*StockUpdateSKURequest)(nil),           // 13: api.StockUpdateSKURequest
+	(*StockSKURequest)(nil),                 // 14: api.StockSKURequest
+	(*StockListSKUsRequest)(nil),            // 15: api.StockListSKUsRequest
+	(*StockSKUResponse)(nil),                // 16: api.StockSKUResponse
+	(*StockListSKUsResponse)(nil),           // 17: api.StockListSKUsResponse
+	(*StockCategory)(nil),                   // 18: api.StockCategory
+	(*StockCreateCategoryRequest)(nil),      // 19: api.StockCreateCategoryRequest
+	(*StockListCategoriesResponse)(nil),     // 20: api.StockListCategoriesResponse
+	(*StockSearchItemsRequest)(nil),         // 21: api.StockSearchItemsRequest
+	(*StockImportRow)(nil),                  // 22: api.StockImportRow
+	(*StockImportRequest)(nil),              // 23: api.StockImportRequest
+	(*StockImportRowError)(nil),             // 24: api.StockImportRowError
+	(*StockImportResponse)(nil),             // 25: api.StockImportResponse
+	(*StockSetThresholdRequest)(nil),        // 26: api.StockSetThresholdRequest
+	(*StockListLowStockRequest)(nil),        // 27: api.StockListLowStockRequest
+	(*StockLowStockItem)(nil),               // 28: api.StockLowStockItem
+	(*StockListLowStockResponse)(nil),       // 29: api.StockListLowStockResponse
+	(*StockSchedulePriceChangeRequest)(nil), // 30: api.StockSchedulePriceChangeRequest
+	(*StockScheduledPrice)(nil),             // 31: api.StockScheduledPrice
+	(*StockGetPriceHistoryRequest)(nil),     // 32: api.StockGetPriceHistoryRequest
+	(*StockPriceChange)(nil),                // 33: api.StockPriceChange
+	(*StockGetPriceHistoryResponse)(nil),    // 34: api.StockGetPriceHistoryResponse
+	(*StockListOffersRequest)(nil),          // 35: api.StockListOffersRequest
+	(*StockSeller)(nil),                     // 36: api.StockSeller
+	(*StockOffer)(nil),                      // 37: api.StockOffer
+	(*StockListOffersResponse)(nil),         // 38: api.StockListOffersResponse
+	(*StockRegisterSellerRequest)(nil),      // 39: api.StockRegisterSellerRequest
+	nil,                                     // 40: api.StockCategory.AttributeSchemaEntry
+	nil,                                     // 41: api.StockCreateCategoryRequest.AttributeSchemaEntry
+	(*structpb.Struct)(nil),                 // 42: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),           // 43: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 44: google.protobuf.Empty
 }
 var file_stock_proto_depIdxs = []int32{
-	5, // 0: api.StockListItemResponse.items:type_name -> api.StockItemResponse
-	0, // 1: api.StockService.AddItem:input_type -> api.StockAddItemRequest
-	1, // 2: api.StockService.DeleteItem:input_type -> api.StockDeleteItemRequest
-	2, // 3: api.StockService.ListItem:input_type -> api.StockListItemRequest
-	3, // 4: api.StockService.GetItem:input_type -> api.StockGetItemRequest
-	6, // 5: api.StockService.AddItem:output_type -> google.protobuf.Empty
-	6, // 6: api.StockService.DeleteItem:output_type -> google.protobuf.Empty
-	4, // 7: api.StockService.ListItem:output_type -> api.StockListItemResponse
-	5, // 8: api.StockService.GetItem:output_type -> api.StockItemResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	42, // 0: api.StockListItemRequest.attributes:type_name -> google.protobuf.Struct
+	10, // 1: api.StockListItemResponse.items:type_name -> api.StockItemResponse
+	18, // 2: api.StockItemResponse.category:type_name -> api.StockCategory
+	42, // 3: api.StockItemResponse.attributes:type_name -> google.protobuf.Struct
+	10, // 4: api.StockGetItemsResponse.items:type_name -> api.StockItemResponse
+	42, // 5: api.StockCreateSKURequest.attributes:type_name -> google.protobuf.Struct
+	42, // 6: api.StockUpdateSKURequest.attributes:type_name -> google.protobuf.Struct
+	18, // 7: api.StockSKUResponse.category:type_name -> api.StockCategory
+	42, // 8: api.StockSKUResponse.attributes:type_name -> google.protobuf.Struct
+	16, // 9: api.StockListSKUsResponse.skus:type_name -> api.StockSKUResponse
+	40, // 10: api.StockCategory.attribute_schema:type_name -> api.StockCategory.AttributeSchemaEntry
+	41, // 11: api.StockCreateCategoryRequest.attribute_schema:type_name -> api.StockCreateCategoryRequest.AttributeSchemaEntry
+	18, // 12: api.StockListCategoriesResponse.categories:type_name -> api.StockCategory
+	0,  // 13: api.StockSearchItemsRequest.sort:type_name -> api.StockSearchSort
+	22, // 14: api.StockImportRequest.rows:type_name -> api.StockImportRow
+	24, // 15: api.StockImportResponse.errors:type_name -> api.StockImportRowError
+	10, // 16: api.StockLowStockItem.item:type_name -> api.StockItemResponse
+	28, // 17: api.StockListLowStockResponse.items:type_name -> api.StockLowStockItem
+	43, // 18: api.StockSchedulePriceChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	43, // 19: api.StockScheduledPrice.effective_at:type_name -> google.protobuf.Timestamp
+	1,  // 20: api.StockPriceChange.source:type_name -> api.StockPriceSource
+	43, // 21: api.StockPriceChange.changed_at:type_name -> google.protobuf.Timestamp
+	33, // 22: api.StockGetPriceHistoryResponse.changes:type_name -> api.StockPriceChange
+	31, // 23: api.StockGetPriceHistoryResponse.scheduled:type_name -> api.StockScheduledPrice
+	36, // 24: api.StockOffer.seller:type_name -> api.StockSeller
+	37, // 25: api.StockListOffersResponse.offers:type_name -> api.StockOffer
+	2,  // 26: api.StockService.AddItem:input_type -> api.StockAddItemRequest
+	3,  // 27: api.StockService.DeleteItem:input_type -> api.StockDeleteItemRequest
+	4,  // 28: api.StockService.RestoreItem:input_type -> api.StockRestoreItemRequest
+	5,  // 29: api.StockService.TransferStock:input_type -> api.StockTransferRequest
+	6,  // 30: api.StockService.ListItem:input_type -> api.StockListItemRequest
+	7,  // 31: api.StockService.GetItem:input_type -> api.StockGetItemRequest
+	8,  // 32: api.StockService.GetItems:input_type -> api.StockGetItemsRequest
+	12, // 33: api.StockService.CreateSKU:input_type -> api.StockCreateSKURequest
+	13, // 34: api.StockService.UpdateSKU:input_type -> api.StockUpdateSKURequest
+	14, // 35: api.StockService.ArchiveSKU:input_type -> api.StockSKURequest
+	14, // 36: api.StockService.GetSKU:input_type -> api.StockSKURequest
+	15, // 37: api.StockService.ListSKUs:input_type -> api.StockListSKUsRequest
+	19, // 38: api.StockService.CreateCategory:input_type -> api.StockCreateCategoryRequest
+	44, // 39: api.StockService.ListCategories:input_type -> google.protobuf.Empty
+	21, // 40: api.StockService.SearchItems:input_type -> api.StockSearchItemsRequest
+	26, // 41: api.StockService.SetThreshold:input_type -> api.StockSetThresholdRequest
+	27, // 42: api.StockService.ListLowStock:input_type -> api.StockListLowStockRequest
+	30, // 43: api.StockService.SchedulePriceChange:input_type -> api.StockSchedulePriceChangeRequest
+	32, // 44: api.StockService.GetPriceHistory:input_type -> api.StockGetPriceHistoryRequest
+	35, // 45: api.StockService.ListOffers:input_type -> api.StockListOffersRequest
+	39, // 46: api.StockService.RegisterSeller:input_type -> api.StockRegisterSellerRequest
+	23, // 47: api.StockService.ImportStock:input_type -> api.StockImportRequest
+	44, // 48: api.StockService.ExportStock:input_type -> google.protobuf.Empty
+	44, // 49: api.StockService.AddItem:output_type -> google.protobuf.Empty
+	44, // 50: api.StockService.DeleteItem:output_type -> google.protobuf.Empty
+	44, // 51: api.StockService.RestoreItem:output_type -> google.protobuf.Empty
+	44, // 52: api.StockService.TransferStock:output_type -> google.protobuf.Empty
+	9,  // 53: api.StockService.ListItem:output_type -> api.StockListItemResponse
+	10, // 54: api.StockService.GetItem:output_type -> api.StockItemResponse
+	11, // 55: api.StockService.GetItems:output_type -> api.StockGetItemsResponse
+	16, // 56: api.StockService.CreateSKU:output_type -> api.StockSKUResponse
+	16, // 57: api.StockService.UpdateSKU:output_type -> api.StockSKUResponse
+	44, // 58: api.StockService.ArchiveSKU:output_type -> google.protobuf.Empty
+	16, // 59: api.StockService.GetSKU:output_type -> api.StockSKUResponse
+	17, // 60: api.StockService.ListSKUs:output_type -> api.StockListSKUsResponse
+	18, // 61: api.StockService.CreateCategory:output_type -> api.StockCategory
+	20, // 62: api.StockService.ListCategories:output_type -> api.StockListCategoriesResponse
+	9,  // 63: api.StockService.SearchItems:output_type -> api.StockListItemResponse
+	44, // 64: api.StockService.SetThreshold:output_type -> google.protobuf.Empty
+	29, // 65: api.StockService.ListLowStock:output_type -> api.StockListLowStockResponse
+	31, // 66: api.StockService.SchedulePriceChange:output_type -> api.StockScheduledPrice
+	34, // 67: api.StockService.GetPriceHistory:output_type -> api.StockGetPriceHistoryResponse
+	38, // 68: api.StockService.ListOffers:output_type -> api.StockListOffersResponse
+	36, // 69: api.StockService.RegisterSeller:output_type -> api.StockSeller
+	25, // 70: api.StockService.ImportStock:output_type -> api.StockImportResponse
+	10, // 71: api.StockService.ExportStock:output_type -> api.StockItemResponse
+	49, // [49:72] is the sub-list for method output_type
+	26, // [26:49] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_stock_proto_init() }
@@ -501,18 +3174,21 @@ func file_stock_proto_init() {
 	if File_stock_proto != nil {
 		return
 	}
+	file_stock_proto_msgTypes[11].OneofWrappers = []any{}
+	file_stock_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stock_proto_rawDesc), len(file_stock_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_stock_proto_goTypes,
 		DependencyIndexes: file_stock_proto_depIdxs,
+		EnumInfos:         file_stock_proto_enumTypes,
 		MessageInfos:      file_stock_proto_msgTypes,
 	}.Build()
 	File_stock_proto = out.File
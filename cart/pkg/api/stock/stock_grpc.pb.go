@@ -20,10 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StockService_AddItem_FullMethodName    = "/api.StockService/AddItem"
-	StockService_DeleteItem_FullMethodName = "/api.StockService/DeleteItem"
-	StockService_ListItem_FullMethodName   = "/api.StockService/ListItem"
-	StockService_GetItem_FullMethodName    = "/api.StockService/GetItem"
+	StockService_AddItem_FullMethodName             = "/api.StockService/AddItem"
+	StockService_DeleteItem_FullMethodName          = "/api.StockService/DeleteItem"
+	StockService_RestoreItem_FullMethodName         = "/api.StockService/RestoreItem"
+	StockService_TransferStock_FullMethodName       = "/api.StockService/TransferStock"
+	StockService_ListItem_FullMethodName            = "/api.StockService/ListItem"
+	StockService_GetItem_FullMethodName             = "/api.StockService/GetItem"
+	StockService_GetItems_FullMethodName            = "/api.StockService/GetItems"
+	StockService_CreateSKU_FullMethodName           = "/api.StockService/CreateSKU"
+	StockService_UpdateSKU_FullMethodName           = "/api.StockService/UpdateSKU"
+	StockService_ArchiveSKU_FullMethodName          = "/api.StockService/ArchiveSKU"
+	StockService_GetSKU_FullMethodName              = "/api.StockService/GetSKU"
+	StockService_ListSKUs_FullMethodName            = "/api.StockService/ListSKUs"
+	StockService_CreateCategory_FullMethodName      = "/api.StockService/CreateCategory"
+	StockService_ListCategories_FullMethodName      = "/api.StockService/ListCategories"
+	StockService_SearchItems_FullMethodName         = "/api.StockService/SearchItems"
+	StockService_SetThreshold_FullMethodName        = "/api.StockService/SetThreshold"
+	StockService_ListLowStock_FullMethodName        = "/api.StockService/ListLowStock"
+	StockService_SchedulePriceChange_FullMethodName = "/api.StockService/SchedulePriceChange"
+	StockService_GetPriceHistory_FullMethodName     = "/api.StockService/GetPriceHistory"
+	StockService_ListOffers_FullMethodName          = "/api.StockService/ListOffers"
+	StockService_RegisterSeller_FullMethodName      = "/api.StockService/RegisterSeller"
+	StockService_ImportStock_FullMethodName         = "/api.StockService/ImportStock"
+	StockService_ExportStock_FullMethodName         = "/api.StockService/ExportStock"
 )
 
 // StockServiceClient is the client API for StockService service.
@@ -32,8 +51,31 @@ const (
 type StockServiceClient interface {
 	AddItem(ctx context.Context, in *StockAddItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteItem(ctx context.Context, in *StockDeleteItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreItem(ctx context.Context, in *StockRestoreItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferStock(ctx context.Context, in *StockTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListItem(ctx context.Context, in *StockListItemRequest, opts ...grpc.CallOption) (*StockListItemResponse, error)
 	GetItem(ctx context.Context, in *StockGetItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error)
+	GetItems(ctx context.Context, in *StockGetItemsRequest, opts ...grpc.CallOption) (*StockGetItemsResponse, error)
+	CreateSKU(ctx context.Context, in *StockCreateSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error)
+	UpdateSKU(ctx context.Context, in *StockUpdateSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error)
+	ArchiveSKU(ctx context.Context, in *StockSKURequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSKU(ctx context.Context, in *StockSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error)
+	ListSKUs(ctx context.Context, in *StockListSKUsRequest, opts ...grpc.CallOption) (*StockListSKUsResponse, error)
+	CreateCategory(ctx context.Context, in *StockCreateCategoryRequest, opts ...grpc.CallOption) (*StockCategory, error)
+	ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StockListCategoriesResponse, error)
+	SearchItems(ctx context.Context, in *StockSearchItemsRequest, opts ...grpc.CallOption) (*StockListItemResponse, error)
+	SetThreshold(ctx context.Context, in *StockSetThresholdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListLowStock(ctx context.Context, in *StockListLowStockRequest, opts ...grpc.CallOption) (*StockListLowStockResponse, error)
+	SchedulePriceChange(ctx context.Context, in *StockSchedulePriceChangeRequest, opts ...grpc.CallOption) (*StockScheduledPrice, error)
+	GetPriceHistory(ctx context.Context, in *StockGetPriceHistoryRequest, opts ...grpc.CallOption) (*StockGetPriceHistoryResponse, error)
+	ListOffers(ctx context.Context, in *StockListOffersRequest, opts ...grpc.CallOption) (*StockListOffersResponse, error)
+	RegisterSeller(ctx context.Context, in *StockRegisterSellerRequest, opts ...grpc.CallOption) (*StockSeller, error)
+	// ImportStock upserts the streamed rows, every message is applied in its own transaction.
+	// The import is not atomic: if a message fails as a whole, the stream fails and the messages
+	// before it stay applied. The rows are upserts, so the whole import can be sent again.
+	ImportStock(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StockImportRequest, StockImportResponse], error)
+	// ExportStock streams the whole inventory ordered by the stock ID.
+	ExportStock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockItemResponse], error)
 }
 
 type stockServiceClient struct {
@@ -64,6 +106,26 @@ func (c *stockServiceClient) DeleteItem(ctx context.Context, in *StockDeleteItem
 	return out, nil
 }
 
+func (c *stockServiceClient) RestoreItem(ctx context.Context, in *StockRestoreItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StockService_RestoreItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) TransferStock(ctx context.Context, in *StockTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StockService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stockServiceClient) ListItem(ctx context.Context, in *StockListItemRequest, opts ...grpc.CallOption) (*StockListItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StockListItemResponse)
@@ -84,14 +146,219 @@ func (c *stockServiceClient) GetItem(ctx context.Context, in *StockGetItemReques
 	return out, nil
 }
 
+func (c *stockServiceClient) GetItems(ctx context.Context, in *StockGetItemsRequest, opts ...grpc.CallOption) (*StockGetItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockGetItemsResponse)
+	err := c.cc.Invoke(ctx, StockService_GetItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) CreateSKU(ctx context.Context, in *StockCreateSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockSKUResponse)
+	err := c.cc.Invoke(ctx, StockService_CreateSKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) UpdateSKU(ctx context.Context, in *StockUpdateSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockSKUResponse)
+	err := c.cc.Invoke(ctx, StockService_UpdateSKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ArchiveSKU(ctx context.Context, in *StockSKURequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StockService_ArchiveSKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) GetSKU(ctx context.Context, in *StockSKURequest, opts ...grpc.CallOption) (*StockSKUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockSKUResponse)
+	err := c.cc.Invoke(ctx, StockService_GetSKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ListSKUs(ctx context.Context, in *StockListSKUsRequest, opts ...grpc.CallOption) (*StockListSKUsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockListSKUsResponse)
+	err := c.cc.Invoke(ctx, StockService_ListSKUs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) CreateCategory(ctx context.Context, in *StockCreateCategoryRequest, opts ...grpc.CallOption) (*StockCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockCategory)
+	err := c.cc.Invoke(ctx, StockService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StockListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockListCategoriesResponse)
+	err := c.cc.Invoke(ctx, StockService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) SearchItems(ctx context.Context, in *StockSearchItemsRequest, opts ...grpc.CallOption) (*StockListItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockListItemResponse)
+	err := c.cc.Invoke(ctx, StockService_SearchItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) SetThreshold(ctx context.Context, in *StockSetThresholdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StockService_SetThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ListLowStock(ctx context.Context, in *StockListLowStockRequest, opts ...grpc.CallOption) (*StockListLowStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockListLowStockResponse)
+	err := c.cc.Invoke(ctx, StockService_ListLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) SchedulePriceChange(ctx context.Context, in *StockSchedulePriceChangeRequest, opts ...grpc.CallOption) (*StockScheduledPrice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockScheduledPrice)
+	err := c.cc.Invoke(ctx, StockService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) GetPriceHistory(ctx context.Context, in *StockGetPriceHistoryRequest, opts ...grpc.CallOption) (*StockGetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockGetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, StockService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ListOffers(ctx context.Context, in *StockListOffersRequest, opts ...grpc.CallOption) (*StockListOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockListOffersResponse)
+	err := c.cc.Invoke(ctx, StockService_ListOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) RegisterSeller(ctx context.Context, in *StockRegisterSellerRequest, opts ...grpc.CallOption) (*StockSeller, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockSeller)
+	err := c.cc.Invoke(ctx, StockService_RegisterSeller_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ImportStock(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StockImportRequest, StockImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StockService_ServiceDesc.Streams[0], StockService_ImportStock_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StockImportRequest, StockImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StockService_ImportStockClient = grpc.ClientStreamingClient[StockImportRequest, StockImportResponse]
+
+func (c *stockServiceClient) ExportStock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockItemResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StockService_ServiceDesc.Streams[1], StockService_ExportStock_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, StockItemResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StockService_ExportStockClient = grpc.ServerStreamingClient[StockItemResponse]
+
 // StockServiceServer is the server API for StockService service.
 // All implementations must embed UnimplementedStockServiceServer
 // for forward compatibility.
 type StockServiceServer interface {
 	AddItem(context.Context, *StockAddItemRequest) (*emptypb.Empty, error)
 	DeleteItem(context.Context, *StockDeleteItemRequest) (*emptypb.Empty, error)
+	RestoreItem(context.Context, *StockRestoreItemRequest) (*emptypb.Empty, error)
+	TransferStock(context.Context, *StockTransferRequest) (*emptypb.Empty, error)
 	ListItem(context.Context, *StockListItemRequest) (*StockListItemResponse, error)
 	GetItem(context.Context, *StockGetItemRequest) (*StockItemResponse, error)
+	GetItems(context.Context, *StockGetItemsRequest) (*StockGetItemsResponse, error)
+	CreateSKU(context.Context, *StockCreateSKURequest) (*StockSKUResponse, error)
+	UpdateSKU(context.Context, *StockUpdateSKURequest) (*StockSKUResponse, error)
+	ArchiveSKU(context.Context, *StockSKURequest) (*emptypb.Empty, error)
+	GetSKU(context.Context, *StockSKURequest) (*StockSKUResponse, error)
+	ListSKUs(context.Context, *StockListSKUsRequest) (*StockListSKUsResponse, error)
+	CreateCategory(context.Context, *StockCreateCategoryRequest) (*StockCategory, error)
+	ListCategories(context.Context, *emptypb.Empty) (*StockListCategoriesResponse, error)
+	SearchItems(context.Context, *StockSearchItemsRequest) (*StockListItemResponse, error)
+	SetThreshold(context.Context, *StockSetThresholdRequest) (*emptypb.Empty, error)
+	ListLowStock(context.Context, *StockListLowStockRequest) (*StockListLowStockResponse, error)
+	SchedulePriceChange(context.Context, *StockSchedulePriceChangeRequest) (*StockScheduledPrice, error)
+	GetPriceHistory(context.Context, *StockGetPriceHistoryRequest) (*StockGetPriceHistoryResponse, error)
+	ListOffers(context.Context, *StockListOffersRequest) (*StockListOffersResponse, error)
+	RegisterSeller(context.Context, *StockRegisterSellerRequest) (*StockSeller, error)
+	// ImportStock upserts the streamed rows, every message is applied in its own transaction.
+	// The import is not atomic: if a message fails as a whole, the stream fails and the messages
+	// before it stay applied. The rows are upserts, so the whole import can be sent again.
+	ImportStock(grpc.ClientStreamingServer[StockImportRequest, StockImportResponse]) error
+	// ExportStock streams the whole inventory ordered by the stock ID.
+	ExportStock(*emptypb.Empty, grpc.ServerStreamingServer[StockItemResponse]) error
 	mustEmbedUnimplementedStockServiceServer()
 }
 
@@ -108,12 +375,69 @@ func (UnimplementedStockServiceServer) AddItem(context.Context, *StockAddItemReq
 func (UnimplementedStockServiceServer) DeleteItem(context.Context, *StockDeleteItemRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
+func (UnimplementedStockServiceServer) RestoreItem(context.Context, *StockRestoreItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreItem not implemented")
+}
+func (UnimplementedStockServiceServer) TransferStock(context.Context, *StockTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
 func (UnimplementedStockServiceServer) ListItem(context.Context, *StockListItemRequest) (*StockListItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListItem not implemented")
 }
 func (UnimplementedStockServiceServer) GetItem(context.Context, *StockGetItemRequest) (*StockItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
 }
+func (UnimplementedStockServiceServer) GetItems(context.Context, *StockGetItemsRequest) (*StockGetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
+}
+func (UnimplementedStockServiceServer) CreateSKU(context.Context, *StockCreateSKURequest) (*StockSKUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSKU not implemented")
+}
+func (UnimplementedStockServiceServer) UpdateSKU(context.Context, *StockUpdateSKURequest) (*StockSKUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSKU not implemented")
+}
+func (UnimplementedStockServiceServer) ArchiveSKU(context.Context, *StockSKURequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSKU not implemented")
+}
+func (UnimplementedStockServiceServer) GetSKU(context.Context, *StockSKURequest) (*StockSKUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSKU not implemented")
+}
+func (UnimplementedStockServiceServer) ListSKUs(context.Context, *StockListSKUsRequest) (*StockListSKUsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSKUs not implemented")
+}
+func (UnimplementedStockServiceServer) CreateCategory(context.Context, *StockCreateCategoryRequest) (*StockCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedStockServiceServer) ListCategories(context.Context, *emptypb.Empty) (*StockListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedStockServiceServer) SearchItems(context.Context, *StockSearchItemsRequest) (*StockListItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
+}
+func (UnimplementedStockServiceServer) SetThreshold(context.Context, *StockSetThresholdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreshold not implemented")
+}
+func (UnimplementedStockServiceServer) ListLowStock(context.Context, *StockListLowStockRequest) (*StockListLowStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStock not implemented")
+}
+func (UnimplementedStockServiceServer) SchedulePriceChange(context.Context, *StockSchedulePriceChangeRequest) (*StockScheduledPrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedStockServiceServer) GetPriceHistory(context.Context, *StockGetPriceHistoryRequest) (*StockGetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedStockServiceServer) ListOffers(context.Context, *StockListOffersRequest) (*StockListOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffers not implemented")
+}
+func (UnimplementedStockServiceServer) RegisterSeller(context.Context, *StockRegisterSellerRequest) (*StockSeller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSeller not implemented")
+}
+func (UnimplementedStockServiceServer) ImportStock(grpc.ClientStreamingServer[StockImportRequest, StockImportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStock not implemented")
+}
+func (UnimplementedStockServiceServer) ExportStock(*emptypb.Empty, grpc.ServerStreamingServer[StockItemResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStock not implemented")
+}
 func (UnimplementedStockServiceServer) mustEmbedUnimplementedStockServiceServer() {}
 func (UnimplementedStockServiceServer) testEmbeddedByValue()                      {}
 
@@ -171,6 +495,42 @@ func _StockService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StockService_RestoreItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockRestoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).RestoreItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_RestoreItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).RestoreItem(ctx, req.(*StockRestoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).TransferStock(ctx, req.(*StockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StockService_ListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StockListItemRequest)
 	if err := dec(in); err != nil {
@@ -207,6 +567,294 @@ func _StockService_GetItem_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StockService_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockGetItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).GetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_GetItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).GetItems(ctx, req.(*StockGetItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_CreateSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockCreateSKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).CreateSKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_CreateSKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).CreateSKU(ctx, req.(*StockCreateSKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_UpdateSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockUpdateSKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).UpdateSKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_UpdateSKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).UpdateSKU(ctx, req.(*StockUpdateSKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ArchiveSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockSKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ArchiveSKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ArchiveSKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ArchiveSKU(ctx, req.(*StockSKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_GetSKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockSKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).GetSKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_GetSKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).GetSKU(ctx, req.(*StockSKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ListSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockListSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ListSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ListSKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ListSKUs(ctx, req.(*StockListSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockCreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).CreateCategory(ctx, req.(*StockCreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ListCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockSearchItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_SearchItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).SearchItems(ctx, req.(*StockSearchItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_SetThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockSetThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).SetThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_SetThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).SetThreshold(ctx, req.(*StockSetThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockListLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ListLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ListLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ListLowStock(ctx, req.(*StockListLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockSchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).SchedulePriceChange(ctx, req.(*StockSchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockGetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).GetPriceHistory(ctx, req.(*StockGetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ListOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ListOffers(ctx, req.(*StockListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_RegisterSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockRegisterSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).RegisterSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_RegisterSeller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).RegisterSeller(ctx, req.(*StockRegisterSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ImportStock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StockServiceServer).ImportStock(&grpc.GenericServerStream[StockImportRequest, StockImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StockService_ImportStockServer = grpc.ClientStreamingServer[StockImportRequest, StockImportResponse]
+
+func _StockService_ExportStock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StockServiceServer).ExportStock(m, &grpc.GenericServerStream[emptypb.Empty, StockItemResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StockService_ExportStockServer = grpc.ServerStreamingServer[StockItemResponse]
+
 // StockService_ServiceDesc is the grpc.ServiceDesc for StockService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +870,14 @@ var StockService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItem",
 			Handler:    _StockService_DeleteItem_Handler,
 		},
+		{
+			MethodName: "RestoreItem",
+			Handler:    _StockService_RestoreItem_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _StockService_TransferStock_Handler,
+		},
 		{
 			MethodName: "ListItem",
 			Handler:    _StockService_ListItem_Handler,
@@ -230,7 +886,78 @@ var StockService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetItem",
 			Handler:    _StockService_GetItem_Handler,
 		},
+		{
+			MethodName: "GetItems",
+			Handler:    _StockService_GetItems_Handler,
+		},
+		{
+			MethodName: "CreateSKU",
+			Handler:    _StockService_CreateSKU_Handler,
+		},
+		{
+			MethodName: "UpdateSKU",
+			Handler:    _StockService_UpdateSKU_Handler,
+		},
+		{
+			MethodName: "ArchiveSKU",
+			Handler:    _StockService_ArchiveSKU_Handler,
+		},
+		{
+			MethodName: "GetSKU",
+			Handler:    _StockService_GetSKU_Handler,
+		},
+		{
+			MethodName: "ListSKUs",
+			Handler:    _StockService_ListSKUs_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _StockService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _StockService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _StockService_SearchItems_Handler,
+		},
+		{
+			MethodName: "SetThreshold",
+			Handler:    _StockService_SetThreshold_Handler,
+		},
+		{
+			MethodName: "ListLowStock",
+			Handler:    _StockService_ListLowStock_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _StockService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _StockService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _StockService_ListOffers_Handler,
+		},
+		{
+			MethodName: "RegisterSeller",
+			Handler:    _StockService_RegisterSeller_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportStock",
+			Handler:       _StockService_ImportStock_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportStock",
+			Handler:       _StockService_ExportStock_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "stock.proto",
 }
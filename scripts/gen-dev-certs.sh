@@ -0,0 +1,40 @@
+#!/usr/bin/env bash
+# Generates a local CA and certificates of the stocks and cart services for development.
+# Every certificate can be used by a server and a client, so the same files serve mTLS in both directions.
+#
+# usage: scripts/gen-dev-certs.sh [out dir, ./certs by default]
+set -euo pipefail
+
+OUT="${1:-certs}"
+DAYS="${DAYS:-365}"
+
+mkdir -p "$OUT"
+
+if [[ ! -f "$OUT/ca.pem" ]]; then
+  openssl req -x509 -newkey ec -pkeyopt ec_paramgen_curve:P-256 -nodes \
+    -keyout "$OUT/ca-key.pem" -out "$OUT/ca.pem" -days "$DAYS" \
+    -subj "/CN=dev-ca" \
+    -addext "basicConstraints=critical,CA:TRUE" \
+    -addext "keyUsage=critical,keyCertSign,cRLSign"
+fi
+
+# cert <name> <subjectAltName>
+cert() {
+  local name="$1" san="$2"
+
+  openssl req -newkey ec -pkeyopt ec_paramgen_curve:P-256 -nodes \
+    -keyout "$OUT/$name-key.pem" -out "$OUT/$name.csr" -subj "/CN=$name"
+
+  openssl x509 -req -in "$OUT/$name.csr" -CA "$OUT/ca.pem" -CAkey "$OUT/ca-key.pem" \
+    -CAcreateserial -out "$OUT/$name.pem" -days "$DAYS" \
+    -extfile <(printf "subjectAltName=%s\nextendedKeyUsage=serverAuth,clientAuth\nkeyUsage=critical,digitalSignature\n" "$san")
+
+  rm -f "$OUT/$name.csr"
+}
+
+cert stocks "DNS:localhost,DNS:stocks_service,DNS:umyt-stocks-service,IP:127.0.0.1"
+cert cart "DNS:localhost,DNS:cart_service,DNS:umyt-cart-service,IP:127.0.0.1"
+
+chmod 600 "$OUT"/*-key.pem
+
+echo "certificates written to $OUT: ca.pem, stocks.pem, stocks-key.pem, cart.pem, cart-key.pem"
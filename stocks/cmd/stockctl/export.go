@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	pb "stocks/pkg/api/stock"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func runExport(ctx context.Context, args []string) error {
+	fs, conn, format := newFlagSet("export")
+
+	path := fs.String("out", "", "file to write, stdout by default")
+
+	_ = fs.Parse(args)
+
+	formatName, err := fileFormat(*format, *path)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+
+	if *path != "" {
+		if out, err = os.Create(*path); err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	client, closeConn, err := newClient(conn)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	stream, err := client.ExportStock(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+
+	write, flush := newRowWriter(out, formatName)
+
+	var count int
+
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err = write(item); err != nil {
+			return err
+		}
+
+		count++
+	}
+
+	if err = flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d rows\n", count)
+
+	return nil
+}
+
+// newRowWriter returns a writer of exported items in the import format, so an export can be imported again.
+func newRowWriter(w io.Writer, format string) (func(*pb.StockItemResponse) error, func() error) {
+	if format == formatCSV {
+		writer := csv.NewWriter(w)
+		header := false
+
+		write := func(item *pb.StockItemResponse) error {
+			if !header {
+				if err := writer.Write(csvColumns); err != nil {
+					return err
+				}
+
+				header = true
+			}
+
+			return writer.Write([]string{
+				strconv.FormatUint(uint64(item.Sku), 10),
+				strconv.FormatInt(item.UserId, 10),
+				strconv.FormatUint(uint64(item.Count), 10),
+				strconv.FormatUint(uint64(item.Price), 10),
+				item.Location,
+			})
+		}
+
+		flush := func() error {
+			writer.Flush()
+
+			return writer.Error()
+		}
+
+		return write, flush
+	}
+
+	encoder := json.NewEncoder(w)
+
+	write := func(item *pb.StockItemResponse) error {
+		return encoder.Encode(jsonlRow{
+			SKU:      item.Sku,
+			UserID:   item.UserId,
+			Count:    item.Count,
+			Price:    item.Price,
+			Location: item.Location,
+		})
+	}
+
+	return write, func() error { return nil }
+}
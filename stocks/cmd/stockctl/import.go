@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "stocks/pkg/api/stock"
+)
+
+// csvColumns are the required columns of the CSV header, in any order.
+var csvColumns = []string{"sku", "user_id", "count", "price", "location"}
+
+// jsonlRow is a JSON Lines row, named like the fields of the REST API.
+type jsonlRow struct {
+	SKU      uint32 `json:"sku"`
+	UserID   int64  `json:"userId"`
+	Count    uint32 `json:"count"`
+	Price    uint32 `json:"price"`
+	Location string `json:"location"`
+}
+
+// rowReader returns the next row of the file, io.EOF at its end or a parse error of a single row.
+type rowReader func() (*pb.StockImportRow, error)
+
+type rowError struct {
+	line int64
+	err  error
+}
+
+func (e rowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.line, e.err)
+}
+
+func runImport(ctx context.Context, args []string) error {
+	fs, conn, format := newFlagSet("import")
+
+	path := fs.String("file", "", "CSV or JSON Lines file to import")
+	dryRun := fs.Bool("dry-run", false, "validate the rows without applying them")
+	batch := fs.Int("batch", 500, "rows per message, every message is applied in its own transaction")
+
+	_ = fs.Parse(args)
+
+	if *path == "" || *batch < 1 {
+		fs.Usage()
+
+		return errors.New("a file and a positive batch size are required")
+	}
+
+	formatName, err := fileFormat(*format, *path)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	next, err := newRowReader(file, formatName)
+	if err != nil {
+		return err
+	}
+
+	client, closeConn, err := newClient(conn)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	resp, parseErrors, err := importRows(ctx, client, next, *dryRun, *batch)
+	if err != nil {
+		return err
+	}
+
+	return printReport(resp, parseErrors)
+}
+
+// importRows streams the parsed rows in batches. Rows that can not be parsed are not sent and returned.
+func importRows(ctx context.Context, client pb.StockServiceClient, next rowReader, dryRun bool, batch int) (*pb.StockImportResponse, []rowError, error) {
+	stream, err := client.ImportStock(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parseErrors []rowError
+
+	req := &pb.StockImportRequest{DryRun: dryRun}
+
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr rowError
+		if errors.As(err, &rowErr) {
+			parseErrors = append(parseErrors, rowErr)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req.Rows = append(req.Rows, row)
+
+		if len(req.Rows) == batch {
+			if err = stream.Send(req); err != nil {
+				return nil, nil, err
+			}
+
+			req = &pb.StockImportRequest{DryRun: dryRun}
+		}
+	}
+
+	if len(req.Rows) > 0 {
+		if err = stream.Send(req); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, parseErrors, nil
+}
+
+func printReport(resp *pb.StockImportResponse, parseErrors []rowError) error {
+	mode := "imported"
+	if resp.DryRun {
+		mode = "valid (dry run)"
+	}
+
+	failed := resp.FailedRows + int64(len(parseErrors))
+
+	fmt.Printf("rows: %d, %s: %d, failed: %d\n", resp.TotalRows+int64(len(parseErrors)), mode, resp.ImportedRows, failed)
+
+	for _, rowErr := range parseErrors {
+		fmt.Println(rowErr.Error())
+	}
+
+	for _, rowErr := range resp.Errors {
+		fmt.Printf("line %d: sku %d: %s\n", rowErr.Line, rowErr.Sku, rowErr.Error)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d rows failed", failed)
+	}
+
+	return nil
+}
+
+func newRowReader(r io.Reader, format string) (rowReader, error) {
+	if format == formatCSV {
+		return newCSVReader(r)
+	}
+
+	return newJSONLReader(r), nil
+}
+
+func newCSVReader(r io.Reader) (rowReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range csvColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV header has no %q column", name)
+		}
+	}
+
+	return func() (*pb.StockImportRow, error) {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, rowError{line: int64(parseErr.Line), err: parseErr.Err}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if len(record) != len(header) {
+			return nil, rowError{line: int64(line), err: fmt.Errorf("expected %d fields, got %d", len(header), len(record))}
+		}
+
+		row, err := csvRow(record, columns)
+		if err != nil {
+			return nil, rowError{line: int64(line), err: err}
+		}
+
+		row.Line = int64(line)
+
+		return row, nil
+	}, nil
+}
+
+func csvRow(record []string, columns map[string]int) (*pb.StockImportRow, error) {
+	field := func(name string) string {
+		return strings.TrimSpace(record[columns[name]])
+	}
+
+	sku, err := strconv.ParseUint(field("sku"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sku: %w", err)
+	}
+
+	userID, err := strconv.ParseInt(field("user_id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	count, err := strconv.ParseUint(field("count"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid count: %w", err)
+	}
+
+	price, err := strconv.ParseUint(field("price"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price: %w", err)
+	}
+
+	return &pb.StockImportRow{
+		Sku:      uint32(sku),
+		UserId:   userID,
+		Count:    uint32(count),
+		Price:    uint32(price),
+		Location: field("location"),
+	}, nil
+}
+
+func newJSONLReader(r io.Reader) rowReader {
+	scanner := bufio.NewScanner(r)
+
+	var line int64
+
+	return func() (*pb.StockImportRow, error) {
+		for scanner.Scan() {
+			line++
+
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+
+			var row jsonlRow
+
+			decoder := json.NewDecoder(strings.NewReader(text))
+			decoder.DisallowUnknownFields()
+
+			if err := decoder.Decode(&row); err != nil {
+				return nil, rowError{line: line, err: err}
+			}
+
+			return &pb.StockImportRow{
+				Line:     line,
+				Sku:      row.SKU,
+				UserId:   row.UserID,
+				Count:    row.Count,
+				Price:    row.Price,
+				Location: row.Location,
+			}, nil
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, io.EOF
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	pb "stocks/pkg/api/stock"
+
+	"stocks/internal/tlsconfig"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+
+	usage = `usage:
+  stockctl import -file <path> [-format csv|jsonl] [-dry-run] [-batch 500] [connection flags]
+  stockctl export [-out <path>] [-format csv|jsonl] [connection flags]
+
+connection flags: [-addr localhost:8091] [-token <jwt>] [-ca <pem> [-cert <pem> -key <pem>] [-server-name <name>]]
+the token is read from STOCKCTL_TOKEN if -token is not set, TLS is used if a CA or a certificate is set`
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+
+	switch os.Args[1] {
+	case "import":
+		err = runImport(ctx, os.Args[2:])
+	case "export":
+		err = runExport(ctx, os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func newClient(flags connFlags) (pb.StockServiceClient, func(), error) {
+	creds := insecure.NewCredentials()
+
+	tlsConfig := tlsconfig.Config{CertFile: *flags.cert, KeyFile: *flags.key, CAFile: *flags.ca}
+	if tlsConfig.Enabled() {
+		certs, err := tlsconfig.NewReloader(tlsConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error loading TLS certificates: %w", err)
+		}
+
+		creds = credentials.NewTLS(certs.ClientConfig(*flags.serverName))
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+
+	if *flags.token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(*flags.token)))
+	}
+
+	conn, err := grpc.NewClient(*flags.addr, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error dialing %s: %w", *flags.addr, err)
+	}
+
+	return pb.NewStockServiceClient(conn), func() { _ = conn.Close() }, nil
+}
+
+// fileFormat returns the given format or the format matching the file extension.
+func fileFormat(format, path string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	switch format {
+	case formatCSV, formatJSONL:
+		return format, nil
+	case "ndjson", "":
+		return formatJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, use %s or %s", format, formatCSV, formatJSONL)
+	}
+}
+
+// connFlags are the flags of the connection to the stocks server shared by all commands.
+type connFlags struct {
+	addr       *string
+	token      *string
+	ca         *string
+	cert       *string
+	key        *string
+	serverName *string
+}
+
+func newFlagSet(name string) (*flag.FlagSet, connFlags, *string) {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+
+	conn := connFlags{
+		addr:       fs.String("addr", "localhost:8091", "address of the stocks gRPC server"),
+		token:      fs.String("token", os.Getenv("STOCKCTL_TOKEN"), "JWT sent as the bearer token of the requests"),
+		ca:         fs.String("ca", "", "CA file of the server certificate, enables TLS"),
+		cert:       fs.String("cert", "", "client certificate file for mTLS"),
+		key:        fs.String("key", "", "client key file for mTLS"),
+		serverName: fs.String("server-name", "", "name verified in the server certificate, the host of -addr by default"),
+	}
+	format := fs.String("format", "", "file format: csv or jsonl, by default taken from the file extension")
+
+	return fs, conn, format
+}
+
+// bearerToken sends the token in the authorization metadata of every request.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity is false, so the token can be sent to a local server without TLS.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -23,9 +23,11 @@ import (
 
 	"go.opentelemetry.io/otel/sdk/trace"
 
+	"buf.build/go/protovalidate"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -111,9 +113,20 @@ func (t *testAppConfig) Setup(ctx context.Context) error {
 	trxManager := postgres.NewPgTxManager(t.DBPool)
 	stockRepo := repository.NewStockRepository(t.DBPool)
 	stockUsecase := usecase.NewStockUsecase(stockRepo, trxManager, kafkaProducer, t.Logger)
-	srv := myGrpc.NewStockServer(stockUsecase)
+	skuRepo := repository.NewSKURepository(t.DBPool)
+	categoryRepo := repository.NewCategoryRepository(t.DBPool)
+	skuUsecase := usecase.NewSKUUsecase(skuRepo, categoryRepo, kafkaProducer, t.Logger)
+	srv := myGrpc.NewStockServer(stockUsecase, skuUsecase)
 
-	t.StockGRPC = grpc.NewServer()
+	validator, err := protovalidate.New()
+	if err != nil {
+		return err
+	}
+
+	t.StockGRPC = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(myGrpc.ErrorInterceptor(), myGrpc.ValidationInterceptor(validator)),
+		grpc.ChainStreamInterceptor(myGrpc.ErrorStreamInterceptor(), myGrpc.ValidationStreamInterceptor(validator)),
+	)
 	pb.RegisterStockServiceServer(t.StockGRPC, srv)
 
 	go func() {
@@ -123,7 +136,7 @@ func (t *testAppConfig) Setup(ctx context.Context) error {
 	}()
 
 	//gateway
-	mux, err := myGrpc.NewMux(ctx, grpcServerAddress)
+	mux, err := myGrpc.NewMux(ctx, grpcServerAddress, insecure.NewCredentials())
 	if err != nil {
 		return err
 	}
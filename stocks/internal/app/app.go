@@ -8,11 +8,17 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"stocks/internal/auth"
 	"stocks/internal/config"
+	"stocks/internal/health"
+	"stocks/internal/jobs"
 	"stocks/internal/producer"
+	"stocks/internal/ratelimit"
 	"stocks/internal/repository"
+	"stocks/internal/tlsconfig"
 	"stocks/internal/usecase"
 	"stocks/pkg/postgres"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -23,27 +29,47 @@ import (
 
 	pb "stocks/pkg/api/stock"
 
+	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
-	ErrLoadEnv        = "error loading .env file: %v"
-	ErrDBConnect      = "error connecting to database: %v"
-	ErrMigration      = "error migration: %v"
-	ErrMigrationUp    = "error migration up: %v"
-	ErrShutdown       = "shutdown error: %v"
-	ErrListener       = "failed to listen: %v"
-	ErrTracerShutdown = "failed to shutdown tracer: %v"
-	ErrListenGRPC     = "failed to serve grpc server"
-	ErrListenGateway  = "failed to serve gateway server"
-	ErrListenMetrics  = "failed to serve metrics server"
+	ErrLoadEnv         = "error loading .env file: %v"
+	ErrDBConnect       = "error connecting to database: %v"
+	ErrMigration       = "error migration: %v"
+	ErrMigrationUp     = "error migration up: %v"
+	ErrShutdown        = "shutdown error: %v"
+	ErrListener        = "failed to listen: %v"
+	ErrTracerShutdown  = "failed to shutdown tracer: %v"
+	ErrListenGRPC      = "failed to serve grpc server"
+	ErrListenGateway   = "failed to serve gateway server"
+	ErrListenMetrics   = "failed to serve metrics server"
+	ErrLoadIdemTTL     = "error loading IDEMPOTENCY_TTL: %v"
+	ErrLoadIdemCleanup = "error loading IDEMPOTENCY_CLEANUP_INTERVAL: %v"
+	ErrLoadPriceApply  = "error loading PRICE_APPLY_INTERVAL: %v"
+	ErrLoadRetention   = "error loading STOCK_RETENTION: %v"
+	ErrLoadPurge       = "error loading STOCK_PURGE_INTERVAL: %v"
+	ErrLoadAuth        = "error loading JWT keys: %v"
+	ErrLoadRateLimit   = "error loading RATE_LIMIT_DEFAULT: %v"
+	ErrLoadRateMethods = "error loading RATE_LIMIT_METHODS: %v"
+	ErrLoadTLS         = "error loading TLS certificates: %v"
+	ErrLoadTLSReload   = "error loading TLS_RELOAD_INTERVAL: %v"
+	ErrValidator       = "error creating request validator: %v"
+	ErrLoadHealthEvery = "error loading HEALTH_CHECK_INTERVAL: %v"
+	ErrLoadHealthWait  = "error loading HEALTH_CHECK_TIMEOUT: %v"
+	ErrLoadDrainDelay  = "error loading SHUTDOWN_DRAIN_DELAY: %v"
+	WarnAuthDisabled   = "authentication is disabled, the user_id of the requests is trusted"
+	WarnTLSDisabled    = "TLS is disabled, the gRPC and gateway servers listen in plaintext"
 
 	tracingServiceName = "stock-service"
 
 	metricsTimeout           = 5 * time.Second
 	gatewayReadHeaderTimeout = 3 * time.Second
 	gatewayShutdownTimeout   = 5 * time.Second
+	grpcShutdownTimeout      = 10 * time.Second
 )
 
 func RunApp(env string, logger myLog.Logger) error {
@@ -103,6 +129,101 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	defer kafkaProducer.Close()
 
+	//idempotency keys
+	idempotencyTTL, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_TTL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadIdemTTL, err)
+	}
+
+	idempotencyCleanupInterval, err := time.ParseDuration(os.Getenv("IDEMPOTENCY_CLEANUP_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadIdemCleanup, err)
+	}
+
+	//scheduled prices
+	priceApplyInterval, err := time.ParseDuration(os.Getenv("PRICE_APPLY_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadPriceApply, err)
+	}
+
+	//deleted stock
+	stockRetention, err := time.ParseDuration(os.Getenv("STOCK_RETENTION"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadRetention, err)
+	}
+
+	stockPurgeInterval, err := time.ParseDuration(os.Getenv("STOCK_PURGE_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadPurge, err)
+	}
+
+	//auth
+	authDisabled, _ := strconv.ParseBool(os.Getenv("AUTH_DISABLED"))
+
+	var verifier *auth.Verifier
+
+	if !authDisabled {
+		verifier, err = auth.NewVerifier(auth.Config{
+			JWKSFile:   os.Getenv("AUTH_JWKS_FILE"),
+			HMACSecret: os.Getenv("AUTH_HS256_SECRET"),
+			Issuer:     os.Getenv("AUTH_ISSUER"),
+			Audience:   os.Getenv("AUTH_AUDIENCE"),
+		})
+		if err != nil {
+			return fmt.Errorf(ErrLoadAuth, err)
+		}
+	}
+
+	//rate limit
+	rateLimitDefault, err := ratelimit.ParseRule(os.Getenv("RATE_LIMIT_DEFAULT"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadRateLimit, err)
+	}
+
+	rateLimitMethods, err := ratelimit.ParseRules(os.Getenv("RATE_LIMIT_METHODS"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadRateMethods, err)
+	}
+
+	//health checks
+	healthCheckInterval, err := time.ParseDuration(os.Getenv("HEALTH_CHECK_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadHealthEvery, err)
+	}
+
+	healthCheckTimeout, err := time.ParseDuration(os.Getenv("HEALTH_CHECK_TIMEOUT"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadHealthWait, err)
+	}
+
+	shutdownDrainDelay, err := time.ParseDuration(os.Getenv("SHUTDOWN_DRAIN_DELAY"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadDrainDelay, err)
+	}
+
+	//tls
+	tlsConfig := tlsconfig.Config{
+		CertFile: os.Getenv("GRPC_TLS_CERT_FILE"),
+		KeyFile:  os.Getenv("GRPC_TLS_KEY_FILE"),
+		CAFile:   os.Getenv("GRPC_TLS_CA_FILE"),
+	}
+
+	var certs *tlsconfig.Reloader
+
+	if tlsConfig.Enabled() {
+		certs, err = tlsconfig.NewServerReloader(tlsConfig)
+		if err != nil {
+			return fmt.Errorf(ErrLoadTLS, err)
+		}
+	} else {
+		logger.Warn(WarnTLSDisabled)
+	}
+
+	tlsReloadInterval, err := time.ParseDuration(os.Getenv("TLS_RELOAD_INTERVAL"))
+	if err != nil {
+		return fmt.Errorf(ErrLoadTLSReload, err)
+	}
+
 	//grpc listener
 	grpcServerAddress := fmt.Sprintf("%s:%s", os.Getenv("GRPC_HOST"), os.Getenv("GRPC_PORT"))
 
@@ -114,34 +235,117 @@ func RunApp(env string, logger myLog.Logger) error {
 	trxManager := postgres.NewPgTxManager(dbPool)
 	stockRepo := repository.NewStockRepository(dbPool)
 	stockUsecase := usecase.NewStockUsecase(stockRepo, trxManager, kafkaProducer, logger)
-	stockService := myGrpc.NewStockServer(stockUsecase)
+	skuRepo := repository.NewSKURepository(dbPool)
+	categoryRepo := repository.NewCategoryRepository(dbPool)
+	skuUsecase := usecase.NewSKUUsecase(skuRepo, categoryRepo, kafkaProducer, logger)
+	stockService := myGrpc.NewStockServer(stockUsecase, skuUsecase)
+	idempotencyRepo := repository.NewIdempotencyRepository(dbPool)
+	idempotencyUsecase := usecase.NewIdempotencyUsecase(idempotencyRepo, idempotencyTTL)
+	idempotencyCleanupJob := jobs.NewIdempotencyCleanupJob(idempotencyUsecase, idempotencyCleanupInterval, logger)
+	priceApplierJob := jobs.NewPriceChangeApplierJob(stockUsecase, priceApplyInterval, logger)
+	stockPurgeJob := jobs.NewStockPurgeJob(stockUsecase, stockRetention, stockPurgeInterval, logger)
 	metric := metrics.RegisterMetrics()
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(
+	rateLimiter := ratelimit.NewLimiter(rateLimitDefault, rateLimitMethods)
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		return fmt.Errorf(ErrValidator, err)
+	}
+
+	// the errors are converted to statuses last, so the logging interceptor still logs the internal ones
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		myGrpc.ErrorInterceptor(),
 		myGrpc.LoggingInterceptor(
 			logger,
 			metric,
 			tracing.Tracer(tracingServiceName),
 		),
-	))
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{myGrpc.ErrorStreamInterceptor()}
+
+	if verifier != nil {
+		unaryInterceptors = append(unaryInterceptors,
+			myGrpc.AuthInterceptor(verifier, logger),
+			myGrpc.PolicyInterceptor(logger),
+		)
+		streamInterceptors = append(streamInterceptors,
+			myGrpc.AuthStreamInterceptor(verifier, logger),
+			myGrpc.PolicyStreamInterceptor(logger),
+		)
+	} else {
+		logger.Warn(WarnAuthDisabled)
+	}
+
+	// the rate limit and the idempotency keys are scoped by the principal, so they are checked after the authentication;
+	// invalid requests are rejected before they are stored under their idempotency key
+	unaryInterceptors = append(unaryInterceptors,
+		myGrpc.RateLimitInterceptor(rateLimiter, metric),
+		myGrpc.ValidationInterceptor(validator),
+		myGrpc.IdempotencyInterceptor(idempotencyUsecase, logger),
+	)
+	streamInterceptors = append(streamInterceptors,
+		myGrpc.RateLimitStreamInterceptor(rateLimiter, metric),
+		myGrpc.ValidationStreamInterceptor(validator),
+	)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	// the gateway dials the gRPC server with the certificate of the service, so it passes the mTLS check
+	var gatewayCreds credentials.TransportCredentials = insecure.NewCredentials()
+
+	if certs != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certs.GRPCServerConfig())))
+		gatewayCreds = credentials.NewTLS(certs.ClientConfig(os.Getenv("GRPC_TLS_SERVER_NAME")))
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
 
 	//grpc register
 	reflection.Register(grpcServer)
 	pb.RegisterStockServiceServer(grpcServer, stockService)
 
+	//health checks
+	healthChecker := health.NewHealth(
+		[]string{pb.StockService_ServiceDesc.ServiceName},
+		health.Config{Interval: healthCheckInterval, Timeout: healthCheckTimeout},
+		logger,
+	)
+	healthChecker.AddChecker("postgres", health.CheckFunc(dbPool.Ping))
+	healthChecker.AddChecker("kafka", health.CheckFunc(kafkaProducer.Check))
+	healthChecker.RegisterServer(grpcServer)
+
 	//gateway listener
 	gatewayAddr := fmt.Sprintf("%s:%s", os.Getenv("GATEWAY_SERVER_HOST"), os.Getenv("GATEWAY_SERVER_PORT"))
 
-	mux, err := myGrpc.NewMux(ctx, grpcServerAddress)
+	mux, err := myGrpc.NewMux(ctx, grpcServerAddress, gatewayCreds)
 	if err != nil {
 		return err
 	}
 
+	err = myGrpc.HandleProbes(mux, healthChecker)
+	if err != nil {
+		return err
+	}
+
+	var handler http.Handler = mux
+	if verifier != nil {
+		handler = myGrpc.AuthMiddleware(mux, verifier, mux)
+	}
+
 	serverConfig := &myGrpc.ServerConfig{
 		Address:           gatewayAddr,
-		Handler:           mux,
+		Handler:           handler,
 		ReadHeaderTimeout: gatewayReadHeaderTimeout,
 	}
 
+	if certs != nil {
+		serverConfig.TLSConfig = certs.HTTPServerConfig()
+	}
+
 	gatewayServer := myGrpc.NewGatewayServer(serverConfig)
 
 	//grpc ListenAndServe
@@ -153,7 +357,7 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	//gateway ListenAndServe
 	go func() {
-		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := listenAndServeGateway(gatewayServer); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			logger.Error(ErrListenGateway, myLog.Error(err))
 		}
 	}()
@@ -165,6 +369,23 @@ func RunApp(env string, logger myLog.Logger) error {
 		}
 	}()
 
+	//tls certificates reload
+	if certs != nil {
+		go certs.Watch(ctx, tlsReloadInterval, logger)
+	}
+
+	//health checks
+	go healthChecker.Run(ctx)
+
+	//idempotency cleanup job
+	go idempotencyCleanupJob.Run(ctx)
+
+	//scheduled price changes job
+	go priceApplierJob.Run(ctx)
+
+	//deleted stock purge job
+	go stockPurgeJob.Run(ctx)
+
 	logger.Infof("listening in %s\n", gatewayAddr)
 
 	//gracefull shutdowns
@@ -172,19 +393,55 @@ func RunApp(env string, logger myLog.Logger) error {
 
 	logger.Info("shutting down server gracefully...")
 
-	grpcServer.GracefulStop()
+	// the probes and the health service report NOT_SERVING while the servers drain, the drain delay
+	// gives the load balancers time to see it and stop sending new requests
+	healthChecker.Shutdown()
+	time.Sleep(shutdownDrainDelay)
 
+	// the gateway calls the gRPC server, so it is shut down first and its running requests still finish
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), gatewayShutdownTimeout)
-
 	defer cancel()
 
-	if err = gatewayServer.Shutdown(shutdownCtx); err != nil {
-		return fmt.Errorf(ErrShutdown, err)
+	gatewayErr := gatewayServer.Shutdown(shutdownCtx)
+
+	gracefulStop(grpcServer, grpcShutdownTimeout)
+
+	if gatewayErr != nil {
+		return fmt.Errorf(ErrShutdown, gatewayErr)
 	}
 
 	return nil
 }
 
+// gracefulStop waits for the running RPCs up to the timeout and then closes the remaining ones,
+// like the Watch streams of the health service, which only end when the client cancels them.
+func gracefulStop(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C:
+		server.Stop()
+	}
+}
+
+// listenAndServeGateway serves HTTPS if the server has a TLS config, the certificates are taken from it.
+func listenAndServeGateway(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+
+	return server.ListenAndServe()
+}
+
 func migrationUp(dbConfig *postgres.PostgresConfig) error {
 	db, err := postgres.NewDB(dbConfig)
 	if err != nil {
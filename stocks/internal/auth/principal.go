@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"stocks/internal/models"
+)
+
+type Role string
+
+const (
+	RoleCustomer Role = "customer"
+	RoleSeller   Role = "seller"
+	RoleAdmin    Role = "admin"
+)
+
+var allRoles = []Role{RoleCustomer, RoleSeller, RoleAdmin}
+
+// Principal is the caller of a request authenticated by its token.
+type Principal struct {
+	UserID  models.UserID
+	Subject string
+	Roles   []Role
+}
+
+// HasAnyRole reports whether the principal has one of the roles.
+func (p Principal) HasAnyRole(roles ...Role) bool {
+	return slices.ContainsFunc(p.Roles, func(role Role) bool {
+		return slices.Contains(roles, role)
+	})
+}
+
+// RoleNames returns the roles of the principal for logging.
+func (p Principal) RoleNames() []string {
+	names := make([]string, len(p.Roles))
+	for i, role := range p.Roles {
+		names[i] = string(role)
+	}
+
+	return names
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal of the request, it reports false for an unauthenticated request.
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+
+	return principal, ok
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"stocks/internal/models"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const bearerPrefix = "bearer "
+
+var (
+	ErrNoToken      error = errors.New("missing bearer token")
+	ErrInvalidToken error = errors.New("invalid token")
+	ErrNoKeys       error = errors.New("no JWT keys are configured")
+	ErrUnknownKey   error = errors.New("unknown signing key")
+)
+
+// Config sets the keys the tokens are verified with: the keys of a JWKS file, selected by the kid
+// of the token, and a HS256 secret used for tokens without a kid.
+type Config struct {
+	JWKSFile   string
+	HMACSecret string
+	Issuer     string
+	Audience   string
+}
+
+// Verifier verifies HS256 and RS256 signed JWTs. The sub claim of a token is the ID of its user
+// and the roles claim lists its roles, a token without known roles is a customer token.
+type Verifier struct {
+	keys   map[string]any
+	parser *jwt.Parser
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	keys := make(map[string]any)
+
+	if cfg.JWKSFile != "" {
+		if err := loadJWKS(cfg.JWKSFile, keys); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.HMACSecret != "" {
+		keys[""] = []byte(cfg.HMACSecret)
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Verifier{keys: keys, parser: jwt.NewParser(opts...)}, nil
+}
+
+// Verify checks the signature and the claims of the token and returns its principal.
+func (v *Verifier) Verify(token string) (Principal, error) {
+	var claims claims
+
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.key); err != nil {
+		return Principal{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID < 1 {
+		return Principal{}, fmt.Errorf("%w: sub must be a user ID", ErrInvalidToken)
+	}
+
+	return Principal{UserID: models.UserID(userID), Subject: claims.Subject, Roles: knownRoles(claims.Roles)}, nil
+}
+
+// knownRoles returns the known roles of the names, or the customer role if there are none.
+func knownRoles(names []string) []Role {
+	var roles []Role
+
+	for _, name := range names {
+		role := Role(name)
+		if !slices.Contains(allRoles, role) || slices.Contains(roles, role) {
+			continue
+		}
+
+		roles = append(roles, role)
+	}
+
+	if len(roles) == 0 {
+		return []Role{RoleCustomer}
+	}
+
+	return roles
+}
+
+// key returns the key of the kid of the token. The parser rejects a key of another type than
+// the signing method of the token, so a HS256 token can not be verified with a public RSA key.
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := v.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+// BearerToken returns the token of an Authorization header value.
+func BearerToken(header string) (string, error) {
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", ErrNoToken
+	}
+
+	return strings.TrimSpace(header[len(bearerPrefix):]), nil
+}
+
+// loadJWKS adds the RSA and symmetric keys of the JWKS file by their kid.
+func loadJWKS(path string, keys map[string]any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading JWKS file: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err = json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("error parsing JWKS file: %w", err)
+	}
+
+	for _, key := range set.Keys {
+		switch key.Kty {
+		case "RSA":
+			publicKey, err := rsaPublicKey(key)
+			if err != nil {
+				return fmt.Errorf("JWKS key %q: %w", key.Kid, err)
+			}
+
+			keys[key.Kid] = publicKey
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(key.K)
+			if err != nil {
+				return fmt.Errorf("JWKS key %q: %w", key.Kid, err)
+			}
+
+			keys[key.Kid] = secret
+		default:
+			return fmt.Errorf("JWKS key %q: unsupported key type %q", key.Kid, key.Kty)
+		}
+	}
+
+	return nil
+}
+
+func rsaPublicKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
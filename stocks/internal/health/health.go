@@ -0,0 +1,225 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	myLog "stocks/internal/observability/log"
+)
+
+const (
+	checkOK = "ok"
+
+	statusAlive        = "ALIVE"
+	statusStarting     = "STARTING"
+	statusShuttingDown = "SHUTTING_DOWN"
+
+	warnNotServing = "service is not serving, a dependency check failed"
+	infoServing    = "service is serving, all dependency checks passed"
+)
+
+// IChecker checks that a dependency of the service is usable.
+type IChecker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckFunc adapts a function, like the Ping of a database pool, to IChecker.
+type CheckFunc func(ctx context.Context) error
+
+func (f CheckFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Config configures how often the checkers run and how long a single check may take.
+type Config struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// Report is the result of the last check, Checks maps every checker to "ok" or its error.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+type namedChecker struct {
+	name    string
+	checker IChecker
+}
+
+// Health runs the checkers of the dependencies every interval and reports the result through the
+// grpc.health.v1 service, for the whole server and the given services, and the readiness probe.
+type Health struct {
+	server   *health.Server
+	services []string
+	checkers []namedChecker
+	cfg      Config
+	logger   myLog.Logger
+
+	mu           sync.RWMutex
+	report       Report
+	shuttingDown bool
+}
+
+// NewHealth returns a Health that is not serving until the first check passes.
+func NewHealth(services []string, cfg Config, logger myLog.Logger) *Health {
+	h := &Health{
+		server:   health.NewServer(),
+		services: services,
+		cfg:      cfg,
+		logger:   logger,
+		report:   Report{Status: statusStarting},
+	}
+
+	h.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return h
+}
+
+// AddChecker adds a dependency check, it must be called before Run.
+func (h *Health) AddChecker(name string, checker IChecker) {
+	h.checkers = append(h.checkers, namedChecker{name: name, checker: checker})
+}
+
+// RegisterServer registers the grpc.health.v1 service on the gRPC server.
+func (h *Health) RegisterServer(registrar grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(registrar, h.server)
+}
+
+// Run checks the dependencies right away and then every interval until ctx is done.
+func (h *Health) Run(ctx context.Context) {
+	h.check(ctx)
+
+	ticker := time.NewTicker(h.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.check(ctx)
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING from now on, so the clients and the orchestrator stop sending requests
+// while the servers drain the current ones.
+func (h *Health) Shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.shuttingDown = true
+	h.report = Report{Status: statusShuttingDown}
+	h.server.Shutdown()
+}
+
+// LivenessHandler answers 200 while the process is able to serve HTTP, the dependencies are not checked,
+// so an outage of a dependency does not get the service restarted.
+func (h *Health) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeReport(w, http.StatusOK, Report{Status: statusAlive})
+	})
+}
+
+// ReadinessHandler answers 200 with the report of the last check if all dependencies passed it and 503 otherwise.
+func (h *Health) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		report := h.Report()
+
+		code := http.StatusOK
+		if report.Status != healthpb.HealthCheckResponse_SERVING.String() {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeReport(w, code, report)
+	})
+}
+
+// Report returns the result of the last check.
+func (h *Health) Report() Report {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.report
+}
+
+// check runs all checkers concurrently, each with its own timeout, and publishes the result.
+func (h *Health) check(ctx context.Context) {
+	results := make([]error, len(h.checkers))
+
+	var wg sync.WaitGroup
+
+	for i, c := range h.checkers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, h.cfg.Timeout)
+			defer cancel()
+
+			results[i] = c.checker.Check(checkCtx)
+		}()
+	}
+
+	wg.Wait()
+
+	status := healthpb.HealthCheckResponse_SERVING
+	checks := make(map[string]string, len(h.checkers))
+
+	var fields []myLog.Field
+
+	for i, c := range h.checkers {
+		if results[i] == nil {
+			checks[c.name] = checkOK
+
+			continue
+		}
+
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+		checks[c.name] = results[i].Error()
+		fields = append(fields, myLog.String(c.name, results[i].Error()))
+	}
+
+	report := Report{Status: status.String(), Checks: checks}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shuttingDown {
+		return
+	}
+
+	if report.Status != h.report.Status {
+		if len(fields) > 0 {
+			h.logger.Warn(warnNotServing, fields...)
+		} else {
+			h.logger.Info(infoServing)
+		}
+	}
+
+	h.report = report
+	h.setServingStatus(status)
+}
+
+func (h *Health) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	h.server.SetServingStatus("", status)
+
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, status)
+	}
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
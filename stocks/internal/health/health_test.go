@@ -0,0 +1,144 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	logMock "stocks/internal/observability/log/mock"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testService = "api.StockService"
+
+func newTestHealth(t *testing.T, checkers map[string]IChecker) *Health {
+	logger := logMock.NewLoggerMock(t)
+	logger.InfoMock.Optional().Return()
+	logger.WarnMock.Optional().Return()
+
+	h := NewHealth([]string{testService}, Config{Interval: time.Minute, Timeout: 50 * time.Millisecond}, logger)
+
+	for name, checker := range checkers {
+		h.AddChecker(name, checker)
+	}
+
+	return h
+}
+
+func servingStatus(t *testing.T, h *Health, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q) error = %v", service, err)
+	}
+
+	return resp.GetStatus()
+}
+
+func readiness(t *testing.T, h *Health) (int, Report) {
+	rec := httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var report Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("decode readiness report: %v", err)
+	}
+
+	return rec.Code, report
+}
+
+func TestCheck(t *testing.T) {
+	ok := CheckFunc(func(context.Context) error { return nil })
+	failing := CheckFunc(func(context.Context) error { return errors.New("connection refused") })
+	slow := CheckFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	tests := []struct {
+		name       string
+		checkers   map[string]IChecker
+		wantStatus healthpb.HealthCheckResponse_ServingStatus
+		wantCode   int
+		wantChecks map[string]string
+	}{
+		{
+			name:       "all checks pass",
+			checkers:   map[string]IChecker{"postgres": ok, "kafka": ok},
+			wantStatus: healthpb.HealthCheckResponse_SERVING,
+			wantCode:   http.StatusOK,
+			wantChecks: map[string]string{"postgres": "ok", "kafka": "ok"},
+		},
+		{
+			name:       "a check fails",
+			checkers:   map[string]IChecker{"postgres": ok, "kafka": failing},
+			wantStatus: healthpb.HealthCheckResponse_NOT_SERVING,
+			wantCode:   http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"postgres": "ok", "kafka": "connection refused"},
+		},
+		{
+			name:       "a check times out",
+			checkers:   map[string]IChecker{"kafka": slow},
+			wantStatus: healthpb.HealthCheckResponse_NOT_SERVING,
+			wantCode:   http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"kafka": context.DeadlineExceeded.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHealth(t, tt.checkers)
+
+			if got := servingStatus(t, h, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+				t.Errorf("status before the first check = %v, want NOT_SERVING", got)
+			}
+
+			h.check(context.Background())
+
+			for _, service := range []string{"", testService} {
+				if got := servingStatus(t, h, service); got != tt.wantStatus {
+					t.Errorf("status of %q = %v, want %v", service, got, tt.wantStatus)
+				}
+			}
+
+			code, report := readiness(t, h)
+			if code != tt.wantCode {
+				t.Errorf("readiness code = %d, want %d", code, tt.wantCode)
+			}
+
+			if report.Status != tt.wantStatus.String() || !reflect.DeepEqual(report.Checks, tt.wantChecks) {
+				t.Errorf("readiness report = %+v, want %v with checks %v", report, tt.wantStatus, tt.wantChecks)
+			}
+		})
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	h := newTestHealth(t, map[string]IChecker{"postgres": CheckFunc(func(context.Context) error { return nil })})
+
+	h.check(context.Background())
+	h.Shutdown()
+	h.check(context.Background())
+
+	if got := servingStatus(t, h, testService); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status after shutdown = %v, want NOT_SERVING", got)
+	}
+
+	code, report := readiness(t, h)
+	if code != http.StatusServiceUnavailable || report.Status != statusShuttingDown {
+		t.Errorf("readiness after shutdown = %d %s, want %d %s", code, report.Status, http.StatusServiceUnavailable, statusShuttingDown)
+	}
+
+	rec := httptest.NewRecorder()
+	h.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("liveness after shutdown = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
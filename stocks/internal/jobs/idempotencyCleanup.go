@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+)
+
+const (
+	errDeleteExpiredKeys = "failed to delete expired idempotency keys"
+	infoDeletedKeys      = "deleted expired idempotency keys"
+)
+
+type IKeyCleaner interface {
+	DeleteExpiredKeys(ctx context.Context) (int, error)
+}
+
+type IdempotencyCleanupJob struct {
+	cleaner  IKeyCleaner
+	interval time.Duration
+	logger   myLog.Logger
+}
+
+func NewIdempotencyCleanupJob(cleaner IKeyCleaner, interval time.Duration, l myLog.Logger) *IdempotencyCleanupJob {
+	return &IdempotencyCleanupJob{
+		cleaner:  cleaner,
+		interval: interval,
+		logger:   l,
+	}
+}
+
+// Run deletes expired idempotency keys every interval until ctx is done.
+func (j *IdempotencyCleanupJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.cleanup(ctx)
+		}
+	}
+}
+
+func (j *IdempotencyCleanupJob) cleanup(ctx context.Context) {
+	deleted, err := j.cleaner.DeleteExpiredKeys(ctx)
+	if err != nil {
+		j.logger.Error(errDeleteExpiredKeys, myLog.Error(err))
+
+		return
+	}
+
+	if deleted > 0 {
+		j.logger.Info(infoDeletedKeys, myLog.Int("count", deleted))
+	}
+}
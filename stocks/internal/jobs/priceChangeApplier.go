@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+)
+
+const (
+	errApplyPriceChanges = "failed to apply scheduled price changes"
+	infoAppliedPrices    = "applied scheduled price changes"
+)
+
+type IPriceApplier interface {
+	ApplyDuePriceChanges(ctx context.Context) (int, error)
+}
+
+type PriceChangeApplierJob struct {
+	applier  IPriceApplier
+	interval time.Duration
+	logger   myLog.Logger
+}
+
+func NewPriceChangeApplierJob(applier IPriceApplier, interval time.Duration, l myLog.Logger) *PriceChangeApplierJob {
+	return &PriceChangeApplierJob{
+		applier:  applier,
+		interval: interval,
+		logger:   l,
+	}
+}
+
+// Run applies the due price changes every interval until ctx is done.
+func (j *PriceChangeApplierJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.apply(ctx)
+		}
+	}
+}
+
+// apply runs batches until no due change is left, so a backlog is not spread over many intervals.
+func (j *PriceChangeApplierJob) apply(ctx context.Context) {
+	for ctx.Err() == nil {
+		applied, err := j.applier.ApplyDuePriceChanges(ctx)
+		if err != nil {
+			j.logger.Error(errApplyPriceChanges, myLog.Error(err))
+
+			return
+		}
+
+		if applied == 0 {
+			return
+		}
+
+		j.logger.Info(infoAppliedPrices, myLog.Int("count", applied))
+	}
+}
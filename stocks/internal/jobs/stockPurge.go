@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+)
+
+const (
+	errPurgeDeletedStock = "failed to purge deleted stock"
+	infoPurgedStock      = "purged deleted stock"
+)
+
+type IStockPurger interface {
+	PurgeDeletedStock(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type StockPurgeJob struct {
+	purger    IStockPurger
+	retention time.Duration
+	interval  time.Duration
+	logger    myLog.Logger
+}
+
+func NewStockPurgeJob(purger IStockPurger, retention, interval time.Duration, l myLog.Logger) *StockPurgeJob {
+	return &StockPurgeJob{
+		purger:    purger,
+		retention: retention,
+		interval:  interval,
+		logger:    l,
+	}
+}
+
+// Run purges the stock deleted longer than the retention ago every interval until ctx is done.
+func (j *StockPurgeJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.purge(ctx)
+		}
+	}
+}
+
+func (j *StockPurgeJob) purge(ctx context.Context) {
+	purged, err := j.purger.PurgeDeletedStock(ctx, j.retention)
+	if err != nil {
+		j.logger.Error(errPurgeDeletedStock, myLog.Error(err))
+
+		return
+	}
+
+	if purged > 0 {
+		j.logger.Info(infoPurgedStock, myLog.Int("count", int(purged)))
+	}
+}
@@ -0,0 +1,7 @@
+ALTER TABLE sku
+ALTER COLUMN sku_id DROP DEFAULT,
+DROP COLUMN IF EXISTS archived_at,
+DROP COLUMN IF EXISTS created_at,
+DROP COLUMN IF EXISTS updated_at;
+
+DROP SEQUENCE IF EXISTS sku_id_seq;
@@ -0,0 +1,7 @@
+CREATE SEQUENCE IF NOT EXISTS sku_id_seq START WITH 100000 OWNED BY sku.sku_id;
+
+ALTER TABLE sku
+ALTER COLUMN sku_id SET DEFAULT nextval('sku_id_seq'),
+ADD COLUMN archived_at TIMESTAMPTZ,
+ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
@@ -0,0 +1,10 @@
+ALTER TABLE sku ADD COLUMN type TEXT;
+
+UPDATE sku SET type = category.name FROM category WHERE category.id = sku.category_id;
+
+ALTER TABLE sku
+DROP COLUMN IF EXISTS description,
+DROP COLUMN IF EXISTS category_id,
+DROP COLUMN IF EXISTS attributes;
+
+DROP TABLE IF EXISTS category;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS category(
+    id BIGSERIAL NOT NULL PRIMARY KEY,
+    parent_id BIGINT REFERENCES category(id) ON DELETE RESTRICT,
+    name TEXT NOT NULL UNIQUE,
+    attribute_schema JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX category_parent_id_idx ON category (parent_id);
+
+INSERT INTO category (name, attribute_schema) VALUES
+('apparel', '{"size": "string", "color": "string"}'),
+('accessory', '{"color": "string"}'),
+('stationery', '{"color": "string"}'),
+('electronics', '{"weight": "number", "color": "string"}');
+
+ALTER TABLE sku
+ADD COLUMN description TEXT NOT NULL DEFAULT '',
+ADD COLUMN category_id BIGINT REFERENCES category(id) ON DELETE RESTRICT,
+ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}';
+
+UPDATE sku SET category_id = category.id FROM category WHERE category.name = sku.type;
+
+ALTER TABLE sku DROP COLUMN type;
+
+CREATE INDEX sku_category_id_idx ON sku (category_id);
+CREATE INDEX sku_attributes_idx ON sku USING GIN (attributes jsonb_path_ops);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS stock_location_idx;
+DROP INDEX IF EXISTS stock_count_idx;
+DROP INDEX IF EXISTS stock_price_idx;
+DROP INDEX IF EXISTS sku_search_vector_idx;
+
+ALTER TABLE sku DROP COLUMN IF EXISTS search_vector;
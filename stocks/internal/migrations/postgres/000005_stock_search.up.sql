@@ -0,0 +1,9 @@
+ALTER TABLE sku
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', description), 'B')
+) STORED;
+
+CREATE INDEX sku_search_vector_idx ON sku USING GIN (search_vector);
+CREATE INDEX stock_price_idx ON stock (price);
+CREATE INDEX stock_count_idx ON stock (count);
+CREATE INDEX stock_location_idx ON stock (location);
@@ -0,0 +1,9 @@
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_sku_id_location_key;
+
+UPDATE stock s SET count = t.total
+FROM (SELECT min(id) AS id, sum(count) AS total FROM stock GROUP BY sku_id) t
+WHERE s.id = t.id;
+
+DELETE FROM stock s USING stock o WHERE s.sku_id = o.sku_id AND s.id > o.id;
+
+ALTER TABLE stock ADD CONSTRAINT stock_sku_id_key UNIQUE (sku_id);
@@ -0,0 +1,3 @@
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_sku_id_key;
+
+ALTER TABLE stock ADD CONSTRAINT stock_sku_id_location_key UNIQUE (sku_id, location);
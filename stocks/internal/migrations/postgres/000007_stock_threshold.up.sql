@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS stock_threshold(
+    sku_id BIGINT NOT NULL REFERENCES sku(sku_id) ON DELETE CASCADE,
+    location TEXT NOT NULL DEFAULT '',
+    threshold INT NOT NULL CHECK (threshold > 0),
+    PRIMARY KEY (sku_id, location)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS price_change;
+
+DROP TABLE IF EXISTS price_history;
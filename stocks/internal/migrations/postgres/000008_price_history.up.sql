@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS price_history(
+    id BIGSERIAL PRIMARY KEY,
+    sku_id BIGINT NOT NULL REFERENCES sku(sku_id) ON DELETE CASCADE,
+    location TEXT NOT NULL,
+    old_price INT NOT NULL DEFAULT 0,
+    price INT NOT NULL,
+    source TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS price_history_sku_idx ON price_history (sku_id, changed_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS price_change(
+    id BIGSERIAL PRIMARY KEY,
+    sku_id BIGINT NOT NULL REFERENCES sku(sku_id) ON DELETE CASCADE,
+    price INT NOT NULL CHECK (price >= 0),
+    effective_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    applied_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS price_change_pending_idx ON price_change (effective_at, id) WHERE applied_at IS NULL;
+
+INSERT INTO price_history (sku_id, location, price, source)
+SELECT sku_id, COALESCE(location, ''), price, 'initial' FROM stock WHERE sku_id IS NOT NULL;
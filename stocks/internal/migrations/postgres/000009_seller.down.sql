@@ -0,0 +1,19 @@
+ALTER TABLE price_change DROP COLUMN IF EXISTS user_id;
+
+ALTER TABLE price_history DROP COLUMN IF EXISTS user_id;
+
+DROP INDEX IF EXISTS stock_sku_id_price_idx;
+
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_sku_id_user_id_location_key;
+
+UPDATE stock s SET count = t.total
+FROM (SELECT min(id) AS id, sum(count) AS total FROM stock GROUP BY sku_id, location) t
+WHERE s.id = t.id;
+
+DELETE FROM stock s USING stock o WHERE s.sku_id = o.sku_id AND s.location = o.location AND s.id > o.id;
+
+ALTER TABLE stock ADD CONSTRAINT stock_sku_id_location_key UNIQUE (sku_id, location);
+
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_user_id_fkey;
+
+DROP TABLE IF EXISTS seller;
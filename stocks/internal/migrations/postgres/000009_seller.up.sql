@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS seller(
+    id BIGINT PRIMARY KEY,
+    name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO seller (id) SELECT DISTINCT user_id FROM stock WHERE user_id IS NOT NULL
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE stock ADD CONSTRAINT stock_user_id_fkey FOREIGN KEY (user_id) REFERENCES seller(id);
+
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_sku_id_location_key;
+
+ALTER TABLE stock ADD CONSTRAINT stock_sku_id_user_id_location_key UNIQUE (sku_id, user_id, location);
+
+CREATE INDEX IF NOT EXISTS stock_sku_id_price_idx ON stock (sku_id, price);
+
+ALTER TABLE price_history ADD COLUMN IF NOT EXISTS user_id BIGINT;
+
+UPDATE price_history h SET user_id = s.user_id FROM stock s
+WHERE s.sku_id = h.sku_id AND COALESCE(s.location, '') = h.location;
+
+ALTER TABLE price_change ADD COLUMN IF NOT EXISTS user_id BIGINT REFERENCES seller(id);
+
+UPDATE price_change p SET user_id = (SELECT user_id FROM stock WHERE sku_id = p.sku_id ORDER BY id LIMIT 1);
@@ -0,0 +1,9 @@
+DELETE FROM stock WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS stock_deleted_at_idx;
+
+DROP INDEX IF EXISTS stock_sku_id_user_id_location_key;
+
+ALTER TABLE stock ADD CONSTRAINT stock_sku_id_user_id_location_key UNIQUE (sku_id, user_id, location);
+
+ALTER TABLE stock DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,9 @@
+ALTER TABLE stock ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- a deleted stock keeps its row until it is purged, so only live stock is unique
+ALTER TABLE stock DROP CONSTRAINT IF EXISTS stock_sku_id_user_id_location_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_sku_id_user_id_location_key ON stock (sku_id, user_id, location)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS stock_deleted_at_idx ON stock (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- a key is scoped to the user and the method, so users can not claim or probe the keys of others
+ALTER TABLE idempotency_key ADD COLUMN IF NOT EXISTS subject TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE idempotency_key DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+
+ALTER TABLE idempotency_key ADD PRIMARY KEY (subject, method, key);
@@ -0,0 +1,23 @@
+package models
+
+// AttributeType - type of a custom sku attribute value.
+type AttributeType string
+
+const (
+	AttributeString AttributeType = "string"
+	AttributeNumber AttributeType = "number"
+	AttributeBool   AttributeType = "boolean"
+)
+
+// AttributeSchema - custom attributes allowed in a category by name.
+type AttributeSchema map[string]AttributeType
+
+// Attributes - custom attribute values of a sku, e.g. size, color or weight.
+type Attributes map[string]any
+
+type Category struct {
+	ID       CategoryID
+	ParentID CategoryID
+	Name     string
+	Schema   AttributeSchema
+}
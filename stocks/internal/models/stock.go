@@ -1,9 +1,14 @@
 package models
 
+import "time"
+
 type SKU struct {
-	ID   SKUID
-	Name string
-	Type string
+	ID          SKUID
+	Name        string
+	Description string
+	Category    Category
+	Attributes  Attributes
+	Archived    bool
 }
 
 type Stock struct {
@@ -19,3 +24,58 @@ type Item struct {
 	SKU   SKU
 	Stock Stock
 }
+
+// Seller - user that stocks skus, its id is the user id of its stock.
+type Seller struct {
+	ID   UserID
+	Name string
+}
+
+// Offer - stock of a sku of one seller at one location, identified by the stock id.
+type Offer struct {
+	Stock  Stock
+	Seller Seller
+}
+
+// StockThreshold - reorder threshold of a sku at a location, an empty location sets it for all locations.
+type StockThreshold struct {
+	SKUID     SKUID
+	Location  string
+	Threshold uint16
+}
+
+// LowStockItem - item with a count at or below its reorder threshold.
+type LowStockItem struct {
+	Item      Item
+	Threshold uint16
+}
+
+// PriceSource - origin of a price change.
+type PriceSource string
+
+const (
+	PriceSourceInitial  PriceSource = "initial"
+	PriceSourceUpdate   PriceSource = "update"
+	PriceSourceImport   PriceSource = "import"
+	PriceSourceSchedule PriceSource = "schedule"
+)
+
+// PriceChange - change of the price of a sku at a location, the old price of a new stock is 0.
+type PriceChange struct {
+	SKUID     SKUID
+	UserID    UserID
+	Location  string
+	OldPrice  uint32
+	Price     uint32
+	Source    PriceSource
+	ChangedAt time.Time
+}
+
+// ScheduledPrice - price of all stock of a sku of a seller that takes effect at EffectiveAt.
+type ScheduledPrice struct {
+	ID          int64
+	SKUID       SKUID
+	UserID      UserID
+	Price       uint32
+	EffectiveAt time.Time
+}
@@ -14,6 +14,19 @@ type UserID int64
 // StockID - type id of stock.
 type StockID int64
 
+// CategoryID - type id of sku category.
+type CategoryID int64
+
+// ItemSort - order of searched stock items.
+type ItemSort int
+
+const (
+	SortRelevance ItemSort = iota
+	SortPrice
+	SortName
+	SortCount
+)
+
 func Uint32ToUint16(v uint32) (uint16, error) {
 	if v > math.MaxUint16 {
 		return 0, fmt.Errorf("%d out of uint16 range", v)
@@ -6,6 +6,7 @@ type Metrics interface {
 	IncRequest(path string)
 	ObserveLatency(path string, duration float64)
 	IncError(path string)
+	IncThrottled(path, by string)
 }
 
 var _ Metrics = &AppMetrics{}
@@ -14,6 +15,7 @@ type AppMetrics struct {
 	RequestCounter  *prometheus.CounterVec
 	ResponseLatency *prometheus.HistogramVec
 	ErrorsTotal     *prometheus.CounterVec
+	ThrottledTotal  *prometheus.CounterVec
 }
 
 func (a *AppMetrics) IncRequest(path string) {
@@ -28,6 +30,11 @@ func (a *AppMetrics) IncError(path string) {
 	a.ErrorsTotal.With(prometheus.Labels{"path": path}).Inc()
 }
 
+// IncThrottled counts a request rejected by the rate limit, by is the key of its bucket: user or ip.
+func (a *AppMetrics) IncThrottled(path, by string) {
+	a.ThrottledTotal.With(prometheus.Labels{"path": path, "by": by}).Inc()
+}
+
 func RegisterMetrics() *AppMetrics {
 	requestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -54,11 +61,20 @@ func RegisterMetrics() *AppMetrics {
 		[]string{"path"},
 	)
 
-	prometheus.MustRegister(requestCounter, responseLatency, errorCounter)
+	throttledCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_throttled_requests_total",
+			Help: "Total number of requests rejected by the rate limit",
+		},
+		[]string{"path", "by"},
+	)
+
+	prometheus.MustRegister(requestCounter, responseLatency, errorCounter, throttledCounter)
 
 	return &AppMetrics{
 		RequestCounter:  requestCounter,
 		ResponseLatency: responseLatency,
 		ErrorsTotal:     errorCounter,
+		ThrottledTotal:  throttledCounter,
 	}
 }
@@ -6,10 +6,17 @@ import (
 )
 
 type ProducerMessageDTO struct {
-	Type      string
-	Service   string
-	Timestamp time.Time
-	SKU       models.SKUID
-	Count     uint16
-	Price     uint32
+	Type         string
+	Service      string
+	Timestamp    time.Time
+	SKU          models.SKUID
+	Count        uint16
+	Price        uint32
+	OldPrice     uint32
+	Name         string
+	Category     string
+	FromLocation string
+	ToLocation   string
+	Location     string
+	Threshold    uint16
 }
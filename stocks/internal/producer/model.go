@@ -1,9 +1,16 @@
 package producer
 
 type Payload struct {
-	SKU   uint32 `json:"sku"`
-	Count uint16 `json:"count"`
-	Price uint32 `json:"price"`
+	SKU          uint32 `json:"sku"`
+	Count        uint16 `json:"count"`
+	Price        uint32 `json:"price"`
+	OldPrice     uint32 `json:"oldPrice,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Category     string `json:"category,omitempty"`
+	FromLocation string `json:"fromLocation,omitempty"`
+	ToLocation   string `json:"toLocation,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Threshold    uint16 `json:"threshold,omitempty"`
 }
 
 type Message struct {
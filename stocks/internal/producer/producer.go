@@ -1,6 +1,7 @@
 package producer
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,14 +12,16 @@ import (
 )
 
 const (
-	acks         = 0
-	flushTimeout = 5000
-	partitionID  = 1
+	acks            = 0
+	flushTimeout    = 5000
+	metadataTimeout = 5000
+	partitionID     = 1
 
 	ErrCreateProducer = "error creating kafka producer: %v"
 	ErrSendMsg        = "error sending message to kafka: %v"
 	ErrMarshallMsg    = "error marshaling message to json: %v"
 	ErrKafkaRespond   = "error kafka respond: %v"
+	ErrKafkaMetadata  = "error requesting kafka metadata: %v"
 )
 
 var (
@@ -49,9 +52,16 @@ func (p *Producer) Produce(dto ProducerMessageDTO, topic string, t time.Time) er
 		Service:   dto.Service,
 		Timestamp: dto.Timestamp.Format(time.RFC3339),
 		Payload: Payload{
-			SKU:   uint32(dto.SKU),
-			Price: dto.Price,
-			Count: dto.Count,
+			SKU:          uint32(dto.SKU),
+			Price:        dto.Price,
+			OldPrice:     dto.OldPrice,
+			Count:        dto.Count,
+			Name:         dto.Name,
+			Category:     dto.Category,
+			FromLocation: dto.FromLocation,
+			ToLocation:   dto.ToLocation,
+			Location:     dto.Location,
+			Threshold:    dto.Threshold,
 		},
 	}
 
@@ -91,6 +101,20 @@ func (p *Producer) Produce(dto ProducerMessageDTO, topic string, t time.Time) er
 	return err
 }
 
+// Check requests the metadata of the cluster, which fails while no broker is reachable.
+func (p *Producer) Check(ctx context.Context) error {
+	timeout := metadataTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = int(time.Until(deadline).Milliseconds())
+	}
+
+	if _, err := p.producer.GetMetadata(nil, false, timeout); err != nil {
+		return fmt.Errorf(ErrKafkaMetadata, err)
+	}
+
+	return nil
+}
+
 func (p *Producer) Close() {
 	p.producer.Flush(flushTimeout)
 	p.producer.Close()
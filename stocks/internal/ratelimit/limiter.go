@@ -0,0 +1,167 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pruneInterval is how often the buckets that are full again are removed.
+const pruneInterval = time.Minute
+
+var ErrInvalidRule error = errors.New("rate limit rule must be <requests per second>:<burst>")
+
+// Rule is a token bucket that refills Rate tokens per second up to Burst tokens. A zero Rate is not limited.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter keeps a token bucket per method and key, the rule of a method is its own rule or the default one.
+type Limiter struct {
+	defaultRule Rule
+	rules       map[string]Rule
+	now         func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastPrune time.Time
+}
+
+type bucketKey struct {
+	method string
+	key    string
+}
+
+type bucket struct {
+	rule   Rule
+	tokens float64
+	last   time.Time
+}
+
+func NewLimiter(defaultRule Rule, rules map[string]Rule) *Limiter {
+	return &Limiter{
+		defaultRule: defaultRule,
+		rules:       rules,
+		now:         time.Now,
+		buckets:     make(map[bucketKey]*bucket),
+	}
+}
+
+// Allow takes a token of the bucket of the method and key. If the bucket is empty the request is
+// not allowed and the wait until the next token is returned.
+func (l *Limiter) Allow(method, key string) (bool, time.Duration) {
+	rule := l.rule(method)
+	if rule.Rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if now.Sub(l.lastPrune) >= pruneInterval {
+		l.prune(now)
+	}
+
+	b, ok := l.buckets[bucketKey{method: method, key: key}]
+	if !ok {
+		b = &bucket{rule: rule, tokens: float64(rule.Burst), last: now}
+		l.buckets[bucketKey{method: method, key: key}] = b
+	}
+
+	b.refill(now)
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / rule.Rate * float64(time.Second))
+
+		return false, wait
+	}
+
+	b.tokens--
+
+	return true, 0
+}
+
+// rule returns the rule of the method, methods are matched by their full name or their RPC name.
+func (l *Limiter) rule(method string) Rule {
+	if rule, ok := l.rules[method]; ok {
+		return rule
+	}
+
+	if rule, ok := l.rules[method[strings.LastIndex(method, "/")+1:]]; ok {
+		return rule
+	}
+
+	return l.defaultRule
+}
+
+// prune removes the buckets that are full again, a new bucket of the same key starts full as well.
+func (l *Limiter) prune(now time.Time) {
+	for key, b := range l.buckets {
+		b.refill(now)
+
+		if b.tokens >= float64(b.rule.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastPrune = now
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.rule.Burst), b.tokens+now.Sub(b.last).Seconds()*b.rule.Rate)
+	b.last = now
+}
+
+// ParseRule parses a rule in the form <requests per second>:<burst>, the burst is the rate rounded up if omitted.
+func ParseRule(s string) (Rule, error) {
+	rateStr, burstStr, hasBurst := strings.Cut(strings.TrimSpace(s), ":")
+
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate < 0 {
+		return Rule{}, fmt.Errorf("%w: %q", ErrInvalidRule, s)
+	}
+
+	burst := int(math.Ceil(rate))
+
+	if hasBurst {
+		burst, err = strconv.Atoi(burstStr)
+		if err != nil || burst < 1 {
+			return Rule{}, fmt.Errorf("%w: %q", ErrInvalidRule, s)
+		}
+	}
+
+	return Rule{Rate: rate, Burst: burst}, nil
+}
+
+// ParseRules parses the rules of methods in the form <method>=<rule>,<method>=<rule>, a method is
+// its RPC name like ListItem or its full name.
+func ParseRules(s string) (map[string]Rule, error) {
+	rules := make(map[string]Rule)
+
+	for _, entry := range strings.Split(s, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		method, ruleStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, entry)
+		}
+
+		rule, err := ParseRule(ruleStr)
+		if err != nil {
+			return nil, err
+		}
+
+		rules[strings.TrimSpace(method)] = rule
+	}
+
+	return rules, nil
+}
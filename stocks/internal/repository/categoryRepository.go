@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"stocks/internal/models"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	createCategoryquery = `INSERT INTO category (parent_id, name, attribute_schema) VALUES ($1, $2, $3) RETURNING id`
+	listCategoriesquery = `SELECT id, parent_id, name, attribute_schema FROM category ORDER BY id`
+	// getCategoryPathquery selects the category and its ancestors, the root first.
+	getCategoryPathquery = `WITH RECURSIVE path AS (
+		SELECT id, parent_id, name, attribute_schema, 0 AS depth FROM category WHERE id = $1
+		UNION ALL SELECT c.id, c.parent_id, c.name, c.attribute_schema, p.depth + 1 FROM category c
+		INNER JOIN path p ON c.id = p.parent_id)
+		SELECT id, parent_id, name, attribute_schema FROM path ORDER BY depth DESC`
+)
+
+type ICategoryRepo interface {
+	CreateCategory(ctx context.Context, category models.Category) (models.CategoryID, error)
+	ListCategories(ctx context.Context) ([]models.Category, error)
+	GetCategory(ctx context.Context, categoryID models.CategoryID) (models.Category, error)
+}
+
+type CategoryRepo struct {
+	db IDBQuery
+}
+
+func NewCategoryRepository(db IDBQuery) *CategoryRepo {
+	return &CategoryRepo{db: db}
+}
+
+// CreateCategory adds a category under its parent, or a root category if the parent is not set.
+// It returns ErrNotFound if the parent is missing.
+func (r *CategoryRepo) CreateCategory(ctx context.Context, category models.Category) (models.CategoryID, error) {
+	var categoryID models.CategoryID
+
+	parentID := pgtype.Int8{Int64: int64(category.ParentID), Valid: category.ParentID != 0}
+
+	schema := category.Schema
+	if schema == nil {
+		schema = models.AttributeSchema{}
+	}
+
+	err := r.db.QueryRow(ctx, createCategoryquery, parentID, category.Name, schema).Scan(&categoryID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, ErrDuplicate
+		}
+
+		if isForeignKeyViolation(err) {
+			return 0, ErrNotFound
+		}
+
+		return 0, err
+	}
+
+	return categoryID, nil
+}
+
+func (r *CategoryRepo) ListCategories(ctx context.Context) ([]models.Category, error) {
+	var categories []models.Category
+
+	rows, err := r.db.Query(ctx, listCategoriesquery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var category Category
+
+		if err = rows.Scan(&category.ID, &category.ParentID, &category.Name, &category.Schema); err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, models.Category{
+			ID:       category.ID,
+			ParentID: models.CategoryID(category.ParentID.Int64),
+			Name:     category.Name,
+			Schema:   category.Schema,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// GetCategory returns the category with its attribute schema including the attributes
+// inherited from its ancestors. A subcategory can override the type of an inherited attribute.
+func (r *CategoryRepo) GetCategory(ctx context.Context, categoryID models.CategoryID) (models.Category, error) {
+	rows, err := r.db.Query(ctx, getCategoryPathquery, categoryID)
+	if err != nil {
+		return models.Category{}, err
+	}
+	defer rows.Close()
+
+	var category Category
+
+	schema := models.AttributeSchema{}
+
+	for rows.Next() {
+		if err = rows.Scan(&category.ID, &category.ParentID, &category.Name, &category.Schema); err != nil {
+			return models.Category{}, err
+		}
+
+		for name, attrType := range category.Schema {
+			schema[name] = attrType
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return models.Category{}, err
+	}
+
+	if category.ID == 0 {
+		return models.Category{}, ErrNotFound
+	}
+
+	return models.Category{
+		ID:       category.ID,
+		ParentID: models.CategoryID(category.ParentID.Int64),
+		Name:     category.Name,
+		Schema:   schema,
+	}, nil
+}
@@ -0,0 +1,1099 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i stocks/internal/repository.ICategoryRepo -o i_category_repo.go -n ICategoryRepoMock -p mock
+
+import (
+	"context"
+	"stocks/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ICategoryRepoMock implements mm_repository.ICategoryRepo
+type ICategoryRepoMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateCategory          func(ctx context.Context, category models.Category) (c2 models.CategoryID, err error)
+	funcCreateCategoryOrigin    string
+	inspectFuncCreateCategory   func(ctx context.Context, category models.Category)
+	afterCreateCategoryCounter  uint64
+	beforeCreateCategoryCounter uint64
+	CreateCategoryMock          mICategoryRepoMockCreateCategory
+
+	funcGetCategory          func(ctx context.Context, categoryID models.CategoryID) (c2 models.Category, err error)
+	funcGetCategoryOrigin    string
+	inspectFuncGetCategory   func(ctx context.Context, categoryID models.CategoryID)
+	afterGetCategoryCounter  uint64
+	beforeGetCategoryCounter uint64
+	GetCategoryMock          mICategoryRepoMockGetCategory
+
+	funcListCategories          func(ctx context.Context) (ca1 []models.Category, err error)
+	funcListCategoriesOrigin    string
+	inspectFuncListCategories   func(ctx context.Context)
+	afterListCategoriesCounter  uint64
+	beforeListCategoriesCounter uint64
+	ListCategoriesMock          mICategoryRepoMockListCategories
+}
+
+// NewICategoryRepoMock returns a mock for mm_repository.ICategoryRepo
+func NewICategoryRepoMock(t minimock.Tester) *ICategoryRepoMock {
+	m := &ICategoryRepoMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateCategoryMock = mICategoryRepoMockCreateCategory{mock: m}
+	m.CreateCategoryMock.callArgs = []*ICategoryRepoMockCreateCategoryParams{}
+
+	m.GetCategoryMock = mICategoryRepoMockGetCategory{mock: m}
+	m.GetCategoryMock.callArgs = []*ICategoryRepoMockGetCategoryParams{}
+
+	m.ListCategoriesMock = mICategoryRepoMockListCategories{mock: m}
+	m.ListCategoriesMock.callArgs = []*ICategoryRepoMockListCategoriesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mICategoryRepoMockCreateCategory struct {
+	optional           bool
+	mock               *ICategoryRepoMock
+	defaultExpectation *ICategoryRepoMockCreateCategoryExpectation
+	expectations       []*ICategoryRepoMockCreateCategoryExpectation
+
+	callArgs []*ICategoryRepoMockCreateCategoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICategoryRepoMockCreateCategoryExpectation specifies expectation struct of the ICategoryRepo.CreateCategory
+type ICategoryRepoMockCreateCategoryExpectation struct {
+	mock               *ICategoryRepoMock
+	params             *ICategoryRepoMockCreateCategoryParams
+	paramPtrs          *ICategoryRepoMockCreateCategoryParamPtrs
+	expectationOrigins ICategoryRepoMockCreateCategoryExpectationOrigins
+	results            *ICategoryRepoMockCreateCategoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICategoryRepoMockCreateCategoryParams contains parameters of the ICategoryRepo.CreateCategory
+type ICategoryRepoMockCreateCategoryParams struct {
+	ctx      context.Context
+	category models.Category
+}
+
+// ICategoryRepoMockCreateCategoryParamPtrs contains pointers to parameters of the ICategoryRepo.CreateCategory
+type ICategoryRepoMockCreateCategoryParamPtrs struct {
+	ctx      *context.Context
+	category *models.Category
+}
+
+// ICategoryRepoMockCreateCategoryResults contains results of the ICategoryRepo.CreateCategory
+type ICategoryRepoMockCreateCategoryResults struct {
+	c2  models.CategoryID
+	err error
+}
+
+// ICategoryRepoMockCreateCategoryOrigins contains origins of expectations of the ICategoryRepo.CreateCategory
+type ICategoryRepoMockCreateCategoryExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originCategory string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Optional() *mICategoryRepoMockCreateCategory {
+	mmCreateCategory.optional = true
+	return mmCreateCategory
+}
+
+// Expect sets up expected params for ICategoryRepo.CreateCategory
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Expect(ctx context.Context, category models.Category) *mICategoryRepoMockCreateCategory {
+	if mmCreateCategory.mock.funcCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Set")
+	}
+
+	if mmCreateCategory.defaultExpectation == nil {
+		mmCreateCategory.defaultExpectation = &ICategoryRepoMockCreateCategoryExpectation{}
+	}
+
+	if mmCreateCategory.defaultExpectation.paramPtrs != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by ExpectParams functions")
+	}
+
+	mmCreateCategory.defaultExpectation.params = &ICategoryRepoMockCreateCategoryParams{ctx, category}
+	mmCreateCategory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateCategory.expectations {
+		if minimock.Equal(e.params, mmCreateCategory.defaultExpectation.params) {
+			mmCreateCategory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateCategory.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateCategory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICategoryRepo.CreateCategory
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) ExpectCtxParam1(ctx context.Context) *mICategoryRepoMockCreateCategory {
+	if mmCreateCategory.mock.funcCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Set")
+	}
+
+	if mmCreateCategory.defaultExpectation == nil {
+		mmCreateCategory.defaultExpectation = &ICategoryRepoMockCreateCategoryExpectation{}
+	}
+
+	if mmCreateCategory.defaultExpectation.params != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Expect")
+	}
+
+	if mmCreateCategory.defaultExpectation.paramPtrs == nil {
+		mmCreateCategory.defaultExpectation.paramPtrs = &ICategoryRepoMockCreateCategoryParamPtrs{}
+	}
+	mmCreateCategory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateCategory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateCategory
+}
+
+// ExpectCategoryParam2 sets up expected param category for ICategoryRepo.CreateCategory
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) ExpectCategoryParam2(category models.Category) *mICategoryRepoMockCreateCategory {
+	if mmCreateCategory.mock.funcCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Set")
+	}
+
+	if mmCreateCategory.defaultExpectation == nil {
+		mmCreateCategory.defaultExpectation = &ICategoryRepoMockCreateCategoryExpectation{}
+	}
+
+	if mmCreateCategory.defaultExpectation.params != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Expect")
+	}
+
+	if mmCreateCategory.defaultExpectation.paramPtrs == nil {
+		mmCreateCategory.defaultExpectation.paramPtrs = &ICategoryRepoMockCreateCategoryParamPtrs{}
+	}
+	mmCreateCategory.defaultExpectation.paramPtrs.category = &category
+	mmCreateCategory.defaultExpectation.expectationOrigins.originCategory = minimock.CallerInfo(1)
+
+	return mmCreateCategory
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICategoryRepo.CreateCategory
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Inspect(f func(ctx context.Context, category models.Category)) *mICategoryRepoMockCreateCategory {
+	if mmCreateCategory.mock.inspectFuncCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("Inspect function is already set for ICategoryRepoMock.CreateCategory")
+	}
+
+	mmCreateCategory.mock.inspectFuncCreateCategory = f
+
+	return mmCreateCategory
+}
+
+// Return sets up results that will be returned by ICategoryRepo.CreateCategory
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Return(c2 models.CategoryID, err error) *ICategoryRepoMock {
+	if mmCreateCategory.mock.funcCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Set")
+	}
+
+	if mmCreateCategory.defaultExpectation == nil {
+		mmCreateCategory.defaultExpectation = &ICategoryRepoMockCreateCategoryExpectation{mock: mmCreateCategory.mock}
+	}
+	mmCreateCategory.defaultExpectation.results = &ICategoryRepoMockCreateCategoryResults{c2, err}
+	mmCreateCategory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateCategory.mock
+}
+
+// Set uses given function f to mock the ICategoryRepo.CreateCategory method
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Set(f func(ctx context.Context, category models.Category) (c2 models.CategoryID, err error)) *ICategoryRepoMock {
+	if mmCreateCategory.defaultExpectation != nil {
+		mmCreateCategory.mock.t.Fatalf("Default expectation is already set for the ICategoryRepo.CreateCategory method")
+	}
+
+	if len(mmCreateCategory.expectations) > 0 {
+		mmCreateCategory.mock.t.Fatalf("Some expectations are already set for the ICategoryRepo.CreateCategory method")
+	}
+
+	mmCreateCategory.mock.funcCreateCategory = f
+	mmCreateCategory.mock.funcCreateCategoryOrigin = minimock.CallerInfo(1)
+	return mmCreateCategory.mock
+}
+
+// When sets expectation for the ICategoryRepo.CreateCategory which will trigger the result defined by the following
+// Then helper
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) When(ctx context.Context, category models.Category) *ICategoryRepoMockCreateCategoryExpectation {
+	if mmCreateCategory.mock.funcCreateCategory != nil {
+		mmCreateCategory.mock.t.Fatalf("ICategoryRepoMock.CreateCategory mock is already set by Set")
+	}
+
+	expectation := &ICategoryRepoMockCreateCategoryExpectation{
+		mock:               mmCreateCategory.mock,
+		params:             &ICategoryRepoMockCreateCategoryParams{ctx, category},
+		expectationOrigins: ICategoryRepoMockCreateCategoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateCategory.expectations = append(mmCreateCategory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICategoryRepo.CreateCategory return parameters for the expectation previously defined by the When method
+func (e *ICategoryRepoMockCreateCategoryExpectation) Then(c2 models.CategoryID, err error) *ICategoryRepoMock {
+	e.results = &ICategoryRepoMockCreateCategoryResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times ICategoryRepo.CreateCategory should be invoked
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Times(n uint64) *mICategoryRepoMockCreateCategory {
+	if n == 0 {
+		mmCreateCategory.mock.t.Fatalf("Times of ICategoryRepoMock.CreateCategory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateCategory.expectedInvocations, n)
+	mmCreateCategory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateCategory
+}
+
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) invocationsDone() bool {
+	if len(mmCreateCategory.expectations) == 0 && mmCreateCategory.defaultExpectation == nil && mmCreateCategory.mock.funcCreateCategory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateCategory.mock.afterCreateCategoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateCategory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateCategory implements mm_repository.ICategoryRepo
+func (mmCreateCategory *ICategoryRepoMock) CreateCategory(ctx context.Context, category models.Category) (c2 models.CategoryID, err error) {
+	mm_atomic.AddUint64(&mmCreateCategory.beforeCreateCategoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateCategory.afterCreateCategoryCounter, 1)
+
+	mmCreateCategory.t.Helper()
+
+	if mmCreateCategory.inspectFuncCreateCategory != nil {
+		mmCreateCategory.inspectFuncCreateCategory(ctx, category)
+	}
+
+	mm_params := ICategoryRepoMockCreateCategoryParams{ctx, category}
+
+	// Record call args
+	mmCreateCategory.CreateCategoryMock.mutex.Lock()
+	mmCreateCategory.CreateCategoryMock.callArgs = append(mmCreateCategory.CreateCategoryMock.callArgs, &mm_params)
+	mmCreateCategory.CreateCategoryMock.mutex.Unlock()
+
+	for _, e := range mmCreateCategory.CreateCategoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreateCategory.CreateCategoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateCategory.CreateCategoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateCategory.CreateCategoryMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateCategory.CreateCategoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ICategoryRepoMockCreateCategoryParams{ctx, category}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateCategory.t.Errorf("ICategoryRepoMock.CreateCategory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategory.CreateCategoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.category != nil && !minimock.Equal(*mm_want_ptrs.category, mm_got.category) {
+				mmCreateCategory.t.Errorf("ICategoryRepoMock.CreateCategory got unexpected parameter category, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCategory.CreateCategoryMock.defaultExpectation.expectationOrigins.originCategory, *mm_want_ptrs.category, mm_got.category, minimock.Diff(*mm_want_ptrs.category, mm_got.category))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateCategory.t.Errorf("ICategoryRepoMock.CreateCategory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateCategory.CreateCategoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateCategory.CreateCategoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateCategory.t.Fatal("No results are set for the ICategoryRepoMock.CreateCategory")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreateCategory.funcCreateCategory != nil {
+		return mmCreateCategory.funcCreateCategory(ctx, category)
+	}
+	mmCreateCategory.t.Fatalf("Unexpected call to ICategoryRepoMock.CreateCategory. %v %v", ctx, category)
+	return
+}
+
+// CreateCategoryAfterCounter returns a count of finished ICategoryRepoMock.CreateCategory invocations
+func (mmCreateCategory *ICategoryRepoMock) CreateCategoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategory.afterCreateCategoryCounter)
+}
+
+// CreateCategoryBeforeCounter returns a count of ICategoryRepoMock.CreateCategory invocations
+func (mmCreateCategory *ICategoryRepoMock) CreateCategoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCategory.beforeCreateCategoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICategoryRepoMock.CreateCategory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateCategory *mICategoryRepoMockCreateCategory) Calls() []*ICategoryRepoMockCreateCategoryParams {
+	mmCreateCategory.mutex.RLock()
+
+	argCopy := make([]*ICategoryRepoMockCreateCategoryParams, len(mmCreateCategory.callArgs))
+	copy(argCopy, mmCreateCategory.callArgs)
+
+	mmCreateCategory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateCategoryDone returns true if the count of the CreateCategory invocations corresponds
+// the number of defined expectations
+func (m *ICategoryRepoMock) MinimockCreateCategoryDone() bool {
+	if m.CreateCategoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateCategoryMock.invocationsDone()
+}
+
+// MinimockCreateCategoryInspect logs each unmet expectation
+func (m *ICategoryRepoMock) MinimockCreateCategoryInspect() {
+	for _, e := range m.CreateCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICategoryRepoMock.CreateCategory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCategoryCounter := mm_atomic.LoadUint64(&m.afterCreateCategoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateCategoryMock.defaultExpectation != nil && afterCreateCategoryCounter < 1 {
+		if m.CreateCategoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICategoryRepoMock.CreateCategory at\n%s", m.CreateCategoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICategoryRepoMock.CreateCategory at\n%s with params: %#v", m.CreateCategoryMock.defaultExpectation.expectationOrigins.origin, *m.CreateCategoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateCategory != nil && afterCreateCategoryCounter < 1 {
+		m.t.Errorf("Expected call to ICategoryRepoMock.CreateCategory at\n%s", m.funcCreateCategoryOrigin)
+	}
+
+	if !m.CreateCategoryMock.invocationsDone() && afterCreateCategoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICategoryRepoMock.CreateCategory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCategoryMock.expectedInvocations), m.CreateCategoryMock.expectedInvocationsOrigin, afterCreateCategoryCounter)
+	}
+}
+
+type mICategoryRepoMockGetCategory struct {
+	optional           bool
+	mock               *ICategoryRepoMock
+	defaultExpectation *ICategoryRepoMockGetCategoryExpectation
+	expectations       []*ICategoryRepoMockGetCategoryExpectation
+
+	callArgs []*ICategoryRepoMockGetCategoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICategoryRepoMockGetCategoryExpectation specifies expectation struct of the ICategoryRepo.GetCategory
+type ICategoryRepoMockGetCategoryExpectation struct {
+	mock               *ICategoryRepoMock
+	params             *ICategoryRepoMockGetCategoryParams
+	paramPtrs          *ICategoryRepoMockGetCategoryParamPtrs
+	expectationOrigins ICategoryRepoMockGetCategoryExpectationOrigins
+	results            *ICategoryRepoMockGetCategoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICategoryRepoMockGetCategoryParams contains parameters of the ICategoryRepo.GetCategory
+type ICategoryRepoMockGetCategoryParams struct {
+	ctx        context.Context
+	categoryID models.CategoryID
+}
+
+// ICategoryRepoMockGetCategoryParamPtrs contains pointers to parameters of the ICategoryRepo.GetCategory
+type ICategoryRepoMockGetCategoryParamPtrs struct {
+	ctx        *context.Context
+	categoryID *models.CategoryID
+}
+
+// ICategoryRepoMockGetCategoryResults contains results of the ICategoryRepo.GetCategory
+type ICategoryRepoMockGetCategoryResults struct {
+	c2  models.Category
+	err error
+}
+
+// ICategoryRepoMockGetCategoryOrigins contains origins of expectations of the ICategoryRepo.GetCategory
+type ICategoryRepoMockGetCategoryExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCategoryID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCategory *mICategoryRepoMockGetCategory) Optional() *mICategoryRepoMockGetCategory {
+	mmGetCategory.optional = true
+	return mmGetCategory
+}
+
+// Expect sets up expected params for ICategoryRepo.GetCategory
+func (mmGetCategory *mICategoryRepoMockGetCategory) Expect(ctx context.Context, categoryID models.CategoryID) *mICategoryRepoMockGetCategory {
+	if mmGetCategory.mock.funcGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Set")
+	}
+
+	if mmGetCategory.defaultExpectation == nil {
+		mmGetCategory.defaultExpectation = &ICategoryRepoMockGetCategoryExpectation{}
+	}
+
+	if mmGetCategory.defaultExpectation.paramPtrs != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by ExpectParams functions")
+	}
+
+	mmGetCategory.defaultExpectation.params = &ICategoryRepoMockGetCategoryParams{ctx, categoryID}
+	mmGetCategory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCategory.expectations {
+		if minimock.Equal(e.params, mmGetCategory.defaultExpectation.params) {
+			mmGetCategory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCategory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCategory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICategoryRepo.GetCategory
+func (mmGetCategory *mICategoryRepoMockGetCategory) ExpectCtxParam1(ctx context.Context) *mICategoryRepoMockGetCategory {
+	if mmGetCategory.mock.funcGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Set")
+	}
+
+	if mmGetCategory.defaultExpectation == nil {
+		mmGetCategory.defaultExpectation = &ICategoryRepoMockGetCategoryExpectation{}
+	}
+
+	if mmGetCategory.defaultExpectation.params != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Expect")
+	}
+
+	if mmGetCategory.defaultExpectation.paramPtrs == nil {
+		mmGetCategory.defaultExpectation.paramPtrs = &ICategoryRepoMockGetCategoryParamPtrs{}
+	}
+	mmGetCategory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCategory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCategory
+}
+
+// ExpectCategoryIDParam2 sets up expected param categoryID for ICategoryRepo.GetCategory
+func (mmGetCategory *mICategoryRepoMockGetCategory) ExpectCategoryIDParam2(categoryID models.CategoryID) *mICategoryRepoMockGetCategory {
+	if mmGetCategory.mock.funcGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Set")
+	}
+
+	if mmGetCategory.defaultExpectation == nil {
+		mmGetCategory.defaultExpectation = &ICategoryRepoMockGetCategoryExpectation{}
+	}
+
+	if mmGetCategory.defaultExpectation.params != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Expect")
+	}
+
+	if mmGetCategory.defaultExpectation.paramPtrs == nil {
+		mmGetCategory.defaultExpectation.paramPtrs = &ICategoryRepoMockGetCategoryParamPtrs{}
+	}
+	mmGetCategory.defaultExpectation.paramPtrs.categoryID = &categoryID
+	mmGetCategory.defaultExpectation.expectationOrigins.originCategoryID = minimock.CallerInfo(1)
+
+	return mmGetCategory
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICategoryRepo.GetCategory
+func (mmGetCategory *mICategoryRepoMockGetCategory) Inspect(f func(ctx context.Context, categoryID models.CategoryID)) *mICategoryRepoMockGetCategory {
+	if mmGetCategory.mock.inspectFuncGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("Inspect function is already set for ICategoryRepoMock.GetCategory")
+	}
+
+	mmGetCategory.mock.inspectFuncGetCategory = f
+
+	return mmGetCategory
+}
+
+// Return sets up results that will be returned by ICategoryRepo.GetCategory
+func (mmGetCategory *mICategoryRepoMockGetCategory) Return(c2 models.Category, err error) *ICategoryRepoMock {
+	if mmGetCategory.mock.funcGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Set")
+	}
+
+	if mmGetCategory.defaultExpectation == nil {
+		mmGetCategory.defaultExpectation = &ICategoryRepoMockGetCategoryExpectation{mock: mmGetCategory.mock}
+	}
+	mmGetCategory.defaultExpectation.results = &ICategoryRepoMockGetCategoryResults{c2, err}
+	mmGetCategory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCategory.mock
+}
+
+// Set uses given function f to mock the ICategoryRepo.GetCategory method
+func (mmGetCategory *mICategoryRepoMockGetCategory) Set(f func(ctx context.Context, categoryID models.CategoryID) (c2 models.Category, err error)) *ICategoryRepoMock {
+	if mmGetCategory.defaultExpectation != nil {
+		mmGetCategory.mock.t.Fatalf("Default expectation is already set for the ICategoryRepo.GetCategory method")
+	}
+
+	if len(mmGetCategory.expectations) > 0 {
+		mmGetCategory.mock.t.Fatalf("Some expectations are already set for the ICategoryRepo.GetCategory method")
+	}
+
+	mmGetCategory.mock.funcGetCategory = f
+	mmGetCategory.mock.funcGetCategoryOrigin = minimock.CallerInfo(1)
+	return mmGetCategory.mock
+}
+
+// When sets expectation for the ICategoryRepo.GetCategory which will trigger the result defined by the following
+// Then helper
+func (mmGetCategory *mICategoryRepoMockGetCategory) When(ctx context.Context, categoryID models.CategoryID) *ICategoryRepoMockGetCategoryExpectation {
+	if mmGetCategory.mock.funcGetCategory != nil {
+		mmGetCategory.mock.t.Fatalf("ICategoryRepoMock.GetCategory mock is already set by Set")
+	}
+
+	expectation := &ICategoryRepoMockGetCategoryExpectation{
+		mock:               mmGetCategory.mock,
+		params:             &ICategoryRepoMockGetCategoryParams{ctx, categoryID},
+		expectationOrigins: ICategoryRepoMockGetCategoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCategory.expectations = append(mmGetCategory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICategoryRepo.GetCategory return parameters for the expectation previously defined by the When method
+func (e *ICategoryRepoMockGetCategoryExpectation) Then(c2 models.Category, err error) *ICategoryRepoMock {
+	e.results = &ICategoryRepoMockGetCategoryResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times ICategoryRepo.GetCategory should be invoked
+func (mmGetCategory *mICategoryRepoMockGetCategory) Times(n uint64) *mICategoryRepoMockGetCategory {
+	if n == 0 {
+		mmGetCategory.mock.t.Fatalf("Times of ICategoryRepoMock.GetCategory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCategory.expectedInvocations, n)
+	mmGetCategory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCategory
+}
+
+func (mmGetCategory *mICategoryRepoMockGetCategory) invocationsDone() bool {
+	if len(mmGetCategory.expectations) == 0 && mmGetCategory.defaultExpectation == nil && mmGetCategory.mock.funcGetCategory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCategory.mock.afterGetCategoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCategory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCategory implements mm_repository.ICategoryRepo
+func (mmGetCategory *ICategoryRepoMock) GetCategory(ctx context.Context, categoryID models.CategoryID) (c2 models.Category, err error) {
+	mm_atomic.AddUint64(&mmGetCategory.beforeGetCategoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCategory.afterGetCategoryCounter, 1)
+
+	mmGetCategory.t.Helper()
+
+	if mmGetCategory.inspectFuncGetCategory != nil {
+		mmGetCategory.inspectFuncGetCategory(ctx, categoryID)
+	}
+
+	mm_params := ICategoryRepoMockGetCategoryParams{ctx, categoryID}
+
+	// Record call args
+	mmGetCategory.GetCategoryMock.mutex.Lock()
+	mmGetCategory.GetCategoryMock.callArgs = append(mmGetCategory.GetCategoryMock.callArgs, &mm_params)
+	mmGetCategory.GetCategoryMock.mutex.Unlock()
+
+	for _, e := range mmGetCategory.GetCategoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetCategory.GetCategoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCategory.GetCategoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCategory.GetCategoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCategory.GetCategoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ICategoryRepoMockGetCategoryParams{ctx, categoryID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCategory.t.Errorf("ICategoryRepoMock.GetCategory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategory.GetCategoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.categoryID != nil && !minimock.Equal(*mm_want_ptrs.categoryID, mm_got.categoryID) {
+				mmGetCategory.t.Errorf("ICategoryRepoMock.GetCategory got unexpected parameter categoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCategory.GetCategoryMock.defaultExpectation.expectationOrigins.originCategoryID, *mm_want_ptrs.categoryID, mm_got.categoryID, minimock.Diff(*mm_want_ptrs.categoryID, mm_got.categoryID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCategory.t.Errorf("ICategoryRepoMock.GetCategory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCategory.GetCategoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCategory.GetCategoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCategory.t.Fatal("No results are set for the ICategoryRepoMock.GetCategory")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetCategory.funcGetCategory != nil {
+		return mmGetCategory.funcGetCategory(ctx, categoryID)
+	}
+	mmGetCategory.t.Fatalf("Unexpected call to ICategoryRepoMock.GetCategory. %v %v", ctx, categoryID)
+	return
+}
+
+// GetCategoryAfterCounter returns a count of finished ICategoryRepoMock.GetCategory invocations
+func (mmGetCategory *ICategoryRepoMock) GetCategoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategory.afterGetCategoryCounter)
+}
+
+// GetCategoryBeforeCounter returns a count of ICategoryRepoMock.GetCategory invocations
+func (mmGetCategory *ICategoryRepoMock) GetCategoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCategory.beforeGetCategoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICategoryRepoMock.GetCategory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCategory *mICategoryRepoMockGetCategory) Calls() []*ICategoryRepoMockGetCategoryParams {
+	mmGetCategory.mutex.RLock()
+
+	argCopy := make([]*ICategoryRepoMockGetCategoryParams, len(mmGetCategory.callArgs))
+	copy(argCopy, mmGetCategory.callArgs)
+
+	mmGetCategory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCategoryDone returns true if the count of the GetCategory invocations corresponds
+// the number of defined expectations
+func (m *ICategoryRepoMock) MinimockGetCategoryDone() bool {
+	if m.GetCategoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCategoryMock.invocationsDone()
+}
+
+// MinimockGetCategoryInspect logs each unmet expectation
+func (m *ICategoryRepoMock) MinimockGetCategoryInspect() {
+	for _, e := range m.GetCategoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICategoryRepoMock.GetCategory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCategoryCounter := mm_atomic.LoadUint64(&m.afterGetCategoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCategoryMock.defaultExpectation != nil && afterGetCategoryCounter < 1 {
+		if m.GetCategoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICategoryRepoMock.GetCategory at\n%s", m.GetCategoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICategoryRepoMock.GetCategory at\n%s with params: %#v", m.GetCategoryMock.defaultExpectation.expectationOrigins.origin, *m.GetCategoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCategory != nil && afterGetCategoryCounter < 1 {
+		m.t.Errorf("Expected call to ICategoryRepoMock.GetCategory at\n%s", m.funcGetCategoryOrigin)
+	}
+
+	if !m.GetCategoryMock.invocationsDone() && afterGetCategoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICategoryRepoMock.GetCategory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCategoryMock.expectedInvocations), m.GetCategoryMock.expectedInvocationsOrigin, afterGetCategoryCounter)
+	}
+}
+
+type mICategoryRepoMockListCategories struct {
+	optional           bool
+	mock               *ICategoryRepoMock
+	defaultExpectation *ICategoryRepoMockListCategoriesExpectation
+	expectations       []*ICategoryRepoMockListCategoriesExpectation
+
+	callArgs []*ICategoryRepoMockListCategoriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ICategoryRepoMockListCategoriesExpectation specifies expectation struct of the ICategoryRepo.ListCategories
+type ICategoryRepoMockListCategoriesExpectation struct {
+	mock               *ICategoryRepoMock
+	params             *ICategoryRepoMockListCategoriesParams
+	paramPtrs          *ICategoryRepoMockListCategoriesParamPtrs
+	expectationOrigins ICategoryRepoMockListCategoriesExpectationOrigins
+	results            *ICategoryRepoMockListCategoriesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ICategoryRepoMockListCategoriesParams contains parameters of the ICategoryRepo.ListCategories
+type ICategoryRepoMockListCategoriesParams struct {
+	ctx context.Context
+}
+
+// ICategoryRepoMockListCategoriesParamPtrs contains pointers to parameters of the ICategoryRepo.ListCategories
+type ICategoryRepoMockListCategoriesParamPtrs struct {
+	ctx *context.Context
+}
+
+// ICategoryRepoMockListCategoriesResults contains results of the ICategoryRepo.ListCategories
+type ICategoryRepoMockListCategoriesResults struct {
+	ca1 []models.Category
+	err error
+}
+
+// ICategoryRepoMockListCategoriesOrigins contains origins of expectations of the ICategoryRepo.ListCategories
+type ICategoryRepoMockListCategoriesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListCategories *mICategoryRepoMockListCategories) Optional() *mICategoryRepoMockListCategories {
+	mmListCategories.optional = true
+	return mmListCategories
+}
+
+// Expect sets up expected params for ICategoryRepo.ListCategories
+func (mmListCategories *mICategoryRepoMockListCategories) Expect(ctx context.Context) *mICategoryRepoMockListCategories {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &ICategoryRepoMockListCategoriesExpectation{}
+	}
+
+	if mmListCategories.defaultExpectation.paramPtrs != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by ExpectParams functions")
+	}
+
+	mmListCategories.defaultExpectation.params = &ICategoryRepoMockListCategoriesParams{ctx}
+	mmListCategories.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListCategories.expectations {
+		if minimock.Equal(e.params, mmListCategories.defaultExpectation.params) {
+			mmListCategories.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListCategories.defaultExpectation.params)
+		}
+	}
+
+	return mmListCategories
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ICategoryRepo.ListCategories
+func (mmListCategories *mICategoryRepoMockListCategories) ExpectCtxParam1(ctx context.Context) *mICategoryRepoMockListCategories {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &ICategoryRepoMockListCategoriesExpectation{}
+	}
+
+	if mmListCategories.defaultExpectation.params != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by Expect")
+	}
+
+	if mmListCategories.defaultExpectation.paramPtrs == nil {
+		mmListCategories.defaultExpectation.paramPtrs = &ICategoryRepoMockListCategoriesParamPtrs{}
+	}
+	mmListCategories.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListCategories.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListCategories
+}
+
+// Inspect accepts an inspector function that has same arguments as the ICategoryRepo.ListCategories
+func (mmListCategories *mICategoryRepoMockListCategories) Inspect(f func(ctx context.Context)) *mICategoryRepoMockListCategories {
+	if mmListCategories.mock.inspectFuncListCategories != nil {
+		mmListCategories.mock.t.Fatalf("Inspect function is already set for ICategoryRepoMock.ListCategories")
+	}
+
+	mmListCategories.mock.inspectFuncListCategories = f
+
+	return mmListCategories
+}
+
+// Return sets up results that will be returned by ICategoryRepo.ListCategories
+func (mmListCategories *mICategoryRepoMockListCategories) Return(ca1 []models.Category, err error) *ICategoryRepoMock {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by Set")
+	}
+
+	if mmListCategories.defaultExpectation == nil {
+		mmListCategories.defaultExpectation = &ICategoryRepoMockListCategoriesExpectation{mock: mmListCategories.mock}
+	}
+	mmListCategories.defaultExpectation.results = &ICategoryRepoMockListCategoriesResults{ca1, err}
+	mmListCategories.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListCategories.mock
+}
+
+// Set uses given function f to mock the ICategoryRepo.ListCategories method
+func (mmListCategories *mICategoryRepoMockListCategories) Set(f func(ctx context.Context) (ca1 []models.Category, err error)) *ICategoryRepoMock {
+	if mmListCategories.defaultExpectation != nil {
+		mmListCategories.mock.t.Fatalf("Default expectation is already set for the ICategoryRepo.ListCategories method")
+	}
+
+	if len(mmListCategories.expectations) > 0 {
+		mmListCategories.mock.t.Fatalf("Some expectations are already set for the ICategoryRepo.ListCategories method")
+	}
+
+	mmListCategories.mock.funcListCategories = f
+	mmListCategories.mock.funcListCategoriesOrigin = minimock.CallerInfo(1)
+	return mmListCategories.mock
+}
+
+// When sets expectation for the ICategoryRepo.ListCategories which will trigger the result defined by the following
+// Then helper
+func (mmListCategories *mICategoryRepoMockListCategories) When(ctx context.Context) *ICategoryRepoMockListCategoriesExpectation {
+	if mmListCategories.mock.funcListCategories != nil {
+		mmListCategories.mock.t.Fatalf("ICategoryRepoMock.ListCategories mock is already set by Set")
+	}
+
+	expectation := &ICategoryRepoMockListCategoriesExpectation{
+		mock:               mmListCategories.mock,
+		params:             &ICategoryRepoMockListCategoriesParams{ctx},
+		expectationOrigins: ICategoryRepoMockListCategoriesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListCategories.expectations = append(mmListCategories.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ICategoryRepo.ListCategories return parameters for the expectation previously defined by the When method
+func (e *ICategoryRepoMockListCategoriesExpectation) Then(ca1 []models.Category, err error) *ICategoryRepoMock {
+	e.results = &ICategoryRepoMockListCategoriesResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times ICategoryRepo.ListCategories should be invoked
+func (mmListCategories *mICategoryRepoMockListCategories) Times(n uint64) *mICategoryRepoMockListCategories {
+	if n == 0 {
+		mmListCategories.mock.t.Fatalf("Times of ICategoryRepoMock.ListCategories mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListCategories.expectedInvocations, n)
+	mmListCategories.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListCategories
+}
+
+func (mmListCategories *mICategoryRepoMockListCategories) invocationsDone() bool {
+	if len(mmListCategories.expectations) == 0 && mmListCategories.defaultExpectation == nil && mmListCategories.mock.funcListCategories == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListCategories.mock.afterListCategoriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListCategories.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListCategories implements mm_repository.ICategoryRepo
+func (mmListCategories *ICategoryRepoMock) ListCategories(ctx context.Context) (ca1 []models.Category, err error) {
+	mm_atomic.AddUint64(&mmListCategories.beforeListCategoriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListCategories.afterListCategoriesCounter, 1)
+
+	mmListCategories.t.Helper()
+
+	if mmListCategories.inspectFuncListCategories != nil {
+		mmListCategories.inspectFuncListCategories(ctx)
+	}
+
+	mm_params := ICategoryRepoMockListCategoriesParams{ctx}
+
+	// Record call args
+	mmListCategories.ListCategoriesMock.mutex.Lock()
+	mmListCategories.ListCategoriesMock.callArgs = append(mmListCategories.ListCategoriesMock.callArgs, &mm_params)
+	mmListCategories.ListCategoriesMock.mutex.Unlock()
+
+	for _, e := range mmListCategories.ListCategoriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListCategories.ListCategoriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListCategories.ListCategoriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListCategories.ListCategoriesMock.defaultExpectation.params
+		mm_want_ptrs := mmListCategories.ListCategoriesMock.defaultExpectation.paramPtrs
+
+		mm_got := ICategoryRepoMockListCategoriesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListCategories.t.Errorf("ICategoryRepoMock.ListCategories got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCategories.ListCategoriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListCategories.t.Errorf("ICategoryRepoMock.ListCategories got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListCategories.ListCategoriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListCategories.ListCategoriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListCategories.t.Fatal("No results are set for the ICategoryRepoMock.ListCategories")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListCategories.funcListCategories != nil {
+		return mmListCategories.funcListCategories(ctx)
+	}
+	mmListCategories.t.Fatalf("Unexpected call to ICategoryRepoMock.ListCategories. %v", ctx)
+	return
+}
+
+// ListCategoriesAfterCounter returns a count of finished ICategoryRepoMock.ListCategories invocations
+func (mmListCategories *ICategoryRepoMock) ListCategoriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCategories.afterListCategoriesCounter)
+}
+
+// ListCategoriesBeforeCounter returns a count of ICategoryRepoMock.ListCategories invocations
+func (mmListCategories *ICategoryRepoMock) ListCategoriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCategories.beforeListCategoriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ICategoryRepoMock.ListCategories.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListCategories *mICategoryRepoMockListCategories) Calls() []*ICategoryRepoMockListCategoriesParams {
+	mmListCategories.mutex.RLock()
+
+	argCopy := make([]*ICategoryRepoMockListCategoriesParams, len(mmListCategories.callArgs))
+	copy(argCopy, mmListCategories.callArgs)
+
+	mmListCategories.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCategoriesDone returns true if the count of the ListCategories invocations corresponds
+// the number of defined expectations
+func (m *ICategoryRepoMock) MinimockListCategoriesDone() bool {
+	if m.ListCategoriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCategoriesMock.invocationsDone()
+}
+
+// MinimockListCategoriesInspect logs each unmet expectation
+func (m *ICategoryRepoMock) MinimockListCategoriesInspect() {
+	for _, e := range m.ListCategoriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ICategoryRepoMock.ListCategories at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCategoriesCounter := mm_atomic.LoadUint64(&m.afterListCategoriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCategoriesMock.defaultExpectation != nil && afterListCategoriesCounter < 1 {
+		if m.ListCategoriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ICategoryRepoMock.ListCategories at\n%s", m.ListCategoriesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ICategoryRepoMock.ListCategories at\n%s with params: %#v", m.ListCategoriesMock.defaultExpectation.expectationOrigins.origin, *m.ListCategoriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListCategories != nil && afterListCategoriesCounter < 1 {
+		m.t.Errorf("Expected call to ICategoryRepoMock.ListCategories at\n%s", m.funcListCategoriesOrigin)
+	}
+
+	if !m.ListCategoriesMock.invocationsDone() && afterListCategoriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ICategoryRepoMock.ListCategories at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCategoriesMock.expectedInvocations), m.ListCategoriesMock.expectedInvocationsOrigin, afterListCategoriesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ICategoryRepoMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateCategoryInspect()
+
+			m.MinimockGetCategoryInspect()
+
+			m.MinimockListCategoriesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ICategoryRepoMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ICategoryRepoMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateCategoryDone() &&
+		m.MinimockGetCategoryDone() &&
+		m.MinimockListCategoriesDone()
+}
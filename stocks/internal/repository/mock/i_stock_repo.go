@@ -10,6 +10,7 @@ import (
 	mm_repository "stocks/internal/repository"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -20,6 +21,13 @@ type IStockRepoMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddPriceHistory          func(ctx context.Context, change models.PriceChange) (err error)
+	funcAddPriceHistoryOrigin    string
+	inspectFuncAddPriceHistory   func(ctx context.Context, change models.PriceChange)
+	afterAddPriceHistoryCounter  uint64
+	beforeAddPriceHistoryCounter uint64
+	AddPriceHistoryMock          mIStockRepoMockAddPriceHistory
+
 	funcAddStock          func(ctx context.Context, stock models.Stock) (err error)
 	funcAddStockOrigin    string
 	inspectFuncAddStock   func(ctx context.Context, stock models.Stock)
@@ -27,13 +35,55 @@ type IStockRepoMock struct {
 	beforeAddStockCounter uint64
 	AddStockMock          mIStockRepoMockAddStock
 
-	funcDeleteStock          func(ctx context.Context, skuID models.SKUID, userID models.UserID) (err error)
+	funcCountItemsByLocation          func(ctx context.Context, param mm_repository.GetStockByLocation) (i1 int64, err error)
+	funcCountItemsByLocationOrigin    string
+	inspectFuncCountItemsByLocation   func(ctx context.Context, param mm_repository.GetStockByLocation)
+	afterCountItemsByLocationCounter  uint64
+	beforeCountItemsByLocationCounter uint64
+	CountItemsByLocationMock          mIStockRepoMockCountItemsByLocation
+
+	funcCountSearchItems          func(ctx context.Context, param mm_repository.SearchItemsParam) (i1 int64, err error)
+	funcCountSearchItemsOrigin    string
+	inspectFuncCountSearchItems   func(ctx context.Context, param mm_repository.SearchItemsParam)
+	afterCountSearchItemsCounter  uint64
+	beforeCountSearchItemsCounter uint64
+	CountSearchItemsMock          mIStockRepoMockCountSearchItems
+
+	funcDeleteStock          func(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) (sa1 []models.Stock, err error)
 	funcDeleteStockOrigin    string
-	inspectFuncDeleteStock   func(ctx context.Context, skuID models.SKUID, userID models.UserID)
+	inspectFuncDeleteStock   func(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time)
 	afterDeleteStockCounter  uint64
 	beforeDeleteStockCounter uint64
 	DeleteStockMock          mIStockRepoMockDeleteStock
 
+	funcDeleteThreshold          func(ctx context.Context, skuID models.SKUID, location string) (err error)
+	funcDeleteThresholdOrigin    string
+	inspectFuncDeleteThreshold   func(ctx context.Context, skuID models.SKUID, location string)
+	afterDeleteThresholdCounter  uint64
+	beforeDeleteThresholdCounter uint64
+	DeleteThresholdMock          mIStockRepoMockDeleteThreshold
+
+	funcEnsureSeller          func(ctx context.Context, userID models.UserID) (err error)
+	funcEnsureSellerOrigin    string
+	inspectFuncEnsureSeller   func(ctx context.Context, userID models.UserID)
+	afterEnsureSellerCounter  uint64
+	beforeEnsureSellerCounter uint64
+	EnsureSellerMock          mIStockRepoMockEnsureSeller
+
+	funcExportItems          func(ctx context.Context, afterID models.StockID, limit int64) (ia1 []models.Item, err error)
+	funcExportItemsOrigin    string
+	inspectFuncExportItems   func(ctx context.Context, afterID models.StockID, limit int64)
+	afterExportItemsCounter  uint64
+	beforeExportItemsCounter uint64
+	ExportItemsMock          mIStockRepoMockExportItems
+
+	funcGetDuePrices          func(ctx context.Context, now time.Time, limit int64) (sa1 []models.ScheduledPrice, err error)
+	funcGetDuePricesOrigin    string
+	inspectFuncGetDuePrices   func(ctx context.Context, now time.Time, limit int64)
+	afterGetDuePricesCounter  uint64
+	beforeGetDuePricesCounter uint64
+	GetDuePricesMock          mIStockRepoMockGetDuePrices
+
 	funcGetItemBySKU          func(ctx context.Context, skuID models.SKUID) (i1 models.Item, err error)
 	funcGetItemBySKUOrigin    string
 	inspectFuncGetItemBySKU   func(ctx context.Context, skuID models.SKUID)
@@ -48,12 +98,124 @@ type IStockRepoMock struct {
 	beforeGetItemsByLocationCounter uint64
 	GetItemsByLocationMock          mIStockRepoMockGetItemsByLocation
 
+	funcGetItemsBySKUs          func(ctx context.Context, skuIDs []models.SKUID) (ia1 []models.Item, err error)
+	funcGetItemsBySKUsOrigin    string
+	inspectFuncGetItemsBySKUs   func(ctx context.Context, skuIDs []models.SKUID)
+	afterGetItemsBySKUsCounter  uint64
+	beforeGetItemsBySKUsCounter uint64
+	GetItemsBySKUsMock          mIStockRepoMockGetItemsBySKUs
+
+	funcGetLocationStock          func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (s1 models.Stock, err error)
+	funcGetLocationStockOrigin    string
+	inspectFuncGetLocationStock   func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string)
+	afterGetLocationStockCounter  uint64
+	beforeGetLocationStockCounter uint64
+	GetLocationStockMock          mIStockRepoMockGetLocationStock
+
+	funcGetOffer          func(ctx context.Context, skuID models.SKUID, offerID models.StockID) (i1 models.Item, err error)
+	funcGetOfferOrigin    string
+	inspectFuncGetOffer   func(ctx context.Context, skuID models.SKUID, offerID models.StockID)
+	afterGetOfferCounter  uint64
+	beforeGetOfferCounter uint64
+	GetOfferMock          mIStockRepoMockGetOffer
+
+	funcGetPendingPrices          func(ctx context.Context, skuID models.SKUID) (sa1 []models.ScheduledPrice, err error)
+	funcGetPendingPricesOrigin    string
+	inspectFuncGetPendingPrices   func(ctx context.Context, skuID models.SKUID)
+	afterGetPendingPricesCounter  uint64
+	beforeGetPendingPricesCounter uint64
+	GetPendingPricesMock          mIStockRepoMockGetPendingPrices
+
+	funcGetPriceHistory          func(ctx context.Context, param mm_repository.GetPriceHistoryParam) (pa1 []models.PriceChange, err error)
+	funcGetPriceHistoryOrigin    string
+	inspectFuncGetPriceHistory   func(ctx context.Context, param mm_repository.GetPriceHistoryParam)
+	afterGetPriceHistoryCounter  uint64
+	beforeGetPriceHistoryCounter uint64
+	GetPriceHistoryMock          mIStockRepoMockGetPriceHistory
+
+	funcGetThreshold          func(ctx context.Context, skuID models.SKUID, location string) (u1 uint16, err error)
+	funcGetThresholdOrigin    string
+	inspectFuncGetThreshold   func(ctx context.Context, skuID models.SKUID, location string)
+	afterGetThresholdCounter  uint64
+	beforeGetThresholdCounter uint64
+	GetThresholdMock          mIStockRepoMockGetThreshold
+
+	funcListLowStock          func(ctx context.Context, param mm_repository.ListLowStockParam) (la1 []models.LowStockItem, err error)
+	funcListLowStockOrigin    string
+	inspectFuncListLowStock   func(ctx context.Context, param mm_repository.ListLowStockParam)
+	afterListLowStockCounter  uint64
+	beforeListLowStockCounter uint64
+	ListLowStockMock          mIStockRepoMockListLowStock
+
+	funcListOffers          func(ctx context.Context, skuID models.SKUID, inStockOnly bool) (oa1 []models.Offer, err error)
+	funcListOffersOrigin    string
+	inspectFuncListOffers   func(ctx context.Context, skuID models.SKUID, inStockOnly bool)
+	afterListOffersCounter  uint64
+	beforeListOffersCounter uint64
+	ListOffersMock          mIStockRepoMockListOffers
+
+	funcMarkPriceApplied          func(ctx context.Context, id int64, appliedAt time.Time) (err error)
+	funcMarkPriceAppliedOrigin    string
+	inspectFuncMarkPriceApplied   func(ctx context.Context, id int64, appliedAt time.Time)
+	afterMarkPriceAppliedCounter  uint64
+	beforeMarkPriceAppliedCounter uint64
+	MarkPriceAppliedMock          mIStockRepoMockMarkPriceApplied
+
+	funcPurgeDeletedStock          func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)
+	funcPurgeDeletedStockOrigin    string
+	inspectFuncPurgeDeletedStock   func(ctx context.Context, deletedBefore time.Time)
+	afterPurgeDeletedStockCounter  uint64
+	beforePurgeDeletedStockCounter uint64
+	PurgeDeletedStockMock          mIStockRepoMockPurgeDeletedStock
+
+	funcRestoreStock          func(ctx context.Context, skuID models.SKUID, userID models.UserID) (sa1 []models.Stock, err error)
+	funcRestoreStockOrigin    string
+	inspectFuncRestoreStock   func(ctx context.Context, skuID models.SKUID, userID models.UserID)
+	afterRestoreStockCounter  uint64
+	beforeRestoreStockCounter uint64
+	RestoreStockMock          mIStockRepoMockRestoreStock
+
+	funcSchedulePrice          func(ctx context.Context, price models.ScheduledPrice) (i1 int64, err error)
+	funcSchedulePriceOrigin    string
+	inspectFuncSchedulePrice   func(ctx context.Context, price models.ScheduledPrice)
+	afterSchedulePriceCounter  uint64
+	beforeSchedulePriceCounter uint64
+	SchedulePriceMock          mIStockRepoMockSchedulePrice
+
+	funcSearchItems          func(ctx context.Context, param mm_repository.SearchItemsParam) (ia1 []models.Item, err error)
+	funcSearchItemsOrigin    string
+	inspectFuncSearchItems   func(ctx context.Context, param mm_repository.SearchItemsParam)
+	afterSearchItemsCounter  uint64
+	beforeSearchItemsCounter uint64
+	SearchItemsMock          mIStockRepoMockSearchItems
+
+	funcSetSKUPrice          func(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) (pa1 []models.PriceChange, err error)
+	funcSetSKUPriceOrigin    string
+	inspectFuncSetSKUPrice   func(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32)
+	afterSetSKUPriceCounter  uint64
+	beforeSetSKUPriceCounter uint64
+	SetSKUPriceMock          mIStockRepoMockSetSKUPrice
+
+	funcSetThreshold          func(ctx context.Context, threshold models.StockThreshold) (err error)
+	funcSetThresholdOrigin    string
+	inspectFuncSetThreshold   func(ctx context.Context, threshold models.StockThreshold)
+	afterSetThresholdCounter  uint64
+	beforeSetThresholdCounter uint64
+	SetThresholdMock          mIStockRepoMockSetThreshold
+
 	funcUpdateStock          func(ctx context.Context, stock models.Stock) (err error)
 	funcUpdateStockOrigin    string
 	inspectFuncUpdateStock   func(ctx context.Context, stock models.Stock)
 	afterUpdateStockCounter  uint64
 	beforeUpdateStockCounter uint64
 	UpdateStockMock          mIStockRepoMockUpdateStock
+
+	funcUpsertSeller          func(ctx context.Context, seller models.Seller) (err error)
+	funcUpsertSellerOrigin    string
+	inspectFuncUpsertSeller   func(ctx context.Context, seller models.Seller)
+	afterUpsertSellerCounter  uint64
+	beforeUpsertSellerCounter uint64
+	UpsertSellerMock          mIStockRepoMockUpsertSeller
 }
 
 // NewIStockRepoMock returns a mock for mm_repository.IStockRepo
@@ -64,26 +226,437 @@ func NewIStockRepoMock(t minimock.Tester) *IStockRepoMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddPriceHistoryMock = mIStockRepoMockAddPriceHistory{mock: m}
+	m.AddPriceHistoryMock.callArgs = []*IStockRepoMockAddPriceHistoryParams{}
+
 	m.AddStockMock = mIStockRepoMockAddStock{mock: m}
 	m.AddStockMock.callArgs = []*IStockRepoMockAddStockParams{}
 
+	m.CountItemsByLocationMock = mIStockRepoMockCountItemsByLocation{mock: m}
+	m.CountItemsByLocationMock.callArgs = []*IStockRepoMockCountItemsByLocationParams{}
+
+	m.CountSearchItemsMock = mIStockRepoMockCountSearchItems{mock: m}
+	m.CountSearchItemsMock.callArgs = []*IStockRepoMockCountSearchItemsParams{}
+
 	m.DeleteStockMock = mIStockRepoMockDeleteStock{mock: m}
 	m.DeleteStockMock.callArgs = []*IStockRepoMockDeleteStockParams{}
 
+	m.DeleteThresholdMock = mIStockRepoMockDeleteThreshold{mock: m}
+	m.DeleteThresholdMock.callArgs = []*IStockRepoMockDeleteThresholdParams{}
+
+	m.EnsureSellerMock = mIStockRepoMockEnsureSeller{mock: m}
+	m.EnsureSellerMock.callArgs = []*IStockRepoMockEnsureSellerParams{}
+
+	m.ExportItemsMock = mIStockRepoMockExportItems{mock: m}
+	m.ExportItemsMock.callArgs = []*IStockRepoMockExportItemsParams{}
+
+	m.GetDuePricesMock = mIStockRepoMockGetDuePrices{mock: m}
+	m.GetDuePricesMock.callArgs = []*IStockRepoMockGetDuePricesParams{}
+
 	m.GetItemBySKUMock = mIStockRepoMockGetItemBySKU{mock: m}
 	m.GetItemBySKUMock.callArgs = []*IStockRepoMockGetItemBySKUParams{}
 
 	m.GetItemsByLocationMock = mIStockRepoMockGetItemsByLocation{mock: m}
 	m.GetItemsByLocationMock.callArgs = []*IStockRepoMockGetItemsByLocationParams{}
 
+	m.GetItemsBySKUsMock = mIStockRepoMockGetItemsBySKUs{mock: m}
+	m.GetItemsBySKUsMock.callArgs = []*IStockRepoMockGetItemsBySKUsParams{}
+
+	m.GetLocationStockMock = mIStockRepoMockGetLocationStock{mock: m}
+	m.GetLocationStockMock.callArgs = []*IStockRepoMockGetLocationStockParams{}
+
+	m.GetOfferMock = mIStockRepoMockGetOffer{mock: m}
+	m.GetOfferMock.callArgs = []*IStockRepoMockGetOfferParams{}
+
+	m.GetPendingPricesMock = mIStockRepoMockGetPendingPrices{mock: m}
+	m.GetPendingPricesMock.callArgs = []*IStockRepoMockGetPendingPricesParams{}
+
+	m.GetPriceHistoryMock = mIStockRepoMockGetPriceHistory{mock: m}
+	m.GetPriceHistoryMock.callArgs = []*IStockRepoMockGetPriceHistoryParams{}
+
+	m.GetThresholdMock = mIStockRepoMockGetThreshold{mock: m}
+	m.GetThresholdMock.callArgs = []*IStockRepoMockGetThresholdParams{}
+
+	m.ListLowStockMock = mIStockRepoMockListLowStock{mock: m}
+	m.ListLowStockMock.callArgs = []*IStockRepoMockListLowStockParams{}
+
+	m.ListOffersMock = mIStockRepoMockListOffers{mock: m}
+	m.ListOffersMock.callArgs = []*IStockRepoMockListOffersParams{}
+
+	m.MarkPriceAppliedMock = mIStockRepoMockMarkPriceApplied{mock: m}
+	m.MarkPriceAppliedMock.callArgs = []*IStockRepoMockMarkPriceAppliedParams{}
+
+	m.PurgeDeletedStockMock = mIStockRepoMockPurgeDeletedStock{mock: m}
+	m.PurgeDeletedStockMock.callArgs = []*IStockRepoMockPurgeDeletedStockParams{}
+
+	m.RestoreStockMock = mIStockRepoMockRestoreStock{mock: m}
+	m.RestoreStockMock.callArgs = []*IStockRepoMockRestoreStockParams{}
+
+	m.SchedulePriceMock = mIStockRepoMockSchedulePrice{mock: m}
+	m.SchedulePriceMock.callArgs = []*IStockRepoMockSchedulePriceParams{}
+
+	m.SearchItemsMock = mIStockRepoMockSearchItems{mock: m}
+	m.SearchItemsMock.callArgs = []*IStockRepoMockSearchItemsParams{}
+
+	m.SetSKUPriceMock = mIStockRepoMockSetSKUPrice{mock: m}
+	m.SetSKUPriceMock.callArgs = []*IStockRepoMockSetSKUPriceParams{}
+
+	m.SetThresholdMock = mIStockRepoMockSetThreshold{mock: m}
+	m.SetThresholdMock.callArgs = []*IStockRepoMockSetThresholdParams{}
+
 	m.UpdateStockMock = mIStockRepoMockUpdateStock{mock: m}
 	m.UpdateStockMock.callArgs = []*IStockRepoMockUpdateStockParams{}
 
+	m.UpsertSellerMock = mIStockRepoMockUpsertSeller{mock: m}
+	m.UpsertSellerMock.callArgs = []*IStockRepoMockUpsertSellerParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mIStockRepoMockAddPriceHistory struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockAddPriceHistoryExpectation
+	expectations       []*IStockRepoMockAddPriceHistoryExpectation
+
+	callArgs []*IStockRepoMockAddPriceHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockAddPriceHistoryExpectation specifies expectation struct of the IStockRepo.AddPriceHistory
+type IStockRepoMockAddPriceHistoryExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockAddPriceHistoryParams
+	paramPtrs          *IStockRepoMockAddPriceHistoryParamPtrs
+	expectationOrigins IStockRepoMockAddPriceHistoryExpectationOrigins
+	results            *IStockRepoMockAddPriceHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockAddPriceHistoryParams contains parameters of the IStockRepo.AddPriceHistory
+type IStockRepoMockAddPriceHistoryParams struct {
+	ctx    context.Context
+	change models.PriceChange
+}
+
+// IStockRepoMockAddPriceHistoryParamPtrs contains pointers to parameters of the IStockRepo.AddPriceHistory
+type IStockRepoMockAddPriceHistoryParamPtrs struct {
+	ctx    *context.Context
+	change *models.PriceChange
+}
+
+// IStockRepoMockAddPriceHistoryResults contains results of the IStockRepo.AddPriceHistory
+type IStockRepoMockAddPriceHistoryResults struct {
+	err error
+}
+
+// IStockRepoMockAddPriceHistoryOrigins contains origins of expectations of the IStockRepo.AddPriceHistory
+type IStockRepoMockAddPriceHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChange string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Optional() *mIStockRepoMockAddPriceHistory {
+	mmAddPriceHistory.optional = true
+	return mmAddPriceHistory
+}
+
+// Expect sets up expected params for IStockRepo.AddPriceHistory
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Expect(ctx context.Context, change models.PriceChange) *mIStockRepoMockAddPriceHistory {
+	if mmAddPriceHistory.mock.funcAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Set")
+	}
+
+	if mmAddPriceHistory.defaultExpectation == nil {
+		mmAddPriceHistory.defaultExpectation = &IStockRepoMockAddPriceHistoryExpectation{}
+	}
+
+	if mmAddPriceHistory.defaultExpectation.paramPtrs != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by ExpectParams functions")
+	}
+
+	mmAddPriceHistory.defaultExpectation.params = &IStockRepoMockAddPriceHistoryParams{ctx, change}
+	mmAddPriceHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddPriceHistory.expectations {
+		if minimock.Equal(e.params, mmAddPriceHistory.defaultExpectation.params) {
+			mmAddPriceHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddPriceHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmAddPriceHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.AddPriceHistory
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockAddPriceHistory {
+	if mmAddPriceHistory.mock.funcAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Set")
+	}
+
+	if mmAddPriceHistory.defaultExpectation == nil {
+		mmAddPriceHistory.defaultExpectation = &IStockRepoMockAddPriceHistoryExpectation{}
+	}
+
+	if mmAddPriceHistory.defaultExpectation.params != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Expect")
+	}
+
+	if mmAddPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmAddPriceHistory.defaultExpectation.paramPtrs = &IStockRepoMockAddPriceHistoryParamPtrs{}
+	}
+	mmAddPriceHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddPriceHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddPriceHistory
+}
+
+// ExpectChangeParam2 sets up expected param change for IStockRepo.AddPriceHistory
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) ExpectChangeParam2(change models.PriceChange) *mIStockRepoMockAddPriceHistory {
+	if mmAddPriceHistory.mock.funcAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Set")
+	}
+
+	if mmAddPriceHistory.defaultExpectation == nil {
+		mmAddPriceHistory.defaultExpectation = &IStockRepoMockAddPriceHistoryExpectation{}
+	}
+
+	if mmAddPriceHistory.defaultExpectation.params != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Expect")
+	}
+
+	if mmAddPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmAddPriceHistory.defaultExpectation.paramPtrs = &IStockRepoMockAddPriceHistoryParamPtrs{}
+	}
+	mmAddPriceHistory.defaultExpectation.paramPtrs.change = &change
+	mmAddPriceHistory.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmAddPriceHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.AddPriceHistory
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Inspect(f func(ctx context.Context, change models.PriceChange)) *mIStockRepoMockAddPriceHistory {
+	if mmAddPriceHistory.mock.inspectFuncAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.AddPriceHistory")
+	}
+
+	mmAddPriceHistory.mock.inspectFuncAddPriceHistory = f
+
+	return mmAddPriceHistory
+}
+
+// Return sets up results that will be returned by IStockRepo.AddPriceHistory
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Return(err error) *IStockRepoMock {
+	if mmAddPriceHistory.mock.funcAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Set")
+	}
+
+	if mmAddPriceHistory.defaultExpectation == nil {
+		mmAddPriceHistory.defaultExpectation = &IStockRepoMockAddPriceHistoryExpectation{mock: mmAddPriceHistory.mock}
+	}
+	mmAddPriceHistory.defaultExpectation.results = &IStockRepoMockAddPriceHistoryResults{err}
+	mmAddPriceHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddPriceHistory.mock
+}
+
+// Set uses given function f to mock the IStockRepo.AddPriceHistory method
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Set(f func(ctx context.Context, change models.PriceChange) (err error)) *IStockRepoMock {
+	if mmAddPriceHistory.defaultExpectation != nil {
+		mmAddPriceHistory.mock.t.Fatalf("Default expectation is already set for the IStockRepo.AddPriceHistory method")
+	}
+
+	if len(mmAddPriceHistory.expectations) > 0 {
+		mmAddPriceHistory.mock.t.Fatalf("Some expectations are already set for the IStockRepo.AddPriceHistory method")
+	}
+
+	mmAddPriceHistory.mock.funcAddPriceHistory = f
+	mmAddPriceHistory.mock.funcAddPriceHistoryOrigin = minimock.CallerInfo(1)
+	return mmAddPriceHistory.mock
+}
+
+// When sets expectation for the IStockRepo.AddPriceHistory which will trigger the result defined by the following
+// Then helper
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) When(ctx context.Context, change models.PriceChange) *IStockRepoMockAddPriceHistoryExpectation {
+	if mmAddPriceHistory.mock.funcAddPriceHistory != nil {
+		mmAddPriceHistory.mock.t.Fatalf("IStockRepoMock.AddPriceHistory mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockAddPriceHistoryExpectation{
+		mock:               mmAddPriceHistory.mock,
+		params:             &IStockRepoMockAddPriceHistoryParams{ctx, change},
+		expectationOrigins: IStockRepoMockAddPriceHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddPriceHistory.expectations = append(mmAddPriceHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.AddPriceHistory return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockAddPriceHistoryExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockAddPriceHistoryResults{err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.AddPriceHistory should be invoked
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Times(n uint64) *mIStockRepoMockAddPriceHistory {
+	if n == 0 {
+		mmAddPriceHistory.mock.t.Fatalf("Times of IStockRepoMock.AddPriceHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddPriceHistory.expectedInvocations, n)
+	mmAddPriceHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddPriceHistory
+}
+
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) invocationsDone() bool {
+	if len(mmAddPriceHistory.expectations) == 0 && mmAddPriceHistory.defaultExpectation == nil && mmAddPriceHistory.mock.funcAddPriceHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddPriceHistory.mock.afterAddPriceHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddPriceHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddPriceHistory implements mm_repository.IStockRepo
+func (mmAddPriceHistory *IStockRepoMock) AddPriceHistory(ctx context.Context, change models.PriceChange) (err error) {
+	mm_atomic.AddUint64(&mmAddPriceHistory.beforeAddPriceHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddPriceHistory.afterAddPriceHistoryCounter, 1)
+
+	mmAddPriceHistory.t.Helper()
+
+	if mmAddPriceHistory.inspectFuncAddPriceHistory != nil {
+		mmAddPriceHistory.inspectFuncAddPriceHistory(ctx, change)
+	}
+
+	mm_params := IStockRepoMockAddPriceHistoryParams{ctx, change}
+
+	// Record call args
+	mmAddPriceHistory.AddPriceHistoryMock.mutex.Lock()
+	mmAddPriceHistory.AddPriceHistoryMock.callArgs = append(mmAddPriceHistory.AddPriceHistoryMock.callArgs, &mm_params)
+	mmAddPriceHistory.AddPriceHistoryMock.mutex.Unlock()
+
+	for _, e := range mmAddPriceHistory.AddPriceHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockAddPriceHistoryParams{ctx, change}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddPriceHistory.t.Errorf("IStockRepoMock.AddPriceHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmAddPriceHistory.t.Errorf("IStockRepoMock.AddPriceHistory got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddPriceHistory.t.Errorf("IStockRepoMock.AddPriceHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddPriceHistory.AddPriceHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddPriceHistory.t.Fatal("No results are set for the IStockRepoMock.AddPriceHistory")
+		}
+		return (*mm_results).err
+	}
+	if mmAddPriceHistory.funcAddPriceHistory != nil {
+		return mmAddPriceHistory.funcAddPriceHistory(ctx, change)
+	}
+	mmAddPriceHistory.t.Fatalf("Unexpected call to IStockRepoMock.AddPriceHistory. %v %v", ctx, change)
+	return
+}
+
+// AddPriceHistoryAfterCounter returns a count of finished IStockRepoMock.AddPriceHistory invocations
+func (mmAddPriceHistory *IStockRepoMock) AddPriceHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPriceHistory.afterAddPriceHistoryCounter)
+}
+
+// AddPriceHistoryBeforeCounter returns a count of IStockRepoMock.AddPriceHistory invocations
+func (mmAddPriceHistory *IStockRepoMock) AddPriceHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPriceHistory.beforeAddPriceHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.AddPriceHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddPriceHistory *mIStockRepoMockAddPriceHistory) Calls() []*IStockRepoMockAddPriceHistoryParams {
+	mmAddPriceHistory.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockAddPriceHistoryParams, len(mmAddPriceHistory.callArgs))
+	copy(argCopy, mmAddPriceHistory.callArgs)
+
+	mmAddPriceHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddPriceHistoryDone returns true if the count of the AddPriceHistory invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockAddPriceHistoryDone() bool {
+	if m.AddPriceHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddPriceHistoryMock.invocationsDone()
+}
+
+// MinimockAddPriceHistoryInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockAddPriceHistoryInspect() {
+	for _, e := range m.AddPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.AddPriceHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddPriceHistoryCounter := mm_atomic.LoadUint64(&m.afterAddPriceHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddPriceHistoryMock.defaultExpectation != nil && afterAddPriceHistoryCounter < 1 {
+		if m.AddPriceHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.AddPriceHistory at\n%s", m.AddPriceHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.AddPriceHistory at\n%s with params: %#v", m.AddPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *m.AddPriceHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddPriceHistory != nil && afterAddPriceHistoryCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.AddPriceHistory at\n%s", m.funcAddPriceHistoryOrigin)
+	}
+
+	if !m.AddPriceHistoryMock.invocationsDone() && afterAddPriceHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.AddPriceHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddPriceHistoryMock.expectedInvocations), m.AddPriceHistoryMock.expectedInvocationsOrigin, afterAddPriceHistoryCounter)
+	}
+}
+
 type mIStockRepoMockAddStock struct {
 	optional           bool
 	mock               *IStockRepoMock
@@ -426,55 +999,53 @@ func (m *IStockRepoMock) MinimockAddStockInspect() {
 	}
 }
 
-type mIStockRepoMockDeleteStock struct {
+type mIStockRepoMockCountItemsByLocation struct {
 	optional           bool
 	mock               *IStockRepoMock
-	defaultExpectation *IStockRepoMockDeleteStockExpectation
-	expectations       []*IStockRepoMockDeleteStockExpectation
+	defaultExpectation *IStockRepoMockCountItemsByLocationExpectation
+	expectations       []*IStockRepoMockCountItemsByLocationExpectation
 
-	callArgs []*IStockRepoMockDeleteStockParams
+	callArgs []*IStockRepoMockCountItemsByLocationParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IStockRepoMockDeleteStockExpectation specifies expectation struct of the IStockRepo.DeleteStock
-type IStockRepoMockDeleteStockExpectation struct {
+// IStockRepoMockCountItemsByLocationExpectation specifies expectation struct of the IStockRepo.CountItemsByLocation
+type IStockRepoMockCountItemsByLocationExpectation struct {
 	mock               *IStockRepoMock
-	params             *IStockRepoMockDeleteStockParams
-	paramPtrs          *IStockRepoMockDeleteStockParamPtrs
-	expectationOrigins IStockRepoMockDeleteStockExpectationOrigins
-	results            *IStockRepoMockDeleteStockResults
+	params             *IStockRepoMockCountItemsByLocationParams
+	paramPtrs          *IStockRepoMockCountItemsByLocationParamPtrs
+	expectationOrigins IStockRepoMockCountItemsByLocationExpectationOrigins
+	results            *IStockRepoMockCountItemsByLocationResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IStockRepoMockDeleteStockParams contains parameters of the IStockRepo.DeleteStock
-type IStockRepoMockDeleteStockParams struct {
-	ctx    context.Context
-	skuID  models.SKUID
-	userID models.UserID
+// IStockRepoMockCountItemsByLocationParams contains parameters of the IStockRepo.CountItemsByLocation
+type IStockRepoMockCountItemsByLocationParams struct {
+	ctx   context.Context
+	param mm_repository.GetStockByLocation
 }
 
-// IStockRepoMockDeleteStockParamPtrs contains pointers to parameters of the IStockRepo.DeleteStock
-type IStockRepoMockDeleteStockParamPtrs struct {
-	ctx    *context.Context
-	skuID  *models.SKUID
-	userID *models.UserID
+// IStockRepoMockCountItemsByLocationParamPtrs contains pointers to parameters of the IStockRepo.CountItemsByLocation
+type IStockRepoMockCountItemsByLocationParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.GetStockByLocation
 }
 
-// IStockRepoMockDeleteStockResults contains results of the IStockRepo.DeleteStock
-type IStockRepoMockDeleteStockResults struct {
+// IStockRepoMockCountItemsByLocationResults contains results of the IStockRepo.CountItemsByLocation
+type IStockRepoMockCountItemsByLocationResults struct {
+	i1  int64
 	err error
 }
 
-// IStockRepoMockDeleteStockOrigins contains origins of expectations of the IStockRepo.DeleteStock
-type IStockRepoMockDeleteStockExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originSkuID  string
-	originUserID string
+// IStockRepoMockCountItemsByLocationOrigins contains origins of expectations of the IStockRepo.CountItemsByLocation
+type IStockRepoMockCountItemsByLocationExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -482,370 +1053,7920 @@ type IStockRepoMockDeleteStockExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Optional() *mIStockRepoMockDeleteStock {
-	mmDeleteStock.optional = true
-	return mmDeleteStock
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Optional() *mIStockRepoMockCountItemsByLocation {
+	mmCountItemsByLocation.optional = true
+	return mmCountItemsByLocation
 }
 
-// Expect sets up expected params for IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Expect(ctx context.Context, skuID models.SKUID, userID models.UserID) *mIStockRepoMockDeleteStock {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+// Expect sets up expected params for IStockRepo.CountItemsByLocation
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Expect(ctx context.Context, param mm_repository.GetStockByLocation) *mIStockRepoMockCountItemsByLocation {
+	if mmCountItemsByLocation.mock.funcCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Set")
 	}
 
-	if mmDeleteStock.defaultExpectation == nil {
-		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	if mmCountItemsByLocation.defaultExpectation == nil {
+		mmCountItemsByLocation.defaultExpectation = &IStockRepoMockCountItemsByLocationExpectation{}
 	}
 
-	if mmDeleteStock.defaultExpectation.paramPtrs != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by ExpectParams functions")
+	if mmCountItemsByLocation.defaultExpectation.paramPtrs != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteStock.defaultExpectation.params = &IStockRepoMockDeleteStockParams{ctx, skuID, userID}
-	mmDeleteStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteStock.expectations {
-		if minimock.Equal(e.params, mmDeleteStock.defaultExpectation.params) {
-			mmDeleteStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStock.defaultExpectation.params)
+	mmCountItemsByLocation.defaultExpectation.params = &IStockRepoMockCountItemsByLocationParams{ctx, param}
+	mmCountItemsByLocation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountItemsByLocation.expectations {
+		if minimock.Equal(e.params, mmCountItemsByLocation.defaultExpectation.params) {
+			mmCountItemsByLocation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountItemsByLocation.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteStock
+	return mmCountItemsByLocation
 }
 
-// ExpectCtxParam1 sets up expected param ctx for IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockDeleteStock {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.CountItemsByLocation
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockCountItemsByLocation {
+	if mmCountItemsByLocation.mock.funcCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Set")
 	}
 
-	if mmDeleteStock.defaultExpectation == nil {
-		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	if mmCountItemsByLocation.defaultExpectation == nil {
+		mmCountItemsByLocation.defaultExpectation = &IStockRepoMockCountItemsByLocationExpectation{}
 	}
 
-	if mmDeleteStock.defaultExpectation.params != nil {
+	if mmCountItemsByLocation.defaultExpectation.params != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Expect")
+	}
+
+	if mmCountItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmCountItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockCountItemsByLocationParamPtrs{}
+	}
+	mmCountItemsByLocation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountItemsByLocation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountItemsByLocation
+}
+
+// ExpectParamParam2 sets up expected param param for IStockRepo.CountItemsByLocation
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) ExpectParamParam2(param mm_repository.GetStockByLocation) *mIStockRepoMockCountItemsByLocation {
+	if mmCountItemsByLocation.mock.funcCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Set")
+	}
+
+	if mmCountItemsByLocation.defaultExpectation == nil {
+		mmCountItemsByLocation.defaultExpectation = &IStockRepoMockCountItemsByLocationExpectation{}
+	}
+
+	if mmCountItemsByLocation.defaultExpectation.params != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Expect")
+	}
+
+	if mmCountItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmCountItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockCountItemsByLocationParamPtrs{}
+	}
+	mmCountItemsByLocation.defaultExpectation.paramPtrs.param = &param
+	mmCountItemsByLocation.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmCountItemsByLocation
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.CountItemsByLocation
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Inspect(f func(ctx context.Context, param mm_repository.GetStockByLocation)) *mIStockRepoMockCountItemsByLocation {
+	if mmCountItemsByLocation.mock.inspectFuncCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.CountItemsByLocation")
+	}
+
+	mmCountItemsByLocation.mock.inspectFuncCountItemsByLocation = f
+
+	return mmCountItemsByLocation
+}
+
+// Return sets up results that will be returned by IStockRepo.CountItemsByLocation
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Return(i1 int64, err error) *IStockRepoMock {
+	if mmCountItemsByLocation.mock.funcCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Set")
+	}
+
+	if mmCountItemsByLocation.defaultExpectation == nil {
+		mmCountItemsByLocation.defaultExpectation = &IStockRepoMockCountItemsByLocationExpectation{mock: mmCountItemsByLocation.mock}
+	}
+	mmCountItemsByLocation.defaultExpectation.results = &IStockRepoMockCountItemsByLocationResults{i1, err}
+	mmCountItemsByLocation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountItemsByLocation.mock
+}
+
+// Set uses given function f to mock the IStockRepo.CountItemsByLocation method
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Set(f func(ctx context.Context, param mm_repository.GetStockByLocation) (i1 int64, err error)) *IStockRepoMock {
+	if mmCountItemsByLocation.defaultExpectation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("Default expectation is already set for the IStockRepo.CountItemsByLocation method")
+	}
+
+	if len(mmCountItemsByLocation.expectations) > 0 {
+		mmCountItemsByLocation.mock.t.Fatalf("Some expectations are already set for the IStockRepo.CountItemsByLocation method")
+	}
+
+	mmCountItemsByLocation.mock.funcCountItemsByLocation = f
+	mmCountItemsByLocation.mock.funcCountItemsByLocationOrigin = minimock.CallerInfo(1)
+	return mmCountItemsByLocation.mock
+}
+
+// When sets expectation for the IStockRepo.CountItemsByLocation which will trigger the result defined by the following
+// Then helper
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) When(ctx context.Context, param mm_repository.GetStockByLocation) *IStockRepoMockCountItemsByLocationExpectation {
+	if mmCountItemsByLocation.mock.funcCountItemsByLocation != nil {
+		mmCountItemsByLocation.mock.t.Fatalf("IStockRepoMock.CountItemsByLocation mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockCountItemsByLocationExpectation{
+		mock:               mmCountItemsByLocation.mock,
+		params:             &IStockRepoMockCountItemsByLocationParams{ctx, param},
+		expectationOrigins: IStockRepoMockCountItemsByLocationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountItemsByLocation.expectations = append(mmCountItemsByLocation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.CountItemsByLocation return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockCountItemsByLocationExpectation) Then(i1 int64, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockCountItemsByLocationResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.CountItemsByLocation should be invoked
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Times(n uint64) *mIStockRepoMockCountItemsByLocation {
+	if n == 0 {
+		mmCountItemsByLocation.mock.t.Fatalf("Times of IStockRepoMock.CountItemsByLocation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountItemsByLocation.expectedInvocations, n)
+	mmCountItemsByLocation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountItemsByLocation
+}
+
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) invocationsDone() bool {
+	if len(mmCountItemsByLocation.expectations) == 0 && mmCountItemsByLocation.defaultExpectation == nil && mmCountItemsByLocation.mock.funcCountItemsByLocation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountItemsByLocation.mock.afterCountItemsByLocationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountItemsByLocation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountItemsByLocation implements mm_repository.IStockRepo
+func (mmCountItemsByLocation *IStockRepoMock) CountItemsByLocation(ctx context.Context, param mm_repository.GetStockByLocation) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountItemsByLocation.beforeCountItemsByLocationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountItemsByLocation.afterCountItemsByLocationCounter, 1)
+
+	mmCountItemsByLocation.t.Helper()
+
+	if mmCountItemsByLocation.inspectFuncCountItemsByLocation != nil {
+		mmCountItemsByLocation.inspectFuncCountItemsByLocation(ctx, param)
+	}
+
+	mm_params := IStockRepoMockCountItemsByLocationParams{ctx, param}
+
+	// Record call args
+	mmCountItemsByLocation.CountItemsByLocationMock.mutex.Lock()
+	mmCountItemsByLocation.CountItemsByLocationMock.callArgs = append(mmCountItemsByLocation.CountItemsByLocationMock.callArgs, &mm_params)
+	mmCountItemsByLocation.CountItemsByLocationMock.mutex.Unlock()
+
+	for _, e := range mmCountItemsByLocation.CountItemsByLocationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.params
+		mm_want_ptrs := mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockCountItemsByLocationParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountItemsByLocation.t.Errorf("IStockRepoMock.CountItemsByLocation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmCountItemsByLocation.t.Errorf("IStockRepoMock.CountItemsByLocation got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountItemsByLocation.t.Errorf("IStockRepoMock.CountItemsByLocation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountItemsByLocation.CountItemsByLocationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountItemsByLocation.t.Fatal("No results are set for the IStockRepoMock.CountItemsByLocation")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountItemsByLocation.funcCountItemsByLocation != nil {
+		return mmCountItemsByLocation.funcCountItemsByLocation(ctx, param)
+	}
+	mmCountItemsByLocation.t.Fatalf("Unexpected call to IStockRepoMock.CountItemsByLocation. %v %v", ctx, param)
+	return
+}
+
+// CountItemsByLocationAfterCounter returns a count of finished IStockRepoMock.CountItemsByLocation invocations
+func (mmCountItemsByLocation *IStockRepoMock) CountItemsByLocationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountItemsByLocation.afterCountItemsByLocationCounter)
+}
+
+// CountItemsByLocationBeforeCounter returns a count of IStockRepoMock.CountItemsByLocation invocations
+func (mmCountItemsByLocation *IStockRepoMock) CountItemsByLocationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountItemsByLocation.beforeCountItemsByLocationCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.CountItemsByLocation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountItemsByLocation *mIStockRepoMockCountItemsByLocation) Calls() []*IStockRepoMockCountItemsByLocationParams {
+	mmCountItemsByLocation.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockCountItemsByLocationParams, len(mmCountItemsByLocation.callArgs))
+	copy(argCopy, mmCountItemsByLocation.callArgs)
+
+	mmCountItemsByLocation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountItemsByLocationDone returns true if the count of the CountItemsByLocation invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockCountItemsByLocationDone() bool {
+	if m.CountItemsByLocationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountItemsByLocationMock.invocationsDone()
+}
+
+// MinimockCountItemsByLocationInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockCountItemsByLocationInspect() {
+	for _, e := range m.CountItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.CountItemsByLocation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountItemsByLocationCounter := mm_atomic.LoadUint64(&m.afterCountItemsByLocationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountItemsByLocationMock.defaultExpectation != nil && afterCountItemsByLocationCounter < 1 {
+		if m.CountItemsByLocationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.CountItemsByLocation at\n%s", m.CountItemsByLocationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.CountItemsByLocation at\n%s with params: %#v", m.CountItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *m.CountItemsByLocationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountItemsByLocation != nil && afterCountItemsByLocationCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.CountItemsByLocation at\n%s", m.funcCountItemsByLocationOrigin)
+	}
+
+	if !m.CountItemsByLocationMock.invocationsDone() && afterCountItemsByLocationCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.CountItemsByLocation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountItemsByLocationMock.expectedInvocations), m.CountItemsByLocationMock.expectedInvocationsOrigin, afterCountItemsByLocationCounter)
+	}
+}
+
+type mIStockRepoMockCountSearchItems struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockCountSearchItemsExpectation
+	expectations       []*IStockRepoMockCountSearchItemsExpectation
+
+	callArgs []*IStockRepoMockCountSearchItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockCountSearchItemsExpectation specifies expectation struct of the IStockRepo.CountSearchItems
+type IStockRepoMockCountSearchItemsExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockCountSearchItemsParams
+	paramPtrs          *IStockRepoMockCountSearchItemsParamPtrs
+	expectationOrigins IStockRepoMockCountSearchItemsExpectationOrigins
+	results            *IStockRepoMockCountSearchItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockCountSearchItemsParams contains parameters of the IStockRepo.CountSearchItems
+type IStockRepoMockCountSearchItemsParams struct {
+	ctx   context.Context
+	param mm_repository.SearchItemsParam
+}
+
+// IStockRepoMockCountSearchItemsParamPtrs contains pointers to parameters of the IStockRepo.CountSearchItems
+type IStockRepoMockCountSearchItemsParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.SearchItemsParam
+}
+
+// IStockRepoMockCountSearchItemsResults contains results of the IStockRepo.CountSearchItems
+type IStockRepoMockCountSearchItemsResults struct {
+	i1  int64
+	err error
+}
+
+// IStockRepoMockCountSearchItemsOrigins contains origins of expectations of the IStockRepo.CountSearchItems
+type IStockRepoMockCountSearchItemsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Optional() *mIStockRepoMockCountSearchItems {
+	mmCountSearchItems.optional = true
+	return mmCountSearchItems
+}
+
+// Expect sets up expected params for IStockRepo.CountSearchItems
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Expect(ctx context.Context, param mm_repository.SearchItemsParam) *mIStockRepoMockCountSearchItems {
+	if mmCountSearchItems.mock.funcCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Set")
+	}
+
+	if mmCountSearchItems.defaultExpectation == nil {
+		mmCountSearchItems.defaultExpectation = &IStockRepoMockCountSearchItemsExpectation{}
+	}
+
+	if mmCountSearchItems.defaultExpectation.paramPtrs != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by ExpectParams functions")
+	}
+
+	mmCountSearchItems.defaultExpectation.params = &IStockRepoMockCountSearchItemsParams{ctx, param}
+	mmCountSearchItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountSearchItems.expectations {
+		if minimock.Equal(e.params, mmCountSearchItems.defaultExpectation.params) {
+			mmCountSearchItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountSearchItems.defaultExpectation.params)
+		}
+	}
+
+	return mmCountSearchItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.CountSearchItems
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockCountSearchItems {
+	if mmCountSearchItems.mock.funcCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Set")
+	}
+
+	if mmCountSearchItems.defaultExpectation == nil {
+		mmCountSearchItems.defaultExpectation = &IStockRepoMockCountSearchItemsExpectation{}
+	}
+
+	if mmCountSearchItems.defaultExpectation.params != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Expect")
+	}
+
+	if mmCountSearchItems.defaultExpectation.paramPtrs == nil {
+		mmCountSearchItems.defaultExpectation.paramPtrs = &IStockRepoMockCountSearchItemsParamPtrs{}
+	}
+	mmCountSearchItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountSearchItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountSearchItems
+}
+
+// ExpectParamParam2 sets up expected param param for IStockRepo.CountSearchItems
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) ExpectParamParam2(param mm_repository.SearchItemsParam) *mIStockRepoMockCountSearchItems {
+	if mmCountSearchItems.mock.funcCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Set")
+	}
+
+	if mmCountSearchItems.defaultExpectation == nil {
+		mmCountSearchItems.defaultExpectation = &IStockRepoMockCountSearchItemsExpectation{}
+	}
+
+	if mmCountSearchItems.defaultExpectation.params != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Expect")
+	}
+
+	if mmCountSearchItems.defaultExpectation.paramPtrs == nil {
+		mmCountSearchItems.defaultExpectation.paramPtrs = &IStockRepoMockCountSearchItemsParamPtrs{}
+	}
+	mmCountSearchItems.defaultExpectation.paramPtrs.param = &param
+	mmCountSearchItems.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmCountSearchItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.CountSearchItems
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Inspect(f func(ctx context.Context, param mm_repository.SearchItemsParam)) *mIStockRepoMockCountSearchItems {
+	if mmCountSearchItems.mock.inspectFuncCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.CountSearchItems")
+	}
+
+	mmCountSearchItems.mock.inspectFuncCountSearchItems = f
+
+	return mmCountSearchItems
+}
+
+// Return sets up results that will be returned by IStockRepo.CountSearchItems
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Return(i1 int64, err error) *IStockRepoMock {
+	if mmCountSearchItems.mock.funcCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Set")
+	}
+
+	if mmCountSearchItems.defaultExpectation == nil {
+		mmCountSearchItems.defaultExpectation = &IStockRepoMockCountSearchItemsExpectation{mock: mmCountSearchItems.mock}
+	}
+	mmCountSearchItems.defaultExpectation.results = &IStockRepoMockCountSearchItemsResults{i1, err}
+	mmCountSearchItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountSearchItems.mock
+}
+
+// Set uses given function f to mock the IStockRepo.CountSearchItems method
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Set(f func(ctx context.Context, param mm_repository.SearchItemsParam) (i1 int64, err error)) *IStockRepoMock {
+	if mmCountSearchItems.defaultExpectation != nil {
+		mmCountSearchItems.mock.t.Fatalf("Default expectation is already set for the IStockRepo.CountSearchItems method")
+	}
+
+	if len(mmCountSearchItems.expectations) > 0 {
+		mmCountSearchItems.mock.t.Fatalf("Some expectations are already set for the IStockRepo.CountSearchItems method")
+	}
+
+	mmCountSearchItems.mock.funcCountSearchItems = f
+	mmCountSearchItems.mock.funcCountSearchItemsOrigin = minimock.CallerInfo(1)
+	return mmCountSearchItems.mock
+}
+
+// When sets expectation for the IStockRepo.CountSearchItems which will trigger the result defined by the following
+// Then helper
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) When(ctx context.Context, param mm_repository.SearchItemsParam) *IStockRepoMockCountSearchItemsExpectation {
+	if mmCountSearchItems.mock.funcCountSearchItems != nil {
+		mmCountSearchItems.mock.t.Fatalf("IStockRepoMock.CountSearchItems mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockCountSearchItemsExpectation{
+		mock:               mmCountSearchItems.mock,
+		params:             &IStockRepoMockCountSearchItemsParams{ctx, param},
+		expectationOrigins: IStockRepoMockCountSearchItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountSearchItems.expectations = append(mmCountSearchItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.CountSearchItems return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockCountSearchItemsExpectation) Then(i1 int64, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockCountSearchItemsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.CountSearchItems should be invoked
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Times(n uint64) *mIStockRepoMockCountSearchItems {
+	if n == 0 {
+		mmCountSearchItems.mock.t.Fatalf("Times of IStockRepoMock.CountSearchItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountSearchItems.expectedInvocations, n)
+	mmCountSearchItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountSearchItems
+}
+
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) invocationsDone() bool {
+	if len(mmCountSearchItems.expectations) == 0 && mmCountSearchItems.defaultExpectation == nil && mmCountSearchItems.mock.funcCountSearchItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountSearchItems.mock.afterCountSearchItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountSearchItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountSearchItems implements mm_repository.IStockRepo
+func (mmCountSearchItems *IStockRepoMock) CountSearchItems(ctx context.Context, param mm_repository.SearchItemsParam) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountSearchItems.beforeCountSearchItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountSearchItems.afterCountSearchItemsCounter, 1)
+
+	mmCountSearchItems.t.Helper()
+
+	if mmCountSearchItems.inspectFuncCountSearchItems != nil {
+		mmCountSearchItems.inspectFuncCountSearchItems(ctx, param)
+	}
+
+	mm_params := IStockRepoMockCountSearchItemsParams{ctx, param}
+
+	// Record call args
+	mmCountSearchItems.CountSearchItemsMock.mutex.Lock()
+	mmCountSearchItems.CountSearchItemsMock.callArgs = append(mmCountSearchItems.CountSearchItemsMock.callArgs, &mm_params)
+	mmCountSearchItems.CountSearchItemsMock.mutex.Unlock()
+
+	for _, e := range mmCountSearchItems.CountSearchItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountSearchItems.CountSearchItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountSearchItems.CountSearchItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountSearchItems.CountSearchItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountSearchItems.CountSearchItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockCountSearchItemsParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountSearchItems.t.Errorf("IStockRepoMock.CountSearchItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSearchItems.CountSearchItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmCountSearchItems.t.Errorf("IStockRepoMock.CountSearchItems got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSearchItems.CountSearchItemsMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountSearchItems.t.Errorf("IStockRepoMock.CountSearchItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountSearchItems.CountSearchItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountSearchItems.CountSearchItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountSearchItems.t.Fatal("No results are set for the IStockRepoMock.CountSearchItems")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountSearchItems.funcCountSearchItems != nil {
+		return mmCountSearchItems.funcCountSearchItems(ctx, param)
+	}
+	mmCountSearchItems.t.Fatalf("Unexpected call to IStockRepoMock.CountSearchItems. %v %v", ctx, param)
+	return
+}
+
+// CountSearchItemsAfterCounter returns a count of finished IStockRepoMock.CountSearchItems invocations
+func (mmCountSearchItems *IStockRepoMock) CountSearchItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSearchItems.afterCountSearchItemsCounter)
+}
+
+// CountSearchItemsBeforeCounter returns a count of IStockRepoMock.CountSearchItems invocations
+func (mmCountSearchItems *IStockRepoMock) CountSearchItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSearchItems.beforeCountSearchItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.CountSearchItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountSearchItems *mIStockRepoMockCountSearchItems) Calls() []*IStockRepoMockCountSearchItemsParams {
+	mmCountSearchItems.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockCountSearchItemsParams, len(mmCountSearchItems.callArgs))
+	copy(argCopy, mmCountSearchItems.callArgs)
+
+	mmCountSearchItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountSearchItemsDone returns true if the count of the CountSearchItems invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockCountSearchItemsDone() bool {
+	if m.CountSearchItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountSearchItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountSearchItemsMock.invocationsDone()
+}
+
+// MinimockCountSearchItemsInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockCountSearchItemsInspect() {
+	for _, e := range m.CountSearchItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.CountSearchItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountSearchItemsCounter := mm_atomic.LoadUint64(&m.afterCountSearchItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountSearchItemsMock.defaultExpectation != nil && afterCountSearchItemsCounter < 1 {
+		if m.CountSearchItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.CountSearchItems at\n%s", m.CountSearchItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.CountSearchItems at\n%s with params: %#v", m.CountSearchItemsMock.defaultExpectation.expectationOrigins.origin, *m.CountSearchItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountSearchItems != nil && afterCountSearchItemsCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.CountSearchItems at\n%s", m.funcCountSearchItemsOrigin)
+	}
+
+	if !m.CountSearchItemsMock.invocationsDone() && afterCountSearchItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.CountSearchItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountSearchItemsMock.expectedInvocations), m.CountSearchItemsMock.expectedInvocationsOrigin, afterCountSearchItemsCounter)
+	}
+}
+
+type mIStockRepoMockDeleteStock struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockDeleteStockExpectation
+	expectations       []*IStockRepoMockDeleteStockExpectation
+
+	callArgs []*IStockRepoMockDeleteStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockDeleteStockExpectation specifies expectation struct of the IStockRepo.DeleteStock
+type IStockRepoMockDeleteStockExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockDeleteStockParams
+	paramPtrs          *IStockRepoMockDeleteStockParamPtrs
+	expectationOrigins IStockRepoMockDeleteStockExpectationOrigins
+	results            *IStockRepoMockDeleteStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockDeleteStockParams contains parameters of the IStockRepo.DeleteStock
+type IStockRepoMockDeleteStockParams struct {
+	ctx       context.Context
+	skuID     models.SKUID
+	userID    models.UserID
+	deletedAt time.Time
+}
+
+// IStockRepoMockDeleteStockParamPtrs contains pointers to parameters of the IStockRepo.DeleteStock
+type IStockRepoMockDeleteStockParamPtrs struct {
+	ctx       *context.Context
+	skuID     *models.SKUID
+	userID    *models.UserID
+	deletedAt *time.Time
+}
+
+// IStockRepoMockDeleteStockResults contains results of the IStockRepo.DeleteStock
+type IStockRepoMockDeleteStockResults struct {
+	sa1 []models.Stock
+	err error
+}
+
+// IStockRepoMockDeleteStockOrigins contains origins of expectations of the IStockRepo.DeleteStock
+type IStockRepoMockDeleteStockExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSkuID     string
+	originUserID    string
+	originDeletedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Optional() *mIStockRepoMockDeleteStock {
+	mmDeleteStock.optional = true
+	return mmDeleteStock
+}
+
+// Expect sets up expected params for IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Expect(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	}
+
+	if mmDeleteStock.defaultExpectation.paramPtrs != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStock.defaultExpectation.params = &IStockRepoMockDeleteStockParams{ctx, skuID, userID, deletedAt}
+	mmDeleteStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteStock.expectations {
+		if minimock.Equal(e.params, mmDeleteStock.defaultExpectation.params) {
+			mmDeleteStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStock.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	}
+
+	if mmDeleteStock.defaultExpectation.params != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
+	}
+
+	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
+		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	}
+	mmDeleteStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteStock
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	}
+
+	if mmDeleteStock.defaultExpectation.params != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
+	}
+
+	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
+		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	}
+	mmDeleteStock.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteStock.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteStock
+}
+
+// ExpectUserIDParam3 sets up expected param userID for IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectUserIDParam3(userID models.UserID) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	}
+
+	if mmDeleteStock.defaultExpectation.params != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
+	}
+
+	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
+		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	}
+	mmDeleteStock.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteStock.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteStock
+}
+
+// ExpectDeletedAtParam4 sets up expected param deletedAt for IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectDeletedAtParam4(deletedAt time.Time) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	}
+
+	if mmDeleteStock.defaultExpectation.params != nil {
 		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
 	}
 
-	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
-		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
+		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	}
+	mmDeleteStock.defaultExpectation.paramPtrs.deletedAt = &deletedAt
+	mmDeleteStock.defaultExpectation.expectationOrigins.originDeletedAt = minimock.CallerInfo(1)
+
+	return mmDeleteStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Inspect(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time)) *mIStockRepoMockDeleteStock {
+	if mmDeleteStock.mock.inspectFuncDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.DeleteStock")
+	}
+
+	mmDeleteStock.mock.inspectFuncDeleteStock = f
+
+	return mmDeleteStock
+}
+
+// Return sets up results that will be returned by IStockRepo.DeleteStock
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Return(sa1 []models.Stock, err error) *IStockRepoMock {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	if mmDeleteStock.defaultExpectation == nil {
+		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{mock: mmDeleteStock.mock}
+	}
+	mmDeleteStock.defaultExpectation.results = &IStockRepoMockDeleteStockResults{sa1, err}
+	mmDeleteStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteStock.mock
+}
+
+// Set uses given function f to mock the IStockRepo.DeleteStock method
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Set(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) (sa1 []models.Stock, err error)) *IStockRepoMock {
+	if mmDeleteStock.defaultExpectation != nil {
+		mmDeleteStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.DeleteStock method")
+	}
+
+	if len(mmDeleteStock.expectations) > 0 {
+		mmDeleteStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.DeleteStock method")
+	}
+
+	mmDeleteStock.mock.funcDeleteStock = f
+	mmDeleteStock.mock.funcDeleteStockOrigin = minimock.CallerInfo(1)
+	return mmDeleteStock.mock
+}
+
+// When sets expectation for the IStockRepo.DeleteStock which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStock *mIStockRepoMockDeleteStock) When(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) *IStockRepoMockDeleteStockExpectation {
+	if mmDeleteStock.mock.funcDeleteStock != nil {
+		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockDeleteStockExpectation{
+		mock:               mmDeleteStock.mock,
+		params:             &IStockRepoMockDeleteStockParams{ctx, skuID, userID, deletedAt},
+		expectationOrigins: IStockRepoMockDeleteStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteStock.expectations = append(mmDeleteStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.DeleteStock return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockDeleteStockExpectation) Then(sa1 []models.Stock, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockDeleteStockResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.DeleteStock should be invoked
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Times(n uint64) *mIStockRepoMockDeleteStock {
+	if n == 0 {
+		mmDeleteStock.mock.t.Fatalf("Times of IStockRepoMock.DeleteStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStock.expectedInvocations, n)
+	mmDeleteStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStock
+}
+
+func (mmDeleteStock *mIStockRepoMockDeleteStock) invocationsDone() bool {
+	if len(mmDeleteStock.expectations) == 0 && mmDeleteStock.defaultExpectation == nil && mmDeleteStock.mock.funcDeleteStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStock.mock.afterDeleteStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStock implements mm_repository.IStockRepo
+func (mmDeleteStock *IStockRepoMock) DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) (sa1 []models.Stock, err error) {
+	mm_atomic.AddUint64(&mmDeleteStock.beforeDeleteStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStock.afterDeleteStockCounter, 1)
+
+	mmDeleteStock.t.Helper()
+
+	if mmDeleteStock.inspectFuncDeleteStock != nil {
+		mmDeleteStock.inspectFuncDeleteStock(ctx, skuID, userID, deletedAt)
+	}
+
+	mm_params := IStockRepoMockDeleteStockParams{ctx, skuID, userID, deletedAt}
+
+	// Record call args
+	mmDeleteStock.DeleteStockMock.mutex.Lock()
+	mmDeleteStock.DeleteStockMock.callArgs = append(mmDeleteStock.DeleteStockMock.callArgs, &mm_params)
+	mmDeleteStock.DeleteStockMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStock.DeleteStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmDeleteStock.DeleteStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStock.DeleteStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStock.DeleteStockMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStock.DeleteStockMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockDeleteStockParams{ctx, skuID, userID, deletedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.deletedAt != nil && !minimock.Equal(*mm_want_ptrs.deletedAt, mm_got.deletedAt) {
+				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter deletedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originDeletedAt, *mm_want_ptrs.deletedAt, mm_got.deletedAt, minimock.Diff(*mm_want_ptrs.deletedAt, mm_got.deletedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStock.DeleteStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStock.t.Fatal("No results are set for the IStockRepoMock.DeleteStock")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmDeleteStock.funcDeleteStock != nil {
+		return mmDeleteStock.funcDeleteStock(ctx, skuID, userID, deletedAt)
+	}
+	mmDeleteStock.t.Fatalf("Unexpected call to IStockRepoMock.DeleteStock. %v %v %v %v", ctx, skuID, userID, deletedAt)
+	return
+}
+
+// DeleteStockAfterCounter returns a count of finished IStockRepoMock.DeleteStock invocations
+func (mmDeleteStock *IStockRepoMock) DeleteStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStock.afterDeleteStockCounter)
+}
+
+// DeleteStockBeforeCounter returns a count of IStockRepoMock.DeleteStock invocations
+func (mmDeleteStock *IStockRepoMock) DeleteStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStock.beforeDeleteStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.DeleteStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStock *mIStockRepoMockDeleteStock) Calls() []*IStockRepoMockDeleteStockParams {
+	mmDeleteStock.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockDeleteStockParams, len(mmDeleteStock.callArgs))
+	copy(argCopy, mmDeleteStock.callArgs)
+
+	mmDeleteStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStockDone returns true if the count of the DeleteStock invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockDeleteStockDone() bool {
+	if m.DeleteStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStockMock.invocationsDone()
+}
+
+// MinimockDeleteStockInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockDeleteStockInspect() {
+	for _, e := range m.DeleteStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteStockCounter := mm_atomic.LoadUint64(&m.afterDeleteStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStockMock.defaultExpectation != nil && afterDeleteStockCounter < 1 {
+		if m.DeleteStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s", m.DeleteStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s with params: %#v", m.DeleteStockMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStock != nil && afterDeleteStockCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s", m.funcDeleteStockOrigin)
+	}
+
+	if !m.DeleteStockMock.invocationsDone() && afterDeleteStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.DeleteStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStockMock.expectedInvocations), m.DeleteStockMock.expectedInvocationsOrigin, afterDeleteStockCounter)
+	}
+}
+
+type mIStockRepoMockDeleteThreshold struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockDeleteThresholdExpectation
+	expectations       []*IStockRepoMockDeleteThresholdExpectation
+
+	callArgs []*IStockRepoMockDeleteThresholdParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockDeleteThresholdExpectation specifies expectation struct of the IStockRepo.DeleteThreshold
+type IStockRepoMockDeleteThresholdExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockDeleteThresholdParams
+	paramPtrs          *IStockRepoMockDeleteThresholdParamPtrs
+	expectationOrigins IStockRepoMockDeleteThresholdExpectationOrigins
+	results            *IStockRepoMockDeleteThresholdResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockDeleteThresholdParams contains parameters of the IStockRepo.DeleteThreshold
+type IStockRepoMockDeleteThresholdParams struct {
+	ctx      context.Context
+	skuID    models.SKUID
+	location string
+}
+
+// IStockRepoMockDeleteThresholdParamPtrs contains pointers to parameters of the IStockRepo.DeleteThreshold
+type IStockRepoMockDeleteThresholdParamPtrs struct {
+	ctx      *context.Context
+	skuID    *models.SKUID
+	location *string
+}
+
+// IStockRepoMockDeleteThresholdResults contains results of the IStockRepo.DeleteThreshold
+type IStockRepoMockDeleteThresholdResults struct {
+	err error
+}
+
+// IStockRepoMockDeleteThresholdOrigins contains origins of expectations of the IStockRepo.DeleteThreshold
+type IStockRepoMockDeleteThresholdExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Optional() *mIStockRepoMockDeleteThreshold {
+	mmDeleteThreshold.optional = true
+	return mmDeleteThreshold
+}
+
+// Expect sets up expected params for IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Expect(ctx context.Context, skuID models.SKUID, location string) *mIStockRepoMockDeleteThreshold {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	if mmDeleteThreshold.defaultExpectation == nil {
+		mmDeleteThreshold.defaultExpectation = &IStockRepoMockDeleteThresholdExpectation{}
+	}
+
+	if mmDeleteThreshold.defaultExpectation.paramPtrs != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteThreshold.defaultExpectation.params = &IStockRepoMockDeleteThresholdParams{ctx, skuID, location}
+	mmDeleteThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteThreshold.expectations {
+		if minimock.Equal(e.params, mmDeleteThreshold.defaultExpectation.params) {
+			mmDeleteThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteThreshold.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteThreshold
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockDeleteThreshold {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	if mmDeleteThreshold.defaultExpectation == nil {
+		mmDeleteThreshold.defaultExpectation = &IStockRepoMockDeleteThresholdExpectation{}
+	}
+
+	if mmDeleteThreshold.defaultExpectation.params != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Expect")
+	}
+
+	if mmDeleteThreshold.defaultExpectation.paramPtrs == nil {
+		mmDeleteThreshold.defaultExpectation.paramPtrs = &IStockRepoMockDeleteThresholdParamPtrs{}
+	}
+	mmDeleteThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteThreshold
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockDeleteThreshold {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	if mmDeleteThreshold.defaultExpectation == nil {
+		mmDeleteThreshold.defaultExpectation = &IStockRepoMockDeleteThresholdExpectation{}
+	}
+
+	if mmDeleteThreshold.defaultExpectation.params != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Expect")
+	}
+
+	if mmDeleteThreshold.defaultExpectation.paramPtrs == nil {
+		mmDeleteThreshold.defaultExpectation.paramPtrs = &IStockRepoMockDeleteThresholdParamPtrs{}
+	}
+	mmDeleteThreshold.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteThreshold.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteThreshold
+}
+
+// ExpectLocationParam3 sets up expected param location for IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) ExpectLocationParam3(location string) *mIStockRepoMockDeleteThreshold {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	if mmDeleteThreshold.defaultExpectation == nil {
+		mmDeleteThreshold.defaultExpectation = &IStockRepoMockDeleteThresholdExpectation{}
+	}
+
+	if mmDeleteThreshold.defaultExpectation.params != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Expect")
+	}
+
+	if mmDeleteThreshold.defaultExpectation.paramPtrs == nil {
+		mmDeleteThreshold.defaultExpectation.paramPtrs = &IStockRepoMockDeleteThresholdParamPtrs{}
+	}
+	mmDeleteThreshold.defaultExpectation.paramPtrs.location = &location
+	mmDeleteThreshold.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmDeleteThreshold
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Inspect(f func(ctx context.Context, skuID models.SKUID, location string)) *mIStockRepoMockDeleteThreshold {
+	if mmDeleteThreshold.mock.inspectFuncDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.DeleteThreshold")
+	}
+
+	mmDeleteThreshold.mock.inspectFuncDeleteThreshold = f
+
+	return mmDeleteThreshold
+}
+
+// Return sets up results that will be returned by IStockRepo.DeleteThreshold
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Return(err error) *IStockRepoMock {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	if mmDeleteThreshold.defaultExpectation == nil {
+		mmDeleteThreshold.defaultExpectation = &IStockRepoMockDeleteThresholdExpectation{mock: mmDeleteThreshold.mock}
+	}
+	mmDeleteThreshold.defaultExpectation.results = &IStockRepoMockDeleteThresholdResults{err}
+	mmDeleteThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteThreshold.mock
+}
+
+// Set uses given function f to mock the IStockRepo.DeleteThreshold method
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Set(f func(ctx context.Context, skuID models.SKUID, location string) (err error)) *IStockRepoMock {
+	if mmDeleteThreshold.defaultExpectation != nil {
+		mmDeleteThreshold.mock.t.Fatalf("Default expectation is already set for the IStockRepo.DeleteThreshold method")
+	}
+
+	if len(mmDeleteThreshold.expectations) > 0 {
+		mmDeleteThreshold.mock.t.Fatalf("Some expectations are already set for the IStockRepo.DeleteThreshold method")
+	}
+
+	mmDeleteThreshold.mock.funcDeleteThreshold = f
+	mmDeleteThreshold.mock.funcDeleteThresholdOrigin = minimock.CallerInfo(1)
+	return mmDeleteThreshold.mock
+}
+
+// When sets expectation for the IStockRepo.DeleteThreshold which will trigger the result defined by the following
+// Then helper
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) When(ctx context.Context, skuID models.SKUID, location string) *IStockRepoMockDeleteThresholdExpectation {
+	if mmDeleteThreshold.mock.funcDeleteThreshold != nil {
+		mmDeleteThreshold.mock.t.Fatalf("IStockRepoMock.DeleteThreshold mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockDeleteThresholdExpectation{
+		mock:               mmDeleteThreshold.mock,
+		params:             &IStockRepoMockDeleteThresholdParams{ctx, skuID, location},
+		expectationOrigins: IStockRepoMockDeleteThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteThreshold.expectations = append(mmDeleteThreshold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.DeleteThreshold return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockDeleteThresholdExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockDeleteThresholdResults{err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.DeleteThreshold should be invoked
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Times(n uint64) *mIStockRepoMockDeleteThreshold {
+	if n == 0 {
+		mmDeleteThreshold.mock.t.Fatalf("Times of IStockRepoMock.DeleteThreshold mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteThreshold.expectedInvocations, n)
+	mmDeleteThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteThreshold
+}
+
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) invocationsDone() bool {
+	if len(mmDeleteThreshold.expectations) == 0 && mmDeleteThreshold.defaultExpectation == nil && mmDeleteThreshold.mock.funcDeleteThreshold == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteThreshold.mock.afterDeleteThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteThreshold.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteThreshold implements mm_repository.IStockRepo
+func (mmDeleteThreshold *IStockRepoMock) DeleteThreshold(ctx context.Context, skuID models.SKUID, location string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteThreshold.beforeDeleteThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteThreshold.afterDeleteThresholdCounter, 1)
+
+	mmDeleteThreshold.t.Helper()
+
+	if mmDeleteThreshold.inspectFuncDeleteThreshold != nil {
+		mmDeleteThreshold.inspectFuncDeleteThreshold(ctx, skuID, location)
+	}
+
+	mm_params := IStockRepoMockDeleteThresholdParams{ctx, skuID, location}
+
+	// Record call args
+	mmDeleteThreshold.DeleteThresholdMock.mutex.Lock()
+	mmDeleteThreshold.DeleteThresholdMock.callArgs = append(mmDeleteThreshold.DeleteThresholdMock.callArgs, &mm_params)
+	mmDeleteThreshold.DeleteThresholdMock.mutex.Unlock()
+
+	for _, e := range mmDeleteThreshold.DeleteThresholdMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteThreshold.DeleteThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockDeleteThresholdParams{ctx, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteThreshold.t.Errorf("IStockRepoMock.DeleteThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteThreshold.t.Errorf("IStockRepoMock.DeleteThreshold got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmDeleteThreshold.t.Errorf("IStockRepoMock.DeleteThreshold got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteThreshold.t.Errorf("IStockRepoMock.DeleteThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteThreshold.DeleteThresholdMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteThreshold.t.Fatal("No results are set for the IStockRepoMock.DeleteThreshold")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteThreshold.funcDeleteThreshold != nil {
+		return mmDeleteThreshold.funcDeleteThreshold(ctx, skuID, location)
+	}
+	mmDeleteThreshold.t.Fatalf("Unexpected call to IStockRepoMock.DeleteThreshold. %v %v %v", ctx, skuID, location)
+	return
+}
+
+// DeleteThresholdAfterCounter returns a count of finished IStockRepoMock.DeleteThreshold invocations
+func (mmDeleteThreshold *IStockRepoMock) DeleteThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteThreshold.afterDeleteThresholdCounter)
+}
+
+// DeleteThresholdBeforeCounter returns a count of IStockRepoMock.DeleteThreshold invocations
+func (mmDeleteThreshold *IStockRepoMock) DeleteThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteThreshold.beforeDeleteThresholdCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.DeleteThreshold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteThreshold *mIStockRepoMockDeleteThreshold) Calls() []*IStockRepoMockDeleteThresholdParams {
+	mmDeleteThreshold.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockDeleteThresholdParams, len(mmDeleteThreshold.callArgs))
+	copy(argCopy, mmDeleteThreshold.callArgs)
+
+	mmDeleteThreshold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteThresholdDone returns true if the count of the DeleteThreshold invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockDeleteThresholdDone() bool {
+	if m.DeleteThresholdMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteThresholdMock.invocationsDone()
+}
+
+// MinimockDeleteThresholdInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockDeleteThresholdInspect() {
+	for _, e := range m.DeleteThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteThresholdCounter := mm_atomic.LoadUint64(&m.afterDeleteThresholdCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteThresholdMock.defaultExpectation != nil && afterDeleteThresholdCounter < 1 {
+		if m.DeleteThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteThreshold at\n%s", m.DeleteThresholdMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.DeleteThreshold at\n%s with params: %#v", m.DeleteThresholdMock.defaultExpectation.expectationOrigins.origin, *m.DeleteThresholdMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteThreshold != nil && afterDeleteThresholdCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.DeleteThreshold at\n%s", m.funcDeleteThresholdOrigin)
+	}
+
+	if !m.DeleteThresholdMock.invocationsDone() && afterDeleteThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.DeleteThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteThresholdMock.expectedInvocations), m.DeleteThresholdMock.expectedInvocationsOrigin, afterDeleteThresholdCounter)
+	}
+}
+
+type mIStockRepoMockEnsureSeller struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockEnsureSellerExpectation
+	expectations       []*IStockRepoMockEnsureSellerExpectation
+
+	callArgs []*IStockRepoMockEnsureSellerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockEnsureSellerExpectation specifies expectation struct of the IStockRepo.EnsureSeller
+type IStockRepoMockEnsureSellerExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockEnsureSellerParams
+	paramPtrs          *IStockRepoMockEnsureSellerParamPtrs
+	expectationOrigins IStockRepoMockEnsureSellerExpectationOrigins
+	results            *IStockRepoMockEnsureSellerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockEnsureSellerParams contains parameters of the IStockRepo.EnsureSeller
+type IStockRepoMockEnsureSellerParams struct {
+	ctx    context.Context
+	userID models.UserID
+}
+
+// IStockRepoMockEnsureSellerParamPtrs contains pointers to parameters of the IStockRepo.EnsureSeller
+type IStockRepoMockEnsureSellerParamPtrs struct {
+	ctx    *context.Context
+	userID *models.UserID
+}
+
+// IStockRepoMockEnsureSellerResults contains results of the IStockRepo.EnsureSeller
+type IStockRepoMockEnsureSellerResults struct {
+	err error
+}
+
+// IStockRepoMockEnsureSellerOrigins contains origins of expectations of the IStockRepo.EnsureSeller
+type IStockRepoMockEnsureSellerExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Optional() *mIStockRepoMockEnsureSeller {
+	mmEnsureSeller.optional = true
+	return mmEnsureSeller
+}
+
+// Expect sets up expected params for IStockRepo.EnsureSeller
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Expect(ctx context.Context, userID models.UserID) *mIStockRepoMockEnsureSeller {
+	if mmEnsureSeller.mock.funcEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Set")
+	}
+
+	if mmEnsureSeller.defaultExpectation == nil {
+		mmEnsureSeller.defaultExpectation = &IStockRepoMockEnsureSellerExpectation{}
+	}
+
+	if mmEnsureSeller.defaultExpectation.paramPtrs != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by ExpectParams functions")
+	}
+
+	mmEnsureSeller.defaultExpectation.params = &IStockRepoMockEnsureSellerParams{ctx, userID}
+	mmEnsureSeller.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEnsureSeller.expectations {
+		if minimock.Equal(e.params, mmEnsureSeller.defaultExpectation.params) {
+			mmEnsureSeller.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEnsureSeller.defaultExpectation.params)
+		}
+	}
+
+	return mmEnsureSeller
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.EnsureSeller
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockEnsureSeller {
+	if mmEnsureSeller.mock.funcEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Set")
+	}
+
+	if mmEnsureSeller.defaultExpectation == nil {
+		mmEnsureSeller.defaultExpectation = &IStockRepoMockEnsureSellerExpectation{}
+	}
+
+	if mmEnsureSeller.defaultExpectation.params != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Expect")
+	}
+
+	if mmEnsureSeller.defaultExpectation.paramPtrs == nil {
+		mmEnsureSeller.defaultExpectation.paramPtrs = &IStockRepoMockEnsureSellerParamPtrs{}
+	}
+	mmEnsureSeller.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEnsureSeller.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEnsureSeller
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IStockRepo.EnsureSeller
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) ExpectUserIDParam2(userID models.UserID) *mIStockRepoMockEnsureSeller {
+	if mmEnsureSeller.mock.funcEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Set")
+	}
+
+	if mmEnsureSeller.defaultExpectation == nil {
+		mmEnsureSeller.defaultExpectation = &IStockRepoMockEnsureSellerExpectation{}
+	}
+
+	if mmEnsureSeller.defaultExpectation.params != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Expect")
+	}
+
+	if mmEnsureSeller.defaultExpectation.paramPtrs == nil {
+		mmEnsureSeller.defaultExpectation.paramPtrs = &IStockRepoMockEnsureSellerParamPtrs{}
+	}
+	mmEnsureSeller.defaultExpectation.paramPtrs.userID = &userID
+	mmEnsureSeller.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmEnsureSeller
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.EnsureSeller
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Inspect(f func(ctx context.Context, userID models.UserID)) *mIStockRepoMockEnsureSeller {
+	if mmEnsureSeller.mock.inspectFuncEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.EnsureSeller")
+	}
+
+	mmEnsureSeller.mock.inspectFuncEnsureSeller = f
+
+	return mmEnsureSeller
+}
+
+// Return sets up results that will be returned by IStockRepo.EnsureSeller
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Return(err error) *IStockRepoMock {
+	if mmEnsureSeller.mock.funcEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Set")
+	}
+
+	if mmEnsureSeller.defaultExpectation == nil {
+		mmEnsureSeller.defaultExpectation = &IStockRepoMockEnsureSellerExpectation{mock: mmEnsureSeller.mock}
+	}
+	mmEnsureSeller.defaultExpectation.results = &IStockRepoMockEnsureSellerResults{err}
+	mmEnsureSeller.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEnsureSeller.mock
+}
+
+// Set uses given function f to mock the IStockRepo.EnsureSeller method
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Set(f func(ctx context.Context, userID models.UserID) (err error)) *IStockRepoMock {
+	if mmEnsureSeller.defaultExpectation != nil {
+		mmEnsureSeller.mock.t.Fatalf("Default expectation is already set for the IStockRepo.EnsureSeller method")
+	}
+
+	if len(mmEnsureSeller.expectations) > 0 {
+		mmEnsureSeller.mock.t.Fatalf("Some expectations are already set for the IStockRepo.EnsureSeller method")
+	}
+
+	mmEnsureSeller.mock.funcEnsureSeller = f
+	mmEnsureSeller.mock.funcEnsureSellerOrigin = minimock.CallerInfo(1)
+	return mmEnsureSeller.mock
+}
+
+// When sets expectation for the IStockRepo.EnsureSeller which will trigger the result defined by the following
+// Then helper
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) When(ctx context.Context, userID models.UserID) *IStockRepoMockEnsureSellerExpectation {
+	if mmEnsureSeller.mock.funcEnsureSeller != nil {
+		mmEnsureSeller.mock.t.Fatalf("IStockRepoMock.EnsureSeller mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockEnsureSellerExpectation{
+		mock:               mmEnsureSeller.mock,
+		params:             &IStockRepoMockEnsureSellerParams{ctx, userID},
+		expectationOrigins: IStockRepoMockEnsureSellerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEnsureSeller.expectations = append(mmEnsureSeller.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.EnsureSeller return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockEnsureSellerExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockEnsureSellerResults{err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.EnsureSeller should be invoked
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Times(n uint64) *mIStockRepoMockEnsureSeller {
+	if n == 0 {
+		mmEnsureSeller.mock.t.Fatalf("Times of IStockRepoMock.EnsureSeller mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEnsureSeller.expectedInvocations, n)
+	mmEnsureSeller.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEnsureSeller
+}
+
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) invocationsDone() bool {
+	if len(mmEnsureSeller.expectations) == 0 && mmEnsureSeller.defaultExpectation == nil && mmEnsureSeller.mock.funcEnsureSeller == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEnsureSeller.mock.afterEnsureSellerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEnsureSeller.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EnsureSeller implements mm_repository.IStockRepo
+func (mmEnsureSeller *IStockRepoMock) EnsureSeller(ctx context.Context, userID models.UserID) (err error) {
+	mm_atomic.AddUint64(&mmEnsureSeller.beforeEnsureSellerCounter, 1)
+	defer mm_atomic.AddUint64(&mmEnsureSeller.afterEnsureSellerCounter, 1)
+
+	mmEnsureSeller.t.Helper()
+
+	if mmEnsureSeller.inspectFuncEnsureSeller != nil {
+		mmEnsureSeller.inspectFuncEnsureSeller(ctx, userID)
+	}
+
+	mm_params := IStockRepoMockEnsureSellerParams{ctx, userID}
+
+	// Record call args
+	mmEnsureSeller.EnsureSellerMock.mutex.Lock()
+	mmEnsureSeller.EnsureSellerMock.callArgs = append(mmEnsureSeller.EnsureSellerMock.callArgs, &mm_params)
+	mmEnsureSeller.EnsureSellerMock.mutex.Unlock()
+
+	for _, e := range mmEnsureSeller.EnsureSellerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEnsureSeller.EnsureSellerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEnsureSeller.EnsureSellerMock.defaultExpectation.Counter, 1)
+		mm_want := mmEnsureSeller.EnsureSellerMock.defaultExpectation.params
+		mm_want_ptrs := mmEnsureSeller.EnsureSellerMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockEnsureSellerParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEnsureSeller.t.Errorf("IStockRepoMock.EnsureSeller got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEnsureSeller.EnsureSellerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmEnsureSeller.t.Errorf("IStockRepoMock.EnsureSeller got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEnsureSeller.EnsureSellerMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEnsureSeller.t.Errorf("IStockRepoMock.EnsureSeller got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEnsureSeller.EnsureSellerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEnsureSeller.EnsureSellerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEnsureSeller.t.Fatal("No results are set for the IStockRepoMock.EnsureSeller")
+		}
+		return (*mm_results).err
+	}
+	if mmEnsureSeller.funcEnsureSeller != nil {
+		return mmEnsureSeller.funcEnsureSeller(ctx, userID)
+	}
+	mmEnsureSeller.t.Fatalf("Unexpected call to IStockRepoMock.EnsureSeller. %v %v", ctx, userID)
+	return
+}
+
+// EnsureSellerAfterCounter returns a count of finished IStockRepoMock.EnsureSeller invocations
+func (mmEnsureSeller *IStockRepoMock) EnsureSellerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnsureSeller.afterEnsureSellerCounter)
+}
+
+// EnsureSellerBeforeCounter returns a count of IStockRepoMock.EnsureSeller invocations
+func (mmEnsureSeller *IStockRepoMock) EnsureSellerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEnsureSeller.beforeEnsureSellerCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.EnsureSeller.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEnsureSeller *mIStockRepoMockEnsureSeller) Calls() []*IStockRepoMockEnsureSellerParams {
+	mmEnsureSeller.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockEnsureSellerParams, len(mmEnsureSeller.callArgs))
+	copy(argCopy, mmEnsureSeller.callArgs)
+
+	mmEnsureSeller.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEnsureSellerDone returns true if the count of the EnsureSeller invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockEnsureSellerDone() bool {
+	if m.EnsureSellerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EnsureSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EnsureSellerMock.invocationsDone()
+}
+
+// MinimockEnsureSellerInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockEnsureSellerInspect() {
+	for _, e := range m.EnsureSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.EnsureSeller at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEnsureSellerCounter := mm_atomic.LoadUint64(&m.afterEnsureSellerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EnsureSellerMock.defaultExpectation != nil && afterEnsureSellerCounter < 1 {
+		if m.EnsureSellerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.EnsureSeller at\n%s", m.EnsureSellerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.EnsureSeller at\n%s with params: %#v", m.EnsureSellerMock.defaultExpectation.expectationOrigins.origin, *m.EnsureSellerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEnsureSeller != nil && afterEnsureSellerCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.EnsureSeller at\n%s", m.funcEnsureSellerOrigin)
+	}
+
+	if !m.EnsureSellerMock.invocationsDone() && afterEnsureSellerCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.EnsureSeller at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EnsureSellerMock.expectedInvocations), m.EnsureSellerMock.expectedInvocationsOrigin, afterEnsureSellerCounter)
+	}
+}
+
+type mIStockRepoMockExportItems struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockExportItemsExpectation
+	expectations       []*IStockRepoMockExportItemsExpectation
+
+	callArgs []*IStockRepoMockExportItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockExportItemsExpectation specifies expectation struct of the IStockRepo.ExportItems
+type IStockRepoMockExportItemsExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockExportItemsParams
+	paramPtrs          *IStockRepoMockExportItemsParamPtrs
+	expectationOrigins IStockRepoMockExportItemsExpectationOrigins
+	results            *IStockRepoMockExportItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockExportItemsParams contains parameters of the IStockRepo.ExportItems
+type IStockRepoMockExportItemsParams struct {
+	ctx     context.Context
+	afterID models.StockID
+	limit   int64
+}
+
+// IStockRepoMockExportItemsParamPtrs contains pointers to parameters of the IStockRepo.ExportItems
+type IStockRepoMockExportItemsParamPtrs struct {
+	ctx     *context.Context
+	afterID *models.StockID
+	limit   *int64
+}
+
+// IStockRepoMockExportItemsResults contains results of the IStockRepo.ExportItems
+type IStockRepoMockExportItemsResults struct {
+	ia1 []models.Item
+	err error
+}
+
+// IStockRepoMockExportItemsOrigins contains origins of expectations of the IStockRepo.ExportItems
+type IStockRepoMockExportItemsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportItems *mIStockRepoMockExportItems) Optional() *mIStockRepoMockExportItems {
+	mmExportItems.optional = true
+	return mmExportItems
+}
+
+// Expect sets up expected params for IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) Expect(ctx context.Context, afterID models.StockID, limit int64) *mIStockRepoMockExportItems {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	if mmExportItems.defaultExpectation == nil {
+		mmExportItems.defaultExpectation = &IStockRepoMockExportItemsExpectation{}
+	}
+
+	if mmExportItems.defaultExpectation.paramPtrs != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by ExpectParams functions")
+	}
+
+	mmExportItems.defaultExpectation.params = &IStockRepoMockExportItemsParams{ctx, afterID, limit}
+	mmExportItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportItems.expectations {
+		if minimock.Equal(e.params, mmExportItems.defaultExpectation.params) {
+			mmExportItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportItems.defaultExpectation.params)
+		}
+	}
+
+	return mmExportItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockExportItems {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	if mmExportItems.defaultExpectation == nil {
+		mmExportItems.defaultExpectation = &IStockRepoMockExportItemsExpectation{}
+	}
+
+	if mmExportItems.defaultExpectation.params != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Expect")
+	}
+
+	if mmExportItems.defaultExpectation.paramPtrs == nil {
+		mmExportItems.defaultExpectation.paramPtrs = &IStockRepoMockExportItemsParamPtrs{}
+	}
+	mmExportItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportItems
+}
+
+// ExpectAfterIDParam2 sets up expected param afterID for IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) ExpectAfterIDParam2(afterID models.StockID) *mIStockRepoMockExportItems {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	if mmExportItems.defaultExpectation == nil {
+		mmExportItems.defaultExpectation = &IStockRepoMockExportItemsExpectation{}
+	}
+
+	if mmExportItems.defaultExpectation.params != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Expect")
+	}
+
+	if mmExportItems.defaultExpectation.paramPtrs == nil {
+		mmExportItems.defaultExpectation.paramPtrs = &IStockRepoMockExportItemsParamPtrs{}
+	}
+	mmExportItems.defaultExpectation.paramPtrs.afterID = &afterID
+	mmExportItems.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmExportItems
+}
+
+// ExpectLimitParam3 sets up expected param limit for IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) ExpectLimitParam3(limit int64) *mIStockRepoMockExportItems {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	if mmExportItems.defaultExpectation == nil {
+		mmExportItems.defaultExpectation = &IStockRepoMockExportItemsExpectation{}
+	}
+
+	if mmExportItems.defaultExpectation.params != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Expect")
+	}
+
+	if mmExportItems.defaultExpectation.paramPtrs == nil {
+		mmExportItems.defaultExpectation.paramPtrs = &IStockRepoMockExportItemsParamPtrs{}
+	}
+	mmExportItems.defaultExpectation.paramPtrs.limit = &limit
+	mmExportItems.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmExportItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) Inspect(f func(ctx context.Context, afterID models.StockID, limit int64)) *mIStockRepoMockExportItems {
+	if mmExportItems.mock.inspectFuncExportItems != nil {
+		mmExportItems.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.ExportItems")
+	}
+
+	mmExportItems.mock.inspectFuncExportItems = f
+
+	return mmExportItems
+}
+
+// Return sets up results that will be returned by IStockRepo.ExportItems
+func (mmExportItems *mIStockRepoMockExportItems) Return(ia1 []models.Item, err error) *IStockRepoMock {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	if mmExportItems.defaultExpectation == nil {
+		mmExportItems.defaultExpectation = &IStockRepoMockExportItemsExpectation{mock: mmExportItems.mock}
+	}
+	mmExportItems.defaultExpectation.results = &IStockRepoMockExportItemsResults{ia1, err}
+	mmExportItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportItems.mock
+}
+
+// Set uses given function f to mock the IStockRepo.ExportItems method
+func (mmExportItems *mIStockRepoMockExportItems) Set(f func(ctx context.Context, afterID models.StockID, limit int64) (ia1 []models.Item, err error)) *IStockRepoMock {
+	if mmExportItems.defaultExpectation != nil {
+		mmExportItems.mock.t.Fatalf("Default expectation is already set for the IStockRepo.ExportItems method")
+	}
+
+	if len(mmExportItems.expectations) > 0 {
+		mmExportItems.mock.t.Fatalf("Some expectations are already set for the IStockRepo.ExportItems method")
+	}
+
+	mmExportItems.mock.funcExportItems = f
+	mmExportItems.mock.funcExportItemsOrigin = minimock.CallerInfo(1)
+	return mmExportItems.mock
+}
+
+// When sets expectation for the IStockRepo.ExportItems which will trigger the result defined by the following
+// Then helper
+func (mmExportItems *mIStockRepoMockExportItems) When(ctx context.Context, afterID models.StockID, limit int64) *IStockRepoMockExportItemsExpectation {
+	if mmExportItems.mock.funcExportItems != nil {
+		mmExportItems.mock.t.Fatalf("IStockRepoMock.ExportItems mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockExportItemsExpectation{
+		mock:               mmExportItems.mock,
+		params:             &IStockRepoMockExportItemsParams{ctx, afterID, limit},
+		expectationOrigins: IStockRepoMockExportItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportItems.expectations = append(mmExportItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.ExportItems return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockExportItemsExpectation) Then(ia1 []models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockExportItemsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.ExportItems should be invoked
+func (mmExportItems *mIStockRepoMockExportItems) Times(n uint64) *mIStockRepoMockExportItems {
+	if n == 0 {
+		mmExportItems.mock.t.Fatalf("Times of IStockRepoMock.ExportItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportItems.expectedInvocations, n)
+	mmExportItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportItems
+}
+
+func (mmExportItems *mIStockRepoMockExportItems) invocationsDone() bool {
+	if len(mmExportItems.expectations) == 0 && mmExportItems.defaultExpectation == nil && mmExportItems.mock.funcExportItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportItems.mock.afterExportItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportItems implements mm_repository.IStockRepo
+func (mmExportItems *IStockRepoMock) ExportItems(ctx context.Context, afterID models.StockID, limit int64) (ia1 []models.Item, err error) {
+	mm_atomic.AddUint64(&mmExportItems.beforeExportItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportItems.afterExportItemsCounter, 1)
+
+	mmExportItems.t.Helper()
+
+	if mmExportItems.inspectFuncExportItems != nil {
+		mmExportItems.inspectFuncExportItems(ctx, afterID, limit)
+	}
+
+	mm_params := IStockRepoMockExportItemsParams{ctx, afterID, limit}
+
+	// Record call args
+	mmExportItems.ExportItemsMock.mutex.Lock()
+	mmExportItems.ExportItemsMock.callArgs = append(mmExportItems.ExportItemsMock.callArgs, &mm_params)
+	mmExportItems.ExportItemsMock.mutex.Unlock()
+
+	for _, e := range mmExportItems.ExportItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmExportItems.ExportItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportItems.ExportItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportItems.ExportItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmExportItems.ExportItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockExportItemsParams{ctx, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportItems.t.Errorf("IStockRepoMock.ExportItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportItems.ExportItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmExportItems.t.Errorf("IStockRepoMock.ExportItems got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportItems.ExportItemsMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmExportItems.t.Errorf("IStockRepoMock.ExportItems got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportItems.ExportItemsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportItems.t.Errorf("IStockRepoMock.ExportItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportItems.ExportItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportItems.ExportItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportItems.t.Fatal("No results are set for the IStockRepoMock.ExportItems")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmExportItems.funcExportItems != nil {
+		return mmExportItems.funcExportItems(ctx, afterID, limit)
+	}
+	mmExportItems.t.Fatalf("Unexpected call to IStockRepoMock.ExportItems. %v %v %v", ctx, afterID, limit)
+	return
+}
+
+// ExportItemsAfterCounter returns a count of finished IStockRepoMock.ExportItems invocations
+func (mmExportItems *IStockRepoMock) ExportItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportItems.afterExportItemsCounter)
+}
+
+// ExportItemsBeforeCounter returns a count of IStockRepoMock.ExportItems invocations
+func (mmExportItems *IStockRepoMock) ExportItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportItems.beforeExportItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.ExportItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportItems *mIStockRepoMockExportItems) Calls() []*IStockRepoMockExportItemsParams {
+	mmExportItems.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockExportItemsParams, len(mmExportItems.callArgs))
+	copy(argCopy, mmExportItems.callArgs)
+
+	mmExportItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportItemsDone returns true if the count of the ExportItems invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockExportItemsDone() bool {
+	if m.ExportItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportItemsMock.invocationsDone()
+}
+
+// MinimockExportItemsInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockExportItemsInspect() {
+	for _, e := range m.ExportItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.ExportItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportItemsCounter := mm_atomic.LoadUint64(&m.afterExportItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportItemsMock.defaultExpectation != nil && afterExportItemsCounter < 1 {
+		if m.ExportItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.ExportItems at\n%s", m.ExportItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.ExportItems at\n%s with params: %#v", m.ExportItemsMock.defaultExpectation.expectationOrigins.origin, *m.ExportItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportItems != nil && afterExportItemsCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.ExportItems at\n%s", m.funcExportItemsOrigin)
+	}
+
+	if !m.ExportItemsMock.invocationsDone() && afterExportItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.ExportItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportItemsMock.expectedInvocations), m.ExportItemsMock.expectedInvocationsOrigin, afterExportItemsCounter)
+	}
+}
+
+type mIStockRepoMockGetDuePrices struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetDuePricesExpectation
+	expectations       []*IStockRepoMockGetDuePricesExpectation
+
+	callArgs []*IStockRepoMockGetDuePricesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetDuePricesExpectation specifies expectation struct of the IStockRepo.GetDuePrices
+type IStockRepoMockGetDuePricesExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetDuePricesParams
+	paramPtrs          *IStockRepoMockGetDuePricesParamPtrs
+	expectationOrigins IStockRepoMockGetDuePricesExpectationOrigins
+	results            *IStockRepoMockGetDuePricesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetDuePricesParams contains parameters of the IStockRepo.GetDuePrices
+type IStockRepoMockGetDuePricesParams struct {
+	ctx   context.Context
+	now   time.Time
+	limit int64
+}
+
+// IStockRepoMockGetDuePricesParamPtrs contains pointers to parameters of the IStockRepo.GetDuePrices
+type IStockRepoMockGetDuePricesParamPtrs struct {
+	ctx   *context.Context
+	now   *time.Time
+	limit *int64
+}
+
+// IStockRepoMockGetDuePricesResults contains results of the IStockRepo.GetDuePrices
+type IStockRepoMockGetDuePricesResults struct {
+	sa1 []models.ScheduledPrice
+	err error
+}
+
+// IStockRepoMockGetDuePricesOrigins contains origins of expectations of the IStockRepo.GetDuePrices
+type IStockRepoMockGetDuePricesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originNow   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Optional() *mIStockRepoMockGetDuePrices {
+	mmGetDuePrices.optional = true
+	return mmGetDuePrices
+}
+
+// Expect sets up expected params for IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Expect(ctx context.Context, now time.Time, limit int64) *mIStockRepoMockGetDuePrices {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	if mmGetDuePrices.defaultExpectation == nil {
+		mmGetDuePrices.defaultExpectation = &IStockRepoMockGetDuePricesExpectation{}
+	}
+
+	if mmGetDuePrices.defaultExpectation.paramPtrs != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by ExpectParams functions")
+	}
+
+	mmGetDuePrices.defaultExpectation.params = &IStockRepoMockGetDuePricesParams{ctx, now, limit}
+	mmGetDuePrices.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDuePrices.expectations {
+		if minimock.Equal(e.params, mmGetDuePrices.defaultExpectation.params) {
+			mmGetDuePrices.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDuePrices.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDuePrices
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetDuePrices {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	if mmGetDuePrices.defaultExpectation == nil {
+		mmGetDuePrices.defaultExpectation = &IStockRepoMockGetDuePricesExpectation{}
+	}
+
+	if mmGetDuePrices.defaultExpectation.params != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Expect")
+	}
+
+	if mmGetDuePrices.defaultExpectation.paramPtrs == nil {
+		mmGetDuePrices.defaultExpectation.paramPtrs = &IStockRepoMockGetDuePricesParamPtrs{}
+	}
+	mmGetDuePrices.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDuePrices.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDuePrices
+}
+
+// ExpectNowParam2 sets up expected param now for IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) ExpectNowParam2(now time.Time) *mIStockRepoMockGetDuePrices {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	if mmGetDuePrices.defaultExpectation == nil {
+		mmGetDuePrices.defaultExpectation = &IStockRepoMockGetDuePricesExpectation{}
+	}
+
+	if mmGetDuePrices.defaultExpectation.params != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Expect")
+	}
+
+	if mmGetDuePrices.defaultExpectation.paramPtrs == nil {
+		mmGetDuePrices.defaultExpectation.paramPtrs = &IStockRepoMockGetDuePricesParamPtrs{}
+	}
+	mmGetDuePrices.defaultExpectation.paramPtrs.now = &now
+	mmGetDuePrices.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmGetDuePrices
+}
+
+// ExpectLimitParam3 sets up expected param limit for IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) ExpectLimitParam3(limit int64) *mIStockRepoMockGetDuePrices {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	if mmGetDuePrices.defaultExpectation == nil {
+		mmGetDuePrices.defaultExpectation = &IStockRepoMockGetDuePricesExpectation{}
+	}
+
+	if mmGetDuePrices.defaultExpectation.params != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Expect")
+	}
+
+	if mmGetDuePrices.defaultExpectation.paramPtrs == nil {
+		mmGetDuePrices.defaultExpectation.paramPtrs = &IStockRepoMockGetDuePricesParamPtrs{}
+	}
+	mmGetDuePrices.defaultExpectation.paramPtrs.limit = &limit
+	mmGetDuePrices.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetDuePrices
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Inspect(f func(ctx context.Context, now time.Time, limit int64)) *mIStockRepoMockGetDuePrices {
+	if mmGetDuePrices.mock.inspectFuncGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetDuePrices")
+	}
+
+	mmGetDuePrices.mock.inspectFuncGetDuePrices = f
+
+	return mmGetDuePrices
+}
+
+// Return sets up results that will be returned by IStockRepo.GetDuePrices
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Return(sa1 []models.ScheduledPrice, err error) *IStockRepoMock {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	if mmGetDuePrices.defaultExpectation == nil {
+		mmGetDuePrices.defaultExpectation = &IStockRepoMockGetDuePricesExpectation{mock: mmGetDuePrices.mock}
+	}
+	mmGetDuePrices.defaultExpectation.results = &IStockRepoMockGetDuePricesResults{sa1, err}
+	mmGetDuePrices.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDuePrices.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetDuePrices method
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Set(f func(ctx context.Context, now time.Time, limit int64) (sa1 []models.ScheduledPrice, err error)) *IStockRepoMock {
+	if mmGetDuePrices.defaultExpectation != nil {
+		mmGetDuePrices.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetDuePrices method")
+	}
+
+	if len(mmGetDuePrices.expectations) > 0 {
+		mmGetDuePrices.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetDuePrices method")
+	}
+
+	mmGetDuePrices.mock.funcGetDuePrices = f
+	mmGetDuePrices.mock.funcGetDuePricesOrigin = minimock.CallerInfo(1)
+	return mmGetDuePrices.mock
+}
+
+// When sets expectation for the IStockRepo.GetDuePrices which will trigger the result defined by the following
+// Then helper
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) When(ctx context.Context, now time.Time, limit int64) *IStockRepoMockGetDuePricesExpectation {
+	if mmGetDuePrices.mock.funcGetDuePrices != nil {
+		mmGetDuePrices.mock.t.Fatalf("IStockRepoMock.GetDuePrices mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetDuePricesExpectation{
+		mock:               mmGetDuePrices.mock,
+		params:             &IStockRepoMockGetDuePricesParams{ctx, now, limit},
+		expectationOrigins: IStockRepoMockGetDuePricesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDuePrices.expectations = append(mmGetDuePrices.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetDuePrices return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetDuePricesExpectation) Then(sa1 []models.ScheduledPrice, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetDuePricesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetDuePrices should be invoked
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Times(n uint64) *mIStockRepoMockGetDuePrices {
+	if n == 0 {
+		mmGetDuePrices.mock.t.Fatalf("Times of IStockRepoMock.GetDuePrices mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDuePrices.expectedInvocations, n)
+	mmGetDuePrices.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDuePrices
+}
+
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) invocationsDone() bool {
+	if len(mmGetDuePrices.expectations) == 0 && mmGetDuePrices.defaultExpectation == nil && mmGetDuePrices.mock.funcGetDuePrices == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDuePrices.mock.afterGetDuePricesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDuePrices.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDuePrices implements mm_repository.IStockRepo
+func (mmGetDuePrices *IStockRepoMock) GetDuePrices(ctx context.Context, now time.Time, limit int64) (sa1 []models.ScheduledPrice, err error) {
+	mm_atomic.AddUint64(&mmGetDuePrices.beforeGetDuePricesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDuePrices.afterGetDuePricesCounter, 1)
+
+	mmGetDuePrices.t.Helper()
+
+	if mmGetDuePrices.inspectFuncGetDuePrices != nil {
+		mmGetDuePrices.inspectFuncGetDuePrices(ctx, now, limit)
+	}
+
+	mm_params := IStockRepoMockGetDuePricesParams{ctx, now, limit}
+
+	// Record call args
+	mmGetDuePrices.GetDuePricesMock.mutex.Lock()
+	mmGetDuePrices.GetDuePricesMock.callArgs = append(mmGetDuePrices.GetDuePricesMock.callArgs, &mm_params)
+	mmGetDuePrices.GetDuePricesMock.mutex.Unlock()
+
+	for _, e := range mmGetDuePrices.GetDuePricesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetDuePrices.GetDuePricesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDuePrices.GetDuePricesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDuePrices.GetDuePricesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDuePrices.GetDuePricesMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetDuePricesParams{ctx, now, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDuePrices.t.Errorf("IStockRepoMock.GetDuePrices got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDuePrices.GetDuePricesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmGetDuePrices.t.Errorf("IStockRepoMock.GetDuePrices got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDuePrices.GetDuePricesMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetDuePrices.t.Errorf("IStockRepoMock.GetDuePrices got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDuePrices.GetDuePricesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDuePrices.t.Errorf("IStockRepoMock.GetDuePrices got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDuePrices.GetDuePricesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDuePrices.GetDuePricesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDuePrices.t.Fatal("No results are set for the IStockRepoMock.GetDuePrices")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetDuePrices.funcGetDuePrices != nil {
+		return mmGetDuePrices.funcGetDuePrices(ctx, now, limit)
+	}
+	mmGetDuePrices.t.Fatalf("Unexpected call to IStockRepoMock.GetDuePrices. %v %v %v", ctx, now, limit)
+	return
+}
+
+// GetDuePricesAfterCounter returns a count of finished IStockRepoMock.GetDuePrices invocations
+func (mmGetDuePrices *IStockRepoMock) GetDuePricesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDuePrices.afterGetDuePricesCounter)
+}
+
+// GetDuePricesBeforeCounter returns a count of IStockRepoMock.GetDuePrices invocations
+func (mmGetDuePrices *IStockRepoMock) GetDuePricesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDuePrices.beforeGetDuePricesCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetDuePrices.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDuePrices *mIStockRepoMockGetDuePrices) Calls() []*IStockRepoMockGetDuePricesParams {
+	mmGetDuePrices.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetDuePricesParams, len(mmGetDuePrices.callArgs))
+	copy(argCopy, mmGetDuePrices.callArgs)
+
+	mmGetDuePrices.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDuePricesDone returns true if the count of the GetDuePrices invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetDuePricesDone() bool {
+	if m.GetDuePricesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDuePricesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDuePricesMock.invocationsDone()
+}
+
+// MinimockGetDuePricesInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetDuePricesInspect() {
+	for _, e := range m.GetDuePricesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetDuePrices at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDuePricesCounter := mm_atomic.LoadUint64(&m.afterGetDuePricesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDuePricesMock.defaultExpectation != nil && afterGetDuePricesCounter < 1 {
+		if m.GetDuePricesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetDuePrices at\n%s", m.GetDuePricesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetDuePrices at\n%s with params: %#v", m.GetDuePricesMock.defaultExpectation.expectationOrigins.origin, *m.GetDuePricesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDuePrices != nil && afterGetDuePricesCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetDuePrices at\n%s", m.funcGetDuePricesOrigin)
+	}
+
+	if !m.GetDuePricesMock.invocationsDone() && afterGetDuePricesCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetDuePrices at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDuePricesMock.expectedInvocations), m.GetDuePricesMock.expectedInvocationsOrigin, afterGetDuePricesCounter)
+	}
+}
+
+type mIStockRepoMockGetItemBySKU struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetItemBySKUExpectation
+	expectations       []*IStockRepoMockGetItemBySKUExpectation
+
+	callArgs []*IStockRepoMockGetItemBySKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetItemBySKUExpectation specifies expectation struct of the IStockRepo.GetItemBySKU
+type IStockRepoMockGetItemBySKUExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetItemBySKUParams
+	paramPtrs          *IStockRepoMockGetItemBySKUParamPtrs
+	expectationOrigins IStockRepoMockGetItemBySKUExpectationOrigins
+	results            *IStockRepoMockGetItemBySKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetItemBySKUParams contains parameters of the IStockRepo.GetItemBySKU
+type IStockRepoMockGetItemBySKUParams struct {
+	ctx   context.Context
+	skuID models.SKUID
+}
+
+// IStockRepoMockGetItemBySKUParamPtrs contains pointers to parameters of the IStockRepo.GetItemBySKU
+type IStockRepoMockGetItemBySKUParamPtrs struct {
+	ctx   *context.Context
+	skuID *models.SKUID
+}
+
+// IStockRepoMockGetItemBySKUResults contains results of the IStockRepo.GetItemBySKU
+type IStockRepoMockGetItemBySKUResults struct {
+	i1  models.Item
+	err error
+}
+
+// IStockRepoMockGetItemBySKUOrigins contains origins of expectations of the IStockRepo.GetItemBySKU
+type IStockRepoMockGetItemBySKUExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Optional() *mIStockRepoMockGetItemBySKU {
+	mmGetItemBySKU.optional = true
+	return mmGetItemBySKU
+}
+
+// Expect sets up expected params for IStockRepo.GetItemBySKU
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Expect(ctx context.Context, skuID models.SKUID) *mIStockRepoMockGetItemBySKU {
+	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+	}
+
+	if mmGetItemBySKU.defaultExpectation == nil {
+		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	}
+
+	if mmGetItemBySKU.defaultExpectation.paramPtrs != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemBySKU.defaultExpectation.params = &IStockRepoMockGetItemBySKUParams{ctx, skuID}
+	mmGetItemBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemBySKU.expectations {
+		if minimock.Equal(e.params, mmGetItemBySKU.defaultExpectation.params) {
+			mmGetItemBySKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemBySKU.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemBySKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetItemBySKU
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetItemBySKU {
+	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+	}
+
+	if mmGetItemBySKU.defaultExpectation == nil {
+		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	}
+
+	if mmGetItemBySKU.defaultExpectation.params != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Expect")
+	}
+
+	if mmGetItemBySKU.defaultExpectation.paramPtrs == nil {
+		mmGetItemBySKU.defaultExpectation.paramPtrs = &IStockRepoMockGetItemBySKUParamPtrs{}
+	}
+	mmGetItemBySKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemBySKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemBySKU
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetItemBySKU
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetItemBySKU {
+	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+	}
+
+	if mmGetItemBySKU.defaultExpectation == nil {
+		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	}
+
+	if mmGetItemBySKU.defaultExpectation.params != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Expect")
+	}
+
+	if mmGetItemBySKU.defaultExpectation.paramPtrs == nil {
+		mmGetItemBySKU.defaultExpectation.paramPtrs = &IStockRepoMockGetItemBySKUParamPtrs{}
+	}
+	mmGetItemBySKU.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetItemBySKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetItemBySKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetItemBySKU
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mIStockRepoMockGetItemBySKU {
+	if mmGetItemBySKU.mock.inspectFuncGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetItemBySKU")
+	}
+
+	mmGetItemBySKU.mock.inspectFuncGetItemBySKU = f
+
+	return mmGetItemBySKU
+}
+
+// Return sets up results that will be returned by IStockRepo.GetItemBySKU
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Return(i1 models.Item, err error) *IStockRepoMock {
+	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+	}
+
+	if mmGetItemBySKU.defaultExpectation == nil {
+		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{mock: mmGetItemBySKU.mock}
+	}
+	mmGetItemBySKU.defaultExpectation.results = &IStockRepoMockGetItemBySKUResults{i1, err}
+	mmGetItemBySKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemBySKU.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetItemBySKU method
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Set(f func(ctx context.Context, skuID models.SKUID) (i1 models.Item, err error)) *IStockRepoMock {
+	if mmGetItemBySKU.defaultExpectation != nil {
+		mmGetItemBySKU.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetItemBySKU method")
+	}
+
+	if len(mmGetItemBySKU.expectations) > 0 {
+		mmGetItemBySKU.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetItemBySKU method")
+	}
+
+	mmGetItemBySKU.mock.funcGetItemBySKU = f
+	mmGetItemBySKU.mock.funcGetItemBySKUOrigin = minimock.CallerInfo(1)
+	return mmGetItemBySKU.mock
+}
+
+// When sets expectation for the IStockRepo.GetItemBySKU which will trigger the result defined by the following
+// Then helper
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) When(ctx context.Context, skuID models.SKUID) *IStockRepoMockGetItemBySKUExpectation {
+	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
+		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetItemBySKUExpectation{
+		mock:               mmGetItemBySKU.mock,
+		params:             &IStockRepoMockGetItemBySKUParams{ctx, skuID},
+		expectationOrigins: IStockRepoMockGetItemBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemBySKU.expectations = append(mmGetItemBySKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetItemBySKU return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetItemBySKUExpectation) Then(i1 models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetItemBySKUResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetItemBySKU should be invoked
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Times(n uint64) *mIStockRepoMockGetItemBySKU {
+	if n == 0 {
+		mmGetItemBySKU.mock.t.Fatalf("Times of IStockRepoMock.GetItemBySKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemBySKU.expectedInvocations, n)
+	mmGetItemBySKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemBySKU
+}
+
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) invocationsDone() bool {
+	if len(mmGetItemBySKU.expectations) == 0 && mmGetItemBySKU.defaultExpectation == nil && mmGetItemBySKU.mock.funcGetItemBySKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemBySKU.mock.afterGetItemBySKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemBySKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemBySKU implements mm_repository.IStockRepo
+func (mmGetItemBySKU *IStockRepoMock) GetItemBySKU(ctx context.Context, skuID models.SKUID) (i1 models.Item, err error) {
+	mm_atomic.AddUint64(&mmGetItemBySKU.beforeGetItemBySKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemBySKU.afterGetItemBySKUCounter, 1)
+
+	mmGetItemBySKU.t.Helper()
+
+	if mmGetItemBySKU.inspectFuncGetItemBySKU != nil {
+		mmGetItemBySKU.inspectFuncGetItemBySKU(ctx, skuID)
+	}
+
+	mm_params := IStockRepoMockGetItemBySKUParams{ctx, skuID}
+
+	// Record call args
+	mmGetItemBySKU.GetItemBySKUMock.mutex.Lock()
+	mmGetItemBySKU.GetItemBySKUMock.callArgs = append(mmGetItemBySKU.GetItemBySKUMock.callArgs, &mm_params)
+	mmGetItemBySKU.GetItemBySKUMock.mutex.Unlock()
+
+	for _, e := range mmGetItemBySKU.GetItemBySKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetItemBySKU.GetItemBySKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetItemBySKUParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemBySKU.t.Fatal("No results are set for the IStockRepoMock.GetItemBySKU")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetItemBySKU.funcGetItemBySKU != nil {
+		return mmGetItemBySKU.funcGetItemBySKU(ctx, skuID)
+	}
+	mmGetItemBySKU.t.Fatalf("Unexpected call to IStockRepoMock.GetItemBySKU. %v %v", ctx, skuID)
+	return
+}
+
+// GetItemBySKUAfterCounter returns a count of finished IStockRepoMock.GetItemBySKU invocations
+func (mmGetItemBySKU *IStockRepoMock) GetItemBySKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemBySKU.afterGetItemBySKUCounter)
+}
+
+// GetItemBySKUBeforeCounter returns a count of IStockRepoMock.GetItemBySKU invocations
+func (mmGetItemBySKU *IStockRepoMock) GetItemBySKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemBySKU.beforeGetItemBySKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetItemBySKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Calls() []*IStockRepoMockGetItemBySKUParams {
+	mmGetItemBySKU.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetItemBySKUParams, len(mmGetItemBySKU.callArgs))
+	copy(argCopy, mmGetItemBySKU.callArgs)
+
+	mmGetItemBySKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemBySKUDone returns true if the count of the GetItemBySKU invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetItemBySKUDone() bool {
+	if m.GetItemBySKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemBySKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemBySKUMock.invocationsDone()
+}
+
+// MinimockGetItemBySKUInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetItemBySKUInspect() {
+	for _, e := range m.GetItemBySKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemBySKUCounter := mm_atomic.LoadUint64(&m.afterGetItemBySKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemBySKUMock.defaultExpectation != nil && afterGetItemBySKUCounter < 1 {
+		if m.GetItemBySKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s", m.GetItemBySKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s with params: %#v", m.GetItemBySKUMock.defaultExpectation.expectationOrigins.origin, *m.GetItemBySKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemBySKU != nil && afterGetItemBySKUCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s", m.funcGetItemBySKUOrigin)
+	}
+
+	if !m.GetItemBySKUMock.invocationsDone() && afterGetItemBySKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetItemBySKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemBySKUMock.expectedInvocations), m.GetItemBySKUMock.expectedInvocationsOrigin, afterGetItemBySKUCounter)
+	}
+}
+
+type mIStockRepoMockGetItemsByLocation struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetItemsByLocationExpectation
+	expectations       []*IStockRepoMockGetItemsByLocationExpectation
+
+	callArgs []*IStockRepoMockGetItemsByLocationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetItemsByLocationExpectation specifies expectation struct of the IStockRepo.GetItemsByLocation
+type IStockRepoMockGetItemsByLocationExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetItemsByLocationParams
+	paramPtrs          *IStockRepoMockGetItemsByLocationParamPtrs
+	expectationOrigins IStockRepoMockGetItemsByLocationExpectationOrigins
+	results            *IStockRepoMockGetItemsByLocationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetItemsByLocationParams contains parameters of the IStockRepo.GetItemsByLocation
+type IStockRepoMockGetItemsByLocationParams struct {
+	ctx   context.Context
+	param mm_repository.GetStockByLocation
+}
+
+// IStockRepoMockGetItemsByLocationParamPtrs contains pointers to parameters of the IStockRepo.GetItemsByLocation
+type IStockRepoMockGetItemsByLocationParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.GetStockByLocation
+}
+
+// IStockRepoMockGetItemsByLocationResults contains results of the IStockRepo.GetItemsByLocation
+type IStockRepoMockGetItemsByLocationResults struct {
+	ia1 []models.Item
+	err error
+}
+
+// IStockRepoMockGetItemsByLocationOrigins contains origins of expectations of the IStockRepo.GetItemsByLocation
+type IStockRepoMockGetItemsByLocationExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Optional() *mIStockRepoMockGetItemsByLocation {
+	mmGetItemsByLocation.optional = true
+	return mmGetItemsByLocation
+}
+
+// Expect sets up expected params for IStockRepo.GetItemsByLocation
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Expect(ctx context.Context, param mm_repository.GetStockByLocation) *mIStockRepoMockGetItemsByLocation {
+	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation == nil {
+		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	}
+
+	if mmGetItemsByLocation.defaultExpectation.paramPtrs != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemsByLocation.defaultExpectation.params = &IStockRepoMockGetItemsByLocationParams{ctx, param}
+	mmGetItemsByLocation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemsByLocation.expectations {
+		if minimock.Equal(e.params, mmGetItemsByLocation.defaultExpectation.params) {
+			mmGetItemsByLocation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsByLocation.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemsByLocation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetItemsByLocation
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetItemsByLocation {
+	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation == nil {
+		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	}
+
+	if mmGetItemsByLocation.defaultExpectation.params != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Expect")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmGetItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsByLocationParamPtrs{}
+	}
+	mmGetItemsByLocation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemsByLocation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemsByLocation
+}
+
+// ExpectParamParam2 sets up expected param param for IStockRepo.GetItemsByLocation
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) ExpectParamParam2(param mm_repository.GetStockByLocation) *mIStockRepoMockGetItemsByLocation {
+	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation == nil {
+		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	}
+
+	if mmGetItemsByLocation.defaultExpectation.params != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Expect")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmGetItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsByLocationParamPtrs{}
+	}
+	mmGetItemsByLocation.defaultExpectation.paramPtrs.param = &param
+	mmGetItemsByLocation.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmGetItemsByLocation
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetItemsByLocation
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Inspect(f func(ctx context.Context, param mm_repository.GetStockByLocation)) *mIStockRepoMockGetItemsByLocation {
+	if mmGetItemsByLocation.mock.inspectFuncGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetItemsByLocation")
+	}
+
+	mmGetItemsByLocation.mock.inspectFuncGetItemsByLocation = f
+
+	return mmGetItemsByLocation
+}
+
+// Return sets up results that will be returned by IStockRepo.GetItemsByLocation
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Return(ia1 []models.Item, err error) *IStockRepoMock {
+	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+	}
+
+	if mmGetItemsByLocation.defaultExpectation == nil {
+		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{mock: mmGetItemsByLocation.mock}
+	}
+	mmGetItemsByLocation.defaultExpectation.results = &IStockRepoMockGetItemsByLocationResults{ia1, err}
+	mmGetItemsByLocation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByLocation.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetItemsByLocation method
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Set(f func(ctx context.Context, param mm_repository.GetStockByLocation) (ia1 []models.Item, err error)) *IStockRepoMock {
+	if mmGetItemsByLocation.defaultExpectation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetItemsByLocation method")
+	}
+
+	if len(mmGetItemsByLocation.expectations) > 0 {
+		mmGetItemsByLocation.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetItemsByLocation method")
+	}
+
+	mmGetItemsByLocation.mock.funcGetItemsByLocation = f
+	mmGetItemsByLocation.mock.funcGetItemsByLocationOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByLocation.mock
+}
+
+// When sets expectation for the IStockRepo.GetItemsByLocation which will trigger the result defined by the following
+// Then helper
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) When(ctx context.Context, param mm_repository.GetStockByLocation) *IStockRepoMockGetItemsByLocationExpectation {
+	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
+		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetItemsByLocationExpectation{
+		mock:               mmGetItemsByLocation.mock,
+		params:             &IStockRepoMockGetItemsByLocationParams{ctx, param},
+		expectationOrigins: IStockRepoMockGetItemsByLocationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemsByLocation.expectations = append(mmGetItemsByLocation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetItemsByLocation return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetItemsByLocationExpectation) Then(ia1 []models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetItemsByLocationResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetItemsByLocation should be invoked
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Times(n uint64) *mIStockRepoMockGetItemsByLocation {
+	if n == 0 {
+		mmGetItemsByLocation.mock.t.Fatalf("Times of IStockRepoMock.GetItemsByLocation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemsByLocation.expectedInvocations, n)
+	mmGetItemsByLocation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByLocation
+}
+
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) invocationsDone() bool {
+	if len(mmGetItemsByLocation.expectations) == 0 && mmGetItemsByLocation.defaultExpectation == nil && mmGetItemsByLocation.mock.funcGetItemsByLocation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsByLocation.mock.afterGetItemsByLocationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsByLocation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemsByLocation implements mm_repository.IStockRepo
+func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocation(ctx context.Context, param mm_repository.GetStockByLocation) (ia1 []models.Item, err error) {
+	mm_atomic.AddUint64(&mmGetItemsByLocation.beforeGetItemsByLocationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemsByLocation.afterGetItemsByLocationCounter, 1)
+
+	mmGetItemsByLocation.t.Helper()
+
+	if mmGetItemsByLocation.inspectFuncGetItemsByLocation != nil {
+		mmGetItemsByLocation.inspectFuncGetItemsByLocation(ctx, param)
+	}
+
+	mm_params := IStockRepoMockGetItemsByLocationParams{ctx, param}
+
+	// Record call args
+	mmGetItemsByLocation.GetItemsByLocationMock.mutex.Lock()
+	mmGetItemsByLocation.GetItemsByLocationMock.callArgs = append(mmGetItemsByLocation.GetItemsByLocationMock.callArgs, &mm_params)
+	mmGetItemsByLocation.GetItemsByLocationMock.mutex.Unlock()
+
+	for _, e := range mmGetItemsByLocation.GetItemsByLocationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetItemsByLocationParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemsByLocation.t.Fatal("No results are set for the IStockRepoMock.GetItemsByLocation")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetItemsByLocation.funcGetItemsByLocation != nil {
+		return mmGetItemsByLocation.funcGetItemsByLocation(ctx, param)
+	}
+	mmGetItemsByLocation.t.Fatalf("Unexpected call to IStockRepoMock.GetItemsByLocation. %v %v", ctx, param)
+	return
+}
+
+// GetItemsByLocationAfterCounter returns a count of finished IStockRepoMock.GetItemsByLocation invocations
+func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsByLocation.afterGetItemsByLocationCounter)
+}
+
+// GetItemsByLocationBeforeCounter returns a count of IStockRepoMock.GetItemsByLocation invocations
+func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsByLocation.beforeGetItemsByLocationCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetItemsByLocation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Calls() []*IStockRepoMockGetItemsByLocationParams {
+	mmGetItemsByLocation.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetItemsByLocationParams, len(mmGetItemsByLocation.callArgs))
+	copy(argCopy, mmGetItemsByLocation.callArgs)
+
+	mmGetItemsByLocation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemsByLocationDone returns true if the count of the GetItemsByLocation invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetItemsByLocationDone() bool {
+	if m.GetItemsByLocationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemsByLocationMock.invocationsDone()
+}
+
+// MinimockGetItemsByLocationInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetItemsByLocationInspect() {
+	for _, e := range m.GetItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemsByLocationCounter := mm_atomic.LoadUint64(&m.afterGetItemsByLocationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemsByLocationMock.defaultExpectation != nil && afterGetItemsByLocationCounter < 1 {
+		if m.GetItemsByLocationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s", m.GetItemsByLocationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s with params: %#v", m.GetItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsByLocationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemsByLocation != nil && afterGetItemsByLocationCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s", m.funcGetItemsByLocationOrigin)
+	}
+
+	if !m.GetItemsByLocationMock.invocationsDone() && afterGetItemsByLocationCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetItemsByLocation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemsByLocationMock.expectedInvocations), m.GetItemsByLocationMock.expectedInvocationsOrigin, afterGetItemsByLocationCounter)
+	}
+}
+
+type mIStockRepoMockGetItemsBySKUs struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetItemsBySKUsExpectation
+	expectations       []*IStockRepoMockGetItemsBySKUsExpectation
+
+	callArgs []*IStockRepoMockGetItemsBySKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetItemsBySKUsExpectation specifies expectation struct of the IStockRepo.GetItemsBySKUs
+type IStockRepoMockGetItemsBySKUsExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetItemsBySKUsParams
+	paramPtrs          *IStockRepoMockGetItemsBySKUsParamPtrs
+	expectationOrigins IStockRepoMockGetItemsBySKUsExpectationOrigins
+	results            *IStockRepoMockGetItemsBySKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetItemsBySKUsParams contains parameters of the IStockRepo.GetItemsBySKUs
+type IStockRepoMockGetItemsBySKUsParams struct {
+	ctx    context.Context
+	skuIDs []models.SKUID
+}
+
+// IStockRepoMockGetItemsBySKUsParamPtrs contains pointers to parameters of the IStockRepo.GetItemsBySKUs
+type IStockRepoMockGetItemsBySKUsParamPtrs struct {
+	ctx    *context.Context
+	skuIDs *[]models.SKUID
+}
+
+// IStockRepoMockGetItemsBySKUsResults contains results of the IStockRepo.GetItemsBySKUs
+type IStockRepoMockGetItemsBySKUsResults struct {
+	ia1 []models.Item
+	err error
+}
+
+// IStockRepoMockGetItemsBySKUsOrigins contains origins of expectations of the IStockRepo.GetItemsBySKUs
+type IStockRepoMockGetItemsBySKUsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Optional() *mIStockRepoMockGetItemsBySKUs {
+	mmGetItemsBySKUs.optional = true
+	return mmGetItemsBySKUs
+}
+
+// Expect sets up expected params for IStockRepo.GetItemsBySKUs
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Expect(ctx context.Context, skuIDs []models.SKUID) *mIStockRepoMockGetItemsBySKUs {
+	if mmGetItemsBySKUs.mock.funcGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation == nil {
+		mmGetItemsBySKUs.defaultExpectation = &IStockRepoMockGetItemsBySKUsExpectation{}
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation.paramPtrs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemsBySKUs.defaultExpectation.params = &IStockRepoMockGetItemsBySKUsParams{ctx, skuIDs}
+	mmGetItemsBySKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemsBySKUs.expectations {
+		if minimock.Equal(e.params, mmGetItemsBySKUs.defaultExpectation.params) {
+			mmGetItemsBySKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsBySKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemsBySKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetItemsBySKUs
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetItemsBySKUs {
+	if mmGetItemsBySKUs.mock.funcGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation == nil {
+		mmGetItemsBySKUs.defaultExpectation = &IStockRepoMockGetItemsBySKUsExpectation{}
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation.params != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Expect")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetItemsBySKUs.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsBySKUsParamPtrs{}
+	}
+	mmGetItemsBySKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemsBySKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemsBySKUs
+}
+
+// ExpectSkuIDsParam2 sets up expected param skuIDs for IStockRepo.GetItemsBySKUs
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) ExpectSkuIDsParam2(skuIDs []models.SKUID) *mIStockRepoMockGetItemsBySKUs {
+	if mmGetItemsBySKUs.mock.funcGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation == nil {
+		mmGetItemsBySKUs.defaultExpectation = &IStockRepoMockGetItemsBySKUsExpectation{}
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation.params != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Expect")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetItemsBySKUs.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsBySKUsParamPtrs{}
+	}
+	mmGetItemsBySKUs.defaultExpectation.paramPtrs.skuIDs = &skuIDs
+	mmGetItemsBySKUs.defaultExpectation.expectationOrigins.originSkuIDs = minimock.CallerInfo(1)
+
+	return mmGetItemsBySKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetItemsBySKUs
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Inspect(f func(ctx context.Context, skuIDs []models.SKUID)) *mIStockRepoMockGetItemsBySKUs {
+	if mmGetItemsBySKUs.mock.inspectFuncGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetItemsBySKUs")
+	}
+
+	mmGetItemsBySKUs.mock.inspectFuncGetItemsBySKUs = f
+
+	return mmGetItemsBySKUs
+}
+
+// Return sets up results that will be returned by IStockRepo.GetItemsBySKUs
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Return(ia1 []models.Item, err error) *IStockRepoMock {
+	if mmGetItemsBySKUs.mock.funcGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetItemsBySKUs.defaultExpectation == nil {
+		mmGetItemsBySKUs.defaultExpectation = &IStockRepoMockGetItemsBySKUsExpectation{mock: mmGetItemsBySKUs.mock}
+	}
+	mmGetItemsBySKUs.defaultExpectation.results = &IStockRepoMockGetItemsBySKUsResults{ia1, err}
+	mmGetItemsBySKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemsBySKUs.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetItemsBySKUs method
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Set(f func(ctx context.Context, skuIDs []models.SKUID) (ia1 []models.Item, err error)) *IStockRepoMock {
+	if mmGetItemsBySKUs.defaultExpectation != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetItemsBySKUs method")
+	}
+
+	if len(mmGetItemsBySKUs.expectations) > 0 {
+		mmGetItemsBySKUs.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetItemsBySKUs method")
+	}
+
+	mmGetItemsBySKUs.mock.funcGetItemsBySKUs = f
+	mmGetItemsBySKUs.mock.funcGetItemsBySKUsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsBySKUs.mock
+}
+
+// When sets expectation for the IStockRepo.GetItemsBySKUs which will trigger the result defined by the following
+// Then helper
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) When(ctx context.Context, skuIDs []models.SKUID) *IStockRepoMockGetItemsBySKUsExpectation {
+	if mmGetItemsBySKUs.mock.funcGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.mock.t.Fatalf("IStockRepoMock.GetItemsBySKUs mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetItemsBySKUsExpectation{
+		mock:               mmGetItemsBySKUs.mock,
+		params:             &IStockRepoMockGetItemsBySKUsParams{ctx, skuIDs},
+		expectationOrigins: IStockRepoMockGetItemsBySKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemsBySKUs.expectations = append(mmGetItemsBySKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetItemsBySKUs return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetItemsBySKUsExpectation) Then(ia1 []models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetItemsBySKUsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetItemsBySKUs should be invoked
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Times(n uint64) *mIStockRepoMockGetItemsBySKUs {
+	if n == 0 {
+		mmGetItemsBySKUs.mock.t.Fatalf("Times of IStockRepoMock.GetItemsBySKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemsBySKUs.expectedInvocations, n)
+	mmGetItemsBySKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsBySKUs
+}
+
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) invocationsDone() bool {
+	if len(mmGetItemsBySKUs.expectations) == 0 && mmGetItemsBySKUs.defaultExpectation == nil && mmGetItemsBySKUs.mock.funcGetItemsBySKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsBySKUs.mock.afterGetItemsBySKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsBySKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemsBySKUs implements mm_repository.IStockRepo
+func (mmGetItemsBySKUs *IStockRepoMock) GetItemsBySKUs(ctx context.Context, skuIDs []models.SKUID) (ia1 []models.Item, err error) {
+	mm_atomic.AddUint64(&mmGetItemsBySKUs.beforeGetItemsBySKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemsBySKUs.afterGetItemsBySKUsCounter, 1)
+
+	mmGetItemsBySKUs.t.Helper()
+
+	if mmGetItemsBySKUs.inspectFuncGetItemsBySKUs != nil {
+		mmGetItemsBySKUs.inspectFuncGetItemsBySKUs(ctx, skuIDs)
+	}
+
+	mm_params := IStockRepoMockGetItemsBySKUsParams{ctx, skuIDs}
+
+	// Record call args
+	mmGetItemsBySKUs.GetItemsBySKUsMock.mutex.Lock()
+	mmGetItemsBySKUs.GetItemsBySKUsMock.callArgs = append(mmGetItemsBySKUs.GetItemsBySKUsMock.callArgs, &mm_params)
+	mmGetItemsBySKUs.GetItemsBySKUsMock.mutex.Unlock()
+
+	for _, e := range mmGetItemsBySKUs.GetItemsBySKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetItemsBySKUsParams{ctx, skuIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemsBySKUs.t.Errorf("IStockRepoMock.GetItemsBySKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuIDs != nil && !minimock.Equal(*mm_want_ptrs.skuIDs, mm_got.skuIDs) {
+				mmGetItemsBySKUs.t.Errorf("IStockRepoMock.GetItemsBySKUs got unexpected parameter skuIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.expectationOrigins.originSkuIDs, *mm_want_ptrs.skuIDs, mm_got.skuIDs, minimock.Diff(*mm_want_ptrs.skuIDs, mm_got.skuIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemsBySKUs.t.Errorf("IStockRepoMock.GetItemsBySKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemsBySKUs.GetItemsBySKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemsBySKUs.t.Fatal("No results are set for the IStockRepoMock.GetItemsBySKUs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetItemsBySKUs.funcGetItemsBySKUs != nil {
+		return mmGetItemsBySKUs.funcGetItemsBySKUs(ctx, skuIDs)
+	}
+	mmGetItemsBySKUs.t.Fatalf("Unexpected call to IStockRepoMock.GetItemsBySKUs. %v %v", ctx, skuIDs)
+	return
+}
+
+// GetItemsBySKUsAfterCounter returns a count of finished IStockRepoMock.GetItemsBySKUs invocations
+func (mmGetItemsBySKUs *IStockRepoMock) GetItemsBySKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsBySKUs.afterGetItemsBySKUsCounter)
+}
+
+// GetItemsBySKUsBeforeCounter returns a count of IStockRepoMock.GetItemsBySKUs invocations
+func (mmGetItemsBySKUs *IStockRepoMock) GetItemsBySKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsBySKUs.beforeGetItemsBySKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetItemsBySKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemsBySKUs *mIStockRepoMockGetItemsBySKUs) Calls() []*IStockRepoMockGetItemsBySKUsParams {
+	mmGetItemsBySKUs.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetItemsBySKUsParams, len(mmGetItemsBySKUs.callArgs))
+	copy(argCopy, mmGetItemsBySKUs.callArgs)
+
+	mmGetItemsBySKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemsBySKUsDone returns true if the count of the GetItemsBySKUs invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetItemsBySKUsDone() bool {
+	if m.GetItemsBySKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemsBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemsBySKUsMock.invocationsDone()
+}
+
+// MinimockGetItemsBySKUsInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetItemsBySKUsInspect() {
+	for _, e := range m.GetItemsBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsBySKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemsBySKUsCounter := mm_atomic.LoadUint64(&m.afterGetItemsBySKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemsBySKUsMock.defaultExpectation != nil && afterGetItemsBySKUsCounter < 1 {
+		if m.GetItemsBySKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsBySKUs at\n%s", m.GetItemsBySKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetItemsBySKUs at\n%s with params: %#v", m.GetItemsBySKUsMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsBySKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemsBySKUs != nil && afterGetItemsBySKUsCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetItemsBySKUs at\n%s", m.funcGetItemsBySKUsOrigin)
+	}
+
+	if !m.GetItemsBySKUsMock.invocationsDone() && afterGetItemsBySKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetItemsBySKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemsBySKUsMock.expectedInvocations), m.GetItemsBySKUsMock.expectedInvocationsOrigin, afterGetItemsBySKUsCounter)
+	}
+}
+
+type mIStockRepoMockGetLocationStock struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetLocationStockExpectation
+	expectations       []*IStockRepoMockGetLocationStockExpectation
+
+	callArgs []*IStockRepoMockGetLocationStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetLocationStockExpectation specifies expectation struct of the IStockRepo.GetLocationStock
+type IStockRepoMockGetLocationStockExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetLocationStockParams
+	paramPtrs          *IStockRepoMockGetLocationStockParamPtrs
+	expectationOrigins IStockRepoMockGetLocationStockExpectationOrigins
+	results            *IStockRepoMockGetLocationStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetLocationStockParams contains parameters of the IStockRepo.GetLocationStock
+type IStockRepoMockGetLocationStockParams struct {
+	ctx      context.Context
+	skuID    models.SKUID
+	userID   models.UserID
+	location string
+}
+
+// IStockRepoMockGetLocationStockParamPtrs contains pointers to parameters of the IStockRepo.GetLocationStock
+type IStockRepoMockGetLocationStockParamPtrs struct {
+	ctx      *context.Context
+	skuID    *models.SKUID
+	userID   *models.UserID
+	location *string
+}
+
+// IStockRepoMockGetLocationStockResults contains results of the IStockRepo.GetLocationStock
+type IStockRepoMockGetLocationStockResults struct {
+	s1  models.Stock
+	err error
+}
+
+// IStockRepoMockGetLocationStockOrigins contains origins of expectations of the IStockRepo.GetLocationStock
+type IStockRepoMockGetLocationStockExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSkuID    string
+	originUserID   string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Optional() *mIStockRepoMockGetLocationStock {
+	mmGetLocationStock.optional = true
+	return mmGetLocationStock
+}
+
+// Expect sets up expected params for IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Expect(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{}
+	}
+
+	if mmGetLocationStock.defaultExpectation.paramPtrs != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by ExpectParams functions")
+	}
+
+	mmGetLocationStock.defaultExpectation.params = &IStockRepoMockGetLocationStockParams{ctx, skuID, userID, location}
+	mmGetLocationStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLocationStock.expectations {
+		if minimock.Equal(e.params, mmGetLocationStock.defaultExpectation.params) {
+			mmGetLocationStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLocationStock.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLocationStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{}
+	}
+
+	if mmGetLocationStock.defaultExpectation.params != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Expect")
+	}
+
+	if mmGetLocationStock.defaultExpectation.paramPtrs == nil {
+		mmGetLocationStock.defaultExpectation.paramPtrs = &IStockRepoMockGetLocationStockParamPtrs{}
+	}
+	mmGetLocationStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetLocationStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetLocationStock
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{}
+	}
+
+	if mmGetLocationStock.defaultExpectation.params != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Expect")
+	}
+
+	if mmGetLocationStock.defaultExpectation.paramPtrs == nil {
+		mmGetLocationStock.defaultExpectation.paramPtrs = &IStockRepoMockGetLocationStockParamPtrs{}
+	}
+	mmGetLocationStock.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetLocationStock.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetLocationStock
+}
+
+// ExpectUserIDParam3 sets up expected param userID for IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) ExpectUserIDParam3(userID models.UserID) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{}
+	}
+
+	if mmGetLocationStock.defaultExpectation.params != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Expect")
+	}
+
+	if mmGetLocationStock.defaultExpectation.paramPtrs == nil {
+		mmGetLocationStock.defaultExpectation.paramPtrs = &IStockRepoMockGetLocationStockParamPtrs{}
+	}
+	mmGetLocationStock.defaultExpectation.paramPtrs.userID = &userID
+	mmGetLocationStock.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetLocationStock
+}
+
+// ExpectLocationParam4 sets up expected param location for IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) ExpectLocationParam4(location string) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{}
+	}
+
+	if mmGetLocationStock.defaultExpectation.params != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Expect")
+	}
+
+	if mmGetLocationStock.defaultExpectation.paramPtrs == nil {
+		mmGetLocationStock.defaultExpectation.paramPtrs = &IStockRepoMockGetLocationStockParamPtrs{}
+	}
+	mmGetLocationStock.defaultExpectation.paramPtrs.location = &location
+	mmGetLocationStock.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmGetLocationStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Inspect(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string)) *mIStockRepoMockGetLocationStock {
+	if mmGetLocationStock.mock.inspectFuncGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetLocationStock")
+	}
+
+	mmGetLocationStock.mock.inspectFuncGetLocationStock = f
+
+	return mmGetLocationStock
+}
+
+// Return sets up results that will be returned by IStockRepo.GetLocationStock
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Return(s1 models.Stock, err error) *IStockRepoMock {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	if mmGetLocationStock.defaultExpectation == nil {
+		mmGetLocationStock.defaultExpectation = &IStockRepoMockGetLocationStockExpectation{mock: mmGetLocationStock.mock}
+	}
+	mmGetLocationStock.defaultExpectation.results = &IStockRepoMockGetLocationStockResults{s1, err}
+	mmGetLocationStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLocationStock.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetLocationStock method
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Set(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (s1 models.Stock, err error)) *IStockRepoMock {
+	if mmGetLocationStock.defaultExpectation != nil {
+		mmGetLocationStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetLocationStock method")
+	}
+
+	if len(mmGetLocationStock.expectations) > 0 {
+		mmGetLocationStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetLocationStock method")
+	}
+
+	mmGetLocationStock.mock.funcGetLocationStock = f
+	mmGetLocationStock.mock.funcGetLocationStockOrigin = minimock.CallerInfo(1)
+	return mmGetLocationStock.mock
+}
+
+// When sets expectation for the IStockRepo.GetLocationStock which will trigger the result defined by the following
+// Then helper
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) When(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) *IStockRepoMockGetLocationStockExpectation {
+	if mmGetLocationStock.mock.funcGetLocationStock != nil {
+		mmGetLocationStock.mock.t.Fatalf("IStockRepoMock.GetLocationStock mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetLocationStockExpectation{
+		mock:               mmGetLocationStock.mock,
+		params:             &IStockRepoMockGetLocationStockParams{ctx, skuID, userID, location},
+		expectationOrigins: IStockRepoMockGetLocationStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLocationStock.expectations = append(mmGetLocationStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetLocationStock return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetLocationStockExpectation) Then(s1 models.Stock, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetLocationStockResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetLocationStock should be invoked
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Times(n uint64) *mIStockRepoMockGetLocationStock {
+	if n == 0 {
+		mmGetLocationStock.mock.t.Fatalf("Times of IStockRepoMock.GetLocationStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLocationStock.expectedInvocations, n)
+	mmGetLocationStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLocationStock
+}
+
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) invocationsDone() bool {
+	if len(mmGetLocationStock.expectations) == 0 && mmGetLocationStock.defaultExpectation == nil && mmGetLocationStock.mock.funcGetLocationStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLocationStock.mock.afterGetLocationStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLocationStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLocationStock implements mm_repository.IStockRepo
+func (mmGetLocationStock *IStockRepoMock) GetLocationStock(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (s1 models.Stock, err error) {
+	mm_atomic.AddUint64(&mmGetLocationStock.beforeGetLocationStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLocationStock.afterGetLocationStockCounter, 1)
+
+	mmGetLocationStock.t.Helper()
+
+	if mmGetLocationStock.inspectFuncGetLocationStock != nil {
+		mmGetLocationStock.inspectFuncGetLocationStock(ctx, skuID, userID, location)
+	}
+
+	mm_params := IStockRepoMockGetLocationStockParams{ctx, skuID, userID, location}
+
+	// Record call args
+	mmGetLocationStock.GetLocationStockMock.mutex.Lock()
+	mmGetLocationStock.GetLocationStockMock.callArgs = append(mmGetLocationStock.GetLocationStockMock.callArgs, &mm_params)
+	mmGetLocationStock.GetLocationStockMock.mutex.Unlock()
+
+	for _, e := range mmGetLocationStock.GetLocationStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetLocationStock.GetLocationStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLocationStock.GetLocationStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLocationStock.GetLocationStockMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLocationStock.GetLocationStockMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetLocationStockParams{ctx, skuID, userID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetLocationStock.t.Errorf("IStockRepoMock.GetLocationStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLocationStock.GetLocationStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetLocationStock.t.Errorf("IStockRepoMock.GetLocationStock got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLocationStock.GetLocationStockMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetLocationStock.t.Errorf("IStockRepoMock.GetLocationStock got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLocationStock.GetLocationStockMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmGetLocationStock.t.Errorf("IStockRepoMock.GetLocationStock got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLocationStock.GetLocationStockMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLocationStock.t.Errorf("IStockRepoMock.GetLocationStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLocationStock.GetLocationStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLocationStock.GetLocationStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLocationStock.t.Fatal("No results are set for the IStockRepoMock.GetLocationStock")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetLocationStock.funcGetLocationStock != nil {
+		return mmGetLocationStock.funcGetLocationStock(ctx, skuID, userID, location)
+	}
+	mmGetLocationStock.t.Fatalf("Unexpected call to IStockRepoMock.GetLocationStock. %v %v %v %v", ctx, skuID, userID, location)
+	return
+}
+
+// GetLocationStockAfterCounter returns a count of finished IStockRepoMock.GetLocationStock invocations
+func (mmGetLocationStock *IStockRepoMock) GetLocationStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLocationStock.afterGetLocationStockCounter)
+}
+
+// GetLocationStockBeforeCounter returns a count of IStockRepoMock.GetLocationStock invocations
+func (mmGetLocationStock *IStockRepoMock) GetLocationStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLocationStock.beforeGetLocationStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetLocationStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLocationStock *mIStockRepoMockGetLocationStock) Calls() []*IStockRepoMockGetLocationStockParams {
+	mmGetLocationStock.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetLocationStockParams, len(mmGetLocationStock.callArgs))
+	copy(argCopy, mmGetLocationStock.callArgs)
+
+	mmGetLocationStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLocationStockDone returns true if the count of the GetLocationStock invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetLocationStockDone() bool {
+	if m.GetLocationStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLocationStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLocationStockMock.invocationsDone()
+}
+
+// MinimockGetLocationStockInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetLocationStockInspect() {
+	for _, e := range m.GetLocationStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetLocationStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLocationStockCounter := mm_atomic.LoadUint64(&m.afterGetLocationStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLocationStockMock.defaultExpectation != nil && afterGetLocationStockCounter < 1 {
+		if m.GetLocationStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetLocationStock at\n%s", m.GetLocationStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetLocationStock at\n%s with params: %#v", m.GetLocationStockMock.defaultExpectation.expectationOrigins.origin, *m.GetLocationStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLocationStock != nil && afterGetLocationStockCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetLocationStock at\n%s", m.funcGetLocationStockOrigin)
+	}
+
+	if !m.GetLocationStockMock.invocationsDone() && afterGetLocationStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetLocationStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLocationStockMock.expectedInvocations), m.GetLocationStockMock.expectedInvocationsOrigin, afterGetLocationStockCounter)
+	}
+}
+
+type mIStockRepoMockGetOffer struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetOfferExpectation
+	expectations       []*IStockRepoMockGetOfferExpectation
+
+	callArgs []*IStockRepoMockGetOfferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetOfferExpectation specifies expectation struct of the IStockRepo.GetOffer
+type IStockRepoMockGetOfferExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetOfferParams
+	paramPtrs          *IStockRepoMockGetOfferParamPtrs
+	expectationOrigins IStockRepoMockGetOfferExpectationOrigins
+	results            *IStockRepoMockGetOfferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetOfferParams contains parameters of the IStockRepo.GetOffer
+type IStockRepoMockGetOfferParams struct {
+	ctx     context.Context
+	skuID   models.SKUID
+	offerID models.StockID
+}
+
+// IStockRepoMockGetOfferParamPtrs contains pointers to parameters of the IStockRepo.GetOffer
+type IStockRepoMockGetOfferParamPtrs struct {
+	ctx     *context.Context
+	skuID   *models.SKUID
+	offerID *models.StockID
+}
+
+// IStockRepoMockGetOfferResults contains results of the IStockRepo.GetOffer
+type IStockRepoMockGetOfferResults struct {
+	i1  models.Item
+	err error
+}
+
+// IStockRepoMockGetOfferOrigins contains origins of expectations of the IStockRepo.GetOffer
+type IStockRepoMockGetOfferExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSkuID   string
+	originOfferID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOffer *mIStockRepoMockGetOffer) Optional() *mIStockRepoMockGetOffer {
+	mmGetOffer.optional = true
+	return mmGetOffer
+}
+
+// Expect sets up expected params for IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) Expect(ctx context.Context, skuID models.SKUID, offerID models.StockID) *mIStockRepoMockGetOffer {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	if mmGetOffer.defaultExpectation == nil {
+		mmGetOffer.defaultExpectation = &IStockRepoMockGetOfferExpectation{}
+	}
+
+	if mmGetOffer.defaultExpectation.paramPtrs != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by ExpectParams functions")
+	}
+
+	mmGetOffer.defaultExpectation.params = &IStockRepoMockGetOfferParams{ctx, skuID, offerID}
+	mmGetOffer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOffer.expectations {
+		if minimock.Equal(e.params, mmGetOffer.defaultExpectation.params) {
+			mmGetOffer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOffer.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOffer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetOffer {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	if mmGetOffer.defaultExpectation == nil {
+		mmGetOffer.defaultExpectation = &IStockRepoMockGetOfferExpectation{}
+	}
+
+	if mmGetOffer.defaultExpectation.params != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Expect")
+	}
+
+	if mmGetOffer.defaultExpectation.paramPtrs == nil {
+		mmGetOffer.defaultExpectation.paramPtrs = &IStockRepoMockGetOfferParamPtrs{}
+	}
+	mmGetOffer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOffer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOffer
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetOffer {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	if mmGetOffer.defaultExpectation == nil {
+		mmGetOffer.defaultExpectation = &IStockRepoMockGetOfferExpectation{}
+	}
+
+	if mmGetOffer.defaultExpectation.params != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Expect")
+	}
+
+	if mmGetOffer.defaultExpectation.paramPtrs == nil {
+		mmGetOffer.defaultExpectation.paramPtrs = &IStockRepoMockGetOfferParamPtrs{}
+	}
+	mmGetOffer.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetOffer.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetOffer
+}
+
+// ExpectOfferIDParam3 sets up expected param offerID for IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) ExpectOfferIDParam3(offerID models.StockID) *mIStockRepoMockGetOffer {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	if mmGetOffer.defaultExpectation == nil {
+		mmGetOffer.defaultExpectation = &IStockRepoMockGetOfferExpectation{}
+	}
+
+	if mmGetOffer.defaultExpectation.params != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Expect")
+	}
+
+	if mmGetOffer.defaultExpectation.paramPtrs == nil {
+		mmGetOffer.defaultExpectation.paramPtrs = &IStockRepoMockGetOfferParamPtrs{}
+	}
+	mmGetOffer.defaultExpectation.paramPtrs.offerID = &offerID
+	mmGetOffer.defaultExpectation.expectationOrigins.originOfferID = minimock.CallerInfo(1)
+
+	return mmGetOffer
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) Inspect(f func(ctx context.Context, skuID models.SKUID, offerID models.StockID)) *mIStockRepoMockGetOffer {
+	if mmGetOffer.mock.inspectFuncGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetOffer")
+	}
+
+	mmGetOffer.mock.inspectFuncGetOffer = f
+
+	return mmGetOffer
+}
+
+// Return sets up results that will be returned by IStockRepo.GetOffer
+func (mmGetOffer *mIStockRepoMockGetOffer) Return(i1 models.Item, err error) *IStockRepoMock {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	if mmGetOffer.defaultExpectation == nil {
+		mmGetOffer.defaultExpectation = &IStockRepoMockGetOfferExpectation{mock: mmGetOffer.mock}
+	}
+	mmGetOffer.defaultExpectation.results = &IStockRepoMockGetOfferResults{i1, err}
+	mmGetOffer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOffer.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetOffer method
+func (mmGetOffer *mIStockRepoMockGetOffer) Set(f func(ctx context.Context, skuID models.SKUID, offerID models.StockID) (i1 models.Item, err error)) *IStockRepoMock {
+	if mmGetOffer.defaultExpectation != nil {
+		mmGetOffer.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetOffer method")
+	}
+
+	if len(mmGetOffer.expectations) > 0 {
+		mmGetOffer.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetOffer method")
+	}
+
+	mmGetOffer.mock.funcGetOffer = f
+	mmGetOffer.mock.funcGetOfferOrigin = minimock.CallerInfo(1)
+	return mmGetOffer.mock
+}
+
+// When sets expectation for the IStockRepo.GetOffer which will trigger the result defined by the following
+// Then helper
+func (mmGetOffer *mIStockRepoMockGetOffer) When(ctx context.Context, skuID models.SKUID, offerID models.StockID) *IStockRepoMockGetOfferExpectation {
+	if mmGetOffer.mock.funcGetOffer != nil {
+		mmGetOffer.mock.t.Fatalf("IStockRepoMock.GetOffer mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetOfferExpectation{
+		mock:               mmGetOffer.mock,
+		params:             &IStockRepoMockGetOfferParams{ctx, skuID, offerID},
+		expectationOrigins: IStockRepoMockGetOfferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOffer.expectations = append(mmGetOffer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetOffer return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetOfferExpectation) Then(i1 models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetOfferResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetOffer should be invoked
+func (mmGetOffer *mIStockRepoMockGetOffer) Times(n uint64) *mIStockRepoMockGetOffer {
+	if n == 0 {
+		mmGetOffer.mock.t.Fatalf("Times of IStockRepoMock.GetOffer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOffer.expectedInvocations, n)
+	mmGetOffer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOffer
+}
+
+func (mmGetOffer *mIStockRepoMockGetOffer) invocationsDone() bool {
+	if len(mmGetOffer.expectations) == 0 && mmGetOffer.defaultExpectation == nil && mmGetOffer.mock.funcGetOffer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOffer.mock.afterGetOfferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOffer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOffer implements mm_repository.IStockRepo
+func (mmGetOffer *IStockRepoMock) GetOffer(ctx context.Context, skuID models.SKUID, offerID models.StockID) (i1 models.Item, err error) {
+	mm_atomic.AddUint64(&mmGetOffer.beforeGetOfferCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOffer.afterGetOfferCounter, 1)
+
+	mmGetOffer.t.Helper()
+
+	if mmGetOffer.inspectFuncGetOffer != nil {
+		mmGetOffer.inspectFuncGetOffer(ctx, skuID, offerID)
+	}
+
+	mm_params := IStockRepoMockGetOfferParams{ctx, skuID, offerID}
+
+	// Record call args
+	mmGetOffer.GetOfferMock.mutex.Lock()
+	mmGetOffer.GetOfferMock.callArgs = append(mmGetOffer.GetOfferMock.callArgs, &mm_params)
+	mmGetOffer.GetOfferMock.mutex.Unlock()
+
+	for _, e := range mmGetOffer.GetOfferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetOffer.GetOfferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOffer.GetOfferMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOffer.GetOfferMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOffer.GetOfferMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetOfferParams{ctx, skuID, offerID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOffer.t.Errorf("IStockRepoMock.GetOffer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOffer.GetOfferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetOffer.t.Errorf("IStockRepoMock.GetOffer got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOffer.GetOfferMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.offerID != nil && !minimock.Equal(*mm_want_ptrs.offerID, mm_got.offerID) {
+				mmGetOffer.t.Errorf("IStockRepoMock.GetOffer got unexpected parameter offerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOffer.GetOfferMock.defaultExpectation.expectationOrigins.originOfferID, *mm_want_ptrs.offerID, mm_got.offerID, minimock.Diff(*mm_want_ptrs.offerID, mm_got.offerID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOffer.t.Errorf("IStockRepoMock.GetOffer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOffer.GetOfferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOffer.GetOfferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOffer.t.Fatal("No results are set for the IStockRepoMock.GetOffer")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetOffer.funcGetOffer != nil {
+		return mmGetOffer.funcGetOffer(ctx, skuID, offerID)
+	}
+	mmGetOffer.t.Fatalf("Unexpected call to IStockRepoMock.GetOffer. %v %v %v", ctx, skuID, offerID)
+	return
+}
+
+// GetOfferAfterCounter returns a count of finished IStockRepoMock.GetOffer invocations
+func (mmGetOffer *IStockRepoMock) GetOfferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOffer.afterGetOfferCounter)
+}
+
+// GetOfferBeforeCounter returns a count of IStockRepoMock.GetOffer invocations
+func (mmGetOffer *IStockRepoMock) GetOfferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOffer.beforeGetOfferCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetOffer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOffer *mIStockRepoMockGetOffer) Calls() []*IStockRepoMockGetOfferParams {
+	mmGetOffer.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetOfferParams, len(mmGetOffer.callArgs))
+	copy(argCopy, mmGetOffer.callArgs)
+
+	mmGetOffer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOfferDone returns true if the count of the GetOffer invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetOfferDone() bool {
+	if m.GetOfferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOfferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOfferMock.invocationsDone()
+}
+
+// MinimockGetOfferInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetOfferInspect() {
+	for _, e := range m.GetOfferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetOffer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOfferCounter := mm_atomic.LoadUint64(&m.afterGetOfferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOfferMock.defaultExpectation != nil && afterGetOfferCounter < 1 {
+		if m.GetOfferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetOffer at\n%s", m.GetOfferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetOffer at\n%s with params: %#v", m.GetOfferMock.defaultExpectation.expectationOrigins.origin, *m.GetOfferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOffer != nil && afterGetOfferCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetOffer at\n%s", m.funcGetOfferOrigin)
+	}
+
+	if !m.GetOfferMock.invocationsDone() && afterGetOfferCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetOffer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOfferMock.expectedInvocations), m.GetOfferMock.expectedInvocationsOrigin, afterGetOfferCounter)
+	}
+}
+
+type mIStockRepoMockGetPendingPrices struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetPendingPricesExpectation
+	expectations       []*IStockRepoMockGetPendingPricesExpectation
+
+	callArgs []*IStockRepoMockGetPendingPricesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetPendingPricesExpectation specifies expectation struct of the IStockRepo.GetPendingPrices
+type IStockRepoMockGetPendingPricesExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetPendingPricesParams
+	paramPtrs          *IStockRepoMockGetPendingPricesParamPtrs
+	expectationOrigins IStockRepoMockGetPendingPricesExpectationOrigins
+	results            *IStockRepoMockGetPendingPricesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetPendingPricesParams contains parameters of the IStockRepo.GetPendingPrices
+type IStockRepoMockGetPendingPricesParams struct {
+	ctx   context.Context
+	skuID models.SKUID
+}
+
+// IStockRepoMockGetPendingPricesParamPtrs contains pointers to parameters of the IStockRepo.GetPendingPrices
+type IStockRepoMockGetPendingPricesParamPtrs struct {
+	ctx   *context.Context
+	skuID *models.SKUID
+}
+
+// IStockRepoMockGetPendingPricesResults contains results of the IStockRepo.GetPendingPrices
+type IStockRepoMockGetPendingPricesResults struct {
+	sa1 []models.ScheduledPrice
+	err error
+}
+
+// IStockRepoMockGetPendingPricesOrigins contains origins of expectations of the IStockRepo.GetPendingPrices
+type IStockRepoMockGetPendingPricesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Optional() *mIStockRepoMockGetPendingPrices {
+	mmGetPendingPrices.optional = true
+	return mmGetPendingPrices
+}
+
+// Expect sets up expected params for IStockRepo.GetPendingPrices
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Expect(ctx context.Context, skuID models.SKUID) *mIStockRepoMockGetPendingPrices {
+	if mmGetPendingPrices.mock.funcGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Set")
+	}
+
+	if mmGetPendingPrices.defaultExpectation == nil {
+		mmGetPendingPrices.defaultExpectation = &IStockRepoMockGetPendingPricesExpectation{}
+	}
+
+	if mmGetPendingPrices.defaultExpectation.paramPtrs != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by ExpectParams functions")
+	}
+
+	mmGetPendingPrices.defaultExpectation.params = &IStockRepoMockGetPendingPricesParams{ctx, skuID}
+	mmGetPendingPrices.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPendingPrices.expectations {
+		if minimock.Equal(e.params, mmGetPendingPrices.defaultExpectation.params) {
+			mmGetPendingPrices.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPendingPrices.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPendingPrices
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetPendingPrices
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetPendingPrices {
+	if mmGetPendingPrices.mock.funcGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Set")
+	}
+
+	if mmGetPendingPrices.defaultExpectation == nil {
+		mmGetPendingPrices.defaultExpectation = &IStockRepoMockGetPendingPricesExpectation{}
+	}
+
+	if mmGetPendingPrices.defaultExpectation.params != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Expect")
+	}
+
+	if mmGetPendingPrices.defaultExpectation.paramPtrs == nil {
+		mmGetPendingPrices.defaultExpectation.paramPtrs = &IStockRepoMockGetPendingPricesParamPtrs{}
+	}
+	mmGetPendingPrices.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPendingPrices.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPendingPrices
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetPendingPrices
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetPendingPrices {
+	if mmGetPendingPrices.mock.funcGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Set")
+	}
+
+	if mmGetPendingPrices.defaultExpectation == nil {
+		mmGetPendingPrices.defaultExpectation = &IStockRepoMockGetPendingPricesExpectation{}
+	}
+
+	if mmGetPendingPrices.defaultExpectation.params != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Expect")
+	}
+
+	if mmGetPendingPrices.defaultExpectation.paramPtrs == nil {
+		mmGetPendingPrices.defaultExpectation.paramPtrs = &IStockRepoMockGetPendingPricesParamPtrs{}
+	}
+	mmGetPendingPrices.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetPendingPrices.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetPendingPrices
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetPendingPrices
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mIStockRepoMockGetPendingPrices {
+	if mmGetPendingPrices.mock.inspectFuncGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetPendingPrices")
+	}
+
+	mmGetPendingPrices.mock.inspectFuncGetPendingPrices = f
+
+	return mmGetPendingPrices
+}
+
+// Return sets up results that will be returned by IStockRepo.GetPendingPrices
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Return(sa1 []models.ScheduledPrice, err error) *IStockRepoMock {
+	if mmGetPendingPrices.mock.funcGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Set")
+	}
+
+	if mmGetPendingPrices.defaultExpectation == nil {
+		mmGetPendingPrices.defaultExpectation = &IStockRepoMockGetPendingPricesExpectation{mock: mmGetPendingPrices.mock}
+	}
+	mmGetPendingPrices.defaultExpectation.results = &IStockRepoMockGetPendingPricesResults{sa1, err}
+	mmGetPendingPrices.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPendingPrices.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetPendingPrices method
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Set(f func(ctx context.Context, skuID models.SKUID) (sa1 []models.ScheduledPrice, err error)) *IStockRepoMock {
+	if mmGetPendingPrices.defaultExpectation != nil {
+		mmGetPendingPrices.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetPendingPrices method")
+	}
+
+	if len(mmGetPendingPrices.expectations) > 0 {
+		mmGetPendingPrices.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetPendingPrices method")
+	}
+
+	mmGetPendingPrices.mock.funcGetPendingPrices = f
+	mmGetPendingPrices.mock.funcGetPendingPricesOrigin = minimock.CallerInfo(1)
+	return mmGetPendingPrices.mock
+}
+
+// When sets expectation for the IStockRepo.GetPendingPrices which will trigger the result defined by the following
+// Then helper
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) When(ctx context.Context, skuID models.SKUID) *IStockRepoMockGetPendingPricesExpectation {
+	if mmGetPendingPrices.mock.funcGetPendingPrices != nil {
+		mmGetPendingPrices.mock.t.Fatalf("IStockRepoMock.GetPendingPrices mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetPendingPricesExpectation{
+		mock:               mmGetPendingPrices.mock,
+		params:             &IStockRepoMockGetPendingPricesParams{ctx, skuID},
+		expectationOrigins: IStockRepoMockGetPendingPricesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPendingPrices.expectations = append(mmGetPendingPrices.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetPendingPrices return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetPendingPricesExpectation) Then(sa1 []models.ScheduledPrice, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetPendingPricesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetPendingPrices should be invoked
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Times(n uint64) *mIStockRepoMockGetPendingPrices {
+	if n == 0 {
+		mmGetPendingPrices.mock.t.Fatalf("Times of IStockRepoMock.GetPendingPrices mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPendingPrices.expectedInvocations, n)
+	mmGetPendingPrices.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingPrices
+}
+
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) invocationsDone() bool {
+	if len(mmGetPendingPrices.expectations) == 0 && mmGetPendingPrices.defaultExpectation == nil && mmGetPendingPrices.mock.funcGetPendingPrices == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPendingPrices.mock.afterGetPendingPricesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPendingPrices.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPendingPrices implements mm_repository.IStockRepo
+func (mmGetPendingPrices *IStockRepoMock) GetPendingPrices(ctx context.Context, skuID models.SKUID) (sa1 []models.ScheduledPrice, err error) {
+	mm_atomic.AddUint64(&mmGetPendingPrices.beforeGetPendingPricesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPendingPrices.afterGetPendingPricesCounter, 1)
+
+	mmGetPendingPrices.t.Helper()
+
+	if mmGetPendingPrices.inspectFuncGetPendingPrices != nil {
+		mmGetPendingPrices.inspectFuncGetPendingPrices(ctx, skuID)
+	}
+
+	mm_params := IStockRepoMockGetPendingPricesParams{ctx, skuID}
+
+	// Record call args
+	mmGetPendingPrices.GetPendingPricesMock.mutex.Lock()
+	mmGetPendingPrices.GetPendingPricesMock.callArgs = append(mmGetPendingPrices.GetPendingPricesMock.callArgs, &mm_params)
+	mmGetPendingPrices.GetPendingPricesMock.mutex.Unlock()
+
+	for _, e := range mmGetPendingPrices.GetPendingPricesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetPendingPrices.GetPendingPricesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetPendingPricesParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPendingPrices.t.Errorf("IStockRepoMock.GetPendingPrices got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetPendingPrices.t.Errorf("IStockRepoMock.GetPendingPrices got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPendingPrices.t.Errorf("IStockRepoMock.GetPendingPrices got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPendingPrices.GetPendingPricesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPendingPrices.t.Fatal("No results are set for the IStockRepoMock.GetPendingPrices")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetPendingPrices.funcGetPendingPrices != nil {
+		return mmGetPendingPrices.funcGetPendingPrices(ctx, skuID)
+	}
+	mmGetPendingPrices.t.Fatalf("Unexpected call to IStockRepoMock.GetPendingPrices. %v %v", ctx, skuID)
+	return
+}
+
+// GetPendingPricesAfterCounter returns a count of finished IStockRepoMock.GetPendingPrices invocations
+func (mmGetPendingPrices *IStockRepoMock) GetPendingPricesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingPrices.afterGetPendingPricesCounter)
+}
+
+// GetPendingPricesBeforeCounter returns a count of IStockRepoMock.GetPendingPrices invocations
+func (mmGetPendingPrices *IStockRepoMock) GetPendingPricesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingPrices.beforeGetPendingPricesCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetPendingPrices.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPendingPrices *mIStockRepoMockGetPendingPrices) Calls() []*IStockRepoMockGetPendingPricesParams {
+	mmGetPendingPrices.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetPendingPricesParams, len(mmGetPendingPrices.callArgs))
+	copy(argCopy, mmGetPendingPrices.callArgs)
+
+	mmGetPendingPrices.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPendingPricesDone returns true if the count of the GetPendingPrices invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetPendingPricesDone() bool {
+	if m.GetPendingPricesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPendingPricesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPendingPricesMock.invocationsDone()
+}
+
+// MinimockGetPendingPricesInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetPendingPricesInspect() {
+	for _, e := range m.GetPendingPricesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPendingPrices at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPendingPricesCounter := mm_atomic.LoadUint64(&m.afterGetPendingPricesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPendingPricesMock.defaultExpectation != nil && afterGetPendingPricesCounter < 1 {
+		if m.GetPendingPricesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPendingPrices at\n%s", m.GetPendingPricesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPendingPrices at\n%s with params: %#v", m.GetPendingPricesMock.defaultExpectation.expectationOrigins.origin, *m.GetPendingPricesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPendingPrices != nil && afterGetPendingPricesCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetPendingPrices at\n%s", m.funcGetPendingPricesOrigin)
+	}
+
+	if !m.GetPendingPricesMock.invocationsDone() && afterGetPendingPricesCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetPendingPrices at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPendingPricesMock.expectedInvocations), m.GetPendingPricesMock.expectedInvocationsOrigin, afterGetPendingPricesCounter)
+	}
+}
+
+type mIStockRepoMockGetPriceHistory struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetPriceHistoryExpectation
+	expectations       []*IStockRepoMockGetPriceHistoryExpectation
+
+	callArgs []*IStockRepoMockGetPriceHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetPriceHistoryExpectation specifies expectation struct of the IStockRepo.GetPriceHistory
+type IStockRepoMockGetPriceHistoryExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetPriceHistoryParams
+	paramPtrs          *IStockRepoMockGetPriceHistoryParamPtrs
+	expectationOrigins IStockRepoMockGetPriceHistoryExpectationOrigins
+	results            *IStockRepoMockGetPriceHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetPriceHistoryParams contains parameters of the IStockRepo.GetPriceHistory
+type IStockRepoMockGetPriceHistoryParams struct {
+	ctx   context.Context
+	param mm_repository.GetPriceHistoryParam
+}
+
+// IStockRepoMockGetPriceHistoryParamPtrs contains pointers to parameters of the IStockRepo.GetPriceHistory
+type IStockRepoMockGetPriceHistoryParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.GetPriceHistoryParam
+}
+
+// IStockRepoMockGetPriceHistoryResults contains results of the IStockRepo.GetPriceHistory
+type IStockRepoMockGetPriceHistoryResults struct {
+	pa1 []models.PriceChange
+	err error
+}
+
+// IStockRepoMockGetPriceHistoryOrigins contains origins of expectations of the IStockRepo.GetPriceHistory
+type IStockRepoMockGetPriceHistoryExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Optional() *mIStockRepoMockGetPriceHistory {
+	mmGetPriceHistory.optional = true
+	return mmGetPriceHistory
+}
+
+// Expect sets up expected params for IStockRepo.GetPriceHistory
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Expect(ctx context.Context, param mm_repository.GetPriceHistoryParam) *mIStockRepoMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &IStockRepoMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetPriceHistory.defaultExpectation.params = &IStockRepoMockGetPriceHistoryParams{ctx, param}
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPriceHistory.expectations {
+		if minimock.Equal(e.params, mmGetPriceHistory.defaultExpectation.params) {
+			mmGetPriceHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPriceHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPriceHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetPriceHistory
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &IStockRepoMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.params != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Expect")
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmGetPriceHistory.defaultExpectation.paramPtrs = &IStockRepoMockGetPriceHistoryParamPtrs{}
+	}
+	mmGetPriceHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPriceHistory
+}
+
+// ExpectParamParam2 sets up expected param param for IStockRepo.GetPriceHistory
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) ExpectParamParam2(param mm_repository.GetPriceHistoryParam) *mIStockRepoMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &IStockRepoMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.params != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Expect")
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmGetPriceHistory.defaultExpectation.paramPtrs = &IStockRepoMockGetPriceHistoryParamPtrs{}
+	}
+	mmGetPriceHistory.defaultExpectation.paramPtrs.param = &param
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmGetPriceHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetPriceHistory
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Inspect(f func(ctx context.Context, param mm_repository.GetPriceHistoryParam)) *mIStockRepoMockGetPriceHistory {
+	if mmGetPriceHistory.mock.inspectFuncGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetPriceHistory")
+	}
+
+	mmGetPriceHistory.mock.inspectFuncGetPriceHistory = f
+
+	return mmGetPriceHistory
+}
+
+// Return sets up results that will be returned by IStockRepo.GetPriceHistory
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Return(pa1 []models.PriceChange, err error) *IStockRepoMock {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &IStockRepoMockGetPriceHistoryExpectation{mock: mmGetPriceHistory.mock}
+	}
+	mmGetPriceHistory.defaultExpectation.results = &IStockRepoMockGetPriceHistoryResults{pa1, err}
+	mmGetPriceHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetPriceHistory method
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Set(f func(ctx context.Context, param mm_repository.GetPriceHistoryParam) (pa1 []models.PriceChange, err error)) *IStockRepoMock {
+	if mmGetPriceHistory.defaultExpectation != nil {
+		mmGetPriceHistory.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetPriceHistory method")
+	}
+
+	if len(mmGetPriceHistory.expectations) > 0 {
+		mmGetPriceHistory.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetPriceHistory method")
+	}
+
+	mmGetPriceHistory.mock.funcGetPriceHistory = f
+	mmGetPriceHistory.mock.funcGetPriceHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory.mock
+}
+
+// When sets expectation for the IStockRepo.GetPriceHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) When(ctx context.Context, param mm_repository.GetPriceHistoryParam) *IStockRepoMockGetPriceHistoryExpectation {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("IStockRepoMock.GetPriceHistory mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetPriceHistoryExpectation{
+		mock:               mmGetPriceHistory.mock,
+		params:             &IStockRepoMockGetPriceHistoryParams{ctx, param},
+		expectationOrigins: IStockRepoMockGetPriceHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPriceHistory.expectations = append(mmGetPriceHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetPriceHistory return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetPriceHistoryExpectation) Then(pa1 []models.PriceChange, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetPriceHistoryResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetPriceHistory should be invoked
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Times(n uint64) *mIStockRepoMockGetPriceHistory {
+	if n == 0 {
+		mmGetPriceHistory.mock.t.Fatalf("Times of IStockRepoMock.GetPriceHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPriceHistory.expectedInvocations, n)
+	mmGetPriceHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory
+}
+
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) invocationsDone() bool {
+	if len(mmGetPriceHistory.expectations) == 0 && mmGetPriceHistory.defaultExpectation == nil && mmGetPriceHistory.mock.funcGetPriceHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPriceHistory.mock.afterGetPriceHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPriceHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPriceHistory implements mm_repository.IStockRepo
+func (mmGetPriceHistory *IStockRepoMock) GetPriceHistory(ctx context.Context, param mm_repository.GetPriceHistoryParam) (pa1 []models.PriceChange, err error) {
+	mm_atomic.AddUint64(&mmGetPriceHistory.beforeGetPriceHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPriceHistory.afterGetPriceHistoryCounter, 1)
+
+	mmGetPriceHistory.t.Helper()
+
+	if mmGetPriceHistory.inspectFuncGetPriceHistory != nil {
+		mmGetPriceHistory.inspectFuncGetPriceHistory(ctx, param)
+	}
+
+	mm_params := IStockRepoMockGetPriceHistoryParams{ctx, param}
+
+	// Record call args
+	mmGetPriceHistory.GetPriceHistoryMock.mutex.Lock()
+	mmGetPriceHistory.GetPriceHistoryMock.callArgs = append(mmGetPriceHistory.GetPriceHistoryMock.callArgs, &mm_params)
+	mmGetPriceHistory.GetPriceHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetPriceHistory.GetPriceHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetPriceHistoryParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPriceHistory.t.Errorf("IStockRepoMock.GetPriceHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmGetPriceHistory.t.Errorf("IStockRepoMock.GetPriceHistory got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPriceHistory.t.Errorf("IStockRepoMock.GetPriceHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPriceHistory.t.Fatal("No results are set for the IStockRepoMock.GetPriceHistory")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPriceHistory.funcGetPriceHistory != nil {
+		return mmGetPriceHistory.funcGetPriceHistory(ctx, param)
+	}
+	mmGetPriceHistory.t.Fatalf("Unexpected call to IStockRepoMock.GetPriceHistory. %v %v", ctx, param)
+	return
+}
+
+// GetPriceHistoryAfterCounter returns a count of finished IStockRepoMock.GetPriceHistory invocations
+func (mmGetPriceHistory *IStockRepoMock) GetPriceHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceHistory.afterGetPriceHistoryCounter)
+}
+
+// GetPriceHistoryBeforeCounter returns a count of IStockRepoMock.GetPriceHistory invocations
+func (mmGetPriceHistory *IStockRepoMock) GetPriceHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceHistory.beforeGetPriceHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetPriceHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPriceHistory *mIStockRepoMockGetPriceHistory) Calls() []*IStockRepoMockGetPriceHistoryParams {
+	mmGetPriceHistory.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetPriceHistoryParams, len(mmGetPriceHistory.callArgs))
+	copy(argCopy, mmGetPriceHistory.callArgs)
+
+	mmGetPriceHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPriceHistoryDone returns true if the count of the GetPriceHistory invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetPriceHistoryDone() bool {
+	if m.GetPriceHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPriceHistoryMock.invocationsDone()
+}
+
+// MinimockGetPriceHistoryInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetPriceHistoryInspect() {
+	for _, e := range m.GetPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPriceHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPriceHistoryCounter := mm_atomic.LoadUint64(&m.afterGetPriceHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPriceHistoryMock.defaultExpectation != nil && afterGetPriceHistoryCounter < 1 {
+		if m.GetPriceHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPriceHistory at\n%s", m.GetPriceHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetPriceHistory at\n%s with params: %#v", m.GetPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetPriceHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPriceHistory != nil && afterGetPriceHistoryCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetPriceHistory at\n%s", m.funcGetPriceHistoryOrigin)
+	}
+
+	if !m.GetPriceHistoryMock.invocationsDone() && afterGetPriceHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetPriceHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPriceHistoryMock.expectedInvocations), m.GetPriceHistoryMock.expectedInvocationsOrigin, afterGetPriceHistoryCounter)
+	}
+}
+
+type mIStockRepoMockGetThreshold struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockGetThresholdExpectation
+	expectations       []*IStockRepoMockGetThresholdExpectation
+
+	callArgs []*IStockRepoMockGetThresholdParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockGetThresholdExpectation specifies expectation struct of the IStockRepo.GetThreshold
+type IStockRepoMockGetThresholdExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockGetThresholdParams
+	paramPtrs          *IStockRepoMockGetThresholdParamPtrs
+	expectationOrigins IStockRepoMockGetThresholdExpectationOrigins
+	results            *IStockRepoMockGetThresholdResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockGetThresholdParams contains parameters of the IStockRepo.GetThreshold
+type IStockRepoMockGetThresholdParams struct {
+	ctx      context.Context
+	skuID    models.SKUID
+	location string
+}
+
+// IStockRepoMockGetThresholdParamPtrs contains pointers to parameters of the IStockRepo.GetThreshold
+type IStockRepoMockGetThresholdParamPtrs struct {
+	ctx      *context.Context
+	skuID    *models.SKUID
+	location *string
+}
+
+// IStockRepoMockGetThresholdResults contains results of the IStockRepo.GetThreshold
+type IStockRepoMockGetThresholdResults struct {
+	u1  uint16
+	err error
+}
+
+// IStockRepoMockGetThresholdOrigins contains origins of expectations of the IStockRepo.GetThreshold
+type IStockRepoMockGetThresholdExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Optional() *mIStockRepoMockGetThreshold {
+	mmGetThreshold.optional = true
+	return mmGetThreshold
+}
+
+// Expect sets up expected params for IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Expect(ctx context.Context, skuID models.SKUID, location string) *mIStockRepoMockGetThreshold {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	if mmGetThreshold.defaultExpectation == nil {
+		mmGetThreshold.defaultExpectation = &IStockRepoMockGetThresholdExpectation{}
+	}
+
+	if mmGetThreshold.defaultExpectation.paramPtrs != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by ExpectParams functions")
+	}
+
+	mmGetThreshold.defaultExpectation.params = &IStockRepoMockGetThresholdParams{ctx, skuID, location}
+	mmGetThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetThreshold.expectations {
+		if minimock.Equal(e.params, mmGetThreshold.defaultExpectation.params) {
+			mmGetThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetThreshold.defaultExpectation.params)
+		}
+	}
+
+	return mmGetThreshold
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetThreshold {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	if mmGetThreshold.defaultExpectation == nil {
+		mmGetThreshold.defaultExpectation = &IStockRepoMockGetThresholdExpectation{}
+	}
+
+	if mmGetThreshold.defaultExpectation.params != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Expect")
+	}
+
+	if mmGetThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetThreshold.defaultExpectation.paramPtrs = &IStockRepoMockGetThresholdParamPtrs{}
+	}
+	mmGetThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetThreshold
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetThreshold {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	if mmGetThreshold.defaultExpectation == nil {
+		mmGetThreshold.defaultExpectation = &IStockRepoMockGetThresholdExpectation{}
+	}
+
+	if mmGetThreshold.defaultExpectation.params != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Expect")
+	}
+
+	if mmGetThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetThreshold.defaultExpectation.paramPtrs = &IStockRepoMockGetThresholdParamPtrs{}
+	}
+	mmGetThreshold.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetThreshold.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetThreshold
+}
+
+// ExpectLocationParam3 sets up expected param location for IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) ExpectLocationParam3(location string) *mIStockRepoMockGetThreshold {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	if mmGetThreshold.defaultExpectation == nil {
+		mmGetThreshold.defaultExpectation = &IStockRepoMockGetThresholdExpectation{}
+	}
+
+	if mmGetThreshold.defaultExpectation.params != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Expect")
+	}
+
+	if mmGetThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetThreshold.defaultExpectation.paramPtrs = &IStockRepoMockGetThresholdParamPtrs{}
+	}
+	mmGetThreshold.defaultExpectation.paramPtrs.location = &location
+	mmGetThreshold.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmGetThreshold
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Inspect(f func(ctx context.Context, skuID models.SKUID, location string)) *mIStockRepoMockGetThreshold {
+	if mmGetThreshold.mock.inspectFuncGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetThreshold")
+	}
+
+	mmGetThreshold.mock.inspectFuncGetThreshold = f
+
+	return mmGetThreshold
+}
+
+// Return sets up results that will be returned by IStockRepo.GetThreshold
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Return(u1 uint16, err error) *IStockRepoMock {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	if mmGetThreshold.defaultExpectation == nil {
+		mmGetThreshold.defaultExpectation = &IStockRepoMockGetThresholdExpectation{mock: mmGetThreshold.mock}
+	}
+	mmGetThreshold.defaultExpectation.results = &IStockRepoMockGetThresholdResults{u1, err}
+	mmGetThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetThreshold.mock
+}
+
+// Set uses given function f to mock the IStockRepo.GetThreshold method
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Set(f func(ctx context.Context, skuID models.SKUID, location string) (u1 uint16, err error)) *IStockRepoMock {
+	if mmGetThreshold.defaultExpectation != nil {
+		mmGetThreshold.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetThreshold method")
+	}
+
+	if len(mmGetThreshold.expectations) > 0 {
+		mmGetThreshold.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetThreshold method")
+	}
+
+	mmGetThreshold.mock.funcGetThreshold = f
+	mmGetThreshold.mock.funcGetThresholdOrigin = minimock.CallerInfo(1)
+	return mmGetThreshold.mock
+}
+
+// When sets expectation for the IStockRepo.GetThreshold which will trigger the result defined by the following
+// Then helper
+func (mmGetThreshold *mIStockRepoMockGetThreshold) When(ctx context.Context, skuID models.SKUID, location string) *IStockRepoMockGetThresholdExpectation {
+	if mmGetThreshold.mock.funcGetThreshold != nil {
+		mmGetThreshold.mock.t.Fatalf("IStockRepoMock.GetThreshold mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockGetThresholdExpectation{
+		mock:               mmGetThreshold.mock,
+		params:             &IStockRepoMockGetThresholdParams{ctx, skuID, location},
+		expectationOrigins: IStockRepoMockGetThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetThreshold.expectations = append(mmGetThreshold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.GetThreshold return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockGetThresholdExpectation) Then(u1 uint16, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockGetThresholdResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.GetThreshold should be invoked
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Times(n uint64) *mIStockRepoMockGetThreshold {
+	if n == 0 {
+		mmGetThreshold.mock.t.Fatalf("Times of IStockRepoMock.GetThreshold mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetThreshold.expectedInvocations, n)
+	mmGetThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetThreshold
+}
+
+func (mmGetThreshold *mIStockRepoMockGetThreshold) invocationsDone() bool {
+	if len(mmGetThreshold.expectations) == 0 && mmGetThreshold.defaultExpectation == nil && mmGetThreshold.mock.funcGetThreshold == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetThreshold.mock.afterGetThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetThreshold.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetThreshold implements mm_repository.IStockRepo
+func (mmGetThreshold *IStockRepoMock) GetThreshold(ctx context.Context, skuID models.SKUID, location string) (u1 uint16, err error) {
+	mm_atomic.AddUint64(&mmGetThreshold.beforeGetThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetThreshold.afterGetThresholdCounter, 1)
+
+	mmGetThreshold.t.Helper()
+
+	if mmGetThreshold.inspectFuncGetThreshold != nil {
+		mmGetThreshold.inspectFuncGetThreshold(ctx, skuID, location)
+	}
+
+	mm_params := IStockRepoMockGetThresholdParams{ctx, skuID, location}
+
+	// Record call args
+	mmGetThreshold.GetThresholdMock.mutex.Lock()
+	mmGetThreshold.GetThresholdMock.callArgs = append(mmGetThreshold.GetThresholdMock.callArgs, &mm_params)
+	mmGetThreshold.GetThresholdMock.mutex.Unlock()
+
+	for _, e := range mmGetThreshold.GetThresholdMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetThreshold.GetThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetThreshold.GetThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetThreshold.GetThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmGetThreshold.GetThresholdMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockGetThresholdParams{ctx, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetThreshold.t.Errorf("IStockRepoMock.GetThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetThreshold.GetThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetThreshold.t.Errorf("IStockRepoMock.GetThreshold got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetThreshold.GetThresholdMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmGetThreshold.t.Errorf("IStockRepoMock.GetThreshold got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetThreshold.GetThresholdMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetThreshold.t.Errorf("IStockRepoMock.GetThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetThreshold.GetThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetThreshold.GetThresholdMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetThreshold.t.Fatal("No results are set for the IStockRepoMock.GetThreshold")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetThreshold.funcGetThreshold != nil {
+		return mmGetThreshold.funcGetThreshold(ctx, skuID, location)
+	}
+	mmGetThreshold.t.Fatalf("Unexpected call to IStockRepoMock.GetThreshold. %v %v %v", ctx, skuID, location)
+	return
+}
+
+// GetThresholdAfterCounter returns a count of finished IStockRepoMock.GetThreshold invocations
+func (mmGetThreshold *IStockRepoMock) GetThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetThreshold.afterGetThresholdCounter)
+}
+
+// GetThresholdBeforeCounter returns a count of IStockRepoMock.GetThreshold invocations
+func (mmGetThreshold *IStockRepoMock) GetThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetThreshold.beforeGetThresholdCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.GetThreshold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetThreshold *mIStockRepoMockGetThreshold) Calls() []*IStockRepoMockGetThresholdParams {
+	mmGetThreshold.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockGetThresholdParams, len(mmGetThreshold.callArgs))
+	copy(argCopy, mmGetThreshold.callArgs)
+
+	mmGetThreshold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetThresholdDone returns true if the count of the GetThreshold invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockGetThresholdDone() bool {
+	if m.GetThresholdMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetThresholdMock.invocationsDone()
+}
+
+// MinimockGetThresholdInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockGetThresholdInspect() {
+	for _, e := range m.GetThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.GetThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetThresholdCounter := mm_atomic.LoadUint64(&m.afterGetThresholdCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetThresholdMock.defaultExpectation != nil && afterGetThresholdCounter < 1 {
+		if m.GetThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.GetThreshold at\n%s", m.GetThresholdMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.GetThreshold at\n%s with params: %#v", m.GetThresholdMock.defaultExpectation.expectationOrigins.origin, *m.GetThresholdMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetThreshold != nil && afterGetThresholdCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.GetThreshold at\n%s", m.funcGetThresholdOrigin)
+	}
+
+	if !m.GetThresholdMock.invocationsDone() && afterGetThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.GetThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetThresholdMock.expectedInvocations), m.GetThresholdMock.expectedInvocationsOrigin, afterGetThresholdCounter)
+	}
+}
+
+type mIStockRepoMockListLowStock struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockListLowStockExpectation
+	expectations       []*IStockRepoMockListLowStockExpectation
+
+	callArgs []*IStockRepoMockListLowStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockListLowStockExpectation specifies expectation struct of the IStockRepo.ListLowStock
+type IStockRepoMockListLowStockExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockListLowStockParams
+	paramPtrs          *IStockRepoMockListLowStockParamPtrs
+	expectationOrigins IStockRepoMockListLowStockExpectationOrigins
+	results            *IStockRepoMockListLowStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockListLowStockParams contains parameters of the IStockRepo.ListLowStock
+type IStockRepoMockListLowStockParams struct {
+	ctx   context.Context
+	param mm_repository.ListLowStockParam
+}
+
+// IStockRepoMockListLowStockParamPtrs contains pointers to parameters of the IStockRepo.ListLowStock
+type IStockRepoMockListLowStockParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.ListLowStockParam
+}
+
+// IStockRepoMockListLowStockResults contains results of the IStockRepo.ListLowStock
+type IStockRepoMockListLowStockResults struct {
+	la1 []models.LowStockItem
+	err error
+}
+
+// IStockRepoMockListLowStockOrigins contains origins of expectations of the IStockRepo.ListLowStock
+type IStockRepoMockListLowStockExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLowStock *mIStockRepoMockListLowStock) Optional() *mIStockRepoMockListLowStock {
+	mmListLowStock.optional = true
+	return mmListLowStock
+}
+
+// Expect sets up expected params for IStockRepo.ListLowStock
+func (mmListLowStock *mIStockRepoMockListLowStock) Expect(ctx context.Context, param mm_repository.ListLowStockParam) *mIStockRepoMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &IStockRepoMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by ExpectParams functions")
+	}
+
+	mmListLowStock.defaultExpectation.params = &IStockRepoMockListLowStockParams{ctx, param}
+	mmListLowStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLowStock.expectations {
+		if minimock.Equal(e.params, mmListLowStock.defaultExpectation.params) {
+			mmListLowStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLowStock.defaultExpectation.params)
+		}
+	}
+
+	return mmListLowStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.ListLowStock
+func (mmListLowStock *mIStockRepoMockListLowStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &IStockRepoMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.params != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Expect")
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs == nil {
+		mmListLowStock.defaultExpectation.paramPtrs = &IStockRepoMockListLowStockParamPtrs{}
+	}
+	mmListLowStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLowStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLowStock
+}
+
+// ExpectParamParam2 sets up expected param param for IStockRepo.ListLowStock
+func (mmListLowStock *mIStockRepoMockListLowStock) ExpectParamParam2(param mm_repository.ListLowStockParam) *mIStockRepoMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &IStockRepoMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.params != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Expect")
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs == nil {
+		mmListLowStock.defaultExpectation.paramPtrs = &IStockRepoMockListLowStockParamPtrs{}
+	}
+	mmListLowStock.defaultExpectation.paramPtrs.param = &param
+	mmListLowStock.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmListLowStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.ListLowStock
+func (mmListLowStock *mIStockRepoMockListLowStock) Inspect(f func(ctx context.Context, param mm_repository.ListLowStockParam)) *mIStockRepoMockListLowStock {
+	if mmListLowStock.mock.inspectFuncListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.ListLowStock")
+	}
+
+	mmListLowStock.mock.inspectFuncListLowStock = f
+
+	return mmListLowStock
+}
+
+// Return sets up results that will be returned by IStockRepo.ListLowStock
+func (mmListLowStock *mIStockRepoMockListLowStock) Return(la1 []models.LowStockItem, err error) *IStockRepoMock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &IStockRepoMockListLowStockExpectation{mock: mmListLowStock.mock}
+	}
+	mmListLowStock.defaultExpectation.results = &IStockRepoMockListLowStockResults{la1, err}
+	mmListLowStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLowStock.mock
+}
+
+// Set uses given function f to mock the IStockRepo.ListLowStock method
+func (mmListLowStock *mIStockRepoMockListLowStock) Set(f func(ctx context.Context, param mm_repository.ListLowStockParam) (la1 []models.LowStockItem, err error)) *IStockRepoMock {
+	if mmListLowStock.defaultExpectation != nil {
+		mmListLowStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.ListLowStock method")
+	}
+
+	if len(mmListLowStock.expectations) > 0 {
+		mmListLowStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.ListLowStock method")
+	}
+
+	mmListLowStock.mock.funcListLowStock = f
+	mmListLowStock.mock.funcListLowStockOrigin = minimock.CallerInfo(1)
+	return mmListLowStock.mock
+}
+
+// When sets expectation for the IStockRepo.ListLowStock which will trigger the result defined by the following
+// Then helper
+func (mmListLowStock *mIStockRepoMockListLowStock) When(ctx context.Context, param mm_repository.ListLowStockParam) *IStockRepoMockListLowStockExpectation {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("IStockRepoMock.ListLowStock mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockListLowStockExpectation{
+		mock:               mmListLowStock.mock,
+		params:             &IStockRepoMockListLowStockParams{ctx, param},
+		expectationOrigins: IStockRepoMockListLowStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLowStock.expectations = append(mmListLowStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.ListLowStock return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockListLowStockExpectation) Then(la1 []models.LowStockItem, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockListLowStockResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.ListLowStock should be invoked
+func (mmListLowStock *mIStockRepoMockListLowStock) Times(n uint64) *mIStockRepoMockListLowStock {
+	if n == 0 {
+		mmListLowStock.mock.t.Fatalf("Times of IStockRepoMock.ListLowStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLowStock.expectedInvocations, n)
+	mmListLowStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLowStock
+}
+
+func (mmListLowStock *mIStockRepoMockListLowStock) invocationsDone() bool {
+	if len(mmListLowStock.expectations) == 0 && mmListLowStock.defaultExpectation == nil && mmListLowStock.mock.funcListLowStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLowStock.mock.afterListLowStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLowStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLowStock implements mm_repository.IStockRepo
+func (mmListLowStock *IStockRepoMock) ListLowStock(ctx context.Context, param mm_repository.ListLowStockParam) (la1 []models.LowStockItem, err error) {
+	mm_atomic.AddUint64(&mmListLowStock.beforeListLowStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLowStock.afterListLowStockCounter, 1)
+
+	mmListLowStock.t.Helper()
+
+	if mmListLowStock.inspectFuncListLowStock != nil {
+		mmListLowStock.inspectFuncListLowStock(ctx, param)
+	}
+
+	mm_params := IStockRepoMockListLowStockParams{ctx, param}
+
+	// Record call args
+	mmListLowStock.ListLowStockMock.mutex.Lock()
+	mmListLowStock.ListLowStockMock.callArgs = append(mmListLowStock.ListLowStockMock.callArgs, &mm_params)
+	mmListLowStock.ListLowStockMock.mutex.Unlock()
+
+	for _, e := range mmListLowStock.ListLowStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmListLowStock.ListLowStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLowStock.ListLowStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLowStock.ListLowStockMock.defaultExpectation.params
+		mm_want_ptrs := mmListLowStock.ListLowStockMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockListLowStockParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLowStock.t.Errorf("IStockRepoMock.ListLowStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmListLowStock.t.Errorf("IStockRepoMock.ListLowStock got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLowStock.t.Errorf("IStockRepoMock.ListLowStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLowStock.ListLowStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLowStock.t.Fatal("No results are set for the IStockRepoMock.ListLowStock")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmListLowStock.funcListLowStock != nil {
+		return mmListLowStock.funcListLowStock(ctx, param)
+	}
+	mmListLowStock.t.Fatalf("Unexpected call to IStockRepoMock.ListLowStock. %v %v", ctx, param)
+	return
+}
+
+// ListLowStockAfterCounter returns a count of finished IStockRepoMock.ListLowStock invocations
+func (mmListLowStock *IStockRepoMock) ListLowStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStock.afterListLowStockCounter)
+}
+
+// ListLowStockBeforeCounter returns a count of IStockRepoMock.ListLowStock invocations
+func (mmListLowStock *IStockRepoMock) ListLowStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStock.beforeListLowStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.ListLowStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLowStock *mIStockRepoMockListLowStock) Calls() []*IStockRepoMockListLowStockParams {
+	mmListLowStock.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockListLowStockParams, len(mmListLowStock.callArgs))
+	copy(argCopy, mmListLowStock.callArgs)
+
+	mmListLowStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLowStockDone returns true if the count of the ListLowStock invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockListLowStockDone() bool {
+	if m.ListLowStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLowStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLowStockMock.invocationsDone()
+}
+
+// MinimockListLowStockInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockListLowStockInspect() {
+	for _, e := range m.ListLowStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.ListLowStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLowStockCounter := mm_atomic.LoadUint64(&m.afterListLowStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLowStockMock.defaultExpectation != nil && afterListLowStockCounter < 1 {
+		if m.ListLowStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.ListLowStock at\n%s", m.ListLowStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.ListLowStock at\n%s with params: %#v", m.ListLowStockMock.defaultExpectation.expectationOrigins.origin, *m.ListLowStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLowStock != nil && afterListLowStockCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.ListLowStock at\n%s", m.funcListLowStockOrigin)
+	}
+
+	if !m.ListLowStockMock.invocationsDone() && afterListLowStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.ListLowStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLowStockMock.expectedInvocations), m.ListLowStockMock.expectedInvocationsOrigin, afterListLowStockCounter)
+	}
+}
+
+type mIStockRepoMockListOffers struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockListOffersExpectation
+	expectations       []*IStockRepoMockListOffersExpectation
+
+	callArgs []*IStockRepoMockListOffersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockListOffersExpectation specifies expectation struct of the IStockRepo.ListOffers
+type IStockRepoMockListOffersExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockListOffersParams
+	paramPtrs          *IStockRepoMockListOffersParamPtrs
+	expectationOrigins IStockRepoMockListOffersExpectationOrigins
+	results            *IStockRepoMockListOffersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockListOffersParams contains parameters of the IStockRepo.ListOffers
+type IStockRepoMockListOffersParams struct {
+	ctx         context.Context
+	skuID       models.SKUID
+	inStockOnly bool
+}
+
+// IStockRepoMockListOffersParamPtrs contains pointers to parameters of the IStockRepo.ListOffers
+type IStockRepoMockListOffersParamPtrs struct {
+	ctx         *context.Context
+	skuID       *models.SKUID
+	inStockOnly *bool
+}
+
+// IStockRepoMockListOffersResults contains results of the IStockRepo.ListOffers
+type IStockRepoMockListOffersResults struct {
+	oa1 []models.Offer
+	err error
+}
+
+// IStockRepoMockListOffersOrigins contains origins of expectations of the IStockRepo.ListOffers
+type IStockRepoMockListOffersExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originSkuID       string
+	originInStockOnly string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOffers *mIStockRepoMockListOffers) Optional() *mIStockRepoMockListOffers {
+	mmListOffers.optional = true
+	return mmListOffers
+}
+
+// Expect sets up expected params for IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) Expect(ctx context.Context, skuID models.SKUID, inStockOnly bool) *mIStockRepoMockListOffers {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	if mmListOffers.defaultExpectation == nil {
+		mmListOffers.defaultExpectation = &IStockRepoMockListOffersExpectation{}
+	}
+
+	if mmListOffers.defaultExpectation.paramPtrs != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by ExpectParams functions")
+	}
+
+	mmListOffers.defaultExpectation.params = &IStockRepoMockListOffersParams{ctx, skuID, inStockOnly}
+	mmListOffers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOffers.expectations {
+		if minimock.Equal(e.params, mmListOffers.defaultExpectation.params) {
+			mmListOffers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOffers.defaultExpectation.params)
+		}
+	}
+
+	return mmListOffers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockListOffers {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	if mmListOffers.defaultExpectation == nil {
+		mmListOffers.defaultExpectation = &IStockRepoMockListOffersExpectation{}
+	}
+
+	if mmListOffers.defaultExpectation.params != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Expect")
+	}
+
+	if mmListOffers.defaultExpectation.paramPtrs == nil {
+		mmListOffers.defaultExpectation.paramPtrs = &IStockRepoMockListOffersParamPtrs{}
+	}
+	mmListOffers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOffers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOffers
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockListOffers {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	if mmListOffers.defaultExpectation == nil {
+		mmListOffers.defaultExpectation = &IStockRepoMockListOffersExpectation{}
+	}
+
+	if mmListOffers.defaultExpectation.params != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Expect")
+	}
+
+	if mmListOffers.defaultExpectation.paramPtrs == nil {
+		mmListOffers.defaultExpectation.paramPtrs = &IStockRepoMockListOffersParamPtrs{}
+	}
+	mmListOffers.defaultExpectation.paramPtrs.skuID = &skuID
+	mmListOffers.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmListOffers
+}
+
+// ExpectInStockOnlyParam3 sets up expected param inStockOnly for IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) ExpectInStockOnlyParam3(inStockOnly bool) *mIStockRepoMockListOffers {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	if mmListOffers.defaultExpectation == nil {
+		mmListOffers.defaultExpectation = &IStockRepoMockListOffersExpectation{}
+	}
+
+	if mmListOffers.defaultExpectation.params != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Expect")
+	}
+
+	if mmListOffers.defaultExpectation.paramPtrs == nil {
+		mmListOffers.defaultExpectation.paramPtrs = &IStockRepoMockListOffersParamPtrs{}
+	}
+	mmListOffers.defaultExpectation.paramPtrs.inStockOnly = &inStockOnly
+	mmListOffers.defaultExpectation.expectationOrigins.originInStockOnly = minimock.CallerInfo(1)
+
+	return mmListOffers
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) Inspect(f func(ctx context.Context, skuID models.SKUID, inStockOnly bool)) *mIStockRepoMockListOffers {
+	if mmListOffers.mock.inspectFuncListOffers != nil {
+		mmListOffers.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.ListOffers")
+	}
+
+	mmListOffers.mock.inspectFuncListOffers = f
+
+	return mmListOffers
+}
+
+// Return sets up results that will be returned by IStockRepo.ListOffers
+func (mmListOffers *mIStockRepoMockListOffers) Return(oa1 []models.Offer, err error) *IStockRepoMock {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	if mmListOffers.defaultExpectation == nil {
+		mmListOffers.defaultExpectation = &IStockRepoMockListOffersExpectation{mock: mmListOffers.mock}
+	}
+	mmListOffers.defaultExpectation.results = &IStockRepoMockListOffersResults{oa1, err}
+	mmListOffers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOffers.mock
+}
+
+// Set uses given function f to mock the IStockRepo.ListOffers method
+func (mmListOffers *mIStockRepoMockListOffers) Set(f func(ctx context.Context, skuID models.SKUID, inStockOnly bool) (oa1 []models.Offer, err error)) *IStockRepoMock {
+	if mmListOffers.defaultExpectation != nil {
+		mmListOffers.mock.t.Fatalf("Default expectation is already set for the IStockRepo.ListOffers method")
+	}
+
+	if len(mmListOffers.expectations) > 0 {
+		mmListOffers.mock.t.Fatalf("Some expectations are already set for the IStockRepo.ListOffers method")
+	}
+
+	mmListOffers.mock.funcListOffers = f
+	mmListOffers.mock.funcListOffersOrigin = minimock.CallerInfo(1)
+	return mmListOffers.mock
+}
+
+// When sets expectation for the IStockRepo.ListOffers which will trigger the result defined by the following
+// Then helper
+func (mmListOffers *mIStockRepoMockListOffers) When(ctx context.Context, skuID models.SKUID, inStockOnly bool) *IStockRepoMockListOffersExpectation {
+	if mmListOffers.mock.funcListOffers != nil {
+		mmListOffers.mock.t.Fatalf("IStockRepoMock.ListOffers mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockListOffersExpectation{
+		mock:               mmListOffers.mock,
+		params:             &IStockRepoMockListOffersParams{ctx, skuID, inStockOnly},
+		expectationOrigins: IStockRepoMockListOffersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOffers.expectations = append(mmListOffers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.ListOffers return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockListOffersExpectation) Then(oa1 []models.Offer, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockListOffersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.ListOffers should be invoked
+func (mmListOffers *mIStockRepoMockListOffers) Times(n uint64) *mIStockRepoMockListOffers {
+	if n == 0 {
+		mmListOffers.mock.t.Fatalf("Times of IStockRepoMock.ListOffers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOffers.expectedInvocations, n)
+	mmListOffers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOffers
+}
+
+func (mmListOffers *mIStockRepoMockListOffers) invocationsDone() bool {
+	if len(mmListOffers.expectations) == 0 && mmListOffers.defaultExpectation == nil && mmListOffers.mock.funcListOffers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOffers.mock.afterListOffersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOffers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOffers implements mm_repository.IStockRepo
+func (mmListOffers *IStockRepoMock) ListOffers(ctx context.Context, skuID models.SKUID, inStockOnly bool) (oa1 []models.Offer, err error) {
+	mm_atomic.AddUint64(&mmListOffers.beforeListOffersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOffers.afterListOffersCounter, 1)
+
+	mmListOffers.t.Helper()
+
+	if mmListOffers.inspectFuncListOffers != nil {
+		mmListOffers.inspectFuncListOffers(ctx, skuID, inStockOnly)
+	}
+
+	mm_params := IStockRepoMockListOffersParams{ctx, skuID, inStockOnly}
+
+	// Record call args
+	mmListOffers.ListOffersMock.mutex.Lock()
+	mmListOffers.ListOffersMock.callArgs = append(mmListOffers.ListOffersMock.callArgs, &mm_params)
+	mmListOffers.ListOffersMock.mutex.Unlock()
+
+	for _, e := range mmListOffers.ListOffersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListOffers.ListOffersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOffers.ListOffersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOffers.ListOffersMock.defaultExpectation.params
+		mm_want_ptrs := mmListOffers.ListOffersMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockListOffersParams{ctx, skuID, inStockOnly}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOffers.t.Errorf("IStockRepoMock.ListOffers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOffers.ListOffersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmListOffers.t.Errorf("IStockRepoMock.ListOffers got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOffers.ListOffersMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.inStockOnly != nil && !minimock.Equal(*mm_want_ptrs.inStockOnly, mm_got.inStockOnly) {
+				mmListOffers.t.Errorf("IStockRepoMock.ListOffers got unexpected parameter inStockOnly, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOffers.ListOffersMock.defaultExpectation.expectationOrigins.originInStockOnly, *mm_want_ptrs.inStockOnly, mm_got.inStockOnly, minimock.Diff(*mm_want_ptrs.inStockOnly, mm_got.inStockOnly))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOffers.t.Errorf("IStockRepoMock.ListOffers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOffers.ListOffersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOffers.ListOffersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOffers.t.Fatal("No results are set for the IStockRepoMock.ListOffers")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListOffers.funcListOffers != nil {
+		return mmListOffers.funcListOffers(ctx, skuID, inStockOnly)
+	}
+	mmListOffers.t.Fatalf("Unexpected call to IStockRepoMock.ListOffers. %v %v %v", ctx, skuID, inStockOnly)
+	return
+}
+
+// ListOffersAfterCounter returns a count of finished IStockRepoMock.ListOffers invocations
+func (mmListOffers *IStockRepoMock) ListOffersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOffers.afterListOffersCounter)
+}
+
+// ListOffersBeforeCounter returns a count of IStockRepoMock.ListOffers invocations
+func (mmListOffers *IStockRepoMock) ListOffersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOffers.beforeListOffersCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.ListOffers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOffers *mIStockRepoMockListOffers) Calls() []*IStockRepoMockListOffersParams {
+	mmListOffers.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockListOffersParams, len(mmListOffers.callArgs))
+	copy(argCopy, mmListOffers.callArgs)
+
+	mmListOffers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOffersDone returns true if the count of the ListOffers invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockListOffersDone() bool {
+	if m.ListOffersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOffersMock.invocationsDone()
+}
+
+// MinimockListOffersInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockListOffersInspect() {
+	for _, e := range m.ListOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.ListOffers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOffersCounter := mm_atomic.LoadUint64(&m.afterListOffersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOffersMock.defaultExpectation != nil && afterListOffersCounter < 1 {
+		if m.ListOffersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.ListOffers at\n%s", m.ListOffersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.ListOffers at\n%s with params: %#v", m.ListOffersMock.defaultExpectation.expectationOrigins.origin, *m.ListOffersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOffers != nil && afterListOffersCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.ListOffers at\n%s", m.funcListOffersOrigin)
+	}
+
+	if !m.ListOffersMock.invocationsDone() && afterListOffersCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.ListOffers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOffersMock.expectedInvocations), m.ListOffersMock.expectedInvocationsOrigin, afterListOffersCounter)
+	}
+}
+
+type mIStockRepoMockMarkPriceApplied struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockMarkPriceAppliedExpectation
+	expectations       []*IStockRepoMockMarkPriceAppliedExpectation
+
+	callArgs []*IStockRepoMockMarkPriceAppliedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockMarkPriceAppliedExpectation specifies expectation struct of the IStockRepo.MarkPriceApplied
+type IStockRepoMockMarkPriceAppliedExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockMarkPriceAppliedParams
+	paramPtrs          *IStockRepoMockMarkPriceAppliedParamPtrs
+	expectationOrigins IStockRepoMockMarkPriceAppliedExpectationOrigins
+	results            *IStockRepoMockMarkPriceAppliedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockMarkPriceAppliedParams contains parameters of the IStockRepo.MarkPriceApplied
+type IStockRepoMockMarkPriceAppliedParams struct {
+	ctx       context.Context
+	id        int64
+	appliedAt time.Time
+}
+
+// IStockRepoMockMarkPriceAppliedParamPtrs contains pointers to parameters of the IStockRepo.MarkPriceApplied
+type IStockRepoMockMarkPriceAppliedParamPtrs struct {
+	ctx       *context.Context
+	id        *int64
+	appliedAt *time.Time
+}
+
+// IStockRepoMockMarkPriceAppliedResults contains results of the IStockRepo.MarkPriceApplied
+type IStockRepoMockMarkPriceAppliedResults struct {
+	err error
+}
+
+// IStockRepoMockMarkPriceAppliedOrigins contains origins of expectations of the IStockRepo.MarkPriceApplied
+type IStockRepoMockMarkPriceAppliedExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originAppliedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Optional() *mIStockRepoMockMarkPriceApplied {
+	mmMarkPriceApplied.optional = true
+	return mmMarkPriceApplied
+}
+
+// Expect sets up expected params for IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Expect(ctx context.Context, id int64, appliedAt time.Time) *mIStockRepoMockMarkPriceApplied {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation == nil {
+		mmMarkPriceApplied.defaultExpectation = &IStockRepoMockMarkPriceAppliedExpectation{}
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.paramPtrs != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by ExpectParams functions")
+	}
+
+	mmMarkPriceApplied.defaultExpectation.params = &IStockRepoMockMarkPriceAppliedParams{ctx, id, appliedAt}
+	mmMarkPriceApplied.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkPriceApplied.expectations {
+		if minimock.Equal(e.params, mmMarkPriceApplied.defaultExpectation.params) {
+			mmMarkPriceApplied.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkPriceApplied.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkPriceApplied
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockMarkPriceApplied {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation == nil {
+		mmMarkPriceApplied.defaultExpectation = &IStockRepoMockMarkPriceAppliedExpectation{}
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.params != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Expect")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.paramPtrs == nil {
+		mmMarkPriceApplied.defaultExpectation.paramPtrs = &IStockRepoMockMarkPriceAppliedParamPtrs{}
+	}
+	mmMarkPriceApplied.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkPriceApplied.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkPriceApplied
+}
+
+// ExpectIdParam2 sets up expected param id for IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) ExpectIdParam2(id int64) *mIStockRepoMockMarkPriceApplied {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation == nil {
+		mmMarkPriceApplied.defaultExpectation = &IStockRepoMockMarkPriceAppliedExpectation{}
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.params != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Expect")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.paramPtrs == nil {
+		mmMarkPriceApplied.defaultExpectation.paramPtrs = &IStockRepoMockMarkPriceAppliedParamPtrs{}
+	}
+	mmMarkPriceApplied.defaultExpectation.paramPtrs.id = &id
+	mmMarkPriceApplied.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkPriceApplied
+}
+
+// ExpectAppliedAtParam3 sets up expected param appliedAt for IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) ExpectAppliedAtParam3(appliedAt time.Time) *mIStockRepoMockMarkPriceApplied {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation == nil {
+		mmMarkPriceApplied.defaultExpectation = &IStockRepoMockMarkPriceAppliedExpectation{}
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.params != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Expect")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation.paramPtrs == nil {
+		mmMarkPriceApplied.defaultExpectation.paramPtrs = &IStockRepoMockMarkPriceAppliedParamPtrs{}
+	}
+	mmMarkPriceApplied.defaultExpectation.paramPtrs.appliedAt = &appliedAt
+	mmMarkPriceApplied.defaultExpectation.expectationOrigins.originAppliedAt = minimock.CallerInfo(1)
+
+	return mmMarkPriceApplied
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Inspect(f func(ctx context.Context, id int64, appliedAt time.Time)) *mIStockRepoMockMarkPriceApplied {
+	if mmMarkPriceApplied.mock.inspectFuncMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.MarkPriceApplied")
+	}
+
+	mmMarkPriceApplied.mock.inspectFuncMarkPriceApplied = f
+
+	return mmMarkPriceApplied
+}
+
+// Return sets up results that will be returned by IStockRepo.MarkPriceApplied
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Return(err error) *IStockRepoMock {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	if mmMarkPriceApplied.defaultExpectation == nil {
+		mmMarkPriceApplied.defaultExpectation = &IStockRepoMockMarkPriceAppliedExpectation{mock: mmMarkPriceApplied.mock}
+	}
+	mmMarkPriceApplied.defaultExpectation.results = &IStockRepoMockMarkPriceAppliedResults{err}
+	mmMarkPriceApplied.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkPriceApplied.mock
+}
+
+// Set uses given function f to mock the IStockRepo.MarkPriceApplied method
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Set(f func(ctx context.Context, id int64, appliedAt time.Time) (err error)) *IStockRepoMock {
+	if mmMarkPriceApplied.defaultExpectation != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("Default expectation is already set for the IStockRepo.MarkPriceApplied method")
+	}
+
+	if len(mmMarkPriceApplied.expectations) > 0 {
+		mmMarkPriceApplied.mock.t.Fatalf("Some expectations are already set for the IStockRepo.MarkPriceApplied method")
+	}
+
+	mmMarkPriceApplied.mock.funcMarkPriceApplied = f
+	mmMarkPriceApplied.mock.funcMarkPriceAppliedOrigin = minimock.CallerInfo(1)
+	return mmMarkPriceApplied.mock
+}
+
+// When sets expectation for the IStockRepo.MarkPriceApplied which will trigger the result defined by the following
+// Then helper
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) When(ctx context.Context, id int64, appliedAt time.Time) *IStockRepoMockMarkPriceAppliedExpectation {
+	if mmMarkPriceApplied.mock.funcMarkPriceApplied != nil {
+		mmMarkPriceApplied.mock.t.Fatalf("IStockRepoMock.MarkPriceApplied mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockMarkPriceAppliedExpectation{
+		mock:               mmMarkPriceApplied.mock,
+		params:             &IStockRepoMockMarkPriceAppliedParams{ctx, id, appliedAt},
+		expectationOrigins: IStockRepoMockMarkPriceAppliedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkPriceApplied.expectations = append(mmMarkPriceApplied.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.MarkPriceApplied return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockMarkPriceAppliedExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockMarkPriceAppliedResults{err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.MarkPriceApplied should be invoked
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Times(n uint64) *mIStockRepoMockMarkPriceApplied {
+	if n == 0 {
+		mmMarkPriceApplied.mock.t.Fatalf("Times of IStockRepoMock.MarkPriceApplied mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkPriceApplied.expectedInvocations, n)
+	mmMarkPriceApplied.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkPriceApplied
+}
+
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) invocationsDone() bool {
+	if len(mmMarkPriceApplied.expectations) == 0 && mmMarkPriceApplied.defaultExpectation == nil && mmMarkPriceApplied.mock.funcMarkPriceApplied == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkPriceApplied.mock.afterMarkPriceAppliedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkPriceApplied.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkPriceApplied implements mm_repository.IStockRepo
+func (mmMarkPriceApplied *IStockRepoMock) MarkPriceApplied(ctx context.Context, id int64, appliedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkPriceApplied.beforeMarkPriceAppliedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkPriceApplied.afterMarkPriceAppliedCounter, 1)
+
+	mmMarkPriceApplied.t.Helper()
+
+	if mmMarkPriceApplied.inspectFuncMarkPriceApplied != nil {
+		mmMarkPriceApplied.inspectFuncMarkPriceApplied(ctx, id, appliedAt)
+	}
+
+	mm_params := IStockRepoMockMarkPriceAppliedParams{ctx, id, appliedAt}
+
+	// Record call args
+	mmMarkPriceApplied.MarkPriceAppliedMock.mutex.Lock()
+	mmMarkPriceApplied.MarkPriceAppliedMock.callArgs = append(mmMarkPriceApplied.MarkPriceAppliedMock.callArgs, &mm_params)
+	mmMarkPriceApplied.MarkPriceAppliedMock.mutex.Unlock()
+
+	for _, e := range mmMarkPriceApplied.MarkPriceAppliedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockMarkPriceAppliedParams{ctx, id, appliedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkPriceApplied.t.Errorf("IStockRepoMock.MarkPriceApplied got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkPriceApplied.t.Errorf("IStockRepoMock.MarkPriceApplied got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.appliedAt != nil && !minimock.Equal(*mm_want_ptrs.appliedAt, mm_got.appliedAt) {
+				mmMarkPriceApplied.t.Errorf("IStockRepoMock.MarkPriceApplied got unexpected parameter appliedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.expectationOrigins.originAppliedAt, *mm_want_ptrs.appliedAt, mm_got.appliedAt, minimock.Diff(*mm_want_ptrs.appliedAt, mm_got.appliedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkPriceApplied.t.Errorf("IStockRepoMock.MarkPriceApplied got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkPriceApplied.MarkPriceAppliedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkPriceApplied.t.Fatal("No results are set for the IStockRepoMock.MarkPriceApplied")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkPriceApplied.funcMarkPriceApplied != nil {
+		return mmMarkPriceApplied.funcMarkPriceApplied(ctx, id, appliedAt)
+	}
+	mmMarkPriceApplied.t.Fatalf("Unexpected call to IStockRepoMock.MarkPriceApplied. %v %v %v", ctx, id, appliedAt)
+	return
+}
+
+// MarkPriceAppliedAfterCounter returns a count of finished IStockRepoMock.MarkPriceApplied invocations
+func (mmMarkPriceApplied *IStockRepoMock) MarkPriceAppliedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPriceApplied.afterMarkPriceAppliedCounter)
+}
+
+// MarkPriceAppliedBeforeCounter returns a count of IStockRepoMock.MarkPriceApplied invocations
+func (mmMarkPriceApplied *IStockRepoMock) MarkPriceAppliedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPriceApplied.beforeMarkPriceAppliedCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.MarkPriceApplied.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkPriceApplied *mIStockRepoMockMarkPriceApplied) Calls() []*IStockRepoMockMarkPriceAppliedParams {
+	mmMarkPriceApplied.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockMarkPriceAppliedParams, len(mmMarkPriceApplied.callArgs))
+	copy(argCopy, mmMarkPriceApplied.callArgs)
+
+	mmMarkPriceApplied.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkPriceAppliedDone returns true if the count of the MarkPriceApplied invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockMarkPriceAppliedDone() bool {
+	if m.MarkPriceAppliedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkPriceAppliedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkPriceAppliedMock.invocationsDone()
+}
+
+// MinimockMarkPriceAppliedInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockMarkPriceAppliedInspect() {
+	for _, e := range m.MarkPriceAppliedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.MarkPriceApplied at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkPriceAppliedCounter := mm_atomic.LoadUint64(&m.afterMarkPriceAppliedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkPriceAppliedMock.defaultExpectation != nil && afterMarkPriceAppliedCounter < 1 {
+		if m.MarkPriceAppliedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.MarkPriceApplied at\n%s", m.MarkPriceAppliedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.MarkPriceApplied at\n%s with params: %#v", m.MarkPriceAppliedMock.defaultExpectation.expectationOrigins.origin, *m.MarkPriceAppliedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkPriceApplied != nil && afterMarkPriceAppliedCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.MarkPriceApplied at\n%s", m.funcMarkPriceAppliedOrigin)
+	}
+
+	if !m.MarkPriceAppliedMock.invocationsDone() && afterMarkPriceAppliedCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.MarkPriceApplied at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkPriceAppliedMock.expectedInvocations), m.MarkPriceAppliedMock.expectedInvocationsOrigin, afterMarkPriceAppliedCounter)
+	}
+}
+
+type mIStockRepoMockPurgeDeletedStock struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockPurgeDeletedStockExpectation
+	expectations       []*IStockRepoMockPurgeDeletedStockExpectation
+
+	callArgs []*IStockRepoMockPurgeDeletedStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockPurgeDeletedStockExpectation specifies expectation struct of the IStockRepo.PurgeDeletedStock
+type IStockRepoMockPurgeDeletedStockExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockPurgeDeletedStockParams
+	paramPtrs          *IStockRepoMockPurgeDeletedStockParamPtrs
+	expectationOrigins IStockRepoMockPurgeDeletedStockExpectationOrigins
+	results            *IStockRepoMockPurgeDeletedStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockPurgeDeletedStockParams contains parameters of the IStockRepo.PurgeDeletedStock
+type IStockRepoMockPurgeDeletedStockParams struct {
+	ctx           context.Context
+	deletedBefore time.Time
+}
+
+// IStockRepoMockPurgeDeletedStockParamPtrs contains pointers to parameters of the IStockRepo.PurgeDeletedStock
+type IStockRepoMockPurgeDeletedStockParamPtrs struct {
+	ctx           *context.Context
+	deletedBefore *time.Time
+}
+
+// IStockRepoMockPurgeDeletedStockResults contains results of the IStockRepo.PurgeDeletedStock
+type IStockRepoMockPurgeDeletedStockResults struct {
+	i1  int64
+	err error
+}
+
+// IStockRepoMockPurgeDeletedStockOrigins contains origins of expectations of the IStockRepo.PurgeDeletedStock
+type IStockRepoMockPurgeDeletedStockExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originDeletedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Optional() *mIStockRepoMockPurgeDeletedStock {
+	mmPurgeDeletedStock.optional = true
+	return mmPurgeDeletedStock
+}
+
+// Expect sets up expected params for IStockRepo.PurgeDeletedStock
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Expect(ctx context.Context, deletedBefore time.Time) *mIStockRepoMockPurgeDeletedStock {
+	if mmPurgeDeletedStock.mock.funcPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation == nil {
+		mmPurgeDeletedStock.defaultExpectation = &IStockRepoMockPurgeDeletedStockExpectation{}
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedStock.defaultExpectation.params = &IStockRepoMockPurgeDeletedStockParams{ctx, deletedBefore}
+	mmPurgeDeletedStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedStock.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedStock.defaultExpectation.params) {
+			mmPurgeDeletedStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedStock.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.PurgeDeletedStock
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockPurgeDeletedStock {
+	if mmPurgeDeletedStock.mock.funcPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation == nil {
+		mmPurgeDeletedStock.defaultExpectation = &IStockRepoMockPurgeDeletedStockExpectation{}
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation.params != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedStock.defaultExpectation.paramPtrs = &IStockRepoMockPurgeDeletedStockParamPtrs{}
+	}
+	mmPurgeDeletedStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedStock
+}
+
+// ExpectDeletedBeforeParam2 sets up expected param deletedBefore for IStockRepo.PurgeDeletedStock
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) ExpectDeletedBeforeParam2(deletedBefore time.Time) *mIStockRepoMockPurgeDeletedStock {
+	if mmPurgeDeletedStock.mock.funcPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation == nil {
+		mmPurgeDeletedStock.defaultExpectation = &IStockRepoMockPurgeDeletedStockExpectation{}
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation.params != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedStock.defaultExpectation.paramPtrs = &IStockRepoMockPurgeDeletedStockParamPtrs{}
+	}
+	mmPurgeDeletedStock.defaultExpectation.paramPtrs.deletedBefore = &deletedBefore
+	mmPurgeDeletedStock.defaultExpectation.expectationOrigins.originDeletedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.PurgeDeletedStock
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Inspect(f func(ctx context.Context, deletedBefore time.Time)) *mIStockRepoMockPurgeDeletedStock {
+	if mmPurgeDeletedStock.mock.inspectFuncPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.PurgeDeletedStock")
+	}
+
+	mmPurgeDeletedStock.mock.inspectFuncPurgeDeletedStock = f
+
+	return mmPurgeDeletedStock
+}
+
+// Return sets up results that will be returned by IStockRepo.PurgeDeletedStock
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Return(i1 int64, err error) *IStockRepoMock {
+	if mmPurgeDeletedStock.mock.funcPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStock.defaultExpectation == nil {
+		mmPurgeDeletedStock.defaultExpectation = &IStockRepoMockPurgeDeletedStockExpectation{mock: mmPurgeDeletedStock.mock}
+	}
+	mmPurgeDeletedStock.defaultExpectation.results = &IStockRepoMockPurgeDeletedStockResults{i1, err}
+	mmPurgeDeletedStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStock.mock
+}
+
+// Set uses given function f to mock the IStockRepo.PurgeDeletedStock method
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Set(f func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)) *IStockRepoMock {
+	if mmPurgeDeletedStock.defaultExpectation != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.PurgeDeletedStock method")
+	}
+
+	if len(mmPurgeDeletedStock.expectations) > 0 {
+		mmPurgeDeletedStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.PurgeDeletedStock method")
+	}
+
+	mmPurgeDeletedStock.mock.funcPurgeDeletedStock = f
+	mmPurgeDeletedStock.mock.funcPurgeDeletedStockOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStock.mock
+}
+
+// When sets expectation for the IStockRepo.PurgeDeletedStock which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) When(ctx context.Context, deletedBefore time.Time) *IStockRepoMockPurgeDeletedStockExpectation {
+	if mmPurgeDeletedStock.mock.funcPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.mock.t.Fatalf("IStockRepoMock.PurgeDeletedStock mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockPurgeDeletedStockExpectation{
+		mock:               mmPurgeDeletedStock.mock,
+		params:             &IStockRepoMockPurgeDeletedStockParams{ctx, deletedBefore},
+		expectationOrigins: IStockRepoMockPurgeDeletedStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedStock.expectations = append(mmPurgeDeletedStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.PurgeDeletedStock return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockPurgeDeletedStockExpectation) Then(i1 int64, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockPurgeDeletedStockResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.PurgeDeletedStock should be invoked
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Times(n uint64) *mIStockRepoMockPurgeDeletedStock {
+	if n == 0 {
+		mmPurgeDeletedStock.mock.t.Fatalf("Times of IStockRepoMock.PurgeDeletedStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedStock.expectedInvocations, n)
+	mmPurgeDeletedStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStock
+}
+
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) invocationsDone() bool {
+	if len(mmPurgeDeletedStock.expectations) == 0 && mmPurgeDeletedStock.defaultExpectation == nil && mmPurgeDeletedStock.mock.funcPurgeDeletedStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedStock.mock.afterPurgeDeletedStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedStock implements mm_repository.IStockRepo
+func (mmPurgeDeletedStock *IStockRepoMock) PurgeDeletedStock(ctx context.Context, deletedBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedStock.beforePurgeDeletedStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedStock.afterPurgeDeletedStockCounter, 1)
+
+	mmPurgeDeletedStock.t.Helper()
+
+	if mmPurgeDeletedStock.inspectFuncPurgeDeletedStock != nil {
+		mmPurgeDeletedStock.inspectFuncPurgeDeletedStock(ctx, deletedBefore)
+	}
+
+	mm_params := IStockRepoMockPurgeDeletedStockParams{ctx, deletedBefore}
+
+	// Record call args
+	mmPurgeDeletedStock.PurgeDeletedStockMock.mutex.Lock()
+	mmPurgeDeletedStock.PurgeDeletedStockMock.callArgs = append(mmPurgeDeletedStock.PurgeDeletedStockMock.callArgs, &mm_params)
+	mmPurgeDeletedStock.PurgeDeletedStockMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedStock.PurgeDeletedStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockPurgeDeletedStockParams{ctx, deletedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedStock.t.Errorf("IStockRepoMock.PurgeDeletedStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deletedBefore != nil && !minimock.Equal(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore) {
+				mmPurgeDeletedStock.t.Errorf("IStockRepoMock.PurgeDeletedStock got unexpected parameter deletedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.expectationOrigins.originDeletedBefore, *mm_want_ptrs.deletedBefore, mm_got.deletedBefore, minimock.Diff(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedStock.t.Errorf("IStockRepoMock.PurgeDeletedStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedStock.PurgeDeletedStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedStock.t.Fatal("No results are set for the IStockRepoMock.PurgeDeletedStock")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeletedStock.funcPurgeDeletedStock != nil {
+		return mmPurgeDeletedStock.funcPurgeDeletedStock(ctx, deletedBefore)
+	}
+	mmPurgeDeletedStock.t.Fatalf("Unexpected call to IStockRepoMock.PurgeDeletedStock. %v %v", ctx, deletedBefore)
+	return
+}
+
+// PurgeDeletedStockAfterCounter returns a count of finished IStockRepoMock.PurgeDeletedStock invocations
+func (mmPurgeDeletedStock *IStockRepoMock) PurgeDeletedStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedStock.afterPurgeDeletedStockCounter)
+}
+
+// PurgeDeletedStockBeforeCounter returns a count of IStockRepoMock.PurgeDeletedStock invocations
+func (mmPurgeDeletedStock *IStockRepoMock) PurgeDeletedStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedStock.beforePurgeDeletedStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.PurgeDeletedStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedStock *mIStockRepoMockPurgeDeletedStock) Calls() []*IStockRepoMockPurgeDeletedStockParams {
+	mmPurgeDeletedStock.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockPurgeDeletedStockParams, len(mmPurgeDeletedStock.callArgs))
+	copy(argCopy, mmPurgeDeletedStock.callArgs)
+
+	mmPurgeDeletedStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedStockDone returns true if the count of the PurgeDeletedStock invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockPurgeDeletedStockDone() bool {
+	if m.PurgeDeletedStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedStockMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedStockInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockPurgeDeletedStockInspect() {
+	for _, e := range m.PurgeDeletedStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.PurgeDeletedStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedStockCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedStockMock.defaultExpectation != nil && afterPurgeDeletedStockCounter < 1 {
+		if m.PurgeDeletedStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.PurgeDeletedStock at\n%s", m.PurgeDeletedStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.PurgeDeletedStock at\n%s with params: %#v", m.PurgeDeletedStockMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedStock != nil && afterPurgeDeletedStockCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.PurgeDeletedStock at\n%s", m.funcPurgeDeletedStockOrigin)
+	}
+
+	if !m.PurgeDeletedStockMock.invocationsDone() && afterPurgeDeletedStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.PurgeDeletedStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedStockMock.expectedInvocations), m.PurgeDeletedStockMock.expectedInvocationsOrigin, afterPurgeDeletedStockCounter)
+	}
+}
+
+type mIStockRepoMockRestoreStock struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockRestoreStockExpectation
+	expectations       []*IStockRepoMockRestoreStockExpectation
+
+	callArgs []*IStockRepoMockRestoreStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockRestoreStockExpectation specifies expectation struct of the IStockRepo.RestoreStock
+type IStockRepoMockRestoreStockExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockRestoreStockParams
+	paramPtrs          *IStockRepoMockRestoreStockParamPtrs
+	expectationOrigins IStockRepoMockRestoreStockExpectationOrigins
+	results            *IStockRepoMockRestoreStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockRestoreStockParams contains parameters of the IStockRepo.RestoreStock
+type IStockRepoMockRestoreStockParams struct {
+	ctx    context.Context
+	skuID  models.SKUID
+	userID models.UserID
+}
+
+// IStockRepoMockRestoreStockParamPtrs contains pointers to parameters of the IStockRepo.RestoreStock
+type IStockRepoMockRestoreStockParamPtrs struct {
+	ctx    *context.Context
+	skuID  *models.SKUID
+	userID *models.UserID
+}
+
+// IStockRepoMockRestoreStockResults contains results of the IStockRepo.RestoreStock
+type IStockRepoMockRestoreStockResults struct {
+	sa1 []models.Stock
+	err error
+}
+
+// IStockRepoMockRestoreStockOrigins contains origins of expectations of the IStockRepo.RestoreStock
+type IStockRepoMockRestoreStockExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuID  string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Optional() *mIStockRepoMockRestoreStock {
+	mmRestoreStock.optional = true
+	return mmRestoreStock
+}
+
+// Expect sets up expected params for IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Expect(ctx context.Context, skuID models.SKUID, userID models.UserID) *mIStockRepoMockRestoreStock {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	if mmRestoreStock.defaultExpectation == nil {
+		mmRestoreStock.defaultExpectation = &IStockRepoMockRestoreStockExpectation{}
+	}
+
+	if mmRestoreStock.defaultExpectation.paramPtrs != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreStock.defaultExpectation.params = &IStockRepoMockRestoreStockParams{ctx, skuID, userID}
+	mmRestoreStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreStock.expectations {
+		if minimock.Equal(e.params, mmRestoreStock.defaultExpectation.params) {
+			mmRestoreStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreStock.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockRestoreStock {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	if mmRestoreStock.defaultExpectation == nil {
+		mmRestoreStock.defaultExpectation = &IStockRepoMockRestoreStockExpectation{}
+	}
+
+	if mmRestoreStock.defaultExpectation.params != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Expect")
+	}
+
+	if mmRestoreStock.defaultExpectation.paramPtrs == nil {
+		mmRestoreStock.defaultExpectation.paramPtrs = &IStockRepoMockRestoreStockParamPtrs{}
+	}
+	mmRestoreStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreStock
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockRestoreStock {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	if mmRestoreStock.defaultExpectation == nil {
+		mmRestoreStock.defaultExpectation = &IStockRepoMockRestoreStockExpectation{}
+	}
+
+	if mmRestoreStock.defaultExpectation.params != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Expect")
+	}
+
+	if mmRestoreStock.defaultExpectation.paramPtrs == nil {
+		mmRestoreStock.defaultExpectation.paramPtrs = &IStockRepoMockRestoreStockParamPtrs{}
+	}
+	mmRestoreStock.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRestoreStock.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRestoreStock
+}
+
+// ExpectUserIDParam3 sets up expected param userID for IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) ExpectUserIDParam3(userID models.UserID) *mIStockRepoMockRestoreStock {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	if mmRestoreStock.defaultExpectation == nil {
+		mmRestoreStock.defaultExpectation = &IStockRepoMockRestoreStockExpectation{}
+	}
+
+	if mmRestoreStock.defaultExpectation.params != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Expect")
+	}
+
+	if mmRestoreStock.defaultExpectation.paramPtrs == nil {
+		mmRestoreStock.defaultExpectation.paramPtrs = &IStockRepoMockRestoreStockParamPtrs{}
+	}
+	mmRestoreStock.defaultExpectation.paramPtrs.userID = &userID
+	mmRestoreStock.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRestoreStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Inspect(f func(ctx context.Context, skuID models.SKUID, userID models.UserID)) *mIStockRepoMockRestoreStock {
+	if mmRestoreStock.mock.inspectFuncRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.RestoreStock")
+	}
+
+	mmRestoreStock.mock.inspectFuncRestoreStock = f
+
+	return mmRestoreStock
+}
+
+// Return sets up results that will be returned by IStockRepo.RestoreStock
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Return(sa1 []models.Stock, err error) *IStockRepoMock {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	if mmRestoreStock.defaultExpectation == nil {
+		mmRestoreStock.defaultExpectation = &IStockRepoMockRestoreStockExpectation{mock: mmRestoreStock.mock}
+	}
+	mmRestoreStock.defaultExpectation.results = &IStockRepoMockRestoreStockResults{sa1, err}
+	mmRestoreStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreStock.mock
+}
+
+// Set uses given function f to mock the IStockRepo.RestoreStock method
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Set(f func(ctx context.Context, skuID models.SKUID, userID models.UserID) (sa1 []models.Stock, err error)) *IStockRepoMock {
+	if mmRestoreStock.defaultExpectation != nil {
+		mmRestoreStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.RestoreStock method")
+	}
+
+	if len(mmRestoreStock.expectations) > 0 {
+		mmRestoreStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.RestoreStock method")
+	}
+
+	mmRestoreStock.mock.funcRestoreStock = f
+	mmRestoreStock.mock.funcRestoreStockOrigin = minimock.CallerInfo(1)
+	return mmRestoreStock.mock
+}
+
+// When sets expectation for the IStockRepo.RestoreStock which will trigger the result defined by the following
+// Then helper
+func (mmRestoreStock *mIStockRepoMockRestoreStock) When(ctx context.Context, skuID models.SKUID, userID models.UserID) *IStockRepoMockRestoreStockExpectation {
+	if mmRestoreStock.mock.funcRestoreStock != nil {
+		mmRestoreStock.mock.t.Fatalf("IStockRepoMock.RestoreStock mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockRestoreStockExpectation{
+		mock:               mmRestoreStock.mock,
+		params:             &IStockRepoMockRestoreStockParams{ctx, skuID, userID},
+		expectationOrigins: IStockRepoMockRestoreStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreStock.expectations = append(mmRestoreStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.RestoreStock return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockRestoreStockExpectation) Then(sa1 []models.Stock, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockRestoreStockResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.RestoreStock should be invoked
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Times(n uint64) *mIStockRepoMockRestoreStock {
+	if n == 0 {
+		mmRestoreStock.mock.t.Fatalf("Times of IStockRepoMock.RestoreStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreStock.expectedInvocations, n)
+	mmRestoreStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreStock
+}
+
+func (mmRestoreStock *mIStockRepoMockRestoreStock) invocationsDone() bool {
+	if len(mmRestoreStock.expectations) == 0 && mmRestoreStock.defaultExpectation == nil && mmRestoreStock.mock.funcRestoreStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreStock.mock.afterRestoreStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreStock implements mm_repository.IStockRepo
+func (mmRestoreStock *IStockRepoMock) RestoreStock(ctx context.Context, skuID models.SKUID, userID models.UserID) (sa1 []models.Stock, err error) {
+	mm_atomic.AddUint64(&mmRestoreStock.beforeRestoreStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreStock.afterRestoreStockCounter, 1)
+
+	mmRestoreStock.t.Helper()
+
+	if mmRestoreStock.inspectFuncRestoreStock != nil {
+		mmRestoreStock.inspectFuncRestoreStock(ctx, skuID, userID)
+	}
+
+	mm_params := IStockRepoMockRestoreStockParams{ctx, skuID, userID}
+
+	// Record call args
+	mmRestoreStock.RestoreStockMock.mutex.Lock()
+	mmRestoreStock.RestoreStockMock.callArgs = append(mmRestoreStock.RestoreStockMock.callArgs, &mm_params)
+	mmRestoreStock.RestoreStockMock.mutex.Unlock()
+
+	for _, e := range mmRestoreStock.RestoreStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmRestoreStock.RestoreStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreStock.RestoreStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreStock.RestoreStockMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreStock.RestoreStockMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockRestoreStockParams{ctx, skuID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreStock.t.Errorf("IStockRepoMock.RestoreStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStock.RestoreStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRestoreStock.t.Errorf("IStockRepoMock.RestoreStock got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStock.RestoreStockMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRestoreStock.t.Errorf("IStockRepoMock.RestoreStock got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStock.RestoreStockMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreStock.t.Errorf("IStockRepoMock.RestoreStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreStock.RestoreStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreStock.RestoreStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreStock.t.Fatal("No results are set for the IStockRepoMock.RestoreStock")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmRestoreStock.funcRestoreStock != nil {
+		return mmRestoreStock.funcRestoreStock(ctx, skuID, userID)
+	}
+	mmRestoreStock.t.Fatalf("Unexpected call to IStockRepoMock.RestoreStock. %v %v %v", ctx, skuID, userID)
+	return
+}
+
+// RestoreStockAfterCounter returns a count of finished IStockRepoMock.RestoreStock invocations
+func (mmRestoreStock *IStockRepoMock) RestoreStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreStock.afterRestoreStockCounter)
+}
+
+// RestoreStockBeforeCounter returns a count of IStockRepoMock.RestoreStock invocations
+func (mmRestoreStock *IStockRepoMock) RestoreStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreStock.beforeRestoreStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.RestoreStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreStock *mIStockRepoMockRestoreStock) Calls() []*IStockRepoMockRestoreStockParams {
+	mmRestoreStock.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockRestoreStockParams, len(mmRestoreStock.callArgs))
+	copy(argCopy, mmRestoreStock.callArgs)
+
+	mmRestoreStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreStockDone returns true if the count of the RestoreStock invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockRestoreStockDone() bool {
+	if m.RestoreStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreStockMock.invocationsDone()
+}
+
+// MinimockRestoreStockInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockRestoreStockInspect() {
+	for _, e := range m.RestoreStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.RestoreStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreStockCounter := mm_atomic.LoadUint64(&m.afterRestoreStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreStockMock.defaultExpectation != nil && afterRestoreStockCounter < 1 {
+		if m.RestoreStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.RestoreStock at\n%s", m.RestoreStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.RestoreStock at\n%s with params: %#v", m.RestoreStockMock.defaultExpectation.expectationOrigins.origin, *m.RestoreStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreStock != nil && afterRestoreStockCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.RestoreStock at\n%s", m.funcRestoreStockOrigin)
+	}
+
+	if !m.RestoreStockMock.invocationsDone() && afterRestoreStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.RestoreStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreStockMock.expectedInvocations), m.RestoreStockMock.expectedInvocationsOrigin, afterRestoreStockCounter)
+	}
+}
+
+type mIStockRepoMockSchedulePrice struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockSchedulePriceExpectation
+	expectations       []*IStockRepoMockSchedulePriceExpectation
+
+	callArgs []*IStockRepoMockSchedulePriceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockSchedulePriceExpectation specifies expectation struct of the IStockRepo.SchedulePrice
+type IStockRepoMockSchedulePriceExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockSchedulePriceParams
+	paramPtrs          *IStockRepoMockSchedulePriceParamPtrs
+	expectationOrigins IStockRepoMockSchedulePriceExpectationOrigins
+	results            *IStockRepoMockSchedulePriceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockSchedulePriceParams contains parameters of the IStockRepo.SchedulePrice
+type IStockRepoMockSchedulePriceParams struct {
+	ctx   context.Context
+	price models.ScheduledPrice
+}
+
+// IStockRepoMockSchedulePriceParamPtrs contains pointers to parameters of the IStockRepo.SchedulePrice
+type IStockRepoMockSchedulePriceParamPtrs struct {
+	ctx   *context.Context
+	price *models.ScheduledPrice
+}
+
+// IStockRepoMockSchedulePriceResults contains results of the IStockRepo.SchedulePrice
+type IStockRepoMockSchedulePriceResults struct {
+	i1  int64
+	err error
+}
+
+// IStockRepoMockSchedulePriceOrigins contains origins of expectations of the IStockRepo.SchedulePrice
+type IStockRepoMockSchedulePriceExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPrice string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Optional() *mIStockRepoMockSchedulePrice {
+	mmSchedulePrice.optional = true
+	return mmSchedulePrice
+}
+
+// Expect sets up expected params for IStockRepo.SchedulePrice
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Expect(ctx context.Context, price models.ScheduledPrice) *mIStockRepoMockSchedulePrice {
+	if mmSchedulePrice.mock.funcSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Set")
+	}
+
+	if mmSchedulePrice.defaultExpectation == nil {
+		mmSchedulePrice.defaultExpectation = &IStockRepoMockSchedulePriceExpectation{}
+	}
+
+	if mmSchedulePrice.defaultExpectation.paramPtrs != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by ExpectParams functions")
+	}
+
+	mmSchedulePrice.defaultExpectation.params = &IStockRepoMockSchedulePriceParams{ctx, price}
+	mmSchedulePrice.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSchedulePrice.expectations {
+		if minimock.Equal(e.params, mmSchedulePrice.defaultExpectation.params) {
+			mmSchedulePrice.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSchedulePrice.defaultExpectation.params)
+		}
+	}
+
+	return mmSchedulePrice
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.SchedulePrice
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockSchedulePrice {
+	if mmSchedulePrice.mock.funcSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Set")
+	}
+
+	if mmSchedulePrice.defaultExpectation == nil {
+		mmSchedulePrice.defaultExpectation = &IStockRepoMockSchedulePriceExpectation{}
+	}
+
+	if mmSchedulePrice.defaultExpectation.params != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Expect")
+	}
+
+	if mmSchedulePrice.defaultExpectation.paramPtrs == nil {
+		mmSchedulePrice.defaultExpectation.paramPtrs = &IStockRepoMockSchedulePriceParamPtrs{}
+	}
+	mmSchedulePrice.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSchedulePrice.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSchedulePrice
+}
+
+// ExpectPriceParam2 sets up expected param price for IStockRepo.SchedulePrice
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) ExpectPriceParam2(price models.ScheduledPrice) *mIStockRepoMockSchedulePrice {
+	if mmSchedulePrice.mock.funcSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Set")
+	}
+
+	if mmSchedulePrice.defaultExpectation == nil {
+		mmSchedulePrice.defaultExpectation = &IStockRepoMockSchedulePriceExpectation{}
+	}
+
+	if mmSchedulePrice.defaultExpectation.params != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Expect")
+	}
+
+	if mmSchedulePrice.defaultExpectation.paramPtrs == nil {
+		mmSchedulePrice.defaultExpectation.paramPtrs = &IStockRepoMockSchedulePriceParamPtrs{}
+	}
+	mmSchedulePrice.defaultExpectation.paramPtrs.price = &price
+	mmSchedulePrice.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
+
+	return mmSchedulePrice
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.SchedulePrice
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Inspect(f func(ctx context.Context, price models.ScheduledPrice)) *mIStockRepoMockSchedulePrice {
+	if mmSchedulePrice.mock.inspectFuncSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.SchedulePrice")
+	}
+
+	mmSchedulePrice.mock.inspectFuncSchedulePrice = f
+
+	return mmSchedulePrice
+}
+
+// Return sets up results that will be returned by IStockRepo.SchedulePrice
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Return(i1 int64, err error) *IStockRepoMock {
+	if mmSchedulePrice.mock.funcSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Set")
+	}
+
+	if mmSchedulePrice.defaultExpectation == nil {
+		mmSchedulePrice.defaultExpectation = &IStockRepoMockSchedulePriceExpectation{mock: mmSchedulePrice.mock}
+	}
+	mmSchedulePrice.defaultExpectation.results = &IStockRepoMockSchedulePriceResults{i1, err}
+	mmSchedulePrice.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSchedulePrice.mock
+}
+
+// Set uses given function f to mock the IStockRepo.SchedulePrice method
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Set(f func(ctx context.Context, price models.ScheduledPrice) (i1 int64, err error)) *IStockRepoMock {
+	if mmSchedulePrice.defaultExpectation != nil {
+		mmSchedulePrice.mock.t.Fatalf("Default expectation is already set for the IStockRepo.SchedulePrice method")
+	}
+
+	if len(mmSchedulePrice.expectations) > 0 {
+		mmSchedulePrice.mock.t.Fatalf("Some expectations are already set for the IStockRepo.SchedulePrice method")
+	}
+
+	mmSchedulePrice.mock.funcSchedulePrice = f
+	mmSchedulePrice.mock.funcSchedulePriceOrigin = minimock.CallerInfo(1)
+	return mmSchedulePrice.mock
+}
+
+// When sets expectation for the IStockRepo.SchedulePrice which will trigger the result defined by the following
+// Then helper
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) When(ctx context.Context, price models.ScheduledPrice) *IStockRepoMockSchedulePriceExpectation {
+	if mmSchedulePrice.mock.funcSchedulePrice != nil {
+		mmSchedulePrice.mock.t.Fatalf("IStockRepoMock.SchedulePrice mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockSchedulePriceExpectation{
+		mock:               mmSchedulePrice.mock,
+		params:             &IStockRepoMockSchedulePriceParams{ctx, price},
+		expectationOrigins: IStockRepoMockSchedulePriceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSchedulePrice.expectations = append(mmSchedulePrice.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.SchedulePrice return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockSchedulePriceExpectation) Then(i1 int64, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockSchedulePriceResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.SchedulePrice should be invoked
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Times(n uint64) *mIStockRepoMockSchedulePrice {
+	if n == 0 {
+		mmSchedulePrice.mock.t.Fatalf("Times of IStockRepoMock.SchedulePrice mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSchedulePrice.expectedInvocations, n)
+	mmSchedulePrice.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSchedulePrice
+}
+
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) invocationsDone() bool {
+	if len(mmSchedulePrice.expectations) == 0 && mmSchedulePrice.defaultExpectation == nil && mmSchedulePrice.mock.funcSchedulePrice == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSchedulePrice.mock.afterSchedulePriceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSchedulePrice.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SchedulePrice implements mm_repository.IStockRepo
+func (mmSchedulePrice *IStockRepoMock) SchedulePrice(ctx context.Context, price models.ScheduledPrice) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmSchedulePrice.beforeSchedulePriceCounter, 1)
+	defer mm_atomic.AddUint64(&mmSchedulePrice.afterSchedulePriceCounter, 1)
+
+	mmSchedulePrice.t.Helper()
+
+	if mmSchedulePrice.inspectFuncSchedulePrice != nil {
+		mmSchedulePrice.inspectFuncSchedulePrice(ctx, price)
+	}
+
+	mm_params := IStockRepoMockSchedulePriceParams{ctx, price}
+
+	// Record call args
+	mmSchedulePrice.SchedulePriceMock.mutex.Lock()
+	mmSchedulePrice.SchedulePriceMock.callArgs = append(mmSchedulePrice.SchedulePriceMock.callArgs, &mm_params)
+	mmSchedulePrice.SchedulePriceMock.mutex.Unlock()
+
+	for _, e := range mmSchedulePrice.SchedulePriceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSchedulePrice.SchedulePriceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSchedulePrice.SchedulePriceMock.defaultExpectation.Counter, 1)
+		mm_want := mmSchedulePrice.SchedulePriceMock.defaultExpectation.params
+		mm_want_ptrs := mmSchedulePrice.SchedulePriceMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockSchedulePriceParams{ctx, price}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSchedulePrice.t.Errorf("IStockRepoMock.SchedulePrice got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePrice.SchedulePriceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
+				mmSchedulePrice.t.Errorf("IStockRepoMock.SchedulePrice got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePrice.SchedulePriceMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSchedulePrice.t.Errorf("IStockRepoMock.SchedulePrice got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSchedulePrice.SchedulePriceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSchedulePrice.SchedulePriceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSchedulePrice.t.Fatal("No results are set for the IStockRepoMock.SchedulePrice")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSchedulePrice.funcSchedulePrice != nil {
+		return mmSchedulePrice.funcSchedulePrice(ctx, price)
+	}
+	mmSchedulePrice.t.Fatalf("Unexpected call to IStockRepoMock.SchedulePrice. %v %v", ctx, price)
+	return
+}
+
+// SchedulePriceAfterCounter returns a count of finished IStockRepoMock.SchedulePrice invocations
+func (mmSchedulePrice *IStockRepoMock) SchedulePriceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePrice.afterSchedulePriceCounter)
+}
+
+// SchedulePriceBeforeCounter returns a count of IStockRepoMock.SchedulePrice invocations
+func (mmSchedulePrice *IStockRepoMock) SchedulePriceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePrice.beforeSchedulePriceCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.SchedulePrice.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSchedulePrice *mIStockRepoMockSchedulePrice) Calls() []*IStockRepoMockSchedulePriceParams {
+	mmSchedulePrice.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockSchedulePriceParams, len(mmSchedulePrice.callArgs))
+	copy(argCopy, mmSchedulePrice.callArgs)
+
+	mmSchedulePrice.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSchedulePriceDone returns true if the count of the SchedulePrice invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockSchedulePriceDone() bool {
+	if m.SchedulePriceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SchedulePriceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SchedulePriceMock.invocationsDone()
+}
+
+// MinimockSchedulePriceInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockSchedulePriceInspect() {
+	for _, e := range m.SchedulePriceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.SchedulePrice at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSchedulePriceCounter := mm_atomic.LoadUint64(&m.afterSchedulePriceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SchedulePriceMock.defaultExpectation != nil && afterSchedulePriceCounter < 1 {
+		if m.SchedulePriceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.SchedulePrice at\n%s", m.SchedulePriceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.SchedulePrice at\n%s with params: %#v", m.SchedulePriceMock.defaultExpectation.expectationOrigins.origin, *m.SchedulePriceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSchedulePrice != nil && afterSchedulePriceCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.SchedulePrice at\n%s", m.funcSchedulePriceOrigin)
+	}
+
+	if !m.SchedulePriceMock.invocationsDone() && afterSchedulePriceCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.SchedulePrice at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SchedulePriceMock.expectedInvocations), m.SchedulePriceMock.expectedInvocationsOrigin, afterSchedulePriceCounter)
 	}
-	mmDeleteStock.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+}
 
-	return mmDeleteStock
+type mIStockRepoMockSearchItems struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockSearchItemsExpectation
+	expectations       []*IStockRepoMockSearchItemsExpectation
+
+	callArgs []*IStockRepoMockSearchItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockDeleteStock {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+// IStockRepoMockSearchItemsExpectation specifies expectation struct of the IStockRepo.SearchItems
+type IStockRepoMockSearchItemsExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockSearchItemsParams
+	paramPtrs          *IStockRepoMockSearchItemsParamPtrs
+	expectationOrigins IStockRepoMockSearchItemsExpectationOrigins
+	results            *IStockRepoMockSearchItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockSearchItemsParams contains parameters of the IStockRepo.SearchItems
+type IStockRepoMockSearchItemsParams struct {
+	ctx   context.Context
+	param mm_repository.SearchItemsParam
+}
+
+// IStockRepoMockSearchItemsParamPtrs contains pointers to parameters of the IStockRepo.SearchItems
+type IStockRepoMockSearchItemsParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.SearchItemsParam
+}
+
+// IStockRepoMockSearchItemsResults contains results of the IStockRepo.SearchItems
+type IStockRepoMockSearchItemsResults struct {
+	ia1 []models.Item
+	err error
+}
+
+// IStockRepoMockSearchItemsOrigins contains origins of expectations of the IStockRepo.SearchItems
+type IStockRepoMockSearchItemsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchItems *mIStockRepoMockSearchItems) Optional() *mIStockRepoMockSearchItems {
+	mmSearchItems.optional = true
+	return mmSearchItems
+}
+
+// Expect sets up expected params for IStockRepo.SearchItems
+func (mmSearchItems *mIStockRepoMockSearchItems) Expect(ctx context.Context, param mm_repository.SearchItemsParam) *mIStockRepoMockSearchItems {
+	if mmSearchItems.mock.funcSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Set")
 	}
 
-	if mmDeleteStock.defaultExpectation == nil {
-		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	if mmSearchItems.defaultExpectation == nil {
+		mmSearchItems.defaultExpectation = &IStockRepoMockSearchItemsExpectation{}
 	}
 
-	if mmDeleteStock.defaultExpectation.params != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
+	if mmSearchItems.defaultExpectation.paramPtrs != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by ExpectParams functions")
 	}
 
-	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
-		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	mmSearchItems.defaultExpectation.params = &IStockRepoMockSearchItemsParams{ctx, param}
+	mmSearchItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchItems.expectations {
+		if minimock.Equal(e.params, mmSearchItems.defaultExpectation.params) {
+			mmSearchItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchItems.defaultExpectation.params)
+		}
 	}
-	mmDeleteStock.defaultExpectation.paramPtrs.skuID = &skuID
-	mmDeleteStock.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
 
-	return mmDeleteStock
+	return mmSearchItems
 }
 
-// ExpectUserIDParam3 sets up expected param userID for IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) ExpectUserIDParam3(userID models.UserID) *mIStockRepoMockDeleteStock {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.SearchItems
+func (mmSearchItems *mIStockRepoMockSearchItems) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockSearchItems {
+	if mmSearchItems.mock.funcSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Set")
 	}
 
-	if mmDeleteStock.defaultExpectation == nil {
-		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{}
+	if mmSearchItems.defaultExpectation == nil {
+		mmSearchItems.defaultExpectation = &IStockRepoMockSearchItemsExpectation{}
 	}
 
-	if mmDeleteStock.defaultExpectation.params != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Expect")
+	if mmSearchItems.defaultExpectation.params != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Expect")
 	}
 
-	if mmDeleteStock.defaultExpectation.paramPtrs == nil {
-		mmDeleteStock.defaultExpectation.paramPtrs = &IStockRepoMockDeleteStockParamPtrs{}
+	if mmSearchItems.defaultExpectation.paramPtrs == nil {
+		mmSearchItems.defaultExpectation.paramPtrs = &IStockRepoMockSearchItemsParamPtrs{}
 	}
-	mmDeleteStock.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteStock.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSearchItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteStock
+	return mmSearchItems
 }
 
-// Inspect accepts an inspector function that has same arguments as the IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Inspect(f func(ctx context.Context, skuID models.SKUID, userID models.UserID)) *mIStockRepoMockDeleteStock {
-	if mmDeleteStock.mock.inspectFuncDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.DeleteStock")
+// ExpectParamParam2 sets up expected param param for IStockRepo.SearchItems
+func (mmSearchItems *mIStockRepoMockSearchItems) ExpectParamParam2(param mm_repository.SearchItemsParam) *mIStockRepoMockSearchItems {
+	if mmSearchItems.mock.funcSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Set")
 	}
 
-	mmDeleteStock.mock.inspectFuncDeleteStock = f
+	if mmSearchItems.defaultExpectation == nil {
+		mmSearchItems.defaultExpectation = &IStockRepoMockSearchItemsExpectation{}
+	}
 
-	return mmDeleteStock
+	if mmSearchItems.defaultExpectation.params != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Expect")
+	}
+
+	if mmSearchItems.defaultExpectation.paramPtrs == nil {
+		mmSearchItems.defaultExpectation.paramPtrs = &IStockRepoMockSearchItemsParamPtrs{}
+	}
+	mmSearchItems.defaultExpectation.paramPtrs.param = &param
+	mmSearchItems.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmSearchItems
 }
 
-// Return sets up results that will be returned by IStockRepo.DeleteStock
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Return(err error) *IStockRepoMock {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.SearchItems
+func (mmSearchItems *mIStockRepoMockSearchItems) Inspect(f func(ctx context.Context, param mm_repository.SearchItemsParam)) *mIStockRepoMockSearchItems {
+	if mmSearchItems.mock.inspectFuncSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.SearchItems")
 	}
 
-	if mmDeleteStock.defaultExpectation == nil {
-		mmDeleteStock.defaultExpectation = &IStockRepoMockDeleteStockExpectation{mock: mmDeleteStock.mock}
+	mmSearchItems.mock.inspectFuncSearchItems = f
+
+	return mmSearchItems
+}
+
+// Return sets up results that will be returned by IStockRepo.SearchItems
+func (mmSearchItems *mIStockRepoMockSearchItems) Return(ia1 []models.Item, err error) *IStockRepoMock {
+	if mmSearchItems.mock.funcSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Set")
 	}
-	mmDeleteStock.defaultExpectation.results = &IStockRepoMockDeleteStockResults{err}
-	mmDeleteStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteStock.mock
+
+	if mmSearchItems.defaultExpectation == nil {
+		mmSearchItems.defaultExpectation = &IStockRepoMockSearchItemsExpectation{mock: mmSearchItems.mock}
+	}
+	mmSearchItems.defaultExpectation.results = &IStockRepoMockSearchItemsResults{ia1, err}
+	mmSearchItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchItems.mock
 }
 
-// Set uses given function f to mock the IStockRepo.DeleteStock method
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Set(f func(ctx context.Context, skuID models.SKUID, userID models.UserID) (err error)) *IStockRepoMock {
-	if mmDeleteStock.defaultExpectation != nil {
-		mmDeleteStock.mock.t.Fatalf("Default expectation is already set for the IStockRepo.DeleteStock method")
+// Set uses given function f to mock the IStockRepo.SearchItems method
+func (mmSearchItems *mIStockRepoMockSearchItems) Set(f func(ctx context.Context, param mm_repository.SearchItemsParam) (ia1 []models.Item, err error)) *IStockRepoMock {
+	if mmSearchItems.defaultExpectation != nil {
+		mmSearchItems.mock.t.Fatalf("Default expectation is already set for the IStockRepo.SearchItems method")
 	}
 
-	if len(mmDeleteStock.expectations) > 0 {
-		mmDeleteStock.mock.t.Fatalf("Some expectations are already set for the IStockRepo.DeleteStock method")
+	if len(mmSearchItems.expectations) > 0 {
+		mmSearchItems.mock.t.Fatalf("Some expectations are already set for the IStockRepo.SearchItems method")
 	}
 
-	mmDeleteStock.mock.funcDeleteStock = f
-	mmDeleteStock.mock.funcDeleteStockOrigin = minimock.CallerInfo(1)
-	return mmDeleteStock.mock
+	mmSearchItems.mock.funcSearchItems = f
+	mmSearchItems.mock.funcSearchItemsOrigin = minimock.CallerInfo(1)
+	return mmSearchItems.mock
 }
 
-// When sets expectation for the IStockRepo.DeleteStock which will trigger the result defined by the following
+// When sets expectation for the IStockRepo.SearchItems which will trigger the result defined by the following
 // Then helper
-func (mmDeleteStock *mIStockRepoMockDeleteStock) When(ctx context.Context, skuID models.SKUID, userID models.UserID) *IStockRepoMockDeleteStockExpectation {
-	if mmDeleteStock.mock.funcDeleteStock != nil {
-		mmDeleteStock.mock.t.Fatalf("IStockRepoMock.DeleteStock mock is already set by Set")
+func (mmSearchItems *mIStockRepoMockSearchItems) When(ctx context.Context, param mm_repository.SearchItemsParam) *IStockRepoMockSearchItemsExpectation {
+	if mmSearchItems.mock.funcSearchItems != nil {
+		mmSearchItems.mock.t.Fatalf("IStockRepoMock.SearchItems mock is already set by Set")
 	}
 
-	expectation := &IStockRepoMockDeleteStockExpectation{
-		mock:               mmDeleteStock.mock,
-		params:             &IStockRepoMockDeleteStockParams{ctx, skuID, userID},
-		expectationOrigins: IStockRepoMockDeleteStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IStockRepoMockSearchItemsExpectation{
+		mock:               mmSearchItems.mock,
+		params:             &IStockRepoMockSearchItemsParams{ctx, param},
+		expectationOrigins: IStockRepoMockSearchItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteStock.expectations = append(mmDeleteStock.expectations, expectation)
+	mmSearchItems.expectations = append(mmSearchItems.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IStockRepo.DeleteStock return parameters for the expectation previously defined by the When method
-func (e *IStockRepoMockDeleteStockExpectation) Then(err error) *IStockRepoMock {
-	e.results = &IStockRepoMockDeleteStockResults{err}
+// Then sets up IStockRepo.SearchItems return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockSearchItemsExpectation) Then(ia1 []models.Item, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockSearchItemsResults{ia1, err}
 	return e.mock
 }
 
-// Times sets number of times IStockRepo.DeleteStock should be invoked
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Times(n uint64) *mIStockRepoMockDeleteStock {
+// Times sets number of times IStockRepo.SearchItems should be invoked
+func (mmSearchItems *mIStockRepoMockSearchItems) Times(n uint64) *mIStockRepoMockSearchItems {
 	if n == 0 {
-		mmDeleteStock.mock.t.Fatalf("Times of IStockRepoMock.DeleteStock mock can not be zero")
+		mmSearchItems.mock.t.Fatalf("Times of IStockRepoMock.SearchItems mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteStock.expectedInvocations, n)
-	mmDeleteStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteStock
+	mm_atomic.StoreUint64(&mmSearchItems.expectedInvocations, n)
+	mmSearchItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchItems
 }
 
-func (mmDeleteStock *mIStockRepoMockDeleteStock) invocationsDone() bool {
-	if len(mmDeleteStock.expectations) == 0 && mmDeleteStock.defaultExpectation == nil && mmDeleteStock.mock.funcDeleteStock == nil {
+func (mmSearchItems *mIStockRepoMockSearchItems) invocationsDone() bool {
+	if len(mmSearchItems.expectations) == 0 && mmSearchItems.defaultExpectation == nil && mmSearchItems.mock.funcSearchItems == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStock.mock.afterDeleteStockCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStock.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchItems.mock.afterSearchItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchItems.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteStock implements mm_repository.IStockRepo
-func (mmDeleteStock *IStockRepoMock) DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteStock.beforeDeleteStockCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteStock.afterDeleteStockCounter, 1)
+// SearchItems implements mm_repository.IStockRepo
+func (mmSearchItems *IStockRepoMock) SearchItems(ctx context.Context, param mm_repository.SearchItemsParam) (ia1 []models.Item, err error) {
+	mm_atomic.AddUint64(&mmSearchItems.beforeSearchItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchItems.afterSearchItemsCounter, 1)
 
-	mmDeleteStock.t.Helper()
+	mmSearchItems.t.Helper()
 
-	if mmDeleteStock.inspectFuncDeleteStock != nil {
-		mmDeleteStock.inspectFuncDeleteStock(ctx, skuID, userID)
+	if mmSearchItems.inspectFuncSearchItems != nil {
+		mmSearchItems.inspectFuncSearchItems(ctx, param)
 	}
 
-	mm_params := IStockRepoMockDeleteStockParams{ctx, skuID, userID}
+	mm_params := IStockRepoMockSearchItemsParams{ctx, param}
 
 	// Record call args
-	mmDeleteStock.DeleteStockMock.mutex.Lock()
-	mmDeleteStock.DeleteStockMock.callArgs = append(mmDeleteStock.DeleteStockMock.callArgs, &mm_params)
-	mmDeleteStock.DeleteStockMock.mutex.Unlock()
+	mmSearchItems.SearchItemsMock.mutex.Lock()
+	mmSearchItems.SearchItemsMock.callArgs = append(mmSearchItems.SearchItemsMock.callArgs, &mm_params)
+	mmSearchItems.SearchItemsMock.mutex.Unlock()
 
-	for _, e := range mmDeleteStock.DeleteStockMock.expectations {
+	for _, e := range mmSearchItems.SearchItemsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ia1, e.results.err
 		}
 	}
 
-	if mmDeleteStock.DeleteStockMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteStock.DeleteStockMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteStock.DeleteStockMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteStock.DeleteStockMock.defaultExpectation.paramPtrs
+	if mmSearchItems.SearchItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchItems.SearchItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchItems.SearchItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchItems.SearchItemsMock.defaultExpectation.paramPtrs
 
-		mm_got := IStockRepoMockDeleteStockParams{ctx, skuID, userID}
+		mm_got := IStockRepoMockSearchItemsParams{ctx, param}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+				mmSearchItems.t.Errorf("IStockRepoMock.SearchItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchItems.SearchItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmSearchItems.t.Errorf("IStockRepoMock.SearchItems got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchItems.SearchItemsMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteStock.t.Errorf("IStockRepoMock.DeleteStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteStock.DeleteStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearchItems.t.Errorf("IStockRepoMock.SearchItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchItems.SearchItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteStock.DeleteStockMock.defaultExpectation.results
+		mm_results := mmSearchItems.SearchItemsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteStock.t.Fatal("No results are set for the IStockRepoMock.DeleteStock")
+			mmSearchItems.t.Fatal("No results are set for the IStockRepoMock.SearchItems")
 		}
-		return (*mm_results).err
+		return (*mm_results).ia1, (*mm_results).err
 	}
-	if mmDeleteStock.funcDeleteStock != nil {
-		return mmDeleteStock.funcDeleteStock(ctx, skuID, userID)
+	if mmSearchItems.funcSearchItems != nil {
+		return mmSearchItems.funcSearchItems(ctx, param)
 	}
-	mmDeleteStock.t.Fatalf("Unexpected call to IStockRepoMock.DeleteStock. %v %v %v", ctx, skuID, userID)
+	mmSearchItems.t.Fatalf("Unexpected call to IStockRepoMock.SearchItems. %v %v", ctx, param)
 	return
 }
 
-// DeleteStockAfterCounter returns a count of finished IStockRepoMock.DeleteStock invocations
-func (mmDeleteStock *IStockRepoMock) DeleteStockAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStock.afterDeleteStockCounter)
+// SearchItemsAfterCounter returns a count of finished IStockRepoMock.SearchItems invocations
+func (mmSearchItems *IStockRepoMock) SearchItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchItems.afterSearchItemsCounter)
 }
 
-// DeleteStockBeforeCounter returns a count of IStockRepoMock.DeleteStock invocations
-func (mmDeleteStock *IStockRepoMock) DeleteStockBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStock.beforeDeleteStockCounter)
+// SearchItemsBeforeCounter returns a count of IStockRepoMock.SearchItems invocations
+func (mmSearchItems *IStockRepoMock) SearchItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchItems.beforeSearchItemsCounter)
 }
 
-// Calls returns a list of arguments used in each call to IStockRepoMock.DeleteStock.
+// Calls returns a list of arguments used in each call to IStockRepoMock.SearchItems.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteStock *mIStockRepoMockDeleteStock) Calls() []*IStockRepoMockDeleteStockParams {
-	mmDeleteStock.mutex.RLock()
+func (mmSearchItems *mIStockRepoMockSearchItems) Calls() []*IStockRepoMockSearchItemsParams {
+	mmSearchItems.mutex.RLock()
 
-	argCopy := make([]*IStockRepoMockDeleteStockParams, len(mmDeleteStock.callArgs))
-	copy(argCopy, mmDeleteStock.callArgs)
+	argCopy := make([]*IStockRepoMockSearchItemsParams, len(mmSearchItems.callArgs))
+	copy(argCopy, mmSearchItems.callArgs)
 
-	mmDeleteStock.mutex.RUnlock()
+	mmSearchItems.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteStockDone returns true if the count of the DeleteStock invocations corresponds
+// MinimockSearchItemsDone returns true if the count of the SearchItems invocations corresponds
 // the number of defined expectations
-func (m *IStockRepoMock) MinimockDeleteStockDone() bool {
-	if m.DeleteStockMock.optional {
+func (m *IStockRepoMock) MinimockSearchItemsDone() bool {
+	if m.SearchItemsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteStockMock.expectations {
+	for _, e := range m.SearchItemsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteStockMock.invocationsDone()
+	return m.SearchItemsMock.invocationsDone()
 }
 
-// MinimockDeleteStockInspect logs each unmet expectation
-func (m *IStockRepoMock) MinimockDeleteStockInspect() {
-	for _, e := range m.DeleteStockMock.expectations {
+// MinimockSearchItemsInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockSearchItemsInspect() {
+	for _, e := range m.SearchItemsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SearchItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteStockCounter := mm_atomic.LoadUint64(&m.afterDeleteStockCounter)
+	afterSearchItemsCounter := mm_atomic.LoadUint64(&m.afterSearchItemsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteStockMock.defaultExpectation != nil && afterDeleteStockCounter < 1 {
-		if m.DeleteStockMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s", m.DeleteStockMock.defaultExpectation.returnOrigin)
+	if m.SearchItemsMock.defaultExpectation != nil && afterSearchItemsCounter < 1 {
+		if m.SearchItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.SearchItems at\n%s", m.SearchItemsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s with params: %#v", m.DeleteStockMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SearchItems at\n%s with params: %#v", m.SearchItemsMock.defaultExpectation.expectationOrigins.origin, *m.SearchItemsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteStock != nil && afterDeleteStockCounter < 1 {
-		m.t.Errorf("Expected call to IStockRepoMock.DeleteStock at\n%s", m.funcDeleteStockOrigin)
+	if m.funcSearchItems != nil && afterSearchItemsCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.SearchItems at\n%s", m.funcSearchItemsOrigin)
 	}
 
-	if !m.DeleteStockMock.invocationsDone() && afterDeleteStockCounter > 0 {
-		m.t.Errorf("Expected %d calls to IStockRepoMock.DeleteStock at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteStockMock.expectedInvocations), m.DeleteStockMock.expectedInvocationsOrigin, afterDeleteStockCounter)
+	if !m.SearchItemsMock.invocationsDone() && afterSearchItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.SearchItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchItemsMock.expectedInvocations), m.SearchItemsMock.expectedInvocationsOrigin, afterSearchItemsCounter)
 	}
 }
 
-type mIStockRepoMockGetItemBySKU struct {
+type mIStockRepoMockSetSKUPrice struct {
 	optional           bool
 	mock               *IStockRepoMock
-	defaultExpectation *IStockRepoMockGetItemBySKUExpectation
-	expectations       []*IStockRepoMockGetItemBySKUExpectation
+	defaultExpectation *IStockRepoMockSetSKUPriceExpectation
+	expectations       []*IStockRepoMockSetSKUPriceExpectation
 
-	callArgs []*IStockRepoMockGetItemBySKUParams
+	callArgs []*IStockRepoMockSetSKUPriceParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IStockRepoMockGetItemBySKUExpectation specifies expectation struct of the IStockRepo.GetItemBySKU
-type IStockRepoMockGetItemBySKUExpectation struct {
+// IStockRepoMockSetSKUPriceExpectation specifies expectation struct of the IStockRepo.SetSKUPrice
+type IStockRepoMockSetSKUPriceExpectation struct {
 	mock               *IStockRepoMock
-	params             *IStockRepoMockGetItemBySKUParams
-	paramPtrs          *IStockRepoMockGetItemBySKUParamPtrs
-	expectationOrigins IStockRepoMockGetItemBySKUExpectationOrigins
-	results            *IStockRepoMockGetItemBySKUResults
+	params             *IStockRepoMockSetSKUPriceParams
+	paramPtrs          *IStockRepoMockSetSKUPriceParamPtrs
+	expectationOrigins IStockRepoMockSetSKUPriceExpectationOrigins
+	results            *IStockRepoMockSetSKUPriceResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IStockRepoMockGetItemBySKUParams contains parameters of the IStockRepo.GetItemBySKU
-type IStockRepoMockGetItemBySKUParams struct {
-	ctx   context.Context
-	skuID models.SKUID
+// IStockRepoMockSetSKUPriceParams contains parameters of the IStockRepo.SetSKUPrice
+type IStockRepoMockSetSKUPriceParams struct {
+	ctx    context.Context
+	skuID  models.SKUID
+	userID models.UserID
+	price  uint32
 }
 
-// IStockRepoMockGetItemBySKUParamPtrs contains pointers to parameters of the IStockRepo.GetItemBySKU
-type IStockRepoMockGetItemBySKUParamPtrs struct {
-	ctx   *context.Context
-	skuID *models.SKUID
+// IStockRepoMockSetSKUPriceParamPtrs contains pointers to parameters of the IStockRepo.SetSKUPrice
+type IStockRepoMockSetSKUPriceParamPtrs struct {
+	ctx    *context.Context
+	skuID  *models.SKUID
+	userID *models.UserID
+	price  *uint32
 }
 
-// IStockRepoMockGetItemBySKUResults contains results of the IStockRepo.GetItemBySKU
-type IStockRepoMockGetItemBySKUResults struct {
-	i1  models.Item
+// IStockRepoMockSetSKUPriceResults contains results of the IStockRepo.SetSKUPrice
+type IStockRepoMockSetSKUPriceResults struct {
+	pa1 []models.PriceChange
 	err error
 }
 
-// IStockRepoMockGetItemBySKUOrigins contains origins of expectations of the IStockRepo.GetItemBySKU
-type IStockRepoMockGetItemBySKUExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originSkuID string
+// IStockRepoMockSetSKUPriceOrigins contains origins of expectations of the IStockRepo.SetSKUPrice
+type IStockRepoMockSetSKUPriceExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuID  string
+	originUserID string
+	originPrice  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -853,342 +8974,397 @@ type IStockRepoMockGetItemBySKUExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Optional() *mIStockRepoMockGetItemBySKU {
-	mmGetItemBySKU.optional = true
-	return mmGetItemBySKU
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Optional() *mIStockRepoMockSetSKUPrice {
+	mmSetSKUPrice.optional = true
+	return mmSetSKUPrice
 }
 
-// Expect sets up expected params for IStockRepo.GetItemBySKU
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Expect(ctx context.Context, skuID models.SKUID) *mIStockRepoMockGetItemBySKU {
-	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+// Expect sets up expected params for IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Expect(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
 	}
 
-	if mmGetItemBySKU.defaultExpectation == nil {
-		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{}
 	}
 
-	if mmGetItemBySKU.defaultExpectation.paramPtrs != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by ExpectParams functions")
+	if mmSetSKUPrice.defaultExpectation.paramPtrs != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by ExpectParams functions")
 	}
 
-	mmGetItemBySKU.defaultExpectation.params = &IStockRepoMockGetItemBySKUParams{ctx, skuID}
-	mmGetItemBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetItemBySKU.expectations {
-		if minimock.Equal(e.params, mmGetItemBySKU.defaultExpectation.params) {
-			mmGetItemBySKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemBySKU.defaultExpectation.params)
+	mmSetSKUPrice.defaultExpectation.params = &IStockRepoMockSetSKUPriceParams{ctx, skuID, userID, price}
+	mmSetSKUPrice.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetSKUPrice.expectations {
+		if minimock.Equal(e.params, mmSetSKUPrice.defaultExpectation.params) {
+			mmSetSKUPrice.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetSKUPrice.defaultExpectation.params)
 		}
 	}
 
-	return mmGetItemBySKU
+	return mmSetSKUPrice
 }
 
-// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetItemBySKU
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetItemBySKU {
-	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
+	}
+
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{}
+	}
+
+	if mmSetSKUPrice.defaultExpectation.params != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Expect")
+	}
+
+	if mmSetSKUPrice.defaultExpectation.paramPtrs == nil {
+		mmSetSKUPrice.defaultExpectation.paramPtrs = &IStockRepoMockSetSKUPriceParamPtrs{}
+	}
+	mmSetSKUPrice.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetSKUPrice.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetSKUPrice
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
+	}
+
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{}
+	}
+
+	if mmSetSKUPrice.defaultExpectation.params != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Expect")
+	}
+
+	if mmSetSKUPrice.defaultExpectation.paramPtrs == nil {
+		mmSetSKUPrice.defaultExpectation.paramPtrs = &IStockRepoMockSetSKUPriceParamPtrs{}
+	}
+	mmSetSKUPrice.defaultExpectation.paramPtrs.skuID = &skuID
+	mmSetSKUPrice.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmSetSKUPrice
+}
+
+// ExpectUserIDParam3 sets up expected param userID for IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) ExpectUserIDParam3(userID models.UserID) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
 	}
 
-	if mmGetItemBySKU.defaultExpectation == nil {
-		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{}
 	}
 
-	if mmGetItemBySKU.defaultExpectation.params != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Expect")
+	if mmSetSKUPrice.defaultExpectation.params != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Expect")
 	}
 
-	if mmGetItemBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetItemBySKU.defaultExpectation.paramPtrs = &IStockRepoMockGetItemBySKUParamPtrs{}
+	if mmSetSKUPrice.defaultExpectation.paramPtrs == nil {
+		mmSetSKUPrice.defaultExpectation.paramPtrs = &IStockRepoMockSetSKUPriceParamPtrs{}
 	}
-	mmGetItemBySKU.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetItemBySKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetSKUPrice.defaultExpectation.paramPtrs.userID = &userID
+	mmSetSKUPrice.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetItemBySKU
+	return mmSetSKUPrice
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for IStockRepo.GetItemBySKU
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) ExpectSkuIDParam2(skuID models.SKUID) *mIStockRepoMockGetItemBySKU {
-	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+// ExpectPriceParam4 sets up expected param price for IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) ExpectPriceParam4(price uint32) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
 	}
 
-	if mmGetItemBySKU.defaultExpectation == nil {
-		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{}
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{}
 	}
 
-	if mmGetItemBySKU.defaultExpectation.params != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Expect")
+	if mmSetSKUPrice.defaultExpectation.params != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Expect")
 	}
 
-	if mmGetItemBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetItemBySKU.defaultExpectation.paramPtrs = &IStockRepoMockGetItemBySKUParamPtrs{}
+	if mmSetSKUPrice.defaultExpectation.paramPtrs == nil {
+		mmSetSKUPrice.defaultExpectation.paramPtrs = &IStockRepoMockSetSKUPriceParamPtrs{}
 	}
-	mmGetItemBySKU.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetItemBySKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmSetSKUPrice.defaultExpectation.paramPtrs.price = &price
+	mmSetSKUPrice.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
 
-	return mmGetItemBySKU
+	return mmSetSKUPrice
 }
 
-// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetItemBySKU
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mIStockRepoMockGetItemBySKU {
-	if mmGetItemBySKU.mock.inspectFuncGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetItemBySKU")
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Inspect(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32)) *mIStockRepoMockSetSKUPrice {
+	if mmSetSKUPrice.mock.inspectFuncSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.SetSKUPrice")
 	}
 
-	mmGetItemBySKU.mock.inspectFuncGetItemBySKU = f
+	mmSetSKUPrice.mock.inspectFuncSetSKUPrice = f
 
-	return mmGetItemBySKU
+	return mmSetSKUPrice
 }
 
-// Return sets up results that will be returned by IStockRepo.GetItemBySKU
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Return(i1 models.Item, err error) *IStockRepoMock {
-	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+// Return sets up results that will be returned by IStockRepo.SetSKUPrice
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Return(pa1 []models.PriceChange, err error) *IStockRepoMock {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
 	}
 
-	if mmGetItemBySKU.defaultExpectation == nil {
-		mmGetItemBySKU.defaultExpectation = &IStockRepoMockGetItemBySKUExpectation{mock: mmGetItemBySKU.mock}
+	if mmSetSKUPrice.defaultExpectation == nil {
+		mmSetSKUPrice.defaultExpectation = &IStockRepoMockSetSKUPriceExpectation{mock: mmSetSKUPrice.mock}
 	}
-	mmGetItemBySKU.defaultExpectation.results = &IStockRepoMockGetItemBySKUResults{i1, err}
-	mmGetItemBySKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetItemBySKU.mock
+	mmSetSKUPrice.defaultExpectation.results = &IStockRepoMockSetSKUPriceResults{pa1, err}
+	mmSetSKUPrice.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetSKUPrice.mock
 }
 
-// Set uses given function f to mock the IStockRepo.GetItemBySKU method
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Set(f func(ctx context.Context, skuID models.SKUID) (i1 models.Item, err error)) *IStockRepoMock {
-	if mmGetItemBySKU.defaultExpectation != nil {
-		mmGetItemBySKU.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetItemBySKU method")
+// Set uses given function f to mock the IStockRepo.SetSKUPrice method
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Set(f func(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) (pa1 []models.PriceChange, err error)) *IStockRepoMock {
+	if mmSetSKUPrice.defaultExpectation != nil {
+		mmSetSKUPrice.mock.t.Fatalf("Default expectation is already set for the IStockRepo.SetSKUPrice method")
 	}
 
-	if len(mmGetItemBySKU.expectations) > 0 {
-		mmGetItemBySKU.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetItemBySKU method")
+	if len(mmSetSKUPrice.expectations) > 0 {
+		mmSetSKUPrice.mock.t.Fatalf("Some expectations are already set for the IStockRepo.SetSKUPrice method")
 	}
 
-	mmGetItemBySKU.mock.funcGetItemBySKU = f
-	mmGetItemBySKU.mock.funcGetItemBySKUOrigin = minimock.CallerInfo(1)
-	return mmGetItemBySKU.mock
+	mmSetSKUPrice.mock.funcSetSKUPrice = f
+	mmSetSKUPrice.mock.funcSetSKUPriceOrigin = minimock.CallerInfo(1)
+	return mmSetSKUPrice.mock
 }
 
-// When sets expectation for the IStockRepo.GetItemBySKU which will trigger the result defined by the following
+// When sets expectation for the IStockRepo.SetSKUPrice which will trigger the result defined by the following
 // Then helper
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) When(ctx context.Context, skuID models.SKUID) *IStockRepoMockGetItemBySKUExpectation {
-	if mmGetItemBySKU.mock.funcGetItemBySKU != nil {
-		mmGetItemBySKU.mock.t.Fatalf("IStockRepoMock.GetItemBySKU mock is already set by Set")
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) When(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) *IStockRepoMockSetSKUPriceExpectation {
+	if mmSetSKUPrice.mock.funcSetSKUPrice != nil {
+		mmSetSKUPrice.mock.t.Fatalf("IStockRepoMock.SetSKUPrice mock is already set by Set")
 	}
 
-	expectation := &IStockRepoMockGetItemBySKUExpectation{
-		mock:               mmGetItemBySKU.mock,
-		params:             &IStockRepoMockGetItemBySKUParams{ctx, skuID},
-		expectationOrigins: IStockRepoMockGetItemBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IStockRepoMockSetSKUPriceExpectation{
+		mock:               mmSetSKUPrice.mock,
+		params:             &IStockRepoMockSetSKUPriceParams{ctx, skuID, userID, price},
+		expectationOrigins: IStockRepoMockSetSKUPriceExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetItemBySKU.expectations = append(mmGetItemBySKU.expectations, expectation)
+	mmSetSKUPrice.expectations = append(mmSetSKUPrice.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IStockRepo.GetItemBySKU return parameters for the expectation previously defined by the When method
-func (e *IStockRepoMockGetItemBySKUExpectation) Then(i1 models.Item, err error) *IStockRepoMock {
-	e.results = &IStockRepoMockGetItemBySKUResults{i1, err}
+// Then sets up IStockRepo.SetSKUPrice return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockSetSKUPriceExpectation) Then(pa1 []models.PriceChange, err error) *IStockRepoMock {
+	e.results = &IStockRepoMockSetSKUPriceResults{pa1, err}
 	return e.mock
 }
 
-// Times sets number of times IStockRepo.GetItemBySKU should be invoked
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Times(n uint64) *mIStockRepoMockGetItemBySKU {
+// Times sets number of times IStockRepo.SetSKUPrice should be invoked
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Times(n uint64) *mIStockRepoMockSetSKUPrice {
 	if n == 0 {
-		mmGetItemBySKU.mock.t.Fatalf("Times of IStockRepoMock.GetItemBySKU mock can not be zero")
+		mmSetSKUPrice.mock.t.Fatalf("Times of IStockRepoMock.SetSKUPrice mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetItemBySKU.expectedInvocations, n)
-	mmGetItemBySKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetItemBySKU
+	mm_atomic.StoreUint64(&mmSetSKUPrice.expectedInvocations, n)
+	mmSetSKUPrice.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetSKUPrice
 }
 
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) invocationsDone() bool {
-	if len(mmGetItemBySKU.expectations) == 0 && mmGetItemBySKU.defaultExpectation == nil && mmGetItemBySKU.mock.funcGetItemBySKU == nil {
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) invocationsDone() bool {
+	if len(mmSetSKUPrice.expectations) == 0 && mmSetSKUPrice.defaultExpectation == nil && mmSetSKUPrice.mock.funcSetSKUPrice == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetItemBySKU.mock.afterGetItemBySKUCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemBySKU.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetSKUPrice.mock.afterSetSKUPriceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetSKUPrice.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetItemBySKU implements mm_repository.IStockRepo
-func (mmGetItemBySKU *IStockRepoMock) GetItemBySKU(ctx context.Context, skuID models.SKUID) (i1 models.Item, err error) {
-	mm_atomic.AddUint64(&mmGetItemBySKU.beforeGetItemBySKUCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetItemBySKU.afterGetItemBySKUCounter, 1)
+// SetSKUPrice implements mm_repository.IStockRepo
+func (mmSetSKUPrice *IStockRepoMock) SetSKUPrice(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) (pa1 []models.PriceChange, err error) {
+	mm_atomic.AddUint64(&mmSetSKUPrice.beforeSetSKUPriceCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetSKUPrice.afterSetSKUPriceCounter, 1)
 
-	mmGetItemBySKU.t.Helper()
+	mmSetSKUPrice.t.Helper()
 
-	if mmGetItemBySKU.inspectFuncGetItemBySKU != nil {
-		mmGetItemBySKU.inspectFuncGetItemBySKU(ctx, skuID)
+	if mmSetSKUPrice.inspectFuncSetSKUPrice != nil {
+		mmSetSKUPrice.inspectFuncSetSKUPrice(ctx, skuID, userID, price)
 	}
 
-	mm_params := IStockRepoMockGetItemBySKUParams{ctx, skuID}
+	mm_params := IStockRepoMockSetSKUPriceParams{ctx, skuID, userID, price}
 
 	// Record call args
-	mmGetItemBySKU.GetItemBySKUMock.mutex.Lock()
-	mmGetItemBySKU.GetItemBySKUMock.callArgs = append(mmGetItemBySKU.GetItemBySKUMock.callArgs, &mm_params)
-	mmGetItemBySKU.GetItemBySKUMock.mutex.Unlock()
+	mmSetSKUPrice.SetSKUPriceMock.mutex.Lock()
+	mmSetSKUPrice.SetSKUPriceMock.callArgs = append(mmSetSKUPrice.SetSKUPriceMock.callArgs, &mm_params)
+	mmSetSKUPrice.SetSKUPriceMock.mutex.Unlock()
 
-	for _, e := range mmGetItemBySKU.GetItemBySKUMock.expectations {
+	for _, e := range mmSetSKUPrice.SetSKUPriceMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.pa1, e.results.err
 		}
 	}
 
-	if mmGetItemBySKU.GetItemBySKUMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.params
-		mm_want_ptrs := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.paramPtrs
+	if mmSetSKUPrice.SetSKUPriceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.params
+		mm_want_ptrs := mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.paramPtrs
 
-		mm_got := IStockRepoMockGetItemBySKUParams{ctx, skuID}
+		mm_got := IStockRepoMockSetSKUPriceParams{ctx, skuID, userID, price}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetSKUPrice.t.Errorf("IStockRepoMock.SetSKUPrice got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+				mmSetSKUPrice.t.Errorf("IStockRepoMock.SetSKUPrice got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetSKUPrice.t.Errorf("IStockRepoMock.SetSKUPrice got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
+				mmSetSKUPrice.t.Errorf("IStockRepoMock.SetSKUPrice got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetItemBySKU.t.Errorf("IStockRepoMock.GetItemBySKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetSKUPrice.t.Errorf("IStockRepoMock.SetSKUPrice got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetItemBySKU.GetItemBySKUMock.defaultExpectation.results
+		mm_results := mmSetSKUPrice.SetSKUPriceMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetItemBySKU.t.Fatal("No results are set for the IStockRepoMock.GetItemBySKU")
+			mmSetSKUPrice.t.Fatal("No results are set for the IStockRepoMock.SetSKUPrice")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).pa1, (*mm_results).err
 	}
-	if mmGetItemBySKU.funcGetItemBySKU != nil {
-		return mmGetItemBySKU.funcGetItemBySKU(ctx, skuID)
+	if mmSetSKUPrice.funcSetSKUPrice != nil {
+		return mmSetSKUPrice.funcSetSKUPrice(ctx, skuID, userID, price)
 	}
-	mmGetItemBySKU.t.Fatalf("Unexpected call to IStockRepoMock.GetItemBySKU. %v %v", ctx, skuID)
+	mmSetSKUPrice.t.Fatalf("Unexpected call to IStockRepoMock.SetSKUPrice. %v %v %v %v", ctx, skuID, userID, price)
 	return
 }
 
-// GetItemBySKUAfterCounter returns a count of finished IStockRepoMock.GetItemBySKU invocations
-func (mmGetItemBySKU *IStockRepoMock) GetItemBySKUAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemBySKU.afterGetItemBySKUCounter)
+// SetSKUPriceAfterCounter returns a count of finished IStockRepoMock.SetSKUPrice invocations
+func (mmSetSKUPrice *IStockRepoMock) SetSKUPriceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetSKUPrice.afterSetSKUPriceCounter)
 }
 
-// GetItemBySKUBeforeCounter returns a count of IStockRepoMock.GetItemBySKU invocations
-func (mmGetItemBySKU *IStockRepoMock) GetItemBySKUBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemBySKU.beforeGetItemBySKUCounter)
+// SetSKUPriceBeforeCounter returns a count of IStockRepoMock.SetSKUPrice invocations
+func (mmSetSKUPrice *IStockRepoMock) SetSKUPriceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetSKUPrice.beforeSetSKUPriceCounter)
 }
 
-// Calls returns a list of arguments used in each call to IStockRepoMock.GetItemBySKU.
+// Calls returns a list of arguments used in each call to IStockRepoMock.SetSKUPrice.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetItemBySKU *mIStockRepoMockGetItemBySKU) Calls() []*IStockRepoMockGetItemBySKUParams {
-	mmGetItemBySKU.mutex.RLock()
+func (mmSetSKUPrice *mIStockRepoMockSetSKUPrice) Calls() []*IStockRepoMockSetSKUPriceParams {
+	mmSetSKUPrice.mutex.RLock()
 
-	argCopy := make([]*IStockRepoMockGetItemBySKUParams, len(mmGetItemBySKU.callArgs))
-	copy(argCopy, mmGetItemBySKU.callArgs)
+	argCopy := make([]*IStockRepoMockSetSKUPriceParams, len(mmSetSKUPrice.callArgs))
+	copy(argCopy, mmSetSKUPrice.callArgs)
 
-	mmGetItemBySKU.mutex.RUnlock()
+	mmSetSKUPrice.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetItemBySKUDone returns true if the count of the GetItemBySKU invocations corresponds
+// MinimockSetSKUPriceDone returns true if the count of the SetSKUPrice invocations corresponds
 // the number of defined expectations
-func (m *IStockRepoMock) MinimockGetItemBySKUDone() bool {
-	if m.GetItemBySKUMock.optional {
+func (m *IStockRepoMock) MinimockSetSKUPriceDone() bool {
+	if m.SetSKUPriceMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetItemBySKUMock.expectations {
+	for _, e := range m.SetSKUPriceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetItemBySKUMock.invocationsDone()
+	return m.SetSKUPriceMock.invocationsDone()
 }
 
-// MinimockGetItemBySKUInspect logs each unmet expectation
-func (m *IStockRepoMock) MinimockGetItemBySKUInspect() {
-	for _, e := range m.GetItemBySKUMock.expectations {
+// MinimockSetSKUPriceInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockSetSKUPriceInspect() {
+	for _, e := range m.SetSKUPriceMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SetSKUPrice at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetItemBySKUCounter := mm_atomic.LoadUint64(&m.afterGetItemBySKUCounter)
+	afterSetSKUPriceCounter := mm_atomic.LoadUint64(&m.afterSetSKUPriceCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetItemBySKUMock.defaultExpectation != nil && afterGetItemBySKUCounter < 1 {
-		if m.GetItemBySKUMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s", m.GetItemBySKUMock.defaultExpectation.returnOrigin)
+	if m.SetSKUPriceMock.defaultExpectation != nil && afterSetSKUPriceCounter < 1 {
+		if m.SetSKUPriceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.SetSKUPrice at\n%s", m.SetSKUPriceMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s with params: %#v", m.GetItemBySKUMock.defaultExpectation.expectationOrigins.origin, *m.GetItemBySKUMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SetSKUPrice at\n%s with params: %#v", m.SetSKUPriceMock.defaultExpectation.expectationOrigins.origin, *m.SetSKUPriceMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetItemBySKU != nil && afterGetItemBySKUCounter < 1 {
-		m.t.Errorf("Expected call to IStockRepoMock.GetItemBySKU at\n%s", m.funcGetItemBySKUOrigin)
+	if m.funcSetSKUPrice != nil && afterSetSKUPriceCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.SetSKUPrice at\n%s", m.funcSetSKUPriceOrigin)
 	}
 
-	if !m.GetItemBySKUMock.invocationsDone() && afterGetItemBySKUCounter > 0 {
-		m.t.Errorf("Expected %d calls to IStockRepoMock.GetItemBySKU at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetItemBySKUMock.expectedInvocations), m.GetItemBySKUMock.expectedInvocationsOrigin, afterGetItemBySKUCounter)
+	if !m.SetSKUPriceMock.invocationsDone() && afterSetSKUPriceCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.SetSKUPrice at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetSKUPriceMock.expectedInvocations), m.SetSKUPriceMock.expectedInvocationsOrigin, afterSetSKUPriceCounter)
 	}
 }
 
-type mIStockRepoMockGetItemsByLocation struct {
+type mIStockRepoMockSetThreshold struct {
 	optional           bool
 	mock               *IStockRepoMock
-	defaultExpectation *IStockRepoMockGetItemsByLocationExpectation
-	expectations       []*IStockRepoMockGetItemsByLocationExpectation
+	defaultExpectation *IStockRepoMockSetThresholdExpectation
+	expectations       []*IStockRepoMockSetThresholdExpectation
 
-	callArgs []*IStockRepoMockGetItemsByLocationParams
+	callArgs []*IStockRepoMockSetThresholdParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IStockRepoMockGetItemsByLocationExpectation specifies expectation struct of the IStockRepo.GetItemsByLocation
-type IStockRepoMockGetItemsByLocationExpectation struct {
+// IStockRepoMockSetThresholdExpectation specifies expectation struct of the IStockRepo.SetThreshold
+type IStockRepoMockSetThresholdExpectation struct {
 	mock               *IStockRepoMock
-	params             *IStockRepoMockGetItemsByLocationParams
-	paramPtrs          *IStockRepoMockGetItemsByLocationParamPtrs
-	expectationOrigins IStockRepoMockGetItemsByLocationExpectationOrigins
-	results            *IStockRepoMockGetItemsByLocationResults
+	params             *IStockRepoMockSetThresholdParams
+	paramPtrs          *IStockRepoMockSetThresholdParamPtrs
+	expectationOrigins IStockRepoMockSetThresholdExpectationOrigins
+	results            *IStockRepoMockSetThresholdResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IStockRepoMockGetItemsByLocationParams contains parameters of the IStockRepo.GetItemsByLocation
-type IStockRepoMockGetItemsByLocationParams struct {
-	ctx   context.Context
-	param mm_repository.GetStockByLocation
+// IStockRepoMockSetThresholdParams contains parameters of the IStockRepo.SetThreshold
+type IStockRepoMockSetThresholdParams struct {
+	ctx       context.Context
+	threshold models.StockThreshold
 }
 
-// IStockRepoMockGetItemsByLocationParamPtrs contains pointers to parameters of the IStockRepo.GetItemsByLocation
-type IStockRepoMockGetItemsByLocationParamPtrs struct {
-	ctx   *context.Context
-	param *mm_repository.GetStockByLocation
+// IStockRepoMockSetThresholdParamPtrs contains pointers to parameters of the IStockRepo.SetThreshold
+type IStockRepoMockSetThresholdParamPtrs struct {
+	ctx       *context.Context
+	threshold *models.StockThreshold
 }
 
-// IStockRepoMockGetItemsByLocationResults contains results of the IStockRepo.GetItemsByLocation
-type IStockRepoMockGetItemsByLocationResults struct {
-	ia1 []models.Item
+// IStockRepoMockSetThresholdResults contains results of the IStockRepo.SetThreshold
+type IStockRepoMockSetThresholdResults struct {
 	err error
 }
 
-// IStockRepoMockGetItemsByLocationOrigins contains origins of expectations of the IStockRepo.GetItemsByLocation
-type IStockRepoMockGetItemsByLocationExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originParam string
+// IStockRepoMockSetThresholdOrigins contains origins of expectations of the IStockRepo.SetThreshold
+type IStockRepoMockSetThresholdExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originThreshold string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1196,292 +9372,292 @@ type IStockRepoMockGetItemsByLocationExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Optional() *mIStockRepoMockGetItemsByLocation {
-	mmGetItemsByLocation.optional = true
-	return mmGetItemsByLocation
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Optional() *mIStockRepoMockSetThreshold {
+	mmSetThreshold.optional = true
+	return mmSetThreshold
 }
 
-// Expect sets up expected params for IStockRepo.GetItemsByLocation
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Expect(ctx context.Context, param mm_repository.GetStockByLocation) *mIStockRepoMockGetItemsByLocation {
-	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+// Expect sets up expected params for IStockRepo.SetThreshold
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Expect(ctx context.Context, threshold models.StockThreshold) *mIStockRepoMockSetThreshold {
+	if mmSetThreshold.mock.funcSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Set")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation == nil {
-		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	if mmSetThreshold.defaultExpectation == nil {
+		mmSetThreshold.defaultExpectation = &IStockRepoMockSetThresholdExpectation{}
 	}
 
-	if mmGetItemsByLocation.defaultExpectation.paramPtrs != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by ExpectParams functions")
+	if mmSetThreshold.defaultExpectation.paramPtrs != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by ExpectParams functions")
 	}
 
-	mmGetItemsByLocation.defaultExpectation.params = &IStockRepoMockGetItemsByLocationParams{ctx, param}
-	mmGetItemsByLocation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetItemsByLocation.expectations {
-		if minimock.Equal(e.params, mmGetItemsByLocation.defaultExpectation.params) {
-			mmGetItemsByLocation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsByLocation.defaultExpectation.params)
+	mmSetThreshold.defaultExpectation.params = &IStockRepoMockSetThresholdParams{ctx, threshold}
+	mmSetThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetThreshold.expectations {
+		if minimock.Equal(e.params, mmSetThreshold.defaultExpectation.params) {
+			mmSetThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetThreshold.defaultExpectation.params)
 		}
 	}
 
-	return mmGetItemsByLocation
+	return mmSetThreshold
 }
 
-// ExpectCtxParam1 sets up expected param ctx for IStockRepo.GetItemsByLocation
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockGetItemsByLocation {
-	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.SetThreshold
+func (mmSetThreshold *mIStockRepoMockSetThreshold) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockSetThreshold {
+	if mmSetThreshold.mock.funcSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Set")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation == nil {
-		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	if mmSetThreshold.defaultExpectation == nil {
+		mmSetThreshold.defaultExpectation = &IStockRepoMockSetThresholdExpectation{}
 	}
 
-	if mmGetItemsByLocation.defaultExpectation.params != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Expect")
+	if mmSetThreshold.defaultExpectation.params != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Expect")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation.paramPtrs == nil {
-		mmGetItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsByLocationParamPtrs{}
+	if mmSetThreshold.defaultExpectation.paramPtrs == nil {
+		mmSetThreshold.defaultExpectation.paramPtrs = &IStockRepoMockSetThresholdParamPtrs{}
 	}
-	mmGetItemsByLocation.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetItemsByLocation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetItemsByLocation
+	return mmSetThreshold
 }
 
-// ExpectParamParam2 sets up expected param param for IStockRepo.GetItemsByLocation
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) ExpectParamParam2(param mm_repository.GetStockByLocation) *mIStockRepoMockGetItemsByLocation {
-	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+// ExpectThresholdParam2 sets up expected param threshold for IStockRepo.SetThreshold
+func (mmSetThreshold *mIStockRepoMockSetThreshold) ExpectThresholdParam2(threshold models.StockThreshold) *mIStockRepoMockSetThreshold {
+	if mmSetThreshold.mock.funcSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Set")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation == nil {
-		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{}
+	if mmSetThreshold.defaultExpectation == nil {
+		mmSetThreshold.defaultExpectation = &IStockRepoMockSetThresholdExpectation{}
 	}
 
-	if mmGetItemsByLocation.defaultExpectation.params != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Expect")
+	if mmSetThreshold.defaultExpectation.params != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Expect")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation.paramPtrs == nil {
-		mmGetItemsByLocation.defaultExpectation.paramPtrs = &IStockRepoMockGetItemsByLocationParamPtrs{}
+	if mmSetThreshold.defaultExpectation.paramPtrs == nil {
+		mmSetThreshold.defaultExpectation.paramPtrs = &IStockRepoMockSetThresholdParamPtrs{}
 	}
-	mmGetItemsByLocation.defaultExpectation.paramPtrs.param = &param
-	mmGetItemsByLocation.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+	mmSetThreshold.defaultExpectation.paramPtrs.threshold = &threshold
+	mmSetThreshold.defaultExpectation.expectationOrigins.originThreshold = minimock.CallerInfo(1)
 
-	return mmGetItemsByLocation
+	return mmSetThreshold
 }
 
-// Inspect accepts an inspector function that has same arguments as the IStockRepo.GetItemsByLocation
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Inspect(f func(ctx context.Context, param mm_repository.GetStockByLocation)) *mIStockRepoMockGetItemsByLocation {
-	if mmGetItemsByLocation.mock.inspectFuncGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.GetItemsByLocation")
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.SetThreshold
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Inspect(f func(ctx context.Context, threshold models.StockThreshold)) *mIStockRepoMockSetThreshold {
+	if mmSetThreshold.mock.inspectFuncSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.SetThreshold")
 	}
 
-	mmGetItemsByLocation.mock.inspectFuncGetItemsByLocation = f
+	mmSetThreshold.mock.inspectFuncSetThreshold = f
 
-	return mmGetItemsByLocation
+	return mmSetThreshold
 }
 
-// Return sets up results that will be returned by IStockRepo.GetItemsByLocation
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Return(ia1 []models.Item, err error) *IStockRepoMock {
-	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+// Return sets up results that will be returned by IStockRepo.SetThreshold
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Return(err error) *IStockRepoMock {
+	if mmSetThreshold.mock.funcSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Set")
 	}
 
-	if mmGetItemsByLocation.defaultExpectation == nil {
-		mmGetItemsByLocation.defaultExpectation = &IStockRepoMockGetItemsByLocationExpectation{mock: mmGetItemsByLocation.mock}
+	if mmSetThreshold.defaultExpectation == nil {
+		mmSetThreshold.defaultExpectation = &IStockRepoMockSetThresholdExpectation{mock: mmSetThreshold.mock}
 	}
-	mmGetItemsByLocation.defaultExpectation.results = &IStockRepoMockGetItemsByLocationResults{ia1, err}
-	mmGetItemsByLocation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByLocation.mock
+	mmSetThreshold.defaultExpectation.results = &IStockRepoMockSetThresholdResults{err}
+	mmSetThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetThreshold.mock
 }
 
-// Set uses given function f to mock the IStockRepo.GetItemsByLocation method
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Set(f func(ctx context.Context, param mm_repository.GetStockByLocation) (ia1 []models.Item, err error)) *IStockRepoMock {
-	if mmGetItemsByLocation.defaultExpectation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("Default expectation is already set for the IStockRepo.GetItemsByLocation method")
+// Set uses given function f to mock the IStockRepo.SetThreshold method
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Set(f func(ctx context.Context, threshold models.StockThreshold) (err error)) *IStockRepoMock {
+	if mmSetThreshold.defaultExpectation != nil {
+		mmSetThreshold.mock.t.Fatalf("Default expectation is already set for the IStockRepo.SetThreshold method")
 	}
 
-	if len(mmGetItemsByLocation.expectations) > 0 {
-		mmGetItemsByLocation.mock.t.Fatalf("Some expectations are already set for the IStockRepo.GetItemsByLocation method")
+	if len(mmSetThreshold.expectations) > 0 {
+		mmSetThreshold.mock.t.Fatalf("Some expectations are already set for the IStockRepo.SetThreshold method")
 	}
 
-	mmGetItemsByLocation.mock.funcGetItemsByLocation = f
-	mmGetItemsByLocation.mock.funcGetItemsByLocationOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByLocation.mock
+	mmSetThreshold.mock.funcSetThreshold = f
+	mmSetThreshold.mock.funcSetThresholdOrigin = minimock.CallerInfo(1)
+	return mmSetThreshold.mock
 }
 
-// When sets expectation for the IStockRepo.GetItemsByLocation which will trigger the result defined by the following
+// When sets expectation for the IStockRepo.SetThreshold which will trigger the result defined by the following
 // Then helper
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) When(ctx context.Context, param mm_repository.GetStockByLocation) *IStockRepoMockGetItemsByLocationExpectation {
-	if mmGetItemsByLocation.mock.funcGetItemsByLocation != nil {
-		mmGetItemsByLocation.mock.t.Fatalf("IStockRepoMock.GetItemsByLocation mock is already set by Set")
+func (mmSetThreshold *mIStockRepoMockSetThreshold) When(ctx context.Context, threshold models.StockThreshold) *IStockRepoMockSetThresholdExpectation {
+	if mmSetThreshold.mock.funcSetThreshold != nil {
+		mmSetThreshold.mock.t.Fatalf("IStockRepoMock.SetThreshold mock is already set by Set")
 	}
 
-	expectation := &IStockRepoMockGetItemsByLocationExpectation{
-		mock:               mmGetItemsByLocation.mock,
-		params:             &IStockRepoMockGetItemsByLocationParams{ctx, param},
-		expectationOrigins: IStockRepoMockGetItemsByLocationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IStockRepoMockSetThresholdExpectation{
+		mock:               mmSetThreshold.mock,
+		params:             &IStockRepoMockSetThresholdParams{ctx, threshold},
+		expectationOrigins: IStockRepoMockSetThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetItemsByLocation.expectations = append(mmGetItemsByLocation.expectations, expectation)
+	mmSetThreshold.expectations = append(mmSetThreshold.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IStockRepo.GetItemsByLocation return parameters for the expectation previously defined by the When method
-func (e *IStockRepoMockGetItemsByLocationExpectation) Then(ia1 []models.Item, err error) *IStockRepoMock {
-	e.results = &IStockRepoMockGetItemsByLocationResults{ia1, err}
+// Then sets up IStockRepo.SetThreshold return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockSetThresholdExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockSetThresholdResults{err}
 	return e.mock
 }
 
-// Times sets number of times IStockRepo.GetItemsByLocation should be invoked
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Times(n uint64) *mIStockRepoMockGetItemsByLocation {
+// Times sets number of times IStockRepo.SetThreshold should be invoked
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Times(n uint64) *mIStockRepoMockSetThreshold {
 	if n == 0 {
-		mmGetItemsByLocation.mock.t.Fatalf("Times of IStockRepoMock.GetItemsByLocation mock can not be zero")
+		mmSetThreshold.mock.t.Fatalf("Times of IStockRepoMock.SetThreshold mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetItemsByLocation.expectedInvocations, n)
-	mmGetItemsByLocation.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByLocation
+	mm_atomic.StoreUint64(&mmSetThreshold.expectedInvocations, n)
+	mmSetThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetThreshold
 }
 
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) invocationsDone() bool {
-	if len(mmGetItemsByLocation.expectations) == 0 && mmGetItemsByLocation.defaultExpectation == nil && mmGetItemsByLocation.mock.funcGetItemsByLocation == nil {
+func (mmSetThreshold *mIStockRepoMockSetThreshold) invocationsDone() bool {
+	if len(mmSetThreshold.expectations) == 0 && mmSetThreshold.defaultExpectation == nil && mmSetThreshold.mock.funcSetThreshold == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsByLocation.mock.afterGetItemsByLocationCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsByLocation.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetThreshold.mock.afterSetThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetThreshold.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetItemsByLocation implements mm_repository.IStockRepo
-func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocation(ctx context.Context, param mm_repository.GetStockByLocation) (ia1 []models.Item, err error) {
-	mm_atomic.AddUint64(&mmGetItemsByLocation.beforeGetItemsByLocationCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetItemsByLocation.afterGetItemsByLocationCounter, 1)
-
-	mmGetItemsByLocation.t.Helper()
+// SetThreshold implements mm_repository.IStockRepo
+func (mmSetThreshold *IStockRepoMock) SetThreshold(ctx context.Context, threshold models.StockThreshold) (err error) {
+	mm_atomic.AddUint64(&mmSetThreshold.beforeSetThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetThreshold.afterSetThresholdCounter, 1)
 
-	if mmGetItemsByLocation.inspectFuncGetItemsByLocation != nil {
-		mmGetItemsByLocation.inspectFuncGetItemsByLocation(ctx, param)
+	mmSetThreshold.t.Helper()
+
+	if mmSetThreshold.inspectFuncSetThreshold != nil {
+		mmSetThreshold.inspectFuncSetThreshold(ctx, threshold)
 	}
 
-	mm_params := IStockRepoMockGetItemsByLocationParams{ctx, param}
+	mm_params := IStockRepoMockSetThresholdParams{ctx, threshold}
 
 	// Record call args
-	mmGetItemsByLocation.GetItemsByLocationMock.mutex.Lock()
-	mmGetItemsByLocation.GetItemsByLocationMock.callArgs = append(mmGetItemsByLocation.GetItemsByLocationMock.callArgs, &mm_params)
-	mmGetItemsByLocation.GetItemsByLocationMock.mutex.Unlock()
+	mmSetThreshold.SetThresholdMock.mutex.Lock()
+	mmSetThreshold.SetThresholdMock.callArgs = append(mmSetThreshold.SetThresholdMock.callArgs, &mm_params)
+	mmSetThreshold.SetThresholdMock.mutex.Unlock()
 
-	for _, e := range mmGetItemsByLocation.GetItemsByLocationMock.expectations {
+	for _, e := range mmSetThreshold.SetThresholdMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ia1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.params
-		mm_want_ptrs := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.paramPtrs
+	if mmSetThreshold.SetThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetThreshold.SetThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetThreshold.SetThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmSetThreshold.SetThresholdMock.defaultExpectation.paramPtrs
 
-		mm_got := IStockRepoMockGetItemsByLocationParams{ctx, param}
+		mm_got := IStockRepoMockSetThresholdParams{ctx, threshold}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetThreshold.t.Errorf("IStockRepoMock.SetThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetThreshold.SetThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
-				mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			if mm_want_ptrs.threshold != nil && !minimock.Equal(*mm_want_ptrs.threshold, mm_got.threshold) {
+				mmSetThreshold.t.Errorf("IStockRepoMock.SetThreshold got unexpected parameter threshold, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetThreshold.SetThresholdMock.defaultExpectation.expectationOrigins.originThreshold, *mm_want_ptrs.threshold, mm_got.threshold, minimock.Diff(*mm_want_ptrs.threshold, mm_got.threshold))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetItemsByLocation.t.Errorf("IStockRepoMock.GetItemsByLocation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetThreshold.t.Errorf("IStockRepoMock.SetThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetThreshold.SetThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetItemsByLocation.GetItemsByLocationMock.defaultExpectation.results
+		mm_results := mmSetThreshold.SetThresholdMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetItemsByLocation.t.Fatal("No results are set for the IStockRepoMock.GetItemsByLocation")
+			mmSetThreshold.t.Fatal("No results are set for the IStockRepoMock.SetThreshold")
 		}
-		return (*mm_results).ia1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetItemsByLocation.funcGetItemsByLocation != nil {
-		return mmGetItemsByLocation.funcGetItemsByLocation(ctx, param)
+	if mmSetThreshold.funcSetThreshold != nil {
+		return mmSetThreshold.funcSetThreshold(ctx, threshold)
 	}
-	mmGetItemsByLocation.t.Fatalf("Unexpected call to IStockRepoMock.GetItemsByLocation. %v %v", ctx, param)
+	mmSetThreshold.t.Fatalf("Unexpected call to IStockRepoMock.SetThreshold. %v %v", ctx, threshold)
 	return
 }
 
-// GetItemsByLocationAfterCounter returns a count of finished IStockRepoMock.GetItemsByLocation invocations
-func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocationAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsByLocation.afterGetItemsByLocationCounter)
+// SetThresholdAfterCounter returns a count of finished IStockRepoMock.SetThreshold invocations
+func (mmSetThreshold *IStockRepoMock) SetThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetThreshold.afterSetThresholdCounter)
 }
 
-// GetItemsByLocationBeforeCounter returns a count of IStockRepoMock.GetItemsByLocation invocations
-func (mmGetItemsByLocation *IStockRepoMock) GetItemsByLocationBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsByLocation.beforeGetItemsByLocationCounter)
+// SetThresholdBeforeCounter returns a count of IStockRepoMock.SetThreshold invocations
+func (mmSetThreshold *IStockRepoMock) SetThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetThreshold.beforeSetThresholdCounter)
 }
 
-// Calls returns a list of arguments used in each call to IStockRepoMock.GetItemsByLocation.
+// Calls returns a list of arguments used in each call to IStockRepoMock.SetThreshold.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetItemsByLocation *mIStockRepoMockGetItemsByLocation) Calls() []*IStockRepoMockGetItemsByLocationParams {
-	mmGetItemsByLocation.mutex.RLock()
+func (mmSetThreshold *mIStockRepoMockSetThreshold) Calls() []*IStockRepoMockSetThresholdParams {
+	mmSetThreshold.mutex.RLock()
 
-	argCopy := make([]*IStockRepoMockGetItemsByLocationParams, len(mmGetItemsByLocation.callArgs))
-	copy(argCopy, mmGetItemsByLocation.callArgs)
+	argCopy := make([]*IStockRepoMockSetThresholdParams, len(mmSetThreshold.callArgs))
+	copy(argCopy, mmSetThreshold.callArgs)
 
-	mmGetItemsByLocation.mutex.RUnlock()
+	mmSetThreshold.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetItemsByLocationDone returns true if the count of the GetItemsByLocation invocations corresponds
+// MinimockSetThresholdDone returns true if the count of the SetThreshold invocations corresponds
 // the number of defined expectations
-func (m *IStockRepoMock) MinimockGetItemsByLocationDone() bool {
-	if m.GetItemsByLocationMock.optional {
+func (m *IStockRepoMock) MinimockSetThresholdDone() bool {
+	if m.SetThresholdMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetItemsByLocationMock.expectations {
+	for _, e := range m.SetThresholdMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetItemsByLocationMock.invocationsDone()
+	return m.SetThresholdMock.invocationsDone()
 }
 
-// MinimockGetItemsByLocationInspect logs each unmet expectation
-func (m *IStockRepoMock) MinimockGetItemsByLocationInspect() {
-	for _, e := range m.GetItemsByLocationMock.expectations {
+// MinimockSetThresholdInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockSetThresholdInspect() {
+	for _, e := range m.SetThresholdMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SetThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetItemsByLocationCounter := mm_atomic.LoadUint64(&m.afterGetItemsByLocationCounter)
+	afterSetThresholdCounter := mm_atomic.LoadUint64(&m.afterSetThresholdCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetItemsByLocationMock.defaultExpectation != nil && afterGetItemsByLocationCounter < 1 {
-		if m.GetItemsByLocationMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s", m.GetItemsByLocationMock.defaultExpectation.returnOrigin)
+	if m.SetThresholdMock.defaultExpectation != nil && afterSetThresholdCounter < 1 {
+		if m.SetThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.SetThreshold at\n%s", m.SetThresholdMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s with params: %#v", m.GetItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsByLocationMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IStockRepoMock.SetThreshold at\n%s with params: %#v", m.SetThresholdMock.defaultExpectation.expectationOrigins.origin, *m.SetThresholdMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetItemsByLocation != nil && afterGetItemsByLocationCounter < 1 {
-		m.t.Errorf("Expected call to IStockRepoMock.GetItemsByLocation at\n%s", m.funcGetItemsByLocationOrigin)
+	if m.funcSetThreshold != nil && afterSetThresholdCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.SetThreshold at\n%s", m.funcSetThresholdOrigin)
 	}
 
-	if !m.GetItemsByLocationMock.invocationsDone() && afterGetItemsByLocationCounter > 0 {
-		m.t.Errorf("Expected %d calls to IStockRepoMock.GetItemsByLocation at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetItemsByLocationMock.expectedInvocations), m.GetItemsByLocationMock.expectedInvocationsOrigin, afterGetItemsByLocationCounter)
+	if !m.SetThresholdMock.invocationsDone() && afterSetThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.SetThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetThresholdMock.expectedInvocations), m.SetThresholdMock.expectedInvocationsOrigin, afterSetThresholdCounter)
 	}
 }
 
@@ -1827,19 +10003,407 @@ func (m *IStockRepoMock) MinimockUpdateStockInspect() {
 	}
 }
 
+type mIStockRepoMockUpsertSeller struct {
+	optional           bool
+	mock               *IStockRepoMock
+	defaultExpectation *IStockRepoMockUpsertSellerExpectation
+	expectations       []*IStockRepoMockUpsertSellerExpectation
+
+	callArgs []*IStockRepoMockUpsertSellerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IStockRepoMockUpsertSellerExpectation specifies expectation struct of the IStockRepo.UpsertSeller
+type IStockRepoMockUpsertSellerExpectation struct {
+	mock               *IStockRepoMock
+	params             *IStockRepoMockUpsertSellerParams
+	paramPtrs          *IStockRepoMockUpsertSellerParamPtrs
+	expectationOrigins IStockRepoMockUpsertSellerExpectationOrigins
+	results            *IStockRepoMockUpsertSellerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IStockRepoMockUpsertSellerParams contains parameters of the IStockRepo.UpsertSeller
+type IStockRepoMockUpsertSellerParams struct {
+	ctx    context.Context
+	seller models.Seller
+}
+
+// IStockRepoMockUpsertSellerParamPtrs contains pointers to parameters of the IStockRepo.UpsertSeller
+type IStockRepoMockUpsertSellerParamPtrs struct {
+	ctx    *context.Context
+	seller *models.Seller
+}
+
+// IStockRepoMockUpsertSellerResults contains results of the IStockRepo.UpsertSeller
+type IStockRepoMockUpsertSellerResults struct {
+	err error
+}
+
+// IStockRepoMockUpsertSellerOrigins contains origins of expectations of the IStockRepo.UpsertSeller
+type IStockRepoMockUpsertSellerExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSeller string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Optional() *mIStockRepoMockUpsertSeller {
+	mmUpsertSeller.optional = true
+	return mmUpsertSeller
+}
+
+// Expect sets up expected params for IStockRepo.UpsertSeller
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Expect(ctx context.Context, seller models.Seller) *mIStockRepoMockUpsertSeller {
+	if mmUpsertSeller.mock.funcUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Set")
+	}
+
+	if mmUpsertSeller.defaultExpectation == nil {
+		mmUpsertSeller.defaultExpectation = &IStockRepoMockUpsertSellerExpectation{}
+	}
+
+	if mmUpsertSeller.defaultExpectation.paramPtrs != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by ExpectParams functions")
+	}
+
+	mmUpsertSeller.defaultExpectation.params = &IStockRepoMockUpsertSellerParams{ctx, seller}
+	mmUpsertSeller.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsertSeller.expectations {
+		if minimock.Equal(e.params, mmUpsertSeller.defaultExpectation.params) {
+			mmUpsertSeller.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertSeller.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsertSeller
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IStockRepo.UpsertSeller
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) ExpectCtxParam1(ctx context.Context) *mIStockRepoMockUpsertSeller {
+	if mmUpsertSeller.mock.funcUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Set")
+	}
+
+	if mmUpsertSeller.defaultExpectation == nil {
+		mmUpsertSeller.defaultExpectation = &IStockRepoMockUpsertSellerExpectation{}
+	}
+
+	if mmUpsertSeller.defaultExpectation.params != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Expect")
+	}
+
+	if mmUpsertSeller.defaultExpectation.paramPtrs == nil {
+		mmUpsertSeller.defaultExpectation.paramPtrs = &IStockRepoMockUpsertSellerParamPtrs{}
+	}
+	mmUpsertSeller.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsertSeller.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpsertSeller
+}
+
+// ExpectSellerParam2 sets up expected param seller for IStockRepo.UpsertSeller
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) ExpectSellerParam2(seller models.Seller) *mIStockRepoMockUpsertSeller {
+	if mmUpsertSeller.mock.funcUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Set")
+	}
+
+	if mmUpsertSeller.defaultExpectation == nil {
+		mmUpsertSeller.defaultExpectation = &IStockRepoMockUpsertSellerExpectation{}
+	}
+
+	if mmUpsertSeller.defaultExpectation.params != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Expect")
+	}
+
+	if mmUpsertSeller.defaultExpectation.paramPtrs == nil {
+		mmUpsertSeller.defaultExpectation.paramPtrs = &IStockRepoMockUpsertSellerParamPtrs{}
+	}
+	mmUpsertSeller.defaultExpectation.paramPtrs.seller = &seller
+	mmUpsertSeller.defaultExpectation.expectationOrigins.originSeller = minimock.CallerInfo(1)
+
+	return mmUpsertSeller
+}
+
+// Inspect accepts an inspector function that has same arguments as the IStockRepo.UpsertSeller
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Inspect(f func(ctx context.Context, seller models.Seller)) *mIStockRepoMockUpsertSeller {
+	if mmUpsertSeller.mock.inspectFuncUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("Inspect function is already set for IStockRepoMock.UpsertSeller")
+	}
+
+	mmUpsertSeller.mock.inspectFuncUpsertSeller = f
+
+	return mmUpsertSeller
+}
+
+// Return sets up results that will be returned by IStockRepo.UpsertSeller
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Return(err error) *IStockRepoMock {
+	if mmUpsertSeller.mock.funcUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Set")
+	}
+
+	if mmUpsertSeller.defaultExpectation == nil {
+		mmUpsertSeller.defaultExpectation = &IStockRepoMockUpsertSellerExpectation{mock: mmUpsertSeller.mock}
+	}
+	mmUpsertSeller.defaultExpectation.results = &IStockRepoMockUpsertSellerResults{err}
+	mmUpsertSeller.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsertSeller.mock
+}
+
+// Set uses given function f to mock the IStockRepo.UpsertSeller method
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Set(f func(ctx context.Context, seller models.Seller) (err error)) *IStockRepoMock {
+	if mmUpsertSeller.defaultExpectation != nil {
+		mmUpsertSeller.mock.t.Fatalf("Default expectation is already set for the IStockRepo.UpsertSeller method")
+	}
+
+	if len(mmUpsertSeller.expectations) > 0 {
+		mmUpsertSeller.mock.t.Fatalf("Some expectations are already set for the IStockRepo.UpsertSeller method")
+	}
+
+	mmUpsertSeller.mock.funcUpsertSeller = f
+	mmUpsertSeller.mock.funcUpsertSellerOrigin = minimock.CallerInfo(1)
+	return mmUpsertSeller.mock
+}
+
+// When sets expectation for the IStockRepo.UpsertSeller which will trigger the result defined by the following
+// Then helper
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) When(ctx context.Context, seller models.Seller) *IStockRepoMockUpsertSellerExpectation {
+	if mmUpsertSeller.mock.funcUpsertSeller != nil {
+		mmUpsertSeller.mock.t.Fatalf("IStockRepoMock.UpsertSeller mock is already set by Set")
+	}
+
+	expectation := &IStockRepoMockUpsertSellerExpectation{
+		mock:               mmUpsertSeller.mock,
+		params:             &IStockRepoMockUpsertSellerParams{ctx, seller},
+		expectationOrigins: IStockRepoMockUpsertSellerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpsertSeller.expectations = append(mmUpsertSeller.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IStockRepo.UpsertSeller return parameters for the expectation previously defined by the When method
+func (e *IStockRepoMockUpsertSellerExpectation) Then(err error) *IStockRepoMock {
+	e.results = &IStockRepoMockUpsertSellerResults{err}
+	return e.mock
+}
+
+// Times sets number of times IStockRepo.UpsertSeller should be invoked
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Times(n uint64) *mIStockRepoMockUpsertSeller {
+	if n == 0 {
+		mmUpsertSeller.mock.t.Fatalf("Times of IStockRepoMock.UpsertSeller mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpsertSeller.expectedInvocations, n)
+	mmUpsertSeller.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsertSeller
+}
+
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) invocationsDone() bool {
+	if len(mmUpsertSeller.expectations) == 0 && mmUpsertSeller.defaultExpectation == nil && mmUpsertSeller.mock.funcUpsertSeller == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertSeller.mock.afterUpsertSellerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertSeller.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpsertSeller implements mm_repository.IStockRepo
+func (mmUpsertSeller *IStockRepoMock) UpsertSeller(ctx context.Context, seller models.Seller) (err error) {
+	mm_atomic.AddUint64(&mmUpsertSeller.beforeUpsertSellerCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertSeller.afterUpsertSellerCounter, 1)
+
+	mmUpsertSeller.t.Helper()
+
+	if mmUpsertSeller.inspectFuncUpsertSeller != nil {
+		mmUpsertSeller.inspectFuncUpsertSeller(ctx, seller)
+	}
+
+	mm_params := IStockRepoMockUpsertSellerParams{ctx, seller}
+
+	// Record call args
+	mmUpsertSeller.UpsertSellerMock.mutex.Lock()
+	mmUpsertSeller.UpsertSellerMock.callArgs = append(mmUpsertSeller.UpsertSellerMock.callArgs, &mm_params)
+	mmUpsertSeller.UpsertSellerMock.mutex.Unlock()
+
+	for _, e := range mmUpsertSeller.UpsertSellerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpsertSeller.UpsertSellerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertSeller.UpsertSellerMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertSeller.UpsertSellerMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertSeller.UpsertSellerMock.defaultExpectation.paramPtrs
+
+		mm_got := IStockRepoMockUpsertSellerParams{ctx, seller}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpsertSeller.t.Errorf("IStockRepoMock.UpsertSeller got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertSeller.UpsertSellerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.seller != nil && !minimock.Equal(*mm_want_ptrs.seller, mm_got.seller) {
+				mmUpsertSeller.t.Errorf("IStockRepoMock.UpsertSeller got unexpected parameter seller, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertSeller.UpsertSellerMock.defaultExpectation.expectationOrigins.originSeller, *mm_want_ptrs.seller, mm_got.seller, minimock.Diff(*mm_want_ptrs.seller, mm_got.seller))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpsertSeller.t.Errorf("IStockRepoMock.UpsertSeller got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsertSeller.UpsertSellerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpsertSeller.UpsertSellerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpsertSeller.t.Fatal("No results are set for the IStockRepoMock.UpsertSeller")
+		}
+		return (*mm_results).err
+	}
+	if mmUpsertSeller.funcUpsertSeller != nil {
+		return mmUpsertSeller.funcUpsertSeller(ctx, seller)
+	}
+	mmUpsertSeller.t.Fatalf("Unexpected call to IStockRepoMock.UpsertSeller. %v %v", ctx, seller)
+	return
+}
+
+// UpsertSellerAfterCounter returns a count of finished IStockRepoMock.UpsertSeller invocations
+func (mmUpsertSeller *IStockRepoMock) UpsertSellerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertSeller.afterUpsertSellerCounter)
+}
+
+// UpsertSellerBeforeCounter returns a count of IStockRepoMock.UpsertSeller invocations
+func (mmUpsertSeller *IStockRepoMock) UpsertSellerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertSeller.beforeUpsertSellerCounter)
+}
+
+// Calls returns a list of arguments used in each call to IStockRepoMock.UpsertSeller.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpsertSeller *mIStockRepoMockUpsertSeller) Calls() []*IStockRepoMockUpsertSellerParams {
+	mmUpsertSeller.mutex.RLock()
+
+	argCopy := make([]*IStockRepoMockUpsertSellerParams, len(mmUpsertSeller.callArgs))
+	copy(argCopy, mmUpsertSeller.callArgs)
+
+	mmUpsertSeller.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpsertSellerDone returns true if the count of the UpsertSeller invocations corresponds
+// the number of defined expectations
+func (m *IStockRepoMock) MinimockUpsertSellerDone() bool {
+	if m.UpsertSellerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpsertSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpsertSellerMock.invocationsDone()
+}
+
+// MinimockUpsertSellerInspect logs each unmet expectation
+func (m *IStockRepoMock) MinimockUpsertSellerInspect() {
+	for _, e := range m.UpsertSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IStockRepoMock.UpsertSeller at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpsertSellerCounter := mm_atomic.LoadUint64(&m.afterUpsertSellerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpsertSellerMock.defaultExpectation != nil && afterUpsertSellerCounter < 1 {
+		if m.UpsertSellerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IStockRepoMock.UpsertSeller at\n%s", m.UpsertSellerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IStockRepoMock.UpsertSeller at\n%s with params: %#v", m.UpsertSellerMock.defaultExpectation.expectationOrigins.origin, *m.UpsertSellerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpsertSeller != nil && afterUpsertSellerCounter < 1 {
+		m.t.Errorf("Expected call to IStockRepoMock.UpsertSeller at\n%s", m.funcUpsertSellerOrigin)
+	}
+
+	if !m.UpsertSellerMock.invocationsDone() && afterUpsertSellerCounter > 0 {
+		m.t.Errorf("Expected %d calls to IStockRepoMock.UpsertSeller at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertSellerMock.expectedInvocations), m.UpsertSellerMock.expectedInvocationsOrigin, afterUpsertSellerCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *IStockRepoMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddPriceHistoryInspect()
+
 			m.MinimockAddStockInspect()
 
+			m.MinimockCountItemsByLocationInspect()
+
+			m.MinimockCountSearchItemsInspect()
+
 			m.MinimockDeleteStockInspect()
 
+			m.MinimockDeleteThresholdInspect()
+
+			m.MinimockEnsureSellerInspect()
+
+			m.MinimockExportItemsInspect()
+
+			m.MinimockGetDuePricesInspect()
+
 			m.MinimockGetItemBySKUInspect()
 
 			m.MinimockGetItemsByLocationInspect()
 
+			m.MinimockGetItemsBySKUsInspect()
+
+			m.MinimockGetLocationStockInspect()
+
+			m.MinimockGetOfferInspect()
+
+			m.MinimockGetPendingPricesInspect()
+
+			m.MinimockGetPriceHistoryInspect()
+
+			m.MinimockGetThresholdInspect()
+
+			m.MinimockListLowStockInspect()
+
+			m.MinimockListOffersInspect()
+
+			m.MinimockMarkPriceAppliedInspect()
+
+			m.MinimockPurgeDeletedStockInspect()
+
+			m.MinimockRestoreStockInspect()
+
+			m.MinimockSchedulePriceInspect()
+
+			m.MinimockSearchItemsInspect()
+
+			m.MinimockSetSKUPriceInspect()
+
+			m.MinimockSetThresholdInspect()
+
 			m.MinimockUpdateStockInspect()
+
+			m.MinimockUpsertSellerInspect()
 		}
 	})
 }
@@ -1863,9 +10427,32 @@ func (m *IStockRepoMock) MinimockWait(timeout mm_time.Duration) {
 func (m *IStockRepoMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddPriceHistoryDone() &&
 		m.MinimockAddStockDone() &&
+		m.MinimockCountItemsByLocationDone() &&
+		m.MinimockCountSearchItemsDone() &&
 		m.MinimockDeleteStockDone() &&
+		m.MinimockDeleteThresholdDone() &&
+		m.MinimockEnsureSellerDone() &&
+		m.MinimockExportItemsDone() &&
+		m.MinimockGetDuePricesDone() &&
 		m.MinimockGetItemBySKUDone() &&
 		m.MinimockGetItemsByLocationDone() &&
-		m.MinimockUpdateStockDone()
+		m.MinimockGetItemsBySKUsDone() &&
+		m.MinimockGetLocationStockDone() &&
+		m.MinimockGetOfferDone() &&
+		m.MinimockGetPendingPricesDone() &&
+		m.MinimockGetPriceHistoryDone() &&
+		m.MinimockGetThresholdDone() &&
+		m.MinimockListLowStockDone() &&
+		m.MinimockListOffersDone() &&
+		m.MinimockMarkPriceAppliedDone() &&
+		m.MinimockPurgeDeletedStockDone() &&
+		m.MinimockRestoreStockDone() &&
+		m.MinimockSchedulePriceDone() &&
+		m.MinimockSearchItemsDone() &&
+		m.MinimockSetSKUPriceDone() &&
+		m.MinimockSetThresholdDone() &&
+		m.MinimockUpdateStockDone() &&
+		m.MinimockUpsertSellerDone()
 }
@@ -0,0 +1,2228 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+//go:generate minimock -i stocks/internal/repository.ISKURepo -o isku_repo.go -n ISKURepoMock -p mock
+
+import (
+	"context"
+	"stocks/internal/models"
+	mm_repository "stocks/internal/repository"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ISKURepoMock implements mm_repository.ISKURepo
+type ISKURepoMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcArchiveSKU          func(ctx context.Context, skuID models.SKUID) (err error)
+	funcArchiveSKUOrigin    string
+	inspectFuncArchiveSKU   func(ctx context.Context, skuID models.SKUID)
+	afterArchiveSKUCounter  uint64
+	beforeArchiveSKUCounter uint64
+	ArchiveSKUMock          mISKURepoMockArchiveSKU
+
+	funcCountSKUs          func(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (i1 int64, err error)
+	funcCountSKUsOrigin    string
+	inspectFuncCountSKUs   func(ctx context.Context, categoryID models.CategoryID, includeArchived bool)
+	afterCountSKUsCounter  uint64
+	beforeCountSKUsCounter uint64
+	CountSKUsMock          mISKURepoMockCountSKUs
+
+	funcCreateSKU          func(ctx context.Context, sku models.SKU) (s1 models.SKUID, err error)
+	funcCreateSKUOrigin    string
+	inspectFuncCreateSKU   func(ctx context.Context, sku models.SKU)
+	afterCreateSKUCounter  uint64
+	beforeCreateSKUCounter uint64
+	CreateSKUMock          mISKURepoMockCreateSKU
+
+	funcGetSKU          func(ctx context.Context, skuID models.SKUID) (s1 models.SKU, err error)
+	funcGetSKUOrigin    string
+	inspectFuncGetSKU   func(ctx context.Context, skuID models.SKUID)
+	afterGetSKUCounter  uint64
+	beforeGetSKUCounter uint64
+	GetSKUMock          mISKURepoMockGetSKU
+
+	funcListSKUs          func(ctx context.Context, param mm_repository.ListSKUsParam) (sa1 []models.SKU, err error)
+	funcListSKUsOrigin    string
+	inspectFuncListSKUs   func(ctx context.Context, param mm_repository.ListSKUsParam)
+	afterListSKUsCounter  uint64
+	beforeListSKUsCounter uint64
+	ListSKUsMock          mISKURepoMockListSKUs
+
+	funcUpdateSKU          func(ctx context.Context, sku models.SKU) (err error)
+	funcUpdateSKUOrigin    string
+	inspectFuncUpdateSKU   func(ctx context.Context, sku models.SKU)
+	afterUpdateSKUCounter  uint64
+	beforeUpdateSKUCounter uint64
+	UpdateSKUMock          mISKURepoMockUpdateSKU
+}
+
+// NewISKURepoMock returns a mock for mm_repository.ISKURepo
+func NewISKURepoMock(t minimock.Tester) *ISKURepoMock {
+	m := &ISKURepoMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ArchiveSKUMock = mISKURepoMockArchiveSKU{mock: m}
+	m.ArchiveSKUMock.callArgs = []*ISKURepoMockArchiveSKUParams{}
+
+	m.CountSKUsMock = mISKURepoMockCountSKUs{mock: m}
+	m.CountSKUsMock.callArgs = []*ISKURepoMockCountSKUsParams{}
+
+	m.CreateSKUMock = mISKURepoMockCreateSKU{mock: m}
+	m.CreateSKUMock.callArgs = []*ISKURepoMockCreateSKUParams{}
+
+	m.GetSKUMock = mISKURepoMockGetSKU{mock: m}
+	m.GetSKUMock.callArgs = []*ISKURepoMockGetSKUParams{}
+
+	m.ListSKUsMock = mISKURepoMockListSKUs{mock: m}
+	m.ListSKUsMock.callArgs = []*ISKURepoMockListSKUsParams{}
+
+	m.UpdateSKUMock = mISKURepoMockUpdateSKU{mock: m}
+	m.UpdateSKUMock.callArgs = []*ISKURepoMockUpdateSKUParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mISKURepoMockArchiveSKU struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockArchiveSKUExpectation
+	expectations       []*ISKURepoMockArchiveSKUExpectation
+
+	callArgs []*ISKURepoMockArchiveSKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockArchiveSKUExpectation specifies expectation struct of the ISKURepo.ArchiveSKU
+type ISKURepoMockArchiveSKUExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockArchiveSKUParams
+	paramPtrs          *ISKURepoMockArchiveSKUParamPtrs
+	expectationOrigins ISKURepoMockArchiveSKUExpectationOrigins
+	results            *ISKURepoMockArchiveSKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockArchiveSKUParams contains parameters of the ISKURepo.ArchiveSKU
+type ISKURepoMockArchiveSKUParams struct {
+	ctx   context.Context
+	skuID models.SKUID
+}
+
+// ISKURepoMockArchiveSKUParamPtrs contains pointers to parameters of the ISKURepo.ArchiveSKU
+type ISKURepoMockArchiveSKUParamPtrs struct {
+	ctx   *context.Context
+	skuID *models.SKUID
+}
+
+// ISKURepoMockArchiveSKUResults contains results of the ISKURepo.ArchiveSKU
+type ISKURepoMockArchiveSKUResults struct {
+	err error
+}
+
+// ISKURepoMockArchiveSKUOrigins contains origins of expectations of the ISKURepo.ArchiveSKU
+type ISKURepoMockArchiveSKUExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Optional() *mISKURepoMockArchiveSKU {
+	mmArchiveSKU.optional = true
+	return mmArchiveSKU
+}
+
+// Expect sets up expected params for ISKURepo.ArchiveSKU
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Expect(ctx context.Context, skuID models.SKUID) *mISKURepoMockArchiveSKU {
+	if mmArchiveSKU.mock.funcArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Set")
+	}
+
+	if mmArchiveSKU.defaultExpectation == nil {
+		mmArchiveSKU.defaultExpectation = &ISKURepoMockArchiveSKUExpectation{}
+	}
+
+	if mmArchiveSKU.defaultExpectation.paramPtrs != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by ExpectParams functions")
+	}
+
+	mmArchiveSKU.defaultExpectation.params = &ISKURepoMockArchiveSKUParams{ctx, skuID}
+	mmArchiveSKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmArchiveSKU.expectations {
+		if minimock.Equal(e.params, mmArchiveSKU.defaultExpectation.params) {
+			mmArchiveSKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchiveSKU.defaultExpectation.params)
+		}
+	}
+
+	return mmArchiveSKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.ArchiveSKU
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) ExpectCtxParam1(ctx context.Context) *mISKURepoMockArchiveSKU {
+	if mmArchiveSKU.mock.funcArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Set")
+	}
+
+	if mmArchiveSKU.defaultExpectation == nil {
+		mmArchiveSKU.defaultExpectation = &ISKURepoMockArchiveSKUExpectation{}
+	}
+
+	if mmArchiveSKU.defaultExpectation.params != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Expect")
+	}
+
+	if mmArchiveSKU.defaultExpectation.paramPtrs == nil {
+		mmArchiveSKU.defaultExpectation.paramPtrs = &ISKURepoMockArchiveSKUParamPtrs{}
+	}
+	mmArchiveSKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmArchiveSKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmArchiveSKU
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for ISKURepo.ArchiveSKU
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) ExpectSkuIDParam2(skuID models.SKUID) *mISKURepoMockArchiveSKU {
+	if mmArchiveSKU.mock.funcArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Set")
+	}
+
+	if mmArchiveSKU.defaultExpectation == nil {
+		mmArchiveSKU.defaultExpectation = &ISKURepoMockArchiveSKUExpectation{}
+	}
+
+	if mmArchiveSKU.defaultExpectation.params != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Expect")
+	}
+
+	if mmArchiveSKU.defaultExpectation.paramPtrs == nil {
+		mmArchiveSKU.defaultExpectation.paramPtrs = &ISKURepoMockArchiveSKUParamPtrs{}
+	}
+	mmArchiveSKU.defaultExpectation.paramPtrs.skuID = &skuID
+	mmArchiveSKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmArchiveSKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.ArchiveSKU
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mISKURepoMockArchiveSKU {
+	if mmArchiveSKU.mock.inspectFuncArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.ArchiveSKU")
+	}
+
+	mmArchiveSKU.mock.inspectFuncArchiveSKU = f
+
+	return mmArchiveSKU
+}
+
+// Return sets up results that will be returned by ISKURepo.ArchiveSKU
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Return(err error) *ISKURepoMock {
+	if mmArchiveSKU.mock.funcArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Set")
+	}
+
+	if mmArchiveSKU.defaultExpectation == nil {
+		mmArchiveSKU.defaultExpectation = &ISKURepoMockArchiveSKUExpectation{mock: mmArchiveSKU.mock}
+	}
+	mmArchiveSKU.defaultExpectation.results = &ISKURepoMockArchiveSKUResults{err}
+	mmArchiveSKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmArchiveSKU.mock
+}
+
+// Set uses given function f to mock the ISKURepo.ArchiveSKU method
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Set(f func(ctx context.Context, skuID models.SKUID) (err error)) *ISKURepoMock {
+	if mmArchiveSKU.defaultExpectation != nil {
+		mmArchiveSKU.mock.t.Fatalf("Default expectation is already set for the ISKURepo.ArchiveSKU method")
+	}
+
+	if len(mmArchiveSKU.expectations) > 0 {
+		mmArchiveSKU.mock.t.Fatalf("Some expectations are already set for the ISKURepo.ArchiveSKU method")
+	}
+
+	mmArchiveSKU.mock.funcArchiveSKU = f
+	mmArchiveSKU.mock.funcArchiveSKUOrigin = minimock.CallerInfo(1)
+	return mmArchiveSKU.mock
+}
+
+// When sets expectation for the ISKURepo.ArchiveSKU which will trigger the result defined by the following
+// Then helper
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) When(ctx context.Context, skuID models.SKUID) *ISKURepoMockArchiveSKUExpectation {
+	if mmArchiveSKU.mock.funcArchiveSKU != nil {
+		mmArchiveSKU.mock.t.Fatalf("ISKURepoMock.ArchiveSKU mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockArchiveSKUExpectation{
+		mock:               mmArchiveSKU.mock,
+		params:             &ISKURepoMockArchiveSKUParams{ctx, skuID},
+		expectationOrigins: ISKURepoMockArchiveSKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmArchiveSKU.expectations = append(mmArchiveSKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.ArchiveSKU return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockArchiveSKUExpectation) Then(err error) *ISKURepoMock {
+	e.results = &ISKURepoMockArchiveSKUResults{err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.ArchiveSKU should be invoked
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Times(n uint64) *mISKURepoMockArchiveSKU {
+	if n == 0 {
+		mmArchiveSKU.mock.t.Fatalf("Times of ISKURepoMock.ArchiveSKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmArchiveSKU.expectedInvocations, n)
+	mmArchiveSKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmArchiveSKU
+}
+
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) invocationsDone() bool {
+	if len(mmArchiveSKU.expectations) == 0 && mmArchiveSKU.defaultExpectation == nil && mmArchiveSKU.mock.funcArchiveSKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmArchiveSKU.mock.afterArchiveSKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmArchiveSKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ArchiveSKU implements mm_repository.ISKURepo
+func (mmArchiveSKU *ISKURepoMock) ArchiveSKU(ctx context.Context, skuID models.SKUID) (err error) {
+	mm_atomic.AddUint64(&mmArchiveSKU.beforeArchiveSKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmArchiveSKU.afterArchiveSKUCounter, 1)
+
+	mmArchiveSKU.t.Helper()
+
+	if mmArchiveSKU.inspectFuncArchiveSKU != nil {
+		mmArchiveSKU.inspectFuncArchiveSKU(ctx, skuID)
+	}
+
+	mm_params := ISKURepoMockArchiveSKUParams{ctx, skuID}
+
+	// Record call args
+	mmArchiveSKU.ArchiveSKUMock.mutex.Lock()
+	mmArchiveSKU.ArchiveSKUMock.callArgs = append(mmArchiveSKU.ArchiveSKUMock.callArgs, &mm_params)
+	mmArchiveSKU.ArchiveSKUMock.mutex.Unlock()
+
+	for _, e := range mmArchiveSKU.ArchiveSKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmArchiveSKU.ArchiveSKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArchiveSKU.ArchiveSKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmArchiveSKU.ArchiveSKUMock.defaultExpectation.params
+		mm_want_ptrs := mmArchiveSKU.ArchiveSKUMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockArchiveSKUParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmArchiveSKU.t.Errorf("ISKURepoMock.ArchiveSKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveSKU.ArchiveSKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmArchiveSKU.t.Errorf("ISKURepoMock.ArchiveSKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveSKU.ArchiveSKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArchiveSKU.t.Errorf("ISKURepoMock.ArchiveSKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmArchiveSKU.ArchiveSKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArchiveSKU.ArchiveSKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArchiveSKU.t.Fatal("No results are set for the ISKURepoMock.ArchiveSKU")
+		}
+		return (*mm_results).err
+	}
+	if mmArchiveSKU.funcArchiveSKU != nil {
+		return mmArchiveSKU.funcArchiveSKU(ctx, skuID)
+	}
+	mmArchiveSKU.t.Fatalf("Unexpected call to ISKURepoMock.ArchiveSKU. %v %v", ctx, skuID)
+	return
+}
+
+// ArchiveSKUAfterCounter returns a count of finished ISKURepoMock.ArchiveSKU invocations
+func (mmArchiveSKU *ISKURepoMock) ArchiveSKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveSKU.afterArchiveSKUCounter)
+}
+
+// ArchiveSKUBeforeCounter returns a count of ISKURepoMock.ArchiveSKU invocations
+func (mmArchiveSKU *ISKURepoMock) ArchiveSKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveSKU.beforeArchiveSKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.ArchiveSKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArchiveSKU *mISKURepoMockArchiveSKU) Calls() []*ISKURepoMockArchiveSKUParams {
+	mmArchiveSKU.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockArchiveSKUParams, len(mmArchiveSKU.callArgs))
+	copy(argCopy, mmArchiveSKU.callArgs)
+
+	mmArchiveSKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArchiveSKUDone returns true if the count of the ArchiveSKU invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockArchiveSKUDone() bool {
+	if m.ArchiveSKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ArchiveSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ArchiveSKUMock.invocationsDone()
+}
+
+// MinimockArchiveSKUInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockArchiveSKUInspect() {
+	for _, e := range m.ArchiveSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.ArchiveSKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterArchiveSKUCounter := mm_atomic.LoadUint64(&m.afterArchiveSKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArchiveSKUMock.defaultExpectation != nil && afterArchiveSKUCounter < 1 {
+		if m.ArchiveSKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.ArchiveSKU at\n%s", m.ArchiveSKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.ArchiveSKU at\n%s with params: %#v", m.ArchiveSKUMock.defaultExpectation.expectationOrigins.origin, *m.ArchiveSKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArchiveSKU != nil && afterArchiveSKUCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.ArchiveSKU at\n%s", m.funcArchiveSKUOrigin)
+	}
+
+	if !m.ArchiveSKUMock.invocationsDone() && afterArchiveSKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.ArchiveSKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ArchiveSKUMock.expectedInvocations), m.ArchiveSKUMock.expectedInvocationsOrigin, afterArchiveSKUCounter)
+	}
+}
+
+type mISKURepoMockCountSKUs struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockCountSKUsExpectation
+	expectations       []*ISKURepoMockCountSKUsExpectation
+
+	callArgs []*ISKURepoMockCountSKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockCountSKUsExpectation specifies expectation struct of the ISKURepo.CountSKUs
+type ISKURepoMockCountSKUsExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockCountSKUsParams
+	paramPtrs          *ISKURepoMockCountSKUsParamPtrs
+	expectationOrigins ISKURepoMockCountSKUsExpectationOrigins
+	results            *ISKURepoMockCountSKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockCountSKUsParams contains parameters of the ISKURepo.CountSKUs
+type ISKURepoMockCountSKUsParams struct {
+	ctx             context.Context
+	categoryID      models.CategoryID
+	includeArchived bool
+}
+
+// ISKURepoMockCountSKUsParamPtrs contains pointers to parameters of the ISKURepo.CountSKUs
+type ISKURepoMockCountSKUsParamPtrs struct {
+	ctx             *context.Context
+	categoryID      *models.CategoryID
+	includeArchived *bool
+}
+
+// ISKURepoMockCountSKUsResults contains results of the ISKURepo.CountSKUs
+type ISKURepoMockCountSKUsResults struct {
+	i1  int64
+	err error
+}
+
+// ISKURepoMockCountSKUsOrigins contains origins of expectations of the ISKURepo.CountSKUs
+type ISKURepoMockCountSKUsExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originCategoryID      string
+	originIncludeArchived string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountSKUs *mISKURepoMockCountSKUs) Optional() *mISKURepoMockCountSKUs {
+	mmCountSKUs.optional = true
+	return mmCountSKUs
+}
+
+// Expect sets up expected params for ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) Expect(ctx context.Context, categoryID models.CategoryID, includeArchived bool) *mISKURepoMockCountSKUs {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	if mmCountSKUs.defaultExpectation == nil {
+		mmCountSKUs.defaultExpectation = &ISKURepoMockCountSKUsExpectation{}
+	}
+
+	if mmCountSKUs.defaultExpectation.paramPtrs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by ExpectParams functions")
+	}
+
+	mmCountSKUs.defaultExpectation.params = &ISKURepoMockCountSKUsParams{ctx, categoryID, includeArchived}
+	mmCountSKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountSKUs.expectations {
+		if minimock.Equal(e.params, mmCountSKUs.defaultExpectation.params) {
+			mmCountSKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountSKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmCountSKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) ExpectCtxParam1(ctx context.Context) *mISKURepoMockCountSKUs {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	if mmCountSKUs.defaultExpectation == nil {
+		mmCountSKUs.defaultExpectation = &ISKURepoMockCountSKUsExpectation{}
+	}
+
+	if mmCountSKUs.defaultExpectation.params != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Expect")
+	}
+
+	if mmCountSKUs.defaultExpectation.paramPtrs == nil {
+		mmCountSKUs.defaultExpectation.paramPtrs = &ISKURepoMockCountSKUsParamPtrs{}
+	}
+	mmCountSKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountSKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountSKUs
+}
+
+// ExpectCategoryIDParam2 sets up expected param categoryID for ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) ExpectCategoryIDParam2(categoryID models.CategoryID) *mISKURepoMockCountSKUs {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	if mmCountSKUs.defaultExpectation == nil {
+		mmCountSKUs.defaultExpectation = &ISKURepoMockCountSKUsExpectation{}
+	}
+
+	if mmCountSKUs.defaultExpectation.params != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Expect")
+	}
+
+	if mmCountSKUs.defaultExpectation.paramPtrs == nil {
+		mmCountSKUs.defaultExpectation.paramPtrs = &ISKURepoMockCountSKUsParamPtrs{}
+	}
+	mmCountSKUs.defaultExpectation.paramPtrs.categoryID = &categoryID
+	mmCountSKUs.defaultExpectation.expectationOrigins.originCategoryID = minimock.CallerInfo(1)
+
+	return mmCountSKUs
+}
+
+// ExpectIncludeArchivedParam3 sets up expected param includeArchived for ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) ExpectIncludeArchivedParam3(includeArchived bool) *mISKURepoMockCountSKUs {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	if mmCountSKUs.defaultExpectation == nil {
+		mmCountSKUs.defaultExpectation = &ISKURepoMockCountSKUsExpectation{}
+	}
+
+	if mmCountSKUs.defaultExpectation.params != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Expect")
+	}
+
+	if mmCountSKUs.defaultExpectation.paramPtrs == nil {
+		mmCountSKUs.defaultExpectation.paramPtrs = &ISKURepoMockCountSKUsParamPtrs{}
+	}
+	mmCountSKUs.defaultExpectation.paramPtrs.includeArchived = &includeArchived
+	mmCountSKUs.defaultExpectation.expectationOrigins.originIncludeArchived = minimock.CallerInfo(1)
+
+	return mmCountSKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) Inspect(f func(ctx context.Context, categoryID models.CategoryID, includeArchived bool)) *mISKURepoMockCountSKUs {
+	if mmCountSKUs.mock.inspectFuncCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.CountSKUs")
+	}
+
+	mmCountSKUs.mock.inspectFuncCountSKUs = f
+
+	return mmCountSKUs
+}
+
+// Return sets up results that will be returned by ISKURepo.CountSKUs
+func (mmCountSKUs *mISKURepoMockCountSKUs) Return(i1 int64, err error) *ISKURepoMock {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	if mmCountSKUs.defaultExpectation == nil {
+		mmCountSKUs.defaultExpectation = &ISKURepoMockCountSKUsExpectation{mock: mmCountSKUs.mock}
+	}
+	mmCountSKUs.defaultExpectation.results = &ISKURepoMockCountSKUsResults{i1, err}
+	mmCountSKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountSKUs.mock
+}
+
+// Set uses given function f to mock the ISKURepo.CountSKUs method
+func (mmCountSKUs *mISKURepoMockCountSKUs) Set(f func(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (i1 int64, err error)) *ISKURepoMock {
+	if mmCountSKUs.defaultExpectation != nil {
+		mmCountSKUs.mock.t.Fatalf("Default expectation is already set for the ISKURepo.CountSKUs method")
+	}
+
+	if len(mmCountSKUs.expectations) > 0 {
+		mmCountSKUs.mock.t.Fatalf("Some expectations are already set for the ISKURepo.CountSKUs method")
+	}
+
+	mmCountSKUs.mock.funcCountSKUs = f
+	mmCountSKUs.mock.funcCountSKUsOrigin = minimock.CallerInfo(1)
+	return mmCountSKUs.mock
+}
+
+// When sets expectation for the ISKURepo.CountSKUs which will trigger the result defined by the following
+// Then helper
+func (mmCountSKUs *mISKURepoMockCountSKUs) When(ctx context.Context, categoryID models.CategoryID, includeArchived bool) *ISKURepoMockCountSKUsExpectation {
+	if mmCountSKUs.mock.funcCountSKUs != nil {
+		mmCountSKUs.mock.t.Fatalf("ISKURepoMock.CountSKUs mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockCountSKUsExpectation{
+		mock:               mmCountSKUs.mock,
+		params:             &ISKURepoMockCountSKUsParams{ctx, categoryID, includeArchived},
+		expectationOrigins: ISKURepoMockCountSKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountSKUs.expectations = append(mmCountSKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.CountSKUs return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockCountSKUsExpectation) Then(i1 int64, err error) *ISKURepoMock {
+	e.results = &ISKURepoMockCountSKUsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.CountSKUs should be invoked
+func (mmCountSKUs *mISKURepoMockCountSKUs) Times(n uint64) *mISKURepoMockCountSKUs {
+	if n == 0 {
+		mmCountSKUs.mock.t.Fatalf("Times of ISKURepoMock.CountSKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountSKUs.expectedInvocations, n)
+	mmCountSKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountSKUs
+}
+
+func (mmCountSKUs *mISKURepoMockCountSKUs) invocationsDone() bool {
+	if len(mmCountSKUs.expectations) == 0 && mmCountSKUs.defaultExpectation == nil && mmCountSKUs.mock.funcCountSKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountSKUs.mock.afterCountSKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountSKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountSKUs implements mm_repository.ISKURepo
+func (mmCountSKUs *ISKURepoMock) CountSKUs(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCountSKUs.beforeCountSKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountSKUs.afterCountSKUsCounter, 1)
+
+	mmCountSKUs.t.Helper()
+
+	if mmCountSKUs.inspectFuncCountSKUs != nil {
+		mmCountSKUs.inspectFuncCountSKUs(ctx, categoryID, includeArchived)
+	}
+
+	mm_params := ISKURepoMockCountSKUsParams{ctx, categoryID, includeArchived}
+
+	// Record call args
+	mmCountSKUs.CountSKUsMock.mutex.Lock()
+	mmCountSKUs.CountSKUsMock.callArgs = append(mmCountSKUs.CountSKUsMock.callArgs, &mm_params)
+	mmCountSKUs.CountSKUsMock.mutex.Unlock()
+
+	for _, e := range mmCountSKUs.CountSKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountSKUs.CountSKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountSKUs.CountSKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountSKUs.CountSKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountSKUs.CountSKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockCountSKUsParams{ctx, categoryID, includeArchived}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountSKUs.t.Errorf("ISKURepoMock.CountSKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSKUs.CountSKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.categoryID != nil && !minimock.Equal(*mm_want_ptrs.categoryID, mm_got.categoryID) {
+				mmCountSKUs.t.Errorf("ISKURepoMock.CountSKUs got unexpected parameter categoryID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSKUs.CountSKUsMock.defaultExpectation.expectationOrigins.originCategoryID, *mm_want_ptrs.categoryID, mm_got.categoryID, minimock.Diff(*mm_want_ptrs.categoryID, mm_got.categoryID))
+			}
+
+			if mm_want_ptrs.includeArchived != nil && !minimock.Equal(*mm_want_ptrs.includeArchived, mm_got.includeArchived) {
+				mmCountSKUs.t.Errorf("ISKURepoMock.CountSKUs got unexpected parameter includeArchived, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSKUs.CountSKUsMock.defaultExpectation.expectationOrigins.originIncludeArchived, *mm_want_ptrs.includeArchived, mm_got.includeArchived, minimock.Diff(*mm_want_ptrs.includeArchived, mm_got.includeArchived))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountSKUs.t.Errorf("ISKURepoMock.CountSKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountSKUs.CountSKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountSKUs.CountSKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountSKUs.t.Fatal("No results are set for the ISKURepoMock.CountSKUs")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountSKUs.funcCountSKUs != nil {
+		return mmCountSKUs.funcCountSKUs(ctx, categoryID, includeArchived)
+	}
+	mmCountSKUs.t.Fatalf("Unexpected call to ISKURepoMock.CountSKUs. %v %v %v", ctx, categoryID, includeArchived)
+	return
+}
+
+// CountSKUsAfterCounter returns a count of finished ISKURepoMock.CountSKUs invocations
+func (mmCountSKUs *ISKURepoMock) CountSKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSKUs.afterCountSKUsCounter)
+}
+
+// CountSKUsBeforeCounter returns a count of ISKURepoMock.CountSKUs invocations
+func (mmCountSKUs *ISKURepoMock) CountSKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSKUs.beforeCountSKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.CountSKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountSKUs *mISKURepoMockCountSKUs) Calls() []*ISKURepoMockCountSKUsParams {
+	mmCountSKUs.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockCountSKUsParams, len(mmCountSKUs.callArgs))
+	copy(argCopy, mmCountSKUs.callArgs)
+
+	mmCountSKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountSKUsDone returns true if the count of the CountSKUs invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockCountSKUsDone() bool {
+	if m.CountSKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountSKUsMock.invocationsDone()
+}
+
+// MinimockCountSKUsInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockCountSKUsInspect() {
+	for _, e := range m.CountSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.CountSKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountSKUsCounter := mm_atomic.LoadUint64(&m.afterCountSKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountSKUsMock.defaultExpectation != nil && afterCountSKUsCounter < 1 {
+		if m.CountSKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.CountSKUs at\n%s", m.CountSKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.CountSKUs at\n%s with params: %#v", m.CountSKUsMock.defaultExpectation.expectationOrigins.origin, *m.CountSKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountSKUs != nil && afterCountSKUsCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.CountSKUs at\n%s", m.funcCountSKUsOrigin)
+	}
+
+	if !m.CountSKUsMock.invocationsDone() && afterCountSKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.CountSKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountSKUsMock.expectedInvocations), m.CountSKUsMock.expectedInvocationsOrigin, afterCountSKUsCounter)
+	}
+}
+
+type mISKURepoMockCreateSKU struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockCreateSKUExpectation
+	expectations       []*ISKURepoMockCreateSKUExpectation
+
+	callArgs []*ISKURepoMockCreateSKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockCreateSKUExpectation specifies expectation struct of the ISKURepo.CreateSKU
+type ISKURepoMockCreateSKUExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockCreateSKUParams
+	paramPtrs          *ISKURepoMockCreateSKUParamPtrs
+	expectationOrigins ISKURepoMockCreateSKUExpectationOrigins
+	results            *ISKURepoMockCreateSKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockCreateSKUParams contains parameters of the ISKURepo.CreateSKU
+type ISKURepoMockCreateSKUParams struct {
+	ctx context.Context
+	sku models.SKU
+}
+
+// ISKURepoMockCreateSKUParamPtrs contains pointers to parameters of the ISKURepo.CreateSKU
+type ISKURepoMockCreateSKUParamPtrs struct {
+	ctx *context.Context
+	sku *models.SKU
+}
+
+// ISKURepoMockCreateSKUResults contains results of the ISKURepo.CreateSKU
+type ISKURepoMockCreateSKUResults struct {
+	s1  models.SKUID
+	err error
+}
+
+// ISKURepoMockCreateSKUOrigins contains origins of expectations of the ISKURepo.CreateSKU
+type ISKURepoMockCreateSKUExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originSku string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSKU *mISKURepoMockCreateSKU) Optional() *mISKURepoMockCreateSKU {
+	mmCreateSKU.optional = true
+	return mmCreateSKU
+}
+
+// Expect sets up expected params for ISKURepo.CreateSKU
+func (mmCreateSKU *mISKURepoMockCreateSKU) Expect(ctx context.Context, sku models.SKU) *mISKURepoMockCreateSKU {
+	if mmCreateSKU.mock.funcCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Set")
+	}
+
+	if mmCreateSKU.defaultExpectation == nil {
+		mmCreateSKU.defaultExpectation = &ISKURepoMockCreateSKUExpectation{}
+	}
+
+	if mmCreateSKU.defaultExpectation.paramPtrs != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSKU.defaultExpectation.params = &ISKURepoMockCreateSKUParams{ctx, sku}
+	mmCreateSKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSKU.expectations {
+		if minimock.Equal(e.params, mmCreateSKU.defaultExpectation.params) {
+			mmCreateSKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSKU.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.CreateSKU
+func (mmCreateSKU *mISKURepoMockCreateSKU) ExpectCtxParam1(ctx context.Context) *mISKURepoMockCreateSKU {
+	if mmCreateSKU.mock.funcCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Set")
+	}
+
+	if mmCreateSKU.defaultExpectation == nil {
+		mmCreateSKU.defaultExpectation = &ISKURepoMockCreateSKUExpectation{}
+	}
+
+	if mmCreateSKU.defaultExpectation.params != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Expect")
+	}
+
+	if mmCreateSKU.defaultExpectation.paramPtrs == nil {
+		mmCreateSKU.defaultExpectation.paramPtrs = &ISKURepoMockCreateSKUParamPtrs{}
+	}
+	mmCreateSKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSKU
+}
+
+// ExpectSkuParam2 sets up expected param sku for ISKURepo.CreateSKU
+func (mmCreateSKU *mISKURepoMockCreateSKU) ExpectSkuParam2(sku models.SKU) *mISKURepoMockCreateSKU {
+	if mmCreateSKU.mock.funcCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Set")
+	}
+
+	if mmCreateSKU.defaultExpectation == nil {
+		mmCreateSKU.defaultExpectation = &ISKURepoMockCreateSKUExpectation{}
+	}
+
+	if mmCreateSKU.defaultExpectation.params != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Expect")
+	}
+
+	if mmCreateSKU.defaultExpectation.paramPtrs == nil {
+		mmCreateSKU.defaultExpectation.paramPtrs = &ISKURepoMockCreateSKUParamPtrs{}
+	}
+	mmCreateSKU.defaultExpectation.paramPtrs.sku = &sku
+	mmCreateSKU.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmCreateSKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.CreateSKU
+func (mmCreateSKU *mISKURepoMockCreateSKU) Inspect(f func(ctx context.Context, sku models.SKU)) *mISKURepoMockCreateSKU {
+	if mmCreateSKU.mock.inspectFuncCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.CreateSKU")
+	}
+
+	mmCreateSKU.mock.inspectFuncCreateSKU = f
+
+	return mmCreateSKU
+}
+
+// Return sets up results that will be returned by ISKURepo.CreateSKU
+func (mmCreateSKU *mISKURepoMockCreateSKU) Return(s1 models.SKUID, err error) *ISKURepoMock {
+	if mmCreateSKU.mock.funcCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Set")
+	}
+
+	if mmCreateSKU.defaultExpectation == nil {
+		mmCreateSKU.defaultExpectation = &ISKURepoMockCreateSKUExpectation{mock: mmCreateSKU.mock}
+	}
+	mmCreateSKU.defaultExpectation.results = &ISKURepoMockCreateSKUResults{s1, err}
+	mmCreateSKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSKU.mock
+}
+
+// Set uses given function f to mock the ISKURepo.CreateSKU method
+func (mmCreateSKU *mISKURepoMockCreateSKU) Set(f func(ctx context.Context, sku models.SKU) (s1 models.SKUID, err error)) *ISKURepoMock {
+	if mmCreateSKU.defaultExpectation != nil {
+		mmCreateSKU.mock.t.Fatalf("Default expectation is already set for the ISKURepo.CreateSKU method")
+	}
+
+	if len(mmCreateSKU.expectations) > 0 {
+		mmCreateSKU.mock.t.Fatalf("Some expectations are already set for the ISKURepo.CreateSKU method")
+	}
+
+	mmCreateSKU.mock.funcCreateSKU = f
+	mmCreateSKU.mock.funcCreateSKUOrigin = minimock.CallerInfo(1)
+	return mmCreateSKU.mock
+}
+
+// When sets expectation for the ISKURepo.CreateSKU which will trigger the result defined by the following
+// Then helper
+func (mmCreateSKU *mISKURepoMockCreateSKU) When(ctx context.Context, sku models.SKU) *ISKURepoMockCreateSKUExpectation {
+	if mmCreateSKU.mock.funcCreateSKU != nil {
+		mmCreateSKU.mock.t.Fatalf("ISKURepoMock.CreateSKU mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockCreateSKUExpectation{
+		mock:               mmCreateSKU.mock,
+		params:             &ISKURepoMockCreateSKUParams{ctx, sku},
+		expectationOrigins: ISKURepoMockCreateSKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSKU.expectations = append(mmCreateSKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.CreateSKU return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockCreateSKUExpectation) Then(s1 models.SKUID, err error) *ISKURepoMock {
+	e.results = &ISKURepoMockCreateSKUResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.CreateSKU should be invoked
+func (mmCreateSKU *mISKURepoMockCreateSKU) Times(n uint64) *mISKURepoMockCreateSKU {
+	if n == 0 {
+		mmCreateSKU.mock.t.Fatalf("Times of ISKURepoMock.CreateSKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSKU.expectedInvocations, n)
+	mmCreateSKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSKU
+}
+
+func (mmCreateSKU *mISKURepoMockCreateSKU) invocationsDone() bool {
+	if len(mmCreateSKU.expectations) == 0 && mmCreateSKU.defaultExpectation == nil && mmCreateSKU.mock.funcCreateSKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSKU.mock.afterCreateSKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSKU implements mm_repository.ISKURepo
+func (mmCreateSKU *ISKURepoMock) CreateSKU(ctx context.Context, sku models.SKU) (s1 models.SKUID, err error) {
+	mm_atomic.AddUint64(&mmCreateSKU.beforeCreateSKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSKU.afterCreateSKUCounter, 1)
+
+	mmCreateSKU.t.Helper()
+
+	if mmCreateSKU.inspectFuncCreateSKU != nil {
+		mmCreateSKU.inspectFuncCreateSKU(ctx, sku)
+	}
+
+	mm_params := ISKURepoMockCreateSKUParams{ctx, sku}
+
+	// Record call args
+	mmCreateSKU.CreateSKUMock.mutex.Lock()
+	mmCreateSKU.CreateSKUMock.callArgs = append(mmCreateSKU.CreateSKUMock.callArgs, &mm_params)
+	mmCreateSKU.CreateSKUMock.mutex.Unlock()
+
+	for _, e := range mmCreateSKU.CreateSKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateSKU.CreateSKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSKU.CreateSKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSKU.CreateSKUMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSKU.CreateSKUMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockCreateSKUParams{ctx, sku}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSKU.t.Errorf("ISKURepoMock.CreateSKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSKU.CreateSKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmCreateSKU.t.Errorf("ISKURepoMock.CreateSKU got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSKU.CreateSKUMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSKU.t.Errorf("ISKURepoMock.CreateSKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSKU.CreateSKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSKU.CreateSKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSKU.t.Fatal("No results are set for the ISKURepoMock.CreateSKU")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateSKU.funcCreateSKU != nil {
+		return mmCreateSKU.funcCreateSKU(ctx, sku)
+	}
+	mmCreateSKU.t.Fatalf("Unexpected call to ISKURepoMock.CreateSKU. %v %v", ctx, sku)
+	return
+}
+
+// CreateSKUAfterCounter returns a count of finished ISKURepoMock.CreateSKU invocations
+func (mmCreateSKU *ISKURepoMock) CreateSKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSKU.afterCreateSKUCounter)
+}
+
+// CreateSKUBeforeCounter returns a count of ISKURepoMock.CreateSKU invocations
+func (mmCreateSKU *ISKURepoMock) CreateSKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSKU.beforeCreateSKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.CreateSKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSKU *mISKURepoMockCreateSKU) Calls() []*ISKURepoMockCreateSKUParams {
+	mmCreateSKU.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockCreateSKUParams, len(mmCreateSKU.callArgs))
+	copy(argCopy, mmCreateSKU.callArgs)
+
+	mmCreateSKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSKUDone returns true if the count of the CreateSKU invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockCreateSKUDone() bool {
+	if m.CreateSKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSKUMock.invocationsDone()
+}
+
+// MinimockCreateSKUInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockCreateSKUInspect() {
+	for _, e := range m.CreateSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.CreateSKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSKUCounter := mm_atomic.LoadUint64(&m.afterCreateSKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSKUMock.defaultExpectation != nil && afterCreateSKUCounter < 1 {
+		if m.CreateSKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.CreateSKU at\n%s", m.CreateSKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.CreateSKU at\n%s with params: %#v", m.CreateSKUMock.defaultExpectation.expectationOrigins.origin, *m.CreateSKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSKU != nil && afterCreateSKUCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.CreateSKU at\n%s", m.funcCreateSKUOrigin)
+	}
+
+	if !m.CreateSKUMock.invocationsDone() && afterCreateSKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.CreateSKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSKUMock.expectedInvocations), m.CreateSKUMock.expectedInvocationsOrigin, afterCreateSKUCounter)
+	}
+}
+
+type mISKURepoMockGetSKU struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockGetSKUExpectation
+	expectations       []*ISKURepoMockGetSKUExpectation
+
+	callArgs []*ISKURepoMockGetSKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockGetSKUExpectation specifies expectation struct of the ISKURepo.GetSKU
+type ISKURepoMockGetSKUExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockGetSKUParams
+	paramPtrs          *ISKURepoMockGetSKUParamPtrs
+	expectationOrigins ISKURepoMockGetSKUExpectationOrigins
+	results            *ISKURepoMockGetSKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockGetSKUParams contains parameters of the ISKURepo.GetSKU
+type ISKURepoMockGetSKUParams struct {
+	ctx   context.Context
+	skuID models.SKUID
+}
+
+// ISKURepoMockGetSKUParamPtrs contains pointers to parameters of the ISKURepo.GetSKU
+type ISKURepoMockGetSKUParamPtrs struct {
+	ctx   *context.Context
+	skuID *models.SKUID
+}
+
+// ISKURepoMockGetSKUResults contains results of the ISKURepo.GetSKU
+type ISKURepoMockGetSKUResults struct {
+	s1  models.SKU
+	err error
+}
+
+// ISKURepoMockGetSKUOrigins contains origins of expectations of the ISKURepo.GetSKU
+type ISKURepoMockGetSKUExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSKU *mISKURepoMockGetSKU) Optional() *mISKURepoMockGetSKU {
+	mmGetSKU.optional = true
+	return mmGetSKU
+}
+
+// Expect sets up expected params for ISKURepo.GetSKU
+func (mmGetSKU *mISKURepoMockGetSKU) Expect(ctx context.Context, skuID models.SKUID) *mISKURepoMockGetSKU {
+	if mmGetSKU.mock.funcGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Set")
+	}
+
+	if mmGetSKU.defaultExpectation == nil {
+		mmGetSKU.defaultExpectation = &ISKURepoMockGetSKUExpectation{}
+	}
+
+	if mmGetSKU.defaultExpectation.paramPtrs != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by ExpectParams functions")
+	}
+
+	mmGetSKU.defaultExpectation.params = &ISKURepoMockGetSKUParams{ctx, skuID}
+	mmGetSKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSKU.expectations {
+		if minimock.Equal(e.params, mmGetSKU.defaultExpectation.params) {
+			mmGetSKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSKU.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.GetSKU
+func (mmGetSKU *mISKURepoMockGetSKU) ExpectCtxParam1(ctx context.Context) *mISKURepoMockGetSKU {
+	if mmGetSKU.mock.funcGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Set")
+	}
+
+	if mmGetSKU.defaultExpectation == nil {
+		mmGetSKU.defaultExpectation = &ISKURepoMockGetSKUExpectation{}
+	}
+
+	if mmGetSKU.defaultExpectation.params != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Expect")
+	}
+
+	if mmGetSKU.defaultExpectation.paramPtrs == nil {
+		mmGetSKU.defaultExpectation.paramPtrs = &ISKURepoMockGetSKUParamPtrs{}
+	}
+	mmGetSKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSKU
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for ISKURepo.GetSKU
+func (mmGetSKU *mISKURepoMockGetSKU) ExpectSkuIDParam2(skuID models.SKUID) *mISKURepoMockGetSKU {
+	if mmGetSKU.mock.funcGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Set")
+	}
+
+	if mmGetSKU.defaultExpectation == nil {
+		mmGetSKU.defaultExpectation = &ISKURepoMockGetSKUExpectation{}
+	}
+
+	if mmGetSKU.defaultExpectation.params != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Expect")
+	}
+
+	if mmGetSKU.defaultExpectation.paramPtrs == nil {
+		mmGetSKU.defaultExpectation.paramPtrs = &ISKURepoMockGetSKUParamPtrs{}
+	}
+	mmGetSKU.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetSKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetSKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.GetSKU
+func (mmGetSKU *mISKURepoMockGetSKU) Inspect(f func(ctx context.Context, skuID models.SKUID)) *mISKURepoMockGetSKU {
+	if mmGetSKU.mock.inspectFuncGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.GetSKU")
+	}
+
+	mmGetSKU.mock.inspectFuncGetSKU = f
+
+	return mmGetSKU
+}
+
+// Return sets up results that will be returned by ISKURepo.GetSKU
+func (mmGetSKU *mISKURepoMockGetSKU) Return(s1 models.SKU, err error) *ISKURepoMock {
+	if mmGetSKU.mock.funcGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Set")
+	}
+
+	if mmGetSKU.defaultExpectation == nil {
+		mmGetSKU.defaultExpectation = &ISKURepoMockGetSKUExpectation{mock: mmGetSKU.mock}
+	}
+	mmGetSKU.defaultExpectation.results = &ISKURepoMockGetSKUResults{s1, err}
+	mmGetSKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSKU.mock
+}
+
+// Set uses given function f to mock the ISKURepo.GetSKU method
+func (mmGetSKU *mISKURepoMockGetSKU) Set(f func(ctx context.Context, skuID models.SKUID) (s1 models.SKU, err error)) *ISKURepoMock {
+	if mmGetSKU.defaultExpectation != nil {
+		mmGetSKU.mock.t.Fatalf("Default expectation is already set for the ISKURepo.GetSKU method")
+	}
+
+	if len(mmGetSKU.expectations) > 0 {
+		mmGetSKU.mock.t.Fatalf("Some expectations are already set for the ISKURepo.GetSKU method")
+	}
+
+	mmGetSKU.mock.funcGetSKU = f
+	mmGetSKU.mock.funcGetSKUOrigin = minimock.CallerInfo(1)
+	return mmGetSKU.mock
+}
+
+// When sets expectation for the ISKURepo.GetSKU which will trigger the result defined by the following
+// Then helper
+func (mmGetSKU *mISKURepoMockGetSKU) When(ctx context.Context, skuID models.SKUID) *ISKURepoMockGetSKUExpectation {
+	if mmGetSKU.mock.funcGetSKU != nil {
+		mmGetSKU.mock.t.Fatalf("ISKURepoMock.GetSKU mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockGetSKUExpectation{
+		mock:               mmGetSKU.mock,
+		params:             &ISKURepoMockGetSKUParams{ctx, skuID},
+		expectationOrigins: ISKURepoMockGetSKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSKU.expectations = append(mmGetSKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.GetSKU return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockGetSKUExpectation) Then(s1 models.SKU, err error) *ISKURepoMock {
+	e.results = &ISKURepoMockGetSKUResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.GetSKU should be invoked
+func (mmGetSKU *mISKURepoMockGetSKU) Times(n uint64) *mISKURepoMockGetSKU {
+	if n == 0 {
+		mmGetSKU.mock.t.Fatalf("Times of ISKURepoMock.GetSKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSKU.expectedInvocations, n)
+	mmGetSKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSKU
+}
+
+func (mmGetSKU *mISKURepoMockGetSKU) invocationsDone() bool {
+	if len(mmGetSKU.expectations) == 0 && mmGetSKU.defaultExpectation == nil && mmGetSKU.mock.funcGetSKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSKU.mock.afterGetSKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSKU implements mm_repository.ISKURepo
+func (mmGetSKU *ISKURepoMock) GetSKU(ctx context.Context, skuID models.SKUID) (s1 models.SKU, err error) {
+	mm_atomic.AddUint64(&mmGetSKU.beforeGetSKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSKU.afterGetSKUCounter, 1)
+
+	mmGetSKU.t.Helper()
+
+	if mmGetSKU.inspectFuncGetSKU != nil {
+		mmGetSKU.inspectFuncGetSKU(ctx, skuID)
+	}
+
+	mm_params := ISKURepoMockGetSKUParams{ctx, skuID}
+
+	// Record call args
+	mmGetSKU.GetSKUMock.mutex.Lock()
+	mmGetSKU.GetSKUMock.callArgs = append(mmGetSKU.GetSKUMock.callArgs, &mm_params)
+	mmGetSKU.GetSKUMock.mutex.Unlock()
+
+	for _, e := range mmGetSKU.GetSKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetSKU.GetSKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSKU.GetSKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSKU.GetSKUMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSKU.GetSKUMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockGetSKUParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSKU.t.Errorf("ISKURepoMock.GetSKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSKU.GetSKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetSKU.t.Errorf("ISKURepoMock.GetSKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSKU.GetSKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSKU.t.Errorf("ISKURepoMock.GetSKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSKU.GetSKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSKU.GetSKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSKU.t.Fatal("No results are set for the ISKURepoMock.GetSKU")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetSKU.funcGetSKU != nil {
+		return mmGetSKU.funcGetSKU(ctx, skuID)
+	}
+	mmGetSKU.t.Fatalf("Unexpected call to ISKURepoMock.GetSKU. %v %v", ctx, skuID)
+	return
+}
+
+// GetSKUAfterCounter returns a count of finished ISKURepoMock.GetSKU invocations
+func (mmGetSKU *ISKURepoMock) GetSKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSKU.afterGetSKUCounter)
+}
+
+// GetSKUBeforeCounter returns a count of ISKURepoMock.GetSKU invocations
+func (mmGetSKU *ISKURepoMock) GetSKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSKU.beforeGetSKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.GetSKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSKU *mISKURepoMockGetSKU) Calls() []*ISKURepoMockGetSKUParams {
+	mmGetSKU.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockGetSKUParams, len(mmGetSKU.callArgs))
+	copy(argCopy, mmGetSKU.callArgs)
+
+	mmGetSKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSKUDone returns true if the count of the GetSKU invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockGetSKUDone() bool {
+	if m.GetSKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSKUMock.invocationsDone()
+}
+
+// MinimockGetSKUInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockGetSKUInspect() {
+	for _, e := range m.GetSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.GetSKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSKUCounter := mm_atomic.LoadUint64(&m.afterGetSKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSKUMock.defaultExpectation != nil && afterGetSKUCounter < 1 {
+		if m.GetSKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.GetSKU at\n%s", m.GetSKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.GetSKU at\n%s with params: %#v", m.GetSKUMock.defaultExpectation.expectationOrigins.origin, *m.GetSKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSKU != nil && afterGetSKUCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.GetSKU at\n%s", m.funcGetSKUOrigin)
+	}
+
+	if !m.GetSKUMock.invocationsDone() && afterGetSKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.GetSKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSKUMock.expectedInvocations), m.GetSKUMock.expectedInvocationsOrigin, afterGetSKUCounter)
+	}
+}
+
+type mISKURepoMockListSKUs struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockListSKUsExpectation
+	expectations       []*ISKURepoMockListSKUsExpectation
+
+	callArgs []*ISKURepoMockListSKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockListSKUsExpectation specifies expectation struct of the ISKURepo.ListSKUs
+type ISKURepoMockListSKUsExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockListSKUsParams
+	paramPtrs          *ISKURepoMockListSKUsParamPtrs
+	expectationOrigins ISKURepoMockListSKUsExpectationOrigins
+	results            *ISKURepoMockListSKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockListSKUsParams contains parameters of the ISKURepo.ListSKUs
+type ISKURepoMockListSKUsParams struct {
+	ctx   context.Context
+	param mm_repository.ListSKUsParam
+}
+
+// ISKURepoMockListSKUsParamPtrs contains pointers to parameters of the ISKURepo.ListSKUs
+type ISKURepoMockListSKUsParamPtrs struct {
+	ctx   *context.Context
+	param *mm_repository.ListSKUsParam
+}
+
+// ISKURepoMockListSKUsResults contains results of the ISKURepo.ListSKUs
+type ISKURepoMockListSKUsResults struct {
+	sa1 []models.SKU
+	err error
+}
+
+// ISKURepoMockListSKUsOrigins contains origins of expectations of the ISKURepo.ListSKUs
+type ISKURepoMockListSKUsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originParam string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSKUs *mISKURepoMockListSKUs) Optional() *mISKURepoMockListSKUs {
+	mmListSKUs.optional = true
+	return mmListSKUs
+}
+
+// Expect sets up expected params for ISKURepo.ListSKUs
+func (mmListSKUs *mISKURepoMockListSKUs) Expect(ctx context.Context, param mm_repository.ListSKUsParam) *mISKURepoMockListSKUs {
+	if mmListSKUs.mock.funcListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Set")
+	}
+
+	if mmListSKUs.defaultExpectation == nil {
+		mmListSKUs.defaultExpectation = &ISKURepoMockListSKUsExpectation{}
+	}
+
+	if mmListSKUs.defaultExpectation.paramPtrs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by ExpectParams functions")
+	}
+
+	mmListSKUs.defaultExpectation.params = &ISKURepoMockListSKUsParams{ctx, param}
+	mmListSKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSKUs.expectations {
+		if minimock.Equal(e.params, mmListSKUs.defaultExpectation.params) {
+			mmListSKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmListSKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.ListSKUs
+func (mmListSKUs *mISKURepoMockListSKUs) ExpectCtxParam1(ctx context.Context) *mISKURepoMockListSKUs {
+	if mmListSKUs.mock.funcListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Set")
+	}
+
+	if mmListSKUs.defaultExpectation == nil {
+		mmListSKUs.defaultExpectation = &ISKURepoMockListSKUsExpectation{}
+	}
+
+	if mmListSKUs.defaultExpectation.params != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Expect")
+	}
+
+	if mmListSKUs.defaultExpectation.paramPtrs == nil {
+		mmListSKUs.defaultExpectation.paramPtrs = &ISKURepoMockListSKUsParamPtrs{}
+	}
+	mmListSKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSKUs
+}
+
+// ExpectParamParam2 sets up expected param param for ISKURepo.ListSKUs
+func (mmListSKUs *mISKURepoMockListSKUs) ExpectParamParam2(param mm_repository.ListSKUsParam) *mISKURepoMockListSKUs {
+	if mmListSKUs.mock.funcListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Set")
+	}
+
+	if mmListSKUs.defaultExpectation == nil {
+		mmListSKUs.defaultExpectation = &ISKURepoMockListSKUsExpectation{}
+	}
+
+	if mmListSKUs.defaultExpectation.params != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Expect")
+	}
+
+	if mmListSKUs.defaultExpectation.paramPtrs == nil {
+		mmListSKUs.defaultExpectation.paramPtrs = &ISKURepoMockListSKUsParamPtrs{}
+	}
+	mmListSKUs.defaultExpectation.paramPtrs.param = &param
+	mmListSKUs.defaultExpectation.expectationOrigins.originParam = minimock.CallerInfo(1)
+
+	return mmListSKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.ListSKUs
+func (mmListSKUs *mISKURepoMockListSKUs) Inspect(f func(ctx context.Context, param mm_repository.ListSKUsParam)) *mISKURepoMockListSKUs {
+	if mmListSKUs.mock.inspectFuncListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.ListSKUs")
+	}
+
+	mmListSKUs.mock.inspectFuncListSKUs = f
+
+	return mmListSKUs
+}
+
+// Return sets up results that will be returned by ISKURepo.ListSKUs
+func (mmListSKUs *mISKURepoMockListSKUs) Return(sa1 []models.SKU, err error) *ISKURepoMock {
+	if mmListSKUs.mock.funcListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Set")
+	}
+
+	if mmListSKUs.defaultExpectation == nil {
+		mmListSKUs.defaultExpectation = &ISKURepoMockListSKUsExpectation{mock: mmListSKUs.mock}
+	}
+	mmListSKUs.defaultExpectation.results = &ISKURepoMockListSKUsResults{sa1, err}
+	mmListSKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSKUs.mock
+}
+
+// Set uses given function f to mock the ISKURepo.ListSKUs method
+func (mmListSKUs *mISKURepoMockListSKUs) Set(f func(ctx context.Context, param mm_repository.ListSKUsParam) (sa1 []models.SKU, err error)) *ISKURepoMock {
+	if mmListSKUs.defaultExpectation != nil {
+		mmListSKUs.mock.t.Fatalf("Default expectation is already set for the ISKURepo.ListSKUs method")
+	}
+
+	if len(mmListSKUs.expectations) > 0 {
+		mmListSKUs.mock.t.Fatalf("Some expectations are already set for the ISKURepo.ListSKUs method")
+	}
+
+	mmListSKUs.mock.funcListSKUs = f
+	mmListSKUs.mock.funcListSKUsOrigin = minimock.CallerInfo(1)
+	return mmListSKUs.mock
+}
+
+// When sets expectation for the ISKURepo.ListSKUs which will trigger the result defined by the following
+// Then helper
+func (mmListSKUs *mISKURepoMockListSKUs) When(ctx context.Context, param mm_repository.ListSKUsParam) *ISKURepoMockListSKUsExpectation {
+	if mmListSKUs.mock.funcListSKUs != nil {
+		mmListSKUs.mock.t.Fatalf("ISKURepoMock.ListSKUs mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockListSKUsExpectation{
+		mock:               mmListSKUs.mock,
+		params:             &ISKURepoMockListSKUsParams{ctx, param},
+		expectationOrigins: ISKURepoMockListSKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSKUs.expectations = append(mmListSKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.ListSKUs return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockListSKUsExpectation) Then(sa1 []models.SKU, err error) *ISKURepoMock {
+	e.results = &ISKURepoMockListSKUsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.ListSKUs should be invoked
+func (mmListSKUs *mISKURepoMockListSKUs) Times(n uint64) *mISKURepoMockListSKUs {
+	if n == 0 {
+		mmListSKUs.mock.t.Fatalf("Times of ISKURepoMock.ListSKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSKUs.expectedInvocations, n)
+	mmListSKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSKUs
+}
+
+func (mmListSKUs *mISKURepoMockListSKUs) invocationsDone() bool {
+	if len(mmListSKUs.expectations) == 0 && mmListSKUs.defaultExpectation == nil && mmListSKUs.mock.funcListSKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSKUs.mock.afterListSKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSKUs implements mm_repository.ISKURepo
+func (mmListSKUs *ISKURepoMock) ListSKUs(ctx context.Context, param mm_repository.ListSKUsParam) (sa1 []models.SKU, err error) {
+	mm_atomic.AddUint64(&mmListSKUs.beforeListSKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSKUs.afterListSKUsCounter, 1)
+
+	mmListSKUs.t.Helper()
+
+	if mmListSKUs.inspectFuncListSKUs != nil {
+		mmListSKUs.inspectFuncListSKUs(ctx, param)
+	}
+
+	mm_params := ISKURepoMockListSKUsParams{ctx, param}
+
+	// Record call args
+	mmListSKUs.ListSKUsMock.mutex.Lock()
+	mmListSKUs.ListSKUsMock.callArgs = append(mmListSKUs.ListSKUsMock.callArgs, &mm_params)
+	mmListSKUs.ListSKUsMock.mutex.Unlock()
+
+	for _, e := range mmListSKUs.ListSKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSKUs.ListSKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSKUs.ListSKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSKUs.ListSKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSKUs.ListSKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockListSKUsParams{ctx, param}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSKUs.t.Errorf("ISKURepoMock.ListSKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSKUs.ListSKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.param != nil && !minimock.Equal(*mm_want_ptrs.param, mm_got.param) {
+				mmListSKUs.t.Errorf("ISKURepoMock.ListSKUs got unexpected parameter param, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSKUs.ListSKUsMock.defaultExpectation.expectationOrigins.originParam, *mm_want_ptrs.param, mm_got.param, minimock.Diff(*mm_want_ptrs.param, mm_got.param))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSKUs.t.Errorf("ISKURepoMock.ListSKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSKUs.ListSKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSKUs.ListSKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSKUs.t.Fatal("No results are set for the ISKURepoMock.ListSKUs")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSKUs.funcListSKUs != nil {
+		return mmListSKUs.funcListSKUs(ctx, param)
+	}
+	mmListSKUs.t.Fatalf("Unexpected call to ISKURepoMock.ListSKUs. %v %v", ctx, param)
+	return
+}
+
+// ListSKUsAfterCounter returns a count of finished ISKURepoMock.ListSKUs invocations
+func (mmListSKUs *ISKURepoMock) ListSKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSKUs.afterListSKUsCounter)
+}
+
+// ListSKUsBeforeCounter returns a count of ISKURepoMock.ListSKUs invocations
+func (mmListSKUs *ISKURepoMock) ListSKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSKUs.beforeListSKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.ListSKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSKUs *mISKURepoMockListSKUs) Calls() []*ISKURepoMockListSKUsParams {
+	mmListSKUs.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockListSKUsParams, len(mmListSKUs.callArgs))
+	copy(argCopy, mmListSKUs.callArgs)
+
+	mmListSKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSKUsDone returns true if the count of the ListSKUs invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockListSKUsDone() bool {
+	if m.ListSKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSKUsMock.invocationsDone()
+}
+
+// MinimockListSKUsInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockListSKUsInspect() {
+	for _, e := range m.ListSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.ListSKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSKUsCounter := mm_atomic.LoadUint64(&m.afterListSKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSKUsMock.defaultExpectation != nil && afterListSKUsCounter < 1 {
+		if m.ListSKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.ListSKUs at\n%s", m.ListSKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.ListSKUs at\n%s with params: %#v", m.ListSKUsMock.defaultExpectation.expectationOrigins.origin, *m.ListSKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSKUs != nil && afterListSKUsCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.ListSKUs at\n%s", m.funcListSKUsOrigin)
+	}
+
+	if !m.ListSKUsMock.invocationsDone() && afterListSKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.ListSKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSKUsMock.expectedInvocations), m.ListSKUsMock.expectedInvocationsOrigin, afterListSKUsCounter)
+	}
+}
+
+type mISKURepoMockUpdateSKU struct {
+	optional           bool
+	mock               *ISKURepoMock
+	defaultExpectation *ISKURepoMockUpdateSKUExpectation
+	expectations       []*ISKURepoMockUpdateSKUExpectation
+
+	callArgs []*ISKURepoMockUpdateSKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ISKURepoMockUpdateSKUExpectation specifies expectation struct of the ISKURepo.UpdateSKU
+type ISKURepoMockUpdateSKUExpectation struct {
+	mock               *ISKURepoMock
+	params             *ISKURepoMockUpdateSKUParams
+	paramPtrs          *ISKURepoMockUpdateSKUParamPtrs
+	expectationOrigins ISKURepoMockUpdateSKUExpectationOrigins
+	results            *ISKURepoMockUpdateSKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ISKURepoMockUpdateSKUParams contains parameters of the ISKURepo.UpdateSKU
+type ISKURepoMockUpdateSKUParams struct {
+	ctx context.Context
+	sku models.SKU
+}
+
+// ISKURepoMockUpdateSKUParamPtrs contains pointers to parameters of the ISKURepo.UpdateSKU
+type ISKURepoMockUpdateSKUParamPtrs struct {
+	ctx *context.Context
+	sku *models.SKU
+}
+
+// ISKURepoMockUpdateSKUResults contains results of the ISKURepo.UpdateSKU
+type ISKURepoMockUpdateSKUResults struct {
+	err error
+}
+
+// ISKURepoMockUpdateSKUOrigins contains origins of expectations of the ISKURepo.UpdateSKU
+type ISKURepoMockUpdateSKUExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originSku string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Optional() *mISKURepoMockUpdateSKU {
+	mmUpdateSKU.optional = true
+	return mmUpdateSKU
+}
+
+// Expect sets up expected params for ISKURepo.UpdateSKU
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Expect(ctx context.Context, sku models.SKU) *mISKURepoMockUpdateSKU {
+	if mmUpdateSKU.mock.funcUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Set")
+	}
+
+	if mmUpdateSKU.defaultExpectation == nil {
+		mmUpdateSKU.defaultExpectation = &ISKURepoMockUpdateSKUExpectation{}
+	}
+
+	if mmUpdateSKU.defaultExpectation.paramPtrs != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateSKU.defaultExpectation.params = &ISKURepoMockUpdateSKUParams{ctx, sku}
+	mmUpdateSKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSKU.expectations {
+		if minimock.Equal(e.params, mmUpdateSKU.defaultExpectation.params) {
+			mmUpdateSKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSKU.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateSKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ISKURepo.UpdateSKU
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) ExpectCtxParam1(ctx context.Context) *mISKURepoMockUpdateSKU {
+	if mmUpdateSKU.mock.funcUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Set")
+	}
+
+	if mmUpdateSKU.defaultExpectation == nil {
+		mmUpdateSKU.defaultExpectation = &ISKURepoMockUpdateSKUExpectation{}
+	}
+
+	if mmUpdateSKU.defaultExpectation.params != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Expect")
+	}
+
+	if mmUpdateSKU.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKU.defaultExpectation.paramPtrs = &ISKURepoMockUpdateSKUParamPtrs{}
+	}
+	mmUpdateSKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateSKU
+}
+
+// ExpectSkuParam2 sets up expected param sku for ISKURepo.UpdateSKU
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) ExpectSkuParam2(sku models.SKU) *mISKURepoMockUpdateSKU {
+	if mmUpdateSKU.mock.funcUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Set")
+	}
+
+	if mmUpdateSKU.defaultExpectation == nil {
+		mmUpdateSKU.defaultExpectation = &ISKURepoMockUpdateSKUExpectation{}
+	}
+
+	if mmUpdateSKU.defaultExpectation.params != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Expect")
+	}
+
+	if mmUpdateSKU.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKU.defaultExpectation.paramPtrs = &ISKURepoMockUpdateSKUParamPtrs{}
+	}
+	mmUpdateSKU.defaultExpectation.paramPtrs.sku = &sku
+	mmUpdateSKU.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmUpdateSKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the ISKURepo.UpdateSKU
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Inspect(f func(ctx context.Context, sku models.SKU)) *mISKURepoMockUpdateSKU {
+	if mmUpdateSKU.mock.inspectFuncUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("Inspect function is already set for ISKURepoMock.UpdateSKU")
+	}
+
+	mmUpdateSKU.mock.inspectFuncUpdateSKU = f
+
+	return mmUpdateSKU
+}
+
+// Return sets up results that will be returned by ISKURepo.UpdateSKU
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Return(err error) *ISKURepoMock {
+	if mmUpdateSKU.mock.funcUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Set")
+	}
+
+	if mmUpdateSKU.defaultExpectation == nil {
+		mmUpdateSKU.defaultExpectation = &ISKURepoMockUpdateSKUExpectation{mock: mmUpdateSKU.mock}
+	}
+	mmUpdateSKU.defaultExpectation.results = &ISKURepoMockUpdateSKUResults{err}
+	mmUpdateSKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKU.mock
+}
+
+// Set uses given function f to mock the ISKURepo.UpdateSKU method
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Set(f func(ctx context.Context, sku models.SKU) (err error)) *ISKURepoMock {
+	if mmUpdateSKU.defaultExpectation != nil {
+		mmUpdateSKU.mock.t.Fatalf("Default expectation is already set for the ISKURepo.UpdateSKU method")
+	}
+
+	if len(mmUpdateSKU.expectations) > 0 {
+		mmUpdateSKU.mock.t.Fatalf("Some expectations are already set for the ISKURepo.UpdateSKU method")
+	}
+
+	mmUpdateSKU.mock.funcUpdateSKU = f
+	mmUpdateSKU.mock.funcUpdateSKUOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKU.mock
+}
+
+// When sets expectation for the ISKURepo.UpdateSKU which will trigger the result defined by the following
+// Then helper
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) When(ctx context.Context, sku models.SKU) *ISKURepoMockUpdateSKUExpectation {
+	if mmUpdateSKU.mock.funcUpdateSKU != nil {
+		mmUpdateSKU.mock.t.Fatalf("ISKURepoMock.UpdateSKU mock is already set by Set")
+	}
+
+	expectation := &ISKURepoMockUpdateSKUExpectation{
+		mock:               mmUpdateSKU.mock,
+		params:             &ISKURepoMockUpdateSKUParams{ctx, sku},
+		expectationOrigins: ISKURepoMockUpdateSKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateSKU.expectations = append(mmUpdateSKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ISKURepo.UpdateSKU return parameters for the expectation previously defined by the When method
+func (e *ISKURepoMockUpdateSKUExpectation) Then(err error) *ISKURepoMock {
+	e.results = &ISKURepoMockUpdateSKUResults{err}
+	return e.mock
+}
+
+// Times sets number of times ISKURepo.UpdateSKU should be invoked
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Times(n uint64) *mISKURepoMockUpdateSKU {
+	if n == 0 {
+		mmUpdateSKU.mock.t.Fatalf("Times of ISKURepoMock.UpdateSKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateSKU.expectedInvocations, n)
+	mmUpdateSKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKU
+}
+
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) invocationsDone() bool {
+	if len(mmUpdateSKU.expectations) == 0 && mmUpdateSKU.defaultExpectation == nil && mmUpdateSKU.mock.funcUpdateSKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSKU.mock.afterUpdateSKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateSKU implements mm_repository.ISKURepo
+func (mmUpdateSKU *ISKURepoMock) UpdateSKU(ctx context.Context, sku models.SKU) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSKU.beforeUpdateSKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSKU.afterUpdateSKUCounter, 1)
+
+	mmUpdateSKU.t.Helper()
+
+	if mmUpdateSKU.inspectFuncUpdateSKU != nil {
+		mmUpdateSKU.inspectFuncUpdateSKU(ctx, sku)
+	}
+
+	mm_params := ISKURepoMockUpdateSKUParams{ctx, sku}
+
+	// Record call args
+	mmUpdateSKU.UpdateSKUMock.mutex.Lock()
+	mmUpdateSKU.UpdateSKUMock.callArgs = append(mmUpdateSKU.UpdateSKUMock.callArgs, &mm_params)
+	mmUpdateSKU.UpdateSKUMock.mutex.Unlock()
+
+	for _, e := range mmUpdateSKU.UpdateSKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateSKU.UpdateSKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSKU.UpdateSKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSKU.UpdateSKUMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSKU.UpdateSKUMock.defaultExpectation.paramPtrs
+
+		mm_got := ISKURepoMockUpdateSKUParams{ctx, sku}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateSKU.t.Errorf("ISKURepoMock.UpdateSKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKU.UpdateSKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmUpdateSKU.t.Errorf("ISKURepoMock.UpdateSKU got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKU.UpdateSKUMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateSKU.t.Errorf("ISKURepoMock.UpdateSKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSKU.UpdateSKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateSKU.UpdateSKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateSKU.t.Fatal("No results are set for the ISKURepoMock.UpdateSKU")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateSKU.funcUpdateSKU != nil {
+		return mmUpdateSKU.funcUpdateSKU(ctx, sku)
+	}
+	mmUpdateSKU.t.Fatalf("Unexpected call to ISKURepoMock.UpdateSKU. %v %v", ctx, sku)
+	return
+}
+
+// UpdateSKUAfterCounter returns a count of finished ISKURepoMock.UpdateSKU invocations
+func (mmUpdateSKU *ISKURepoMock) UpdateSKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKU.afterUpdateSKUCounter)
+}
+
+// UpdateSKUBeforeCounter returns a count of ISKURepoMock.UpdateSKU invocations
+func (mmUpdateSKU *ISKURepoMock) UpdateSKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKU.beforeUpdateSKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to ISKURepoMock.UpdateSKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateSKU *mISKURepoMockUpdateSKU) Calls() []*ISKURepoMockUpdateSKUParams {
+	mmUpdateSKU.mutex.RLock()
+
+	argCopy := make([]*ISKURepoMockUpdateSKUParams, len(mmUpdateSKU.callArgs))
+	copy(argCopy, mmUpdateSKU.callArgs)
+
+	mmUpdateSKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateSKUDone returns true if the count of the UpdateSKU invocations corresponds
+// the number of defined expectations
+func (m *ISKURepoMock) MinimockUpdateSKUDone() bool {
+	if m.UpdateSKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateSKUMock.invocationsDone()
+}
+
+// MinimockUpdateSKUInspect logs each unmet expectation
+func (m *ISKURepoMock) MinimockUpdateSKUInspect() {
+	for _, e := range m.UpdateSKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ISKURepoMock.UpdateSKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateSKUCounter := mm_atomic.LoadUint64(&m.afterUpdateSKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateSKUMock.defaultExpectation != nil && afterUpdateSKUCounter < 1 {
+		if m.UpdateSKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ISKURepoMock.UpdateSKU at\n%s", m.UpdateSKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ISKURepoMock.UpdateSKU at\n%s with params: %#v", m.UpdateSKUMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateSKU != nil && afterUpdateSKUCounter < 1 {
+		m.t.Errorf("Expected call to ISKURepoMock.UpdateSKU at\n%s", m.funcUpdateSKUOrigin)
+	}
+
+	if !m.UpdateSKUMock.invocationsDone() && afterUpdateSKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to ISKURepoMock.UpdateSKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSKUMock.expectedInvocations), m.UpdateSKUMock.expectedInvocationsOrigin, afterUpdateSKUCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ISKURepoMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockArchiveSKUInspect()
+
+			m.MinimockCountSKUsInspect()
+
+			m.MinimockCreateSKUInspect()
+
+			m.MinimockGetSKUInspect()
+
+			m.MinimockListSKUsInspect()
+
+			m.MinimockUpdateSKUInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ISKURepoMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ISKURepoMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockArchiveSKUDone() &&
+		m.MinimockCountSKUsDone() &&
+		m.MinimockCreateSKUDone() &&
+		m.MinimockGetSKUDone() &&
+		m.MinimockListSKUsDone() &&
+		m.MinimockUpdateSKUDone()
+}
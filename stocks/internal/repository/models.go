@@ -7,12 +7,45 @@ import (
 )
 
 type GetStockByLocation struct {
+	UserID     models.UserID
+	Location   string
+	Limit      int64
+	Offset     int64
+	AfterID    models.StockID
+	CategoryID models.CategoryID
+	Attributes models.Attributes
+}
+
+type SearchItemsParam struct {
+	Query       string
+	CategoryID  models.CategoryID
+	Type        string
+	MinPrice    *uint32
+	MaxPrice    *uint32
+	MinCount    *uint32
+	MaxCount    *uint32
+	InStockOnly bool
+	Location    string
+	Sort        models.ItemSort
+	Descending  bool
+	Limit       int64
+	Offset      int64
+}
+
+type ListLowStockParam struct {
 	UserID   models.UserID
 	Location string
 	Limit    int64
 	Offset   int64
 }
 
+type GetPriceHistoryParam struct {
+	SKUID    models.SKUID
+	Location string
+	Limit    int64
+	Offset   int64
+}
+
 type Stock struct {
 	ID       pgtype.Int8   `db:"id"`
 	SKUID    pgtype.Uint32 `db:"sku_id"`
@@ -23,7 +56,26 @@ type Stock struct {
 }
 
 type SKU struct {
-	ID   models.SKUID `db:"sku_id"`
-	Name string       `db:"name"`
-	Type string       `db:"type"`
+	ID               models.SKUID      `db:"sku_id"`
+	Name             string            `db:"name"`
+	Description      string            `db:"description"`
+	Attributes       models.Attributes `db:"attributes"`
+	Archived         bool              `db:"archived"`
+	CategoryID       pgtype.Int8       `db:"category_id"`
+	CategoryParentID pgtype.Int8       `db:"category_parent_id"`
+	CategoryName     pgtype.Text       `db:"category_name"`
+}
+
+type Category struct {
+	ID       models.CategoryID      `db:"id"`
+	ParentID pgtype.Int8            `db:"parent_id"`
+	Name     string                 `db:"name"`
+	Schema   models.AttributeSchema `db:"attribute_schema"`
+}
+
+type ListSKUsParam struct {
+	CategoryID      models.CategoryID
+	IncludeArchived bool
+	Limit           int64
+	Offset          int64
 }
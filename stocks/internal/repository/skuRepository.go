@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+
+	createSKUquery = `INSERT INTO sku (name, description, category_id, attributes) VALUES ($1, $2, $3, $4) RETURNING sku_id`
+	updateSKUquery = `UPDATE sku SET name = $2, description = $3, category_id = $4, attributes = $5, updated_at = now()
+		WHERE sku_id = $1`
+	archiveSKUquery = `UPDATE sku SET archived_at = now(), updated_at = now() WHERE sku_id = $1 AND archived_at IS NULL`
+	getSKUquery     = `SELECT ` + skuColumns + ` FROM sku l LEFT JOIN category c ON c.id = l.category_id WHERE l.sku_id = $1`
+	skusTree        = `WITH RECURSIVE tree AS (SELECT id FROM category WHERE id = $1
+		UNION ALL SELECT c.id FROM category c INNER JOIN tree t ON c.parent_id = t.id) `
+	skusFilter = ` FROM sku l LEFT JOIN category c ON c.id = l.category_id
+		WHERE ($1::BIGINT = 0 OR l.category_id IN (SELECT id FROM tree)) AND ($2 OR l.archived_at IS NULL)`
+	listSKUsquery  = skusTree + `SELECT ` + skuColumns + skusFilter + ` ORDER BY l.sku_id LIMIT $3 OFFSET $4`
+	countSKUsquery = skusTree + `SELECT count(*)` + skusFilter
+)
+
+var ErrDuplicate error = errors.New("already exists")
+
+type ISKURepo interface {
+	CreateSKU(ctx context.Context, sku models.SKU) (models.SKUID, error)
+	UpdateSKU(ctx context.Context, sku models.SKU) error
+	ArchiveSKU(ctx context.Context, skuID models.SKUID) error
+	GetSKU(ctx context.Context, skuID models.SKUID) (models.SKU, error)
+	ListSKUs(ctx context.Context, param ListSKUsParam) ([]models.SKU, error)
+	CountSKUs(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (int64, error)
+}
+
+type SKURepo struct {
+	db IDBQuery
+}
+
+func NewSKURepository(db IDBQuery) *SKURepo {
+	return &SKURepo{db: db}
+}
+
+func (r *SKURepo) CreateSKU(ctx context.Context, sku models.SKU) (models.SKUID, error) {
+	var skuID models.SKUID
+
+	err := r.db.QueryRow(ctx, createSKUquery, sku.Name, sku.Description, sku.Category.ID, skuAttributes(sku)).Scan(&skuID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, ErrDuplicate
+		}
+
+		if isForeignKeyViolation(err) {
+			return 0, ErrNotFound
+		}
+
+		return 0, err
+	}
+
+	return skuID, nil
+}
+
+func (r *SKURepo) UpdateSKU(ctx context.Context, sku models.SKU) error {
+	tag, err := r.db.Exec(ctx, updateSKUquery, sku.ID, sku.Name, sku.Description, sku.Category.ID, skuAttributes(sku))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicate
+		}
+
+		if isForeignKeyViolation(err) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ArchiveSKU marks the SKU as archived. It returns ErrNotFound if the SKU is missing or already archived.
+func (r *SKURepo) ArchiveSKU(ctx context.Context, skuID models.SKUID) error {
+	tag, err := r.db.Exec(ctx, archiveSKUquery, skuID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() < 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *SKURepo) GetSKU(ctx context.Context, skuID models.SKUID) (models.SKU, error) {
+	var sku SKU
+
+	err := r.db.QueryRow(ctx, getSKUquery, skuID).Scan(skuScanArgs(&sku)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SKU{}, ErrNotFound
+		}
+
+		return models.SKU{}, err
+	}
+
+	return skuFromDB(sku), nil
+}
+
+func (r *SKURepo) ListSKUs(ctx context.Context, param ListSKUsParam) ([]models.SKU, error) {
+	var skus []models.SKU
+
+	rows, err := r.db.Query(ctx, listSKUsquery, param.CategoryID, param.IncludeArchived, param.Limit, param.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sku SKU
+
+		if err = rows.Scan(skuScanArgs(&sku)...); err != nil {
+			return nil, err
+		}
+
+		skus = append(skus, skuFromDB(sku))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return skus, nil
+}
+
+// CountSKUs returns the number of all SKUs of the category and its subcategories, of all categories if it is 0.
+func (r *SKURepo) CountSKUs(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (int64, error) {
+	var count int64
+
+	if err := r.db.QueryRow(ctx, countSKUsquery, categoryID, includeArchived).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// skuScanArgs returns the scan destinations for the skuColumns of a row.
+func skuScanArgs(sku *SKU) []any {
+	return []any{&sku.ID, &sku.Name, &sku.Description, &sku.Attributes, &sku.Archived,
+		&sku.CategoryID, &sku.CategoryParentID, &sku.CategoryName}
+}
+
+func skuFromDB(sku SKU) models.SKU {
+	return models.SKU{
+		ID:          sku.ID,
+		Name:        sku.Name,
+		Description: sku.Description,
+		Attributes:  sku.Attributes,
+		Archived:    sku.Archived,
+		Category: models.Category{
+			ID:       models.CategoryID(sku.CategoryID.Int64),
+			ParentID: models.CategoryID(sku.CategoryParentID.Int64),
+			Name:     sku.CategoryName.String,
+		},
+	}
+}
+
+// skuAttributes returns the attributes of the sku, with no attributes stored as an empty object.
+func skuAttributes(sku models.SKU) models.Attributes {
+	if sku.Attributes == nil {
+		return models.Attributes{}
+	}
+
+	return sku.Attributes
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
@@ -4,19 +4,120 @@ import (
 	"context"
 	"errors"
 	"stocks/internal/models"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
 const (
-	getItemSKUquery    = `SELECT * FROM sku l LEFT JOIN stock r ON r.sku_id = l.sku_id WHERE l.sku_id = $1`
-	addStockquery      = `INSERT INTO stock (price, location, count, user_id, sku_id) VALUES ($1, $2, $3, $4, $5)`
-	updateStockquery   = `UPDATE stock SET price = $1, location = $2, count = $3 WHERE sku_id = $4`
-	deleteStockquery   = `DELETE FROM stock WHERE sku_id = $1 AND user_id = $2`
-	getItemsByLocquery = `SELECT * FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id WHERE r.location = $1 AND r.user_id = $2 LIMIT $3 OFFSET $4`
+	skuColumns = `l.sku_id, l.name, l.description, l.attributes, l.archived_at IS NOT NULL,
+		c.id, c.parent_id, c.name`
+	itemColumns  = skuColumns + `, r.id, r.sku_id, r.price, r.location, r.count, r.user_id`
+	stockColumns = `id, sku_id, price, location, count, user_id`
+
+	// A SKU can be stocked at several locations, the SKU level queries return its first stock.
+	// Deleted stock (deleted_at is set) is kept until it is purged and is skipped by all reads.
+	getItemSKUquery = `SELECT ` + itemColumns + ` FROM sku l LEFT JOIN stock r ON r.sku_id = l.sku_id
+		AND r.deleted_at IS NULL LEFT JOIN category c ON c.id = l.category_id WHERE l.sku_id = $1 ORDER BY r.id LIMIT 1`
+	getLocationStockquery = `SELECT ` + stockColumns + ` FROM stock
+		WHERE sku_id = $1 AND user_id = $2 AND location = $3 AND deleted_at IS NULL FOR UPDATE`
+	addStockquery    = `INSERT INTO stock (price, location, count, user_id, sku_id) VALUES ($1, $2, $3, $4, $5)`
+	updateStockquery = `UPDATE stock SET price = $1, location = $2, count = $3 WHERE id = $4 AND deleted_at IS NULL`
+	deleteStockquery = `UPDATE stock SET deleted_at = $3 WHERE sku_id = $1 AND user_id = $2 AND deleted_at IS NULL
+		RETURNING ` + stockColumns
+	// restoreStockquery restores the stock removed by the last delete of the SKU of the seller.
+	restoreStockquery = `UPDATE stock SET deleted_at = NULL WHERE sku_id = $1 AND user_id = $2
+		AND deleted_at = (SELECT max(deleted_at) FROM stock WHERE sku_id = $1 AND user_id = $2) RETURNING ` + stockColumns
+	purgeStockquery = `DELETE FROM stock WHERE deleted_at < $1`
+	// itemsByLocTree and itemsByLocFilter are shared by the list and the count of items by location.
+	itemsByLocTree = `WITH RECURSIVE tree AS (SELECT id FROM category WHERE id = $3
+		UNION ALL SELECT c.id FROM category c INNER JOIN tree t ON c.parent_id = t.id) `
+	itemsByLocFilter = ` FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id
+		LEFT JOIN category c ON c.id = l.category_id WHERE r.deleted_at IS NULL AND r.location = $1 AND r.user_id = $2
+		AND ($3::BIGINT = 0 OR l.category_id IN (SELECT id FROM tree)) AND l.attributes @> $4::JSONB`
+	getItemsByLocquery = itemsByLocTree + `SELECT ` + itemColumns + itemsByLocFilter +
+		` AND r.id > $5 ORDER BY r.id LIMIT $6 OFFSET $7`
+	countItemsByLocquery = itemsByLocTree + `SELECT count(*)` + itemsByLocFilter
+	exportItemsquery     = `SELECT ` + itemColumns + ` FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id
+		LEFT JOIN category c ON c.id = l.category_id WHERE r.id > $1 AND r.deleted_at IS NULL ORDER BY r.id LIMIT $2`
+	getItemsBySKUsquery = `SELECT DISTINCT ON (l.sku_id) ` + itemColumns + ` FROM sku l
+		LEFT JOIN stock r ON r.sku_id = l.sku_id AND r.deleted_at IS NULL LEFT JOIN category c ON c.id = l.category_id
+		WHERE l.sku_id = ANY($1) ORDER BY l.sku_id, r.id`
+	searchItemsTree = `WITH RECURSIVE tree AS (SELECT id FROM category WHERE id = $2
+		UNION ALL SELECT c.id FROM category c INNER JOIN tree t ON c.parent_id = t.id) `
+	searchItemsFilter = ` FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id
+		LEFT JOIN category c ON c.id = l.category_id WHERE l.archived_at IS NULL AND r.deleted_at IS NULL
+		AND ($1 = '' OR l.search_vector @@ to_tsquery('simple', $1))
+		AND ($2::BIGINT = 0 OR l.category_id IN (SELECT id FROM tree)) AND ($3 = '' OR c.name = $3)
+		AND ($4::BIGINT IS NULL OR r.price >= $4) AND ($5::BIGINT IS NULL OR r.price <= $5)
+		AND ($6::BIGINT IS NULL OR r.count >= $6) AND ($7::BIGINT IS NULL OR r.count <= $7)
+		AND (NOT $8 OR r.count > 0) AND ($9 = '' OR r.location = $9)`
+	// searchItemsquery is completed by one of the searchOrderBy clauses and LIMIT $10 OFFSET $11.
+	searchItemsquery      = searchItemsTree + `SELECT ` + itemColumns + searchItemsFilter + ` ORDER BY `
+	countSearchItemsquery = searchItemsTree + `SELECT count(*)` + searchItemsFilter
+)
+
+const (
+	setThresholdquery = `INSERT INTO stock_threshold (sku_id, location, threshold) VALUES ($1, $2, $3)
+		ON CONFLICT (sku_id, location) DO UPDATE SET threshold = EXCLUDED.threshold`
+	deleteThresholdquery = `DELETE FROM stock_threshold WHERE sku_id = $1 AND location = $2`
+	// thresholdJoin selects the threshold of the location of the stock r, or else the threshold of its SKU.
+	thresholdJoin = ` INNER JOIN LATERAL (SELECT threshold FROM stock_threshold t
+		WHERE t.sku_id = r.sku_id AND t.location IN (r.location, '') ORDER BY t.location DESC LIMIT 1) t ON TRUE`
+	getThresholdquery = `SELECT threshold FROM stock_threshold WHERE sku_id = $1 AND location IN ($2, '')
+		ORDER BY location DESC LIMIT 1`
+	listLowStockquery = `SELECT ` + itemColumns + `, t.threshold FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id
+		LEFT JOIN category c ON c.id = l.category_id` + thresholdJoin + ` WHERE r.count <= t.threshold
+		AND l.archived_at IS NULL AND r.deleted_at IS NULL AND ($1 = '' OR r.location = $1) AND ($2::BIGINT = 0 OR r.user_id = $2)
+		ORDER BY r.count, r.id LIMIT $3 OFFSET $4`
+)
+
+const (
+	addPriceHistoryquery = `INSERT INTO price_history (sku_id, user_id, location, old_price, price, source, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	getPriceHistoryquery = `SELECT sku_id, COALESCE(user_id, 0), location, old_price, price, source, changed_at FROM price_history
+		WHERE sku_id = $1 AND ($2 = '' OR location = $2) ORDER BY changed_at DESC, id DESC LIMIT $3 OFFSET $4`
+	schedulePricequery = `INSERT INTO price_change (sku_id, user_id, price, effective_at) VALUES ($1, $2, $3, $4)
+		RETURNING id`
+	getPendingPricesquery = `SELECT id, sku_id, COALESCE(user_id, 0), price, effective_at FROM price_change
+		WHERE sku_id = $1 AND applied_at IS NULL ORDER BY effective_at, id`
+	// getDuePricesquery skips the changes locked by another applier, so several instances can run the job.
+	getDuePricesquery = `SELECT id, sku_id, COALESCE(user_id, 0), price, effective_at FROM price_change
+		WHERE applied_at IS NULL AND effective_at <= $1 ORDER BY effective_at, id LIMIT $2 FOR UPDATE SKIP LOCKED`
+	markPriceAppliedquery = `UPDATE price_change SET applied_at = $2 WHERE id = $1`
+	// setSKUPricequery changes the price of every stock of the SKU of the seller
+	// and returns the old prices of the changed stock.
+	setSKUPricequery = `UPDATE stock s SET price = $3 FROM (SELECT id, price FROM stock
+		WHERE sku_id = $1 AND user_id = $2 AND price <> $3 AND deleted_at IS NULL FOR UPDATE) old WHERE s.id = old.id
+		RETURNING COALESCE(s.location, ''), old.price`
+)
+
+const (
+	ensureSellerquery = `INSERT INTO seller (id) VALUES ($1) ON CONFLICT (id) DO NOTHING`
+	upsertSellerquery = `INSERT INTO seller (id, name) VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`
+	listOffersquery = `SELECT r.id, r.sku_id, r.price, r.location, r.count, r.user_id, COALESCE(s.name, '')
+		FROM stock r LEFT JOIN seller s ON s.id = r.user_id WHERE r.sku_id = $1 AND r.deleted_at IS NULL
+		AND (NOT $2 OR r.count > 0)
+		ORDER BY r.price, r.count DESC, r.id`
+	getOfferquery = `SELECT ` + itemColumns + ` FROM sku l INNER JOIN stock r ON r.sku_id = l.sku_id
+		LEFT JOIN category c ON c.id = l.category_id WHERE l.sku_id = $1 AND r.id = $2 AND r.deleted_at IS NULL`
 )
 
+// searchOrderBy maps the sort of searched items to the ascending and descending ORDER BY clauses.
+var searchOrderBy = map[models.ItemSort][2]string{
+	models.SortRelevance: {
+		`ts_rank(l.search_vector, to_tsquery('simple', $1)) DESC, r.id`,
+		`ts_rank(l.search_vector, to_tsquery('simple', $1)), r.id`,
+	},
+	models.SortPrice: {`r.price, r.id`, `r.price DESC, r.id DESC`},
+	models.SortName:  {`l.name, r.id`, `l.name DESC, r.id DESC`},
+	models.SortCount: {`r.count, r.id`, `r.count DESC, r.id DESC`},
+}
+
 type IDBQuery interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
@@ -27,10 +128,33 @@ type IDBQuery interface {
 //go:generate minimock -o ./mock/ -s .go
 type IStockRepo interface {
 	GetItemBySKU(ctx context.Context, skuID models.SKUID) (models.Item, error)
+	GetLocationStock(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (models.Stock, error)
 	AddStock(ctx context.Context, stock models.Stock) error
 	UpdateStock(ctx context.Context, stock models.Stock) error
-	DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID) error
+	DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) ([]models.Stock, error)
+	RestoreStock(ctx context.Context, skuID models.SKUID, userID models.UserID) ([]models.Stock, error)
+	PurgeDeletedStock(ctx context.Context, deletedBefore time.Time) (int64, error)
 	GetItemsByLocation(ctx context.Context, param GetStockByLocation) ([]models.Item, error)
+	CountItemsByLocation(ctx context.Context, param GetStockByLocation) (int64, error)
+	GetItemsBySKUs(ctx context.Context, skuIDs []models.SKUID) ([]models.Item, error)
+	SearchItems(ctx context.Context, param SearchItemsParam) ([]models.Item, error)
+	CountSearchItems(ctx context.Context, param SearchItemsParam) (int64, error)
+	ExportItems(ctx context.Context, afterID models.StockID, limit int64) ([]models.Item, error)
+	SetThreshold(ctx context.Context, threshold models.StockThreshold) error
+	DeleteThreshold(ctx context.Context, skuID models.SKUID, location string) error
+	GetThreshold(ctx context.Context, skuID models.SKUID, location string) (uint16, error)
+	ListLowStock(ctx context.Context, param ListLowStockParam) ([]models.LowStockItem, error)
+	AddPriceHistory(ctx context.Context, change models.PriceChange) error
+	GetPriceHistory(ctx context.Context, param GetPriceHistoryParam) ([]models.PriceChange, error)
+	SchedulePrice(ctx context.Context, price models.ScheduledPrice) (int64, error)
+	GetPendingPrices(ctx context.Context, skuID models.SKUID) ([]models.ScheduledPrice, error)
+	GetDuePrices(ctx context.Context, now time.Time, limit int64) ([]models.ScheduledPrice, error)
+	MarkPriceApplied(ctx context.Context, id int64, appliedAt time.Time) error
+	SetSKUPrice(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) ([]models.PriceChange, error)
+	EnsureSeller(ctx context.Context, userID models.UserID) error
+	UpsertSeller(ctx context.Context, seller models.Seller) error
+	ListOffers(ctx context.Context, skuID models.SKUID, inStockOnly bool) ([]models.Offer, error)
+	GetOffer(ctx context.Context, skuID models.SKUID, offerID models.StockID) (models.Item, error)
 }
 
 type StockRepo struct {
@@ -49,29 +173,33 @@ func (r *StockRepo) GetItemBySKU(ctx context.Context, skuID models.SKUID) (model
 
 	var item models.Item
 
-	err := r.db.QueryRow(ctx, getItemSKUquery, skuID).Scan(&sku.ID, &sku.Name, &sku.Type, &stock.ID, &stock.SKUID, &stock.Price, &stock.Location, &stock.Count, &stock.UserID)
+	err := r.db.QueryRow(ctx, getItemSKUquery, skuID).Scan(itemScanArgs(&sku, &stock)...)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return item, ErrNotFound
+		}
+
 		return item, err
 	}
 
-	item.SKU = models.SKU{
-		ID:   sku.ID,
-		Name: sku.Name,
-		Type: sku.Type,
-	}
+	return itemFromDB(sku, stock), nil
+}
 
-	if !stock.ID.Valid {
-		return item, nil
-	} else {
-		item.Stock.ID = models.StockID(stock.ID.Int64)
-		item.Stock.SKUID = models.SKUID(stock.SKUID.Uint32)
-		item.Stock.Count = uint16(float32(stock.Count.Uint32))
-		item.Stock.Price = stock.Price.Uint32
-		item.Stock.Location = stock.Location.String
-		item.Stock.UserID = models.UserID(stock.UserID.Int64)
+// GetLocationStock returns the stock of the SKU of the seller at the location and locks it until the end of the transaction.
+func (r *StockRepo) GetLocationStock(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (models.Stock, error) {
+	var stock Stock
 
-		return item, nil
+	err := r.db.QueryRow(ctx, getLocationStockquery, skuID, userID, location).
+		Scan(&stock.ID, &stock.SKUID, &stock.Price, &stock.Location, &stock.Count, &stock.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Stock{}, ErrNotFound
+		}
+
+		return models.Stock{}, err
 	}
+
+	return itemFromDB(SKU{}, stock).Stock, nil
 }
 
 func (r *StockRepo) AddStock(ctx context.Context, stock models.Stock) error {
@@ -88,7 +216,7 @@ func (r *StockRepo) AddStock(ctx context.Context, stock models.Stock) error {
 }
 
 func (r *StockRepo) UpdateStock(ctx context.Context, stock models.Stock) error {
-	tag, err := r.db.Exec(ctx, updateStockquery, stock.Price, stock.Location, stock.Count, stock.SKUID)
+	tag, err := r.db.Exec(ctx, updateStockquery, stock.Price, stock.Location, stock.Count, stock.ID)
 	if err != nil {
 		return err
 	}
@@ -100,8 +228,150 @@ func (r *StockRepo) UpdateStock(ctx context.Context, stock models.Stock) error {
 	return nil
 }
 
-func (r *StockRepo) DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID) error {
-	tag, err := r.db.Exec(ctx, deleteStockquery, skuID, userID)
+// DeleteStock marks all stock of the SKU of the seller as deleted and returns it.
+func (r *StockRepo) DeleteStock(ctx context.Context, skuID models.SKUID, userID models.UserID, deletedAt time.Time) ([]models.Stock, error) {
+	rows, err := r.db.Query(ctx, deleteStockquery, skuID, userID, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectStock(rows)
+}
+
+// RestoreStock restores the stock of the SKU of the seller removed by its last delete and returns it.
+// It returns ErrDuplicate if the seller has stocked a location of the deleted stock again.
+func (r *StockRepo) RestoreStock(ctx context.Context, skuID models.SKUID, userID models.UserID) ([]models.Stock, error) {
+	rows, err := r.db.Query(ctx, restoreStockquery, skuID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := collectStock(rows)
+	if isUniqueViolation(err) {
+		return nil, ErrDuplicate
+	}
+
+	return stock, err
+}
+
+// PurgeDeletedStock removes the stock deleted before deletedBefore for good.
+func (r *StockRepo) PurgeDeletedStock(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, purgeStockquery, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetItemsByLocation returns the items ordered by the stock ID, starting after param.AfterID.
+func (r *StockRepo) GetItemsByLocation(ctx context.Context, param GetStockByLocation) ([]models.Item, error) {
+	rows, err := r.db.Query(ctx, getItemsByLocquery, param.Location, param.UserID, param.CategoryID,
+		locationAttributes(param), param.AfterID, param.Limit, param.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectItems(rows)
+}
+
+// CountItemsByLocation returns the number of all items matching the filters of the param.
+func (r *StockRepo) CountItemsByLocation(ctx context.Context, param GetStockByLocation) (int64, error) {
+	var count int64
+
+	err := r.db.QueryRow(ctx, countItemsByLocquery, param.Location, param.UserID, param.CategoryID,
+		locationAttributes(param)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *StockRepo) GetItemsBySKUs(ctx context.Context, skuIDs []models.SKUID) ([]models.Item, error) {
+	ids := make([]int64, len(skuIDs))
+	for i, skuID := range skuIDs {
+		ids[i] = int64(skuID)
+	}
+
+	rows, err := r.db.Query(ctx, getItemsBySKUsquery, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectItems(rows)
+}
+
+// SearchItems returns the stock of active SKUs matching all set filters. The query matches
+// the words of the SKU name and description by prefix.
+func (r *StockRepo) SearchItems(ctx context.Context, param SearchItemsParam) ([]models.Item, error) {
+	orderBy, ok := searchOrderBy[param.Sort]
+	if !ok {
+		orderBy = searchOrderBy[models.SortRelevance]
+	}
+
+	query := searchItemsquery + orderBy[0] + ` LIMIT $10 OFFSET $11`
+	if param.Descending {
+		query = searchItemsquery + orderBy[1] + ` LIMIT $10 OFFSET $11`
+	}
+
+	rows, err := r.db.Query(ctx, query, searchTSQuery(param.Query), param.CategoryID, param.Type, param.MinPrice, param.MaxPrice,
+		param.MinCount, param.MaxCount, param.InStockOnly, param.Location, param.Limit, param.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectItems(rows)
+}
+
+// CountSearchItems returns the number of all items matching the filters of the param, its limit and offset are ignored.
+func (r *StockRepo) CountSearchItems(ctx context.Context, param SearchItemsParam) (int64, error) {
+	var count int64
+
+	err := r.db.QueryRow(ctx, countSearchItemsquery, searchTSQuery(param.Query), param.CategoryID, param.Type,
+		param.MinPrice, param.MaxPrice, param.MinCount, param.MaxCount, param.InStockOnly, param.Location).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// locationAttributes returns the attribute filter of the param, an empty filter matches all items.
+func locationAttributes(param GetStockByLocation) models.Attributes {
+	if param.Attributes == nil {
+		return models.Attributes{}
+	}
+
+	return param.Attributes
+}
+
+// ExportItems returns up to limit items of all stock ordered by the stock ID, starting after afterID.
+func (r *StockRepo) ExportItems(ctx context.Context, afterID models.StockID, limit int64) ([]models.Item, error) {
+	rows, err := r.db.Query(ctx, exportItemsquery, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectItems(rows)
+}
+
+// SetThreshold creates or changes the threshold, it returns ErrNotFound if the SKU is missing.
+func (r *StockRepo) SetThreshold(ctx context.Context, threshold models.StockThreshold) error {
+	_, err := r.db.Exec(ctx, setThresholdquery, threshold.SKUID, threshold.Location, threshold.Threshold)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *StockRepo) DeleteThreshold(ctx context.Context, skuID models.SKUID, location string) error {
+	tag, err := r.db.Exec(ctx, deleteThresholdquery, skuID, location)
 	if err != nil {
 		return err
 	}
@@ -110,44 +380,329 @@ func (r *StockRepo) DeleteStock(ctx context.Context, skuID models.SKUID, userID
 		return ErrNotFound
 	}
 
-	return err
+	return nil
 }
 
-func (r *StockRepo) GetItemsByLocation(ctx context.Context, param GetStockByLocation) ([]models.Item, error) {
-	var items []models.Item
+// GetThreshold returns the threshold of the SKU at the location, or else the threshold for all its locations.
+// It returns 0 if no threshold is set.
+func (r *StockRepo) GetThreshold(ctx context.Context, skuID models.SKUID, location string) (uint16, error) {
+	var threshold uint16
 
-	rows, err := r.db.Query(ctx, getItemsByLocquery, param.Location, param.UserID, param.Limit, param.Offset)
+	err := r.db.QueryRow(ctx, getThresholdquery, skuID, location).Scan(&threshold)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return threshold, nil
+}
+
+// ListLowStock returns the stock of active SKUs at or below its threshold, the lowest count first.
+func (r *StockRepo) ListLowStock(ctx context.Context, param ListLowStockParam) ([]models.LowStockItem, error) {
+	rows, err := r.db.Query(ctx, listLowStockquery, param.Location, param.UserID, param.Limit, param.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	var items []models.LowStockItem
+
 	for rows.Next() {
 		var sku SKU
 		var stock Stock
+		var threshold uint16
+
+		if err = rows.Scan(append(itemScanArgs(&sku, &stock), &threshold)...); err != nil {
+			return nil, err
+		}
+
+		items = append(items, models.LowStockItem{Item: itemFromDB(sku, stock), Threshold: threshold})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *StockRepo) AddPriceHistory(ctx context.Context, change models.PriceChange) error {
+	_, err := r.db.Exec(ctx, addPriceHistoryquery, change.SKUID, change.UserID, change.Location, change.OldPrice,
+		change.Price, change.Source, change.ChangedAt)
+
+	return err
+}
+
+// GetPriceHistory returns the price changes of the SKU, the latest first.
+func (r *StockRepo) GetPriceHistory(ctx context.Context, param GetPriceHistoryParam) ([]models.PriceChange, error) {
+	rows, err := r.db.Query(ctx, getPriceHistoryquery, param.SKUID, param.Location, param.Limit, param.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []models.PriceChange
+
+	for rows.Next() {
+		var change models.PriceChange
+
+		err = rows.Scan(&change.SKUID, &change.UserID, &change.Location, &change.OldPrice, &change.Price,
+			&change.Source, &change.ChangedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// SchedulePrice stores the price change and returns its ID, it returns ErrNotFound if the SKU is missing.
+func (r *StockRepo) SchedulePrice(ctx context.Context, price models.ScheduledPrice) (int64, error) {
+	var id int64
+
+	err := r.db.QueryRow(ctx, schedulePricequery, price.SKUID, price.UserID, price.Price, price.EffectiveAt).Scan(&id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, ErrNotFound
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetPendingPrices returns the price changes of the SKU that are not applied yet, the earliest first.
+func (r *StockRepo) GetPendingPrices(ctx context.Context, skuID models.SKUID) ([]models.ScheduledPrice, error) {
+	rows, err := r.db.Query(ctx, getPendingPricesquery, skuID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectScheduledPrices(rows)
+}
+
+// GetDuePrices locks and returns up to limit price changes that take effect at now or earlier, the earliest first.
+func (r *StockRepo) GetDuePrices(ctx context.Context, now time.Time, limit int64) ([]models.ScheduledPrice, error) {
+	rows, err := r.db.Query(ctx, getDuePricesquery, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectScheduledPrices(rows)
+}
+
+func (r *StockRepo) MarkPriceApplied(ctx context.Context, id int64, appliedAt time.Time) error {
+	_, err := r.db.Exec(ctx, markPriceAppliedquery, id, appliedAt)
+
+	return err
+}
+
+// SetSKUPrice sets the price of all stock of the SKU of the seller and returns the changes,
+// stock already at the price is not changed.
+func (r *StockRepo) SetSKUPrice(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) ([]models.PriceChange, error) {
+	rows, err := r.db.Query(ctx, setSKUPricequery, skuID, userID, price)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []models.PriceChange
+
+	for rows.Next() {
+		change := models.PriceChange{SKUID: skuID, UserID: userID, Price: price}
+
+		if err = rows.Scan(&change.Location, &change.OldPrice); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// EnsureSeller creates the seller of the user if it is missing.
+func (r *StockRepo) EnsureSeller(ctx context.Context, userID models.UserID) error {
+	_, err := r.db.Exec(ctx, ensureSellerquery, userID)
+
+	return err
+}
+
+func (r *StockRepo) UpsertSeller(ctx context.Context, seller models.Seller) error {
+	_, err := r.db.Exec(ctx, upsertSellerquery, seller.ID, seller.Name)
+
+	return err
+}
+
+// ListOffers returns the stock of all sellers of the SKU, the cheapest first.
+func (r *StockRepo) ListOffers(ctx context.Context, skuID models.SKUID, inStockOnly bool) ([]models.Offer, error) {
+	rows, err := r.db.Query(ctx, listOffersquery, skuID, inStockOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var offers []models.Offer
+
+	for rows.Next() {
+		var stock Stock
+		var name string
 
-		err = rows.Scan(&sku.ID, &sku.Name, &sku.Type, &stock.ID, &stock.SKUID, &stock.Price, &stock.Location, &stock.Count, &stock.UserID)
+		err = rows.Scan(&stock.ID, &stock.SKUID, &stock.Price, &stock.Location, &stock.Count, &stock.UserID, &name)
 		if err != nil {
 			return nil, err
 		}
 
-		item := models.Item{
-			SKU: models.SKU{
-				ID:   sku.ID,
-				Name: sku.Name,
-				Type: sku.Type,
-			},
-			Stock: models.Stock{
-				ID:       models.StockID(stock.ID.Int64),
-				Price:    stock.Price.Uint32,
-				Location: stock.Location.String,
-				Count:    uint16(float32(stock.Count.Uint32)),
-				UserID:   models.UserID(stock.UserID.Int64),
-			},
+		offer := models.Offer{Stock: itemFromDB(SKU{}, stock).Stock}
+		offer.Seller = models.Seller{ID: offer.Stock.UserID, Name: name}
+
+		offers = append(offers, offer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+// GetOffer returns the SKU with the stock of the offer, it returns ErrNotFound if the offer is not a stock of the SKU.
+func (r *StockRepo) GetOffer(ctx context.Context, skuID models.SKUID, offerID models.StockID) (models.Item, error) {
+	var sku SKU
+	var stock Stock
+
+	err := r.db.QueryRow(ctx, getOfferquery, skuID, offerID).Scan(itemScanArgs(&sku, &stock)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Item{}, ErrNotFound
+		}
+
+		return models.Item{}, err
+	}
+
+	return itemFromDB(sku, stock), nil
+}
+
+func collectScheduledPrices(rows pgx.Rows) ([]models.ScheduledPrice, error) {
+	defer rows.Close()
+
+	var prices []models.ScheduledPrice
+
+	for rows.Next() {
+		var price models.ScheduledPrice
+
+		if err := rows.Scan(&price.ID, &price.SKUID, &price.UserID, &price.Price, &price.EffectiveAt); err != nil {
+			return nil, err
 		}
 
-		items = append(items, item)
+		prices = append(prices, price)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// collectStock reads and closes the rows of a stockColumns query, it returns ErrNotFound if there are none.
+func collectStock(rows pgx.Rows) ([]models.Stock, error) {
+	defer rows.Close()
+
+	var stock []models.Stock
+
+	for rows.Next() {
+		var row Stock
+
+		if err := rows.Scan(&row.ID, &row.SKUID, &row.Price, &row.Location, &row.Count, &row.UserID); err != nil {
+			return nil, err
+		}
+
+		stock = append(stock, itemFromDB(SKU{}, row).Stock)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(stock) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return stock, nil
+}
+
+// collectItems reads and closes the rows of an item query.
+func collectItems(rows pgx.Rows) ([]models.Item, error) {
+	defer rows.Close()
+
+	var items []models.Item
+
+	for rows.Next() {
+		var sku SKU
+		var stock Stock
+
+		if err := rows.Scan(itemScanArgs(&sku, &stock)...); err != nil {
+			return nil, err
+		}
+
+		items = append(items, itemFromDB(sku, stock))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
 	return items, nil
 }
+
+// searchTSQuery converts a search query to a tsquery matching all of its words by prefix,
+// e.g. "pink hood" to "pink:* & hood:*".
+func searchTSQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
+
+// itemFromDB converts a sku row and its optional stock row to models.Item.
+func itemFromDB(sku SKU, stock Stock) models.Item {
+	item := models.Item{SKU: skuFromDB(sku)}
+
+	if !stock.ID.Valid {
+		return item
+	}
+
+	item.Stock.ID = models.StockID(stock.ID.Int64)
+	item.Stock.SKUID = models.SKUID(stock.SKUID.Uint32)
+	item.Stock.Count = uint16(float32(stock.Count.Uint32))
+	item.Stock.Price = stock.Price.Uint32
+	item.Stock.Location = stock.Location.String
+	item.Stock.UserID = models.UserID(stock.UserID.Int64)
+
+	return item
+}
+
+// itemScanArgs returns the scan destinations for the itemColumns of a row.
+func itemScanArgs(sku *SKU, stock *Stock) []any {
+	return append(skuScanArgs(sku), &stock.ID, &stock.SKUID, &stock.Price, &stock.Location, &stock.Count, &stock.UserID)
+}
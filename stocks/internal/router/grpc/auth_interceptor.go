@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"stocks/internal/auth"
+	"stocks/internal/models"
+	myLog "stocks/internal/observability/log"
+	pb "stocks/pkg/api/stock"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+
+	errAuthFailed = "authentication failed"
+)
+
+var errUserMismatch error = errors.New("user_id does not match the authenticated user")
+
+// publicMethods are the catalog reads and the health checks that can be called without a token.
+// A token sent with them is still verified.
+var publicMethods = map[string]struct{}{
+	pb.StockService_GetItem_FullMethodName:         {},
+	pb.StockService_GetItems_FullMethodName:        {},
+	pb.StockService_GetSKU_FullMethodName:          {},
+	pb.StockService_ListSKUs_FullMethodName:        {},
+	pb.StockService_ListCategories_FullMethodName:  {},
+	pb.StockService_SearchItems_FullMethodName:     {},
+	pb.StockService_GetPriceHistory_FullMethodName: {},
+	pb.StockService_ListOffers_FullMethodName:      {},
+	healthpb.Health_Check_FullMethodName:           {},
+	healthpb.Health_Watch_FullMethodName:           {},
+}
+
+type IVerifier interface {
+	Verify(token string) (auth.Principal, error)
+}
+
+// AuthInterceptor verifies the bearer token of the authorization metadata and puts its principal
+// in the context. Requests without a valid token are rejected with UNAUTHENTICATED.
+func AuthInterceptor(verifier IVerifier, logger myLog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is AuthInterceptor of the streaming RPCs.
+func AuthStreamInterceptor(verifier IVerifier, logger myLog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), verifier, logger, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, verifier IVerifier, logger myLog.Logger, method string) (context.Context, error) {
+	_, public := publicMethods[method]
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		if public {
+			return ctx, nil
+		}
+
+		return nil, status.Error(codes.Unauthenticated, auth.ErrNoToken.Error())
+	}
+
+	token, err := auth.BearerToken(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	principal, err := verifier.Verify(token)
+	if err != nil {
+		logger.Warn(errAuthFailed, myLog.String("method", method), myLog.Error(err))
+
+		return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+	}
+
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// requestUser returns the authenticated user of the request. The deprecated user_id of the request
+// is used if the server runs without authentication or if an admin manages the stock of a seller,
+// otherwise it must be unset or match.
+func requestUser(ctx context.Context, deprecatedUserID int64) (models.UserID, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok || (deprecatedUserID != 0 && principal.HasAnyRole(auth.RoleAdmin)) {
+		return models.UserID(deprecatedUserID), nil
+	}
+
+	if deprecatedUserID != 0 && models.UserID(deprecatedUserID) != principal.UserID {
+		return 0, status.Error(codes.PermissionDenied, errUserMismatch.Error())
+	}
+
+	return principal.UserID, nil
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
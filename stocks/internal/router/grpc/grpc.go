@@ -4,100 +4,309 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"stocks/internal/models"
 	"stocks/internal/usecase"
 	pb "stocks/pkg/api/stock"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type IStockUsecase interface {
 	AddStock(ctx context.Context, stock usecase.AddStockDTO) error
 	DeleteStockBySKU(ctx context.Context, delStock usecase.DeleteStockDTO) error
+	RestoreStockBySKU(ctx context.Context, restore usecase.RestoreStockDTO) error
+	TransferStock(ctx context.Context, transfer usecase.TransferStockDTO) error
 	GetStocksByLocation(ctx context.Context, param usecase.GetItemByLocDTO) (usecase.ItemsByLocDTO, error)
 	GetItemBySKU(ctx context.Context, sku models.SKUID) (usecase.StockDTO, error)
+	GetItemsBySKUs(ctx context.Context, skus []models.SKUID) ([]usecase.StockDTO, error)
+	SearchItems(ctx context.Context, param usecase.SearchItemsDTO) (usecase.ItemsByLocDTO, error)
+	ImportStock(ctx context.Context, param usecase.ImportStockDTO) (usecase.ImportStockResultDTO, error)
+	ExportStock(ctx context.Context, send func(usecase.StockDTO) error) error
+	SetThreshold(ctx context.Context, threshold usecase.SetThresholdDTO) error
+	ListLowStock(ctx context.Context, param usecase.ListLowStockDTO) (usecase.LowStocksDTO, error)
+	SchedulePriceChange(ctx context.Context, param usecase.SchedulePriceDTO) (models.ScheduledPrice, error)
+	GetPriceHistory(ctx context.Context, param usecase.GetPriceHistoryDTO) (usecase.PriceHistoryDTO, error)
+	RegisterSeller(ctx context.Context, seller models.Seller) (models.Seller, error)
+	ListOffers(ctx context.Context, param usecase.ListOffersDTO) ([]usecase.OfferDTO, error)
+	GetOffer(ctx context.Context, skuID models.SKUID, offerID models.StockID) (usecase.StockDTO, error)
+}
+
+type ISKUUsecase interface {
+	CreateSKU(ctx context.Context, sku usecase.CreateSKUDTO) (usecase.SKUDTO, error)
+	UpdateSKU(ctx context.Context, update usecase.UpdateSKUDTO) (usecase.SKUDTO, error)
+	ArchiveSKU(ctx context.Context, skuID models.SKUID) error
+	GetSKU(ctx context.Context, skuID models.SKUID) (usecase.SKUDTO, error)
+	ListSKUs(ctx context.Context, param usecase.ListSKUsDTO) (usecase.SKUsDTO, error)
+	CreateCategory(ctx context.Context, category usecase.CreateCategoryDTO) (usecase.CategoryDTO, error)
+	ListCategories(ctx context.Context) ([]usecase.CategoryDTO, error)
 }
 
 type StockServer struct {
 	stockUsecase IStockUsecase
+	skuUsecase   ISKUUsecase
 	pb.UnimplementedStockServiceServer
 }
 
-func NewStockServer(us IStockUsecase) *StockServer {
-	return &StockServer{stockUsecase: us}
+func NewStockServer(us IStockUsecase, skuUs ISKUUsecase) *StockServer {
+	return &StockServer{stockUsecase: us, skuUsecase: skuUs}
 }
 
 func (s *StockServer) AddItem(ctx context.Context, req *pb.StockAddItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	count, err := models.Uint32ToUint16(req.Count)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidArgument("count", err)
 	}
 
 	dto := usecase.AddStockDTO{
 		SKUID:    models.SKUID(req.Sku),
-		UserID:   models.UserID(req.UserId),
+		UserID:   userID,
 		Count:    count,
 		Price:    req.Price,
 		Location: req.Location,
 	}
 
-	if err := s.stockUsecase.AddStock(ctx, dto); err != nil {
-		if errors.Is(err, usecase.ErrNotFound) || errors.Is(err, usecase.ErrUserID) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-
-		return nil, status.Error(codes.Unknown, err.Error())
+	if err = s.stockUsecase.AddStock(ctx, dto); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *StockServer) DeleteItem(ctx context.Context, req *pb.StockDeleteItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	dto := usecase.DeleteStockDTO{
-		UserID: models.UserID(req.UserId),
+		UserID: userID,
 		SKUID:  models.SKUID(req.Sku),
 	}
 
-	if err := s.stockUsecase.DeleteStockBySKU(ctx, dto); err != nil {
-		if errors.Is(err, usecase.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
+	if err = s.stockUsecase.DeleteStockBySKU(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StockServer) RestoreItem(ctx context.Context, req *pb.StockRestoreItemRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
 
-		return nil, status.Error(codes.Unknown, err.Error())
+	dto := usecase.RestoreStockDTO{
+		UserID: userID,
+		SKUID:  models.SKUID(req.Sku),
+	}
+
+	if err = s.stockUsecase.RestoreStockBySKU(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StockServer) TransferStock(ctx context.Context, req *pb.StockTransferRequest) (*emptypb.Empty, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := models.Uint32ToUint16(req.Count)
+	if err != nil {
+		return nil, invalidArgument("count", err)
+	}
+
+	dto := usecase.TransferStockDTO{
+		SKUID:        models.SKUID(req.Sku),
+		UserID:       userID,
+		FromLocation: req.FromLocation,
+		ToLocation:   req.ToLocation,
+		Count:        count,
+	}
+
+	if err = s.stockUsecase.TransferStock(ctx, dto); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *StockServer) ListItem(ctx context.Context, req *pb.StockListItemRequest) (*pb.StockListItemResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	dto := usecase.GetItemByLocDTO{
-		UserID:      models.UserID(req.UserId),
+		UserID:      userID,
 		Location:    req.Location,
 		PageSize:    req.PageSize,
 		CurrentPage: req.CurrentPage,
+		PageToken:   req.PageToken,
+		CategoryID:  models.CategoryID(req.CategoryId),
+		Attributes:  req.Attributes.AsMap(),
 	}
 
 	list, err := s.stockUsecase.GetStocksByLocation(ctx, dto)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, err
 	}
 
-	respList := make([]*pb.StockItemResponse, len(list.Stocks))
+	return listItemResponse(list)
+}
 
-	for i, item := range list.Stocks {
-		respItem := pb.StockItemResponse{}
+func (s *StockServer) SearchItems(ctx context.Context, req *pb.StockSearchItemsRequest) (*pb.StockListItemResponse, error) {
+	dto := usecase.SearchItemsDTO{
+		Query:       req.Query,
+		CategoryID:  models.CategoryID(req.CategoryId),
+		Type:        req.Type,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		MinCount:    req.MinCount,
+		MaxCount:    req.MaxCount,
+		InStockOnly: req.InStockOnly,
+		Location:    req.Location,
+		Sort:        searchSort(req.Sort),
+		Descending:  req.Descending,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	list, err := s.stockUsecase.SearchItems(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return listItemResponse(list)
+}
 
-		respItem.Sku = uint32(item.SKU.SKUID)
-		respItem.Name = item.SKU.Name
-		respItem.Type = item.SKU.Type
-		respItem.Count = uint32(item.Count)
-		respItem.Price = item.Price
-		respItem.Location = item.Location
-		respItem.UserId = int64(item.UserID)
+func (s *StockServer) GetItem(ctx context.Context, req *pb.StockGetItemRequest) (*pb.StockItemResponse, error) {
+	var item usecase.StockDTO
+	var err error
 
-		respList[i] = &respItem
+	if req.OfferId != 0 {
+		item, err = s.stockUsecase.GetOffer(ctx, models.SKUID(req.Sku), models.StockID(req.OfferId))
+	} else {
+		item, err = s.stockUsecase.GetItemBySKU(ctx, models.SKUID(req.Sku))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return itemResponse(item)
+}
+
+func (s *StockServer) GetItems(ctx context.Context, req *pb.StockGetItemsRequest) (*pb.StockGetItemsResponse, error) {
+	skus := make([]models.SKUID, len(req.Skus))
+	for i, sku := range req.Skus {
+		skus[i] = models.SKUID(sku)
+	}
+
+	items, err := s.stockUsecase.GetItemsBySKUs(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	respList := make([]*pb.StockItemResponse, len(items))
+
+	for i, item := range items {
+		if respList[i], err = itemResponse(item); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.StockGetItemsResponse{Items: respList}, nil
+}
+
+func (s *StockServer) CreateSKU(ctx context.Context, req *pb.StockCreateSKURequest) (*pb.StockSKUResponse, error) {
+	dto := usecase.CreateSKUDTO{
+		Name:        req.Name,
+		Description: req.Description,
+		CategoryID:  models.CategoryID(req.CategoryId),
+		Attributes:  req.Attributes.AsMap(),
+	}
+
+	sku, err := s.skuUsecase.CreateSKU(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return skuResponse(sku)
+}
+
+func (s *StockServer) UpdateSKU(ctx context.Context, req *pb.StockUpdateSKURequest) (*pb.StockSKUResponse, error) {
+	dto := usecase.UpdateSKUDTO{
+		SKUID:       models.SKUID(req.Sku),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if req.CategoryId != nil {
+		categoryID := models.CategoryID(*req.CategoryId)
+		dto.CategoryID = &categoryID
+	}
+
+	if req.Attributes != nil {
+		dto.Attributes = req.Attributes.AsMap()
+	}
+
+	sku, err := s.skuUsecase.UpdateSKU(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return skuResponse(sku)
+}
+
+func (s *StockServer) ArchiveSKU(ctx context.Context, req *pb.StockSKURequest) (*emptypb.Empty, error) {
+	if err := s.skuUsecase.ArchiveSKU(ctx, models.SKUID(req.Sku)); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StockServer) GetSKU(ctx context.Context, req *pb.StockSKURequest) (*pb.StockSKUResponse, error) {
+	sku, err := s.skuUsecase.GetSKU(ctx, models.SKUID(req.Sku))
+	if err != nil {
+		return nil, err
+	}
+
+	return skuResponse(sku)
+}
+
+func (s *StockServer) ListSKUs(ctx context.Context, req *pb.StockListSKUsRequest) (*pb.StockListSKUsResponse, error) {
+	dto := usecase.ListSKUsDTO{
+		CategoryID:      models.CategoryID(req.CategoryId),
+		IncludeArchived: req.IncludeArchived,
+		PageSize:        req.PageSize,
+		CurrentPage:     req.CurrentPage,
+	}
+
+	list, err := s.skuUsecase.ListSKUs(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	respList := make([]*pb.StockSKUResponse, len(list.SKUs))
+
+	for i, sku := range list.SKUs {
+		if respList[i], err = skuResponse(sku); err != nil {
+			return nil, err
+		}
 	}
 
 	totalCount, err := models.IntToInt32(list.TotalCount)
@@ -105,32 +314,407 @@ func (s *StockServer) ListItem(ctx context.Context, req *pb.StockListItemRequest
 		return nil, fmt.Errorf("totalCount = %w", err)
 	}
 
-	response := &pb.StockListItemResponse{
-		Items:      respList,
+	return &pb.StockListSKUsResponse{
+		Skus:       respList,
 		TotalCount: totalCount,
 		PageNumber: list.PageNumber,
+	}, nil
+}
+
+func (s *StockServer) CreateCategory(ctx context.Context, req *pb.StockCreateCategoryRequest) (*pb.StockCategory, error) {
+	dto := usecase.CreateCategoryDTO{
+		ParentID: models.CategoryID(req.ParentId),
+		Name:     req.Name,
+		Schema:   make(models.AttributeSchema, len(req.AttributeSchema)),
 	}
 
-	return response, nil
+	for name, attrType := range req.AttributeSchema {
+		dto.Schema[name] = models.AttributeType(attrType)
+	}
+
+	category, err := s.skuUsecase.CreateCategory(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return categoryResponse(category), nil
 }
 
-func (s *StockServer) GetItem(ctx context.Context, req *pb.StockGetItemRequest) (*pb.StockItemResponse, error) {
-	item, err := s.stockUsecase.GetItemBySKU(ctx, models.SKUID(req.Sku))
+func (s *StockServer) ListCategories(ctx context.Context, _ *emptypb.Empty) (*pb.StockListCategoriesResponse, error) {
+	categories, err := s.skuUsecase.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	respList := make([]*pb.StockCategory, len(categories))
+
+	for i, category := range categories {
+		respList[i] = categoryResponse(category)
+	}
+
+	return &pb.StockListCategoriesResponse{Categories: respList}, nil
+}
+
+func (s *StockServer) SetThreshold(ctx context.Context, req *pb.StockSetThresholdRequest) (*emptypb.Empty, error) {
+	threshold, err := models.Uint32ToUint16(req.Threshold)
+	if err != nil {
+		return nil, invalidArgument("threshold", err)
+	}
+
+	dto := usecase.SetThresholdDTO{
+		SKUID:     models.SKUID(req.Sku),
+		Location:  req.Location,
+		Threshold: threshold,
+	}
+
+	if err := s.stockUsecase.SetThreshold(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *StockServer) ListLowStock(ctx context.Context, req *pb.StockListLowStockRequest) (*pb.StockListLowStockResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
 	if err != nil {
-		if errors.Is(err, usecase.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
+		return nil, err
+	}
+
+	dto := usecase.ListLowStockDTO{
+		UserID:      userID,
+		Location:    req.Location,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	list, err := s.stockUsecase.ListLowStock(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StockListLowStockResponse{
+		Items:      make([]*pb.StockLowStockItem, len(list.Items)),
+		PageNumber: list.PageNumber,
+	}
+
+	for i, item := range list.Items {
+		itemResp, err := itemResponse(item.Stock)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, status.Error(codes.Unknown, err.Error())
+		resp.Items[i] = &pb.StockLowStockItem{Item: itemResp, Threshold: uint32(item.Threshold)}
+	}
+
+	return resp, nil
+}
+
+func (s *StockServer) SchedulePriceChange(ctx context.Context, req *pb.StockSchedulePriceChangeRequest) (*pb.StockScheduledPrice, error) {
+	if req.EffectiveAt == nil {
+		return nil, usecase.ErrInvalidEffectiveAt
+	}
+
+	if err := req.EffectiveAt.CheckValid(); err != nil {
+		return nil, invalidArgument("effective_at", err)
+	}
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := usecase.SchedulePriceDTO{
+		SKUID:       models.SKUID(req.Sku),
+		UserID:      userID,
+		Price:       req.Price,
+		EffectiveAt: req.EffectiveAt.AsTime(),
+	}
+
+	price, err := s.stockUsecase.SchedulePriceChange(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledPriceResponse(price), nil
+}
+
+func (s *StockServer) GetPriceHistory(ctx context.Context, req *pb.StockGetPriceHistoryRequest) (*pb.StockGetPriceHistoryResponse, error) {
+	dto := usecase.GetPriceHistoryDTO{
+		SKUID:       models.SKUID(req.Sku),
+		Location:    req.Location,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	history, err := s.stockUsecase.GetPriceHistory(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StockGetPriceHistoryResponse{
+		Changes:    make([]*pb.StockPriceChange, len(history.Changes)),
+		Scheduled:  make([]*pb.StockScheduledPrice, len(history.Scheduled)),
+		PageNumber: history.PageNumber,
+	}
+
+	for i, change := range history.Changes {
+		resp.Changes[i] = &pb.StockPriceChange{
+			Sku:       uint32(change.SKUID),
+			Location:  change.Location,
+			OldPrice:  change.OldPrice,
+			Price:     change.Price,
+			Source:    priceSource(change.Source),
+			ChangedAt: timestamppb.New(change.ChangedAt),
+			UserId:    int64(change.UserID),
+		}
+	}
+
+	for i, price := range history.Scheduled {
+		resp.Scheduled[i] = scheduledPriceResponse(price)
+	}
+
+	return resp, nil
+}
+
+func (s *StockServer) ListOffers(ctx context.Context, req *pb.StockListOffersRequest) (*pb.StockListOffersResponse, error) {
+	dto := usecase.ListOffersDTO{
+		SKUID:       models.SKUID(req.Sku),
+		InStockOnly: req.InStockOnly,
+	}
+
+	offers, err := s.stockUsecase.ListOffers(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StockListOffersResponse{Offers: make([]*pb.StockOffer, len(offers))}
+
+	for i, offer := range offers {
+		resp.Offers[i] = &pb.StockOffer{
+			OfferId:  int64(offer.OfferID),
+			Sku:      uint32(offer.SKUID),
+			Seller:   &pb.StockSeller{Id: int64(offer.Seller.ID), Name: offer.Seller.Name},
+			Price:    offer.Price,
+			Count:    uint32(offer.Count),
+			Location: offer.Location,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *StockServer) RegisterSeller(ctx context.Context, req *pb.StockRegisterSellerRequest) (*pb.StockSeller, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	seller, err := s.stockUsecase.RegisterSeller(ctx, models.Seller{ID: userID, Name: req.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StockSeller{Id: int64(seller.ID), Name: seller.Name}, nil
+}
+
+// ImportStock applies every received message in its own transaction and responds with the report
+// of all rows when the client closes the stream. The dry run mode of the first message is used.
+func (s *StockServer) ImportStock(stream grpc.ClientStreamingServer[pb.StockImportRequest, pb.StockImportResponse]) error {
+	var resp pb.StockImportResponse
+	var line int64
+	var received bool
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&resp)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !received {
+			resp.DryRun = req.DryRun
+			received = true
+		} else if req.DryRun != resp.DryRun {
+			// a dry run must not apply a later message, nor a real import skip one
+			return usecase.ErrImportDryRunChanged
+		}
+
+		dto := usecase.ImportStockDTO{
+			Rows:   make([]usecase.ImportStockRowDTO, len(req.Rows)),
+			DryRun: resp.DryRun,
+		}
+
+		for i, row := range req.Rows {
+			line++
+			if row.Line > 0 {
+				line = row.Line
+			}
+
+			userID, err := requestUser(stream.Context(), row.UserId)
+			if err != nil {
+				return err
+			}
+
+			dto.Rows[i] = usecase.ImportStockRowDTO{
+				Line:     line,
+				SKUID:    models.SKUID(row.Sku),
+				UserID:   userID,
+				Count:    row.Count,
+				Price:    row.Price,
+				Location: row.Location,
+			}
+		}
+
+		result, err := s.stockUsecase.ImportStock(stream.Context(), dto)
+		if err != nil {
+			return err
+		}
+
+		resp.TotalRows += int64(len(dto.Rows))
+		resp.ImportedRows += int64(result.Imported)
+		resp.FailedRows += int64(len(result.Errors))
+
+		for _, rowErr := range result.Errors {
+			resp.Errors = append(resp.Errors, &pb.StockImportRowError{
+				Line:  rowErr.Line,
+				Sku:   uint32(rowErr.SKUID),
+				Error: rowErr.Err.Error(),
+			})
+		}
+	}
+}
+
+func (s *StockServer) ExportStock(_ *emptypb.Empty, stream grpc.ServerStreamingServer[pb.StockItemResponse]) error {
+	return s.stockUsecase.ExportStock(stream.Context(), func(item usecase.StockDTO) error {
+		resp, err := itemResponse(item)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(resp)
+	})
+}
+
+func listItemResponse(list usecase.ItemsByLocDTO) (*pb.StockListItemResponse, error) {
+	var err error
+
+	respList := make([]*pb.StockItemResponse, len(list.Stocks))
+
+	for i, item := range list.Stocks {
+		if respList[i], err = itemResponse(item); err != nil {
+			return nil, err
+		}
+	}
+
+	totalCount, err := models.IntToInt32(list.TotalCount)
+	if err != nil {
+		return nil, fmt.Errorf("totalCount = %w", err)
+	}
+
+	response := &pb.StockListItemResponse{
+		Items:         respList,
+		TotalCount:    totalCount,
+		PageNumber:    list.PageNumber,
+		NextPageToken: list.NextPageToken,
+	}
+
+	return response, nil
+}
+
+func searchSort(sort pb.StockSearchSort) models.ItemSort {
+	switch sort {
+	case pb.StockSearchSort_STOCK_SEARCH_SORT_PRICE:
+		return models.SortPrice
+	case pb.StockSearchSort_STOCK_SEARCH_SORT_NAME:
+		return models.SortName
+	case pb.StockSearchSort_STOCK_SEARCH_SORT_COUNT:
+		return models.SortCount
+	case pb.StockSearchSort_STOCK_SEARCH_SORT_UNSPECIFIED, pb.StockSearchSort_STOCK_SEARCH_SORT_RELEVANCE:
+		return models.SortRelevance
+	default:
+		return models.SortRelevance
+	}
+}
+
+func priceSource(source models.PriceSource) pb.StockPriceSource {
+	switch source {
+	case models.PriceSourceInitial:
+		return pb.StockPriceSource_STOCK_PRICE_SOURCE_INITIAL
+	case models.PriceSourceUpdate:
+		return pb.StockPriceSource_STOCK_PRICE_SOURCE_UPDATE
+	case models.PriceSourceImport:
+		return pb.StockPriceSource_STOCK_PRICE_SOURCE_IMPORT
+	case models.PriceSourceSchedule:
+		return pb.StockPriceSource_STOCK_PRICE_SOURCE_SCHEDULE
+	default:
+		return pb.StockPriceSource_STOCK_PRICE_SOURCE_UNSPECIFIED
+	}
+}
+
+func scheduledPriceResponse(price models.ScheduledPrice) *pb.StockScheduledPrice {
+	return &pb.StockScheduledPrice{
+		Id:          price.ID,
+		Sku:         uint32(price.SKUID),
+		Price:       price.Price,
+		EffectiveAt: timestamppb.New(price.EffectiveAt),
+		UserId:      int64(price.UserID),
+	}
+}
+
+func itemResponse(item usecase.StockDTO) (*pb.StockItemResponse, error) {
+	attributes, err := structpb.NewStruct(item.SKU.Attributes)
+	if err != nil {
+		return nil, err
 	}
 
 	return &pb.StockItemResponse{
-		Sku:      uint32(item.SKU.SKUID),
-		Name:     item.SKU.Name,
-		Type:     item.SKU.Type,
-		Count:    uint32(item.Count),
-		Price:    item.Price,
-		Location: item.Location,
-		UserId:   int64(item.UserID),
+		Sku:         uint32(item.SKU.SKUID),
+		Name:        item.SKU.Name,
+		Type:        item.SKU.Category.Name,
+		Count:       uint32(item.Count),
+		Price:       item.Price,
+		Location:    item.Location,
+		UserId:      int64(item.UserID),
+		Description: item.SKU.Description,
+		Category:    categoryResponse(item.SKU.Category),
+		Attributes:  attributes,
+		OfferId:     int64(item.OfferID),
+	}, nil
+}
+
+func skuResponse(sku usecase.SKUDTO) (*pb.StockSKUResponse, error) {
+	attributes, err := structpb.NewStruct(sku.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StockSKUResponse{
+		Sku:         uint32(sku.SKUID),
+		Name:        sku.Name,
+		Archived:    sku.Archived,
+		Description: sku.Description,
+		Category:    categoryResponse(sku.Category),
+		Attributes:  attributes,
 	}, nil
 }
+
+func categoryResponse(category usecase.CategoryDTO) *pb.StockCategory {
+	resp := &pb.StockCategory{
+		Id:       int64(category.ID),
+		ParentId: int64(category.ParentID),
+		Name:     category.Name,
+	}
+
+	if len(category.Schema) > 0 {
+		resp.AttributeSchema = make(map[string]string, len(category.Schema))
+
+		for name, attrType := range category.Schema {
+			resp.AttributeSchema[name] = string(attrType)
+		}
+	}
+
+	return resp
+}
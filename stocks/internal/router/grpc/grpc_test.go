@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"stocks/internal/usecase"
+	pb "stocks/pkg/api/stock"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+var errImportBatch = errors.New("failed to import batch")
+
+// importUsecaseStub applies the imported batches and fails the batch with the failBatch row count.
+type importUsecaseStub struct {
+	IStockUsecase
+	failBatch int
+	applied   []usecase.ImportStockDTO
+}
+
+func (s *importUsecaseStub) ImportStock(ctx context.Context, param usecase.ImportStockDTO) (usecase.ImportStockResultDTO, error) {
+	if len(param.Rows) == s.failBatch {
+		return usecase.ImportStockResultDTO{}, errImportBatch
+	}
+
+	s.applied = append(s.applied, param)
+
+	return usecase.ImportStockResultDTO{Imported: len(param.Rows)}, nil
+}
+
+// importStreamStub streams the requests to ImportStock and keeps its response.
+type importStreamStub struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pb.StockImportRequest
+	resp *pb.StockImportResponse
+}
+
+func (s *importStreamStub) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStreamStub) Recv() (*pb.StockImportRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *importStreamStub) SendAndClose(resp *pb.StockImportResponse) error {
+	s.resp = resp
+
+	return nil
+}
+
+func importBatch(dryRun bool, rows int) *pb.StockImportRequest {
+	req := &pb.StockImportRequest{DryRun: dryRun}
+
+	for range rows {
+		req.Rows = append(req.Rows, &pb.StockImportRow{Sku: 1001, UserId: 1, Count: 1, Location: "AG"})
+	}
+
+	return req
+}
+
+func TestImportStock(t *testing.T) {
+	tests := []struct {
+		name        string
+		reqs        []*pb.StockImportRequest
+		failBatch   int
+		wantErr     error
+		wantApplied int
+		wantRows    int64
+	}{
+		{
+			name:        "Succes",
+			reqs:        []*pb.StockImportRequest{importBatch(false, 1), importBatch(false, 2), importBatch(false, 3)},
+			wantErr:     nil,
+			wantApplied: 3,
+			wantRows:    6,
+		},
+		{
+			name:        "ErrorMiddleBatch",
+			reqs:        []*pb.StockImportRequest{importBatch(false, 1), importBatch(false, 2), importBatch(false, 3)},
+			failBatch:   2,
+			wantErr:     errImportBatch,
+			wantApplied: 1,
+		},
+		{
+			name:        "ErrorDryRunChanged",
+			reqs:        []*pb.StockImportRequest{importBatch(true, 1), importBatch(false, 3)},
+			wantErr:     usecase.ErrImportDryRunChanged,
+			wantApplied: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			us := &importUsecaseStub{failBatch: tt.failBatch}
+
+			stream := &importStreamStub{ctx: t.Context(), reqs: tt.reqs}
+
+			err := NewStockServer(us, nil).ImportStock(stream)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			// the batches before a failed one stay applied
+			if len(us.applied) != tt.wantApplied {
+				t.Errorf("wanted %d applied batches, respond: %d", tt.wantApplied, len(us.applied))
+			}
+
+			if tt.wantErr != nil {
+				if stream.resp != nil {
+					t.Errorf("wanted no response, respond: %v", stream.resp)
+				}
+
+				return
+			}
+
+			if stream.resp.GetImportedRows() != tt.wantRows || stream.resp.GetTotalRows() != tt.wantRows {
+				t.Errorf("wanted %d rows, respond: %v", tt.wantRows, stream.resp)
+			}
+		})
+	}
+}
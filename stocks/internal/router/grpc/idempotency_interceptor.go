@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"stocks/internal/auth"
+	"stocks/internal/models"
+	myLog "stocks/internal/observability/log"
+	"stocks/internal/usecase"
+	pb "stocks/pkg/api/stock"
+)
+
+const (
+	idempotencyKeyMetadataKey = "idempotency-key"
+
+	errReleaseIdempotencyKey = "failed to release idempotency key"
+	errSaveIdempotentResp    = "failed to save idempotent response"
+
+	completeAttempts   = 3
+	completeRetryDelay = 100 * time.Millisecond
+)
+
+// idempotentMethods are the mutating RPCs that honor the idempotency key.
+var idempotentMethods = map[string]struct{}{
+	pb.StockService_AddItem_FullMethodName:             {},
+	pb.StockService_DeleteItem_FullMethodName:          {},
+	pb.StockService_RestoreItem_FullMethodName:         {},
+	pb.StockService_TransferStock_FullMethodName:       {},
+	pb.StockService_SetThreshold_FullMethodName:        {},
+	pb.StockService_SchedulePriceChange_FullMethodName: {},
+	pb.StockService_RegisterSeller_FullMethodName:      {},
+	pb.StockService_CreateSKU_FullMethodName:           {},
+	pb.StockService_UpdateSKU_FullMethodName:           {},
+	pb.StockService_ArchiveSKU_FullMethodName:          {},
+	pb.StockService_CreateCategory_FullMethodName:      {},
+}
+
+type IIdempotencyUsecase interface {
+	Begin(ctx context.Context, req usecase.IdempotentRequestDTO) (usecase.IdempotentResultDTO, error)
+	Complete(ctx context.Context, key models.IdempotencyKey, response []byte) error
+	Release(ctx context.Context, key models.IdempotencyKey) error
+}
+
+// IdempotencyInterceptor executes a mutating request sent with the idempotency-key metadata only once.
+// A retry with the same key and payload gets the stored response, a retry with another payload is rejected.
+// The keys are scoped to the authenticated user and the method.
+func IdempotencyInterceptor(us IIdempotencyUsecase, logger myLog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var subject string
+		if principal, ok := auth.FromContext(ctx); ok {
+			subject = principal.Subject
+		}
+
+		idemKey := models.IdempotencyKey{Subject: subject, Method: info.FullMethod, Key: key}
+
+		result, err := us.Begin(ctx, usecase.IdempotentRequestDTO{
+			Subject:     subject,
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: hash,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !result.Reserved {
+			return storedResponse(result.Response)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := us.Release(ctx, idemKey); releaseErr != nil {
+				logger.Error(errReleaseIdempotencyKey, myLog.String("key", key), myLog.Error(releaseErr))
+			}
+
+			return nil, err
+		}
+
+		// the request is applied, so the key stays reserved even if its response can not be saved;
+		// the save is retried and not canceled with the request, as a key without a response answers
+		// every retry with IDEMPOTENCY_IN_PROGRESS until it expires
+		if err = completeIdempotentRequest(context.WithoutCancel(ctx), us, idemKey, resp); err != nil {
+			logger.Error(errSaveIdempotentResp, myLog.String("key", key), myLog.Error(err))
+		}
+
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(idempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// requestHash hashes the request, so a key reused for another payload is rejected.
+func requestHash(req proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hash[:], nil
+}
+
+// completeIdempotentRequest saves the response of the key, trying again after a growing delay if it fails.
+func completeIdempotentRequest(ctx context.Context, us IIdempotencyUsecase, key models.IdempotencyKey, resp any) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	anyResp, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(anyResp)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = us.Complete(ctx, key, data)
+		if err == nil || attempt == completeAttempts {
+			return err
+		}
+
+		time.Sleep(time.Duration(attempt) * completeRetryDelay)
+	}
+}
+
+func storedResponse(data []byte) (any, error) {
+	var anyResp anypb.Any
+
+	if err := proto.Unmarshal(data, &anyResp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp, err := anyResp.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/usecase"
+	pb "stocks/pkg/api/stock"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errSaveResponse = errors.New("failed to save response")
+
+// idempotencyUsecaseStub reserves every key and fails the first completeErrs saves of the response.
+type idempotencyUsecaseStub struct {
+	completeErrs  int
+	completeCalls int
+	completedKey  models.IdempotencyKey
+	completed     []byte
+}
+
+func (s *idempotencyUsecaseStub) Begin(ctx context.Context, req usecase.IdempotentRequestDTO) (usecase.IdempotentResultDTO, error) {
+	return usecase.IdempotentResultDTO{Reserved: true}, nil
+}
+
+func (s *idempotencyUsecaseStub) Complete(ctx context.Context, key models.IdempotencyKey, response []byte) error {
+	s.completeCalls++
+	if s.completeCalls <= s.completeErrs {
+		return errSaveResponse
+	}
+
+	s.completedKey = key
+	s.completed = response
+
+	return nil
+}
+
+func (s *idempotencyUsecaseStub) Release(ctx context.Context, key models.IdempotencyKey) error {
+	return nil
+}
+
+func TestIdempotencyInterceptorComplete(t *testing.T) {
+	tests := []struct {
+		name          string
+		completeErrs  int
+		wantCalls     int
+		wantCompleted bool
+	}{
+		{
+			name:          "Succes",
+			completeErrs:  0,
+			wantCalls:     1,
+			wantCompleted: true,
+		},
+		{
+			name:          "Retried",
+			completeErrs:  completeAttempts - 1,
+			wantCalls:     completeAttempts,
+			wantCompleted: true,
+		},
+		{
+			name:          "Failed",
+			completeErrs:  completeAttempts,
+			wantCalls:     completeAttempts,
+			wantCompleted: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := logMock.NewLoggerMock(t)
+			if !tt.wantCompleted {
+				logger.ErrorMock.Return()
+			}
+
+			us := &idempotencyUsecaseStub{completeErrs: tt.completeErrs}
+			interceptor := IdempotencyInterceptor(us, logger)
+
+			ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(idempotencyKeyMetadataKey, "key"))
+			info := &grpc.UnaryServerInfo{FullMethod: pb.StockService_DeleteItem_FullMethodName}
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return &emptypb.Empty{}, nil
+			}
+
+			resp, err := interceptor(ctx, &pb.StockDeleteItemRequest{}, info, handler)
+			if err != nil || resp == nil {
+				t.Fatalf("wanted a response, respond: %v, %v", resp, err)
+			}
+
+			if us.completeCalls != tt.wantCalls {
+				t.Errorf("wanted %d saves, respond: %d", tt.wantCalls, us.completeCalls)
+			}
+
+			if (us.completed != nil) != tt.wantCompleted {
+				t.Errorf("wanted completed: %v, respond: %v", tt.wantCompleted, us.completed != nil)
+			}
+
+			wantKey := models.IdempotencyKey{Method: pb.StockService_DeleteItem_FullMethodName, Key: "key"}
+			if tt.wantCompleted && us.completedKey != wantKey {
+				t.Errorf("wanted: %v, respond: %v", wantKey, us.completedKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"stocks/internal/auth"
+	myLog "stocks/internal/observability/log"
+	pb "stocks/pkg/api/stock"
+)
+
+const errPermissionDenied = "permission denied"
+
+var errMethodDenied error = errors.New("the roles of the token do not allow this method")
+
+var (
+	anyRole = []auth.Role{auth.RoleCustomer, auth.RoleSeller, auth.RoleAdmin}
+	sellers = []auth.Role{auth.RoleSeller, auth.RoleAdmin}
+	admins  = []auth.Role{auth.RoleAdmin}
+)
+
+// policies maps every RPC to the roles allowed to call it, a method missing from the table is denied.
+// Sellers manage their own stock, admins manage the catalog and the stock of every seller.
+var policies = map[string][]auth.Role{
+	pb.StockService_GetItem_FullMethodName:         anyRole,
+	pb.StockService_GetItems_FullMethodName:        anyRole,
+	pb.StockService_GetSKU_FullMethodName:          anyRole,
+	pb.StockService_ListSKUs_FullMethodName:        anyRole,
+	pb.StockService_ListCategories_FullMethodName:  anyRole,
+	pb.StockService_SearchItems_FullMethodName:     anyRole,
+	pb.StockService_GetPriceHistory_FullMethodName: anyRole,
+	pb.StockService_ListOffers_FullMethodName:      anyRole,
+
+	pb.StockService_AddItem_FullMethodName:             sellers,
+	pb.StockService_DeleteItem_FullMethodName:          sellers,
+	pb.StockService_RestoreItem_FullMethodName:         sellers,
+	pb.StockService_TransferStock_FullMethodName:       sellers,
+	pb.StockService_ListItem_FullMethodName:            sellers,
+	pb.StockService_ListLowStock_FullMethodName:        sellers,
+	pb.StockService_SchedulePriceChange_FullMethodName: sellers,
+	pb.StockService_RegisterSeller_FullMethodName:      sellers,
+	pb.StockService_ImportStock_FullMethodName:         sellers,
+
+	pb.StockService_CreateSKU_FullMethodName:      admins,
+	pb.StockService_UpdateSKU_FullMethodName:      admins,
+	pb.StockService_ArchiveSKU_FullMethodName:     admins,
+	pb.StockService_CreateCategory_FullMethodName: admins,
+	pb.StockService_SetThreshold_FullMethodName:   admins,
+	pb.StockService_ExportStock_FullMethodName:    admins,
+
+	healthpb.Health_Check_FullMethodName: anyRole,
+	healthpb.Health_Watch_FullMethodName: anyRole,
+
+	reflectionv1.ServerReflection_ServerReflectionInfo_FullMethodName:      anyRole,
+	reflectionv1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: anyRole,
+}
+
+// PolicyInterceptor rejects the requests of a principal without a role allowed by the policy of the method
+// with PERMISSION_DENIED. It runs after AuthInterceptor, so a request without a principal is a public read.
+func PolicyInterceptor(logger myLog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, logger, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// PolicyStreamInterceptor is PolicyInterceptor of the streaming RPCs.
+func PolicyStreamInterceptor(logger myLog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), logger, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, logger myLog.Logger, method string) error {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if roles, ok := policies[method]; ok && principal.HasAnyRole(roles...) {
+		return nil
+	}
+
+	logger.Warn(errPermissionDenied,
+		myLog.String("method", method),
+		myLog.Int64("user_id", int64(principal.UserID)),
+		myLog.Strings("roles", principal.RoleNames()),
+	)
+
+	return status.Error(codes.PermissionDenied, errMethodDenied.Error())
+}
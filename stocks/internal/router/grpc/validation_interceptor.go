@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor checks the request against the protovalidate constraints of stock.proto.
+// An invalid request is rejected with INVALID_ARGUMENT and a google.rpc.BadRequest violation per field.
+func ValidationInterceptor(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate(validator, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor is ValidationInterceptor for every message received from a stream.
+func ValidationStreamInterceptor(validator protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss, validator: validator})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator protovalidate.Validator
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(s.validator, m)
+}
+
+// validate returns the status of the violations of the request. Errors of the validator itself,
+// like a constraint that fails to compile, are returned as is and become internal errors.
+func validate(validator protovalidate.Validator, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := validator.Validate(msg)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErr.Violations))
+
+	for i, violation := range validationErr.Violations {
+		violations[i] = fieldViolation(protovalidate.FieldPathString(violation.Proto.GetField()), violation.Proto.GetMessage())
+	}
+
+	return detailedError(codes.InvalidArgument, reasonInvalidArgument, validationErr.Error(), violations...)
+}
@@ -1,6 +1,9 @@
 package usecase
 
-import "stocks/internal/models"
+import (
+	"stocks/internal/models"
+	"time"
+)
 
 type AddStockDTO struct {
 	SKUID    models.SKUID
@@ -10,25 +13,52 @@ type AddStockDTO struct {
 	Location string
 }
 
+type TransferStockDTO struct {
+	SKUID        models.SKUID
+	UserID       models.UserID
+	FromLocation string
+	ToLocation   string
+	Count        uint16
+}
+
 type DeleteStockDTO struct {
 	UserID models.UserID
 	SKUID  models.SKUID
 }
 
+type RestoreStockDTO struct {
+	UserID models.UserID
+	SKUID  models.SKUID
+}
+
 type GetItemByLocDTO struct {
 	UserID      models.UserID
 	Location    string
 	PageSize    int64
 	CurrentPage int64
+	PageToken   string
+	CategoryID  models.CategoryID
+	Attributes  models.Attributes
+}
+
+type CategoryDTO struct {
+	ID       models.CategoryID
+	ParentID models.CategoryID
+	Name     string
+	Schema   models.AttributeSchema
 }
 
 type SKUDTO struct {
-	SKUID models.SKUID
-	Name  string
-	Type  string
+	SKUID       models.SKUID
+	Name        string
+	Description string
+	Category    CategoryDTO
+	Attributes  models.Attributes
+	Archived    bool
 }
 
 type StockDTO struct {
+	OfferID  models.StockID
 	SKU      SKUDTO
 	Count    uint16
 	Price    uint32
@@ -36,8 +66,153 @@ type StockDTO struct {
 	UserID   models.UserID
 }
 
+type SearchItemsDTO struct {
+	Query       string
+	CategoryID  models.CategoryID
+	Type        string
+	MinPrice    *uint32
+	MaxPrice    *uint32
+	MinCount    *uint32
+	MaxCount    *uint32
+	InStockOnly bool
+	Location    string
+	Sort        models.ItemSort
+	Descending  bool
+	PageSize    int64
+	CurrentPage int64
+}
+
 type ItemsByLocDTO struct {
-	Stocks     []StockDTO
+	Stocks        []StockDTO
+	TotalCount    int
+	PageNumber    int64
+	NextPageToken string
+}
+
+type CreateSKUDTO struct {
+	Name        string
+	Description string
+	CategoryID  models.CategoryID
+	Attributes  models.Attributes
+}
+
+type UpdateSKUDTO struct {
+	SKUID       models.SKUID
+	Name        *string
+	Description *string
+	CategoryID  *models.CategoryID
+	Attributes  models.Attributes
+}
+
+type CreateCategoryDTO struct {
+	ParentID models.CategoryID
+	Name     string
+	Schema   models.AttributeSchema
+}
+
+type ListSKUsDTO struct {
+	CategoryID      models.CategoryID
+	IncludeArchived bool
+	PageSize        int64
+	CurrentPage     int64
+}
+
+type SKUsDTO struct {
+	SKUs       []SKUDTO
 	TotalCount int
 	PageNumber int64
 }
+
+type IdempotentRequestDTO struct {
+	Subject     string
+	Key         string
+	Method      string
+	RequestHash []byte
+}
+
+type IdempotentResultDTO struct {
+	Reserved bool
+	Response []byte
+}
+
+type ImportStockRowDTO struct {
+	Line     int64
+	SKUID    models.SKUID
+	UserID   models.UserID
+	Count    uint32
+	Price    uint32
+	Location string
+}
+
+type ImportStockDTO struct {
+	Rows   []ImportStockRowDTO
+	DryRun bool
+}
+
+type ImportRowErrorDTO struct {
+	Line  int64
+	SKUID models.SKUID
+	Err   error
+}
+
+type ImportStockResultDTO struct {
+	Imported int
+	Errors   []ImportRowErrorDTO
+}
+
+type SetThresholdDTO struct {
+	SKUID     models.SKUID
+	Location  string
+	Threshold uint16
+}
+
+type ListLowStockDTO struct {
+	UserID      models.UserID
+	Location    string
+	PageSize    int64
+	CurrentPage int64
+}
+
+type LowStockDTO struct {
+	Stock     StockDTO
+	Threshold uint16
+}
+
+type LowStocksDTO struct {
+	Items      []LowStockDTO
+	PageNumber int64
+}
+
+type SchedulePriceDTO struct {
+	SKUID       models.SKUID
+	UserID      models.UserID
+	Price       uint32
+	EffectiveAt time.Time
+}
+
+type GetPriceHistoryDTO struct {
+	SKUID       models.SKUID
+	Location    string
+	PageSize    int64
+	CurrentPage int64
+}
+
+type PriceHistoryDTO struct {
+	Changes    []models.PriceChange
+	Scheduled  []models.ScheduledPrice
+	PageNumber int64
+}
+
+type ListOffersDTO struct {
+	SKUID       models.SKUID
+	InStockOnly bool
+}
+
+type OfferDTO struct {
+	OfferID  models.StockID
+	SKUID    models.SKUID
+	Seller   models.Seller
+	Price    uint32
+	Count    uint16
+	Location string
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import "errors"
+
+// ErrorKind is the class of a domain error, which the transport maps to its own status code.
+type ErrorKind int
+
+const (
+	KindInternal ErrorKind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindAlreadyExists
+	KindFailedPrecondition
+	KindAborted
+	KindUnavailable
+)
+
+// Error is a domain error. Reason is a stable UPPER_SNAKE_CASE identifier clients can match on,
+// Field is the request field an invalid argument is about, if any.
+type Error struct {
+	Kind    ErrorKind
+	Reason  string
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(kind ErrorKind, reason, message string) *Error {
+	return &Error{Kind: kind, Reason: reason, Message: message}
+}
+
+func newFieldError(field, reason, message string) *Error {
+	return &Error{Kind: KindInvalidArgument, Reason: reason, Field: field, Message: message}
+}
+
+// AsError returns the domain error in the chain of err.
+func AsError(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+
+	return nil, false
+}
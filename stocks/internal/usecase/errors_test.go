@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAsError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantOK     bool
+		wantKind   ErrorKind
+		wantReason string
+		wantField  string
+	}{
+		{
+			name:       "sentinel",
+			err:        ErrNotFound,
+			wantOK:     true,
+			wantKind:   KindNotFound,
+			wantReason: "NOT_FOUND",
+		},
+		{
+			name:       "wrapped",
+			err:        fmt.Errorf("%w: %q is not defined for the category", ErrInvalidAttribute, "color"),
+			wantOK:     true,
+			wantKind:   KindInvalidArgument,
+			wantReason: "INVALID_ATTRIBUTE",
+			wantField:  "attributes",
+		},
+		{
+			name: "not a domain error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domainErr, ok := AsError(tt.err)
+			if ok != tt.wantOK {
+				t.Fatalf("AsError() ok = %v, want %v", ok, tt.wantOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if domainErr.Kind != tt.wantKind || domainErr.Reason != tt.wantReason || domainErr.Field != tt.wantField {
+				t.Errorf("AsError() = %+v, want kind %v, reason %q, field %q", domainErr, tt.wantKind, tt.wantReason, tt.wantField)
+			}
+		})
+	}
+}
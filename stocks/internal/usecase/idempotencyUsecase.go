@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"stocks/internal/models"
+	"stocks/internal/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	idempotencyBeginSpanName    = "stock-idempotency-begin-usecase"
+	idempotencyCompleteSpanName = "stock-idempotency-complete-usecase"
+)
+
+var (
+	ErrIdempotencyKeyReused    error = newError(KindInvalidArgument, "IDEMPOTENCY_KEY_REUSED", "idempotency key was already used for a different request")
+	ErrIdempotencyInProgress   error = newError(KindAborted, "IDEMPOTENCY_IN_PROGRESS", "request with this idempotency key is still in progress")
+	ErrIdempotencyEmptyRequest error = newError(KindInternal, "IDEMPOTENCY_EMPTY_REQUEST", "idempotency key and request hash are required")
+)
+
+// IdempotencyUsecase remembers the responses of requests sent with an idempotency key,
+// so a retried request gets the first response instead of being applied again.
+type IdempotencyUsecase struct {
+	repo repository.IIdempotencyRepo
+	ttl  time.Duration
+}
+
+func NewIdempotencyUsecase(repo repository.IIdempotencyRepo, ttl time.Duration) *IdempotencyUsecase {
+	return &IdempotencyUsecase{repo: repo, ttl: ttl}
+}
+
+// Begin reserves the key of the user for the method of the request. If the key was already used for
+// the same request, the stored response is returned with Reserved unset and the request must not be executed.
+// The keys of every user and method are separate, so a key of another user never conflicts.
+func (u *IdempotencyUsecase) Begin(ctx context.Context, req IdempotentRequestDTO) (IdempotentResultDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, idempotencyBeginSpanName)
+	defer span.End()
+
+	if req.Key == "" || len(req.RequestHash) == 0 {
+		return IdempotentResultDTO{}, ErrIdempotencyEmptyRequest
+	}
+
+	reserved, err := u.repo.ReserveKey(ctx, models.IdempotencyRecord{
+		Subject:     req.Subject,
+		Key:         req.Key,
+		Method:      req.Method,
+		RequestHash: req.RequestHash,
+		ExpiresAt:   time.Now().Add(u.ttl),
+	})
+	if err != nil {
+		return IdempotentResultDTO{}, err
+	}
+
+	if reserved {
+		return IdempotentResultDTO{Reserved: true}, nil
+	}
+
+	record, err := u.repo.GetKey(ctx, models.IdempotencyKey{Subject: req.Subject, Method: req.Method, Key: req.Key})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// the first request failed and released the key in the meantime
+			return IdempotentResultDTO{}, ErrIdempotencyInProgress
+		}
+
+		return IdempotentResultDTO{}, err
+	}
+
+	if !bytes.Equal(record.RequestHash, req.RequestHash) {
+		return IdempotentResultDTO{}, ErrIdempotencyKeyReused
+	}
+
+	if record.Response == nil {
+		return IdempotentResultDTO{}, ErrIdempotencyInProgress
+	}
+
+	return IdempotentResultDTO{Response: record.Response}, nil
+}
+
+// Complete stores the response of a request reserved by Begin.
+func (u *IdempotencyUsecase) Complete(ctx context.Context, key models.IdempotencyKey, response []byte) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, idempotencyCompleteSpanName)
+	defer span.End()
+
+	return u.repo.SaveResponse(ctx, key, response)
+}
+
+// Release frees the key of a failed request, so the client can retry it with the same key.
+func (u *IdempotencyUsecase) Release(ctx context.Context, key models.IdempotencyKey) error {
+	return u.repo.DeleteKey(ctx, key)
+}
+
+// DeleteExpiredKeys removes the keys whose expiry has passed and returns their number.
+func (u *IdempotencyUsecase) DeleteExpiredKeys(ctx context.Context) (int, error) {
+	deleted, err := u.repo.DeleteExpiredKeys(ctx)
+
+	return int(deleted), err
+}
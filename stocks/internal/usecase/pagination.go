@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"stocks/internal/models"
+)
+
+const maxPageSize = 100
+
+var (
+	ErrInvalidPage      error = newFieldError("page_size", "INVALID_PAGE", fmt.Sprintf("page size must be from 1 to %d and current page must be positive", maxPageSize))
+	ErrInvalidPageToken error = newFieldError("page_token", "INVALID_PAGE_TOKEN", "invalid page token")
+)
+
+// pageToken is the position of the next page of a keyset paginated list.
+type pageToken struct {
+	AfterID    models.StockID `json:"a"`
+	PageNumber int64          `json:"p"`
+}
+
+func validPage(pageSize, currentPage int64) bool {
+	return pageSize >= 1 && pageSize <= maxPageSize && currentPage >= 1
+}
+
+// encodePageToken returns the opaque token of the page following the last item of the current page.
+func encodePageToken(token pageToken) string {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return pageToken{}, ErrInvalidPageToken
+	}
+
+	if err = json.Unmarshal(data, &token); err != nil || token.AfterID < 1 || token.PageNumber < 2 {
+		return pageToken{}, ErrInvalidPageToken
+	}
+
+	return token, nil
+}
@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/models"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	"strings"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	eventCatalogCreateType  = "catalog_sku_created"
+	eventCatalogUpdateType  = "catalog_sku_updated"
+	eventCatalogArchiveType = "catalog_sku_archived"
+
+	skuCreateSpanName  = "sku-create-usecase"
+	skuUpdateSpanName  = "sku-update-usecase"
+	skuArchiveSpanName = "sku-archive-usecase"
+	skuGetSpanName     = "sku-get-usecase"
+	skuListSpanName    = "sku-list-usecase"
+	categoryCreateSpan = "category-create-usecase"
+	categoryListSpan   = "category-list-usecase"
+)
+
+var (
+	ErrSKUNameTaken   error = newError(KindAlreadyExists, "SKU_NAME_TAKEN", "sku name is already taken")
+	ErrSKUInvalidName error = newFieldError("name", "INVALID_SKU_NAME", "sku name must not be empty")
+	ErrSKUArchived    error = newError(KindFailedPrecondition, "SKU_ARCHIVED", "sku is archived")
+
+	ErrCategoryNotFound     error = newFieldError("category_id", "CATEGORY_NOT_FOUND", "category not found")
+	ErrCategoryNameTaken    error = newError(KindAlreadyExists, "CATEGORY_NAME_TAKEN", "category name is already taken")
+	ErrCategoryInvalidName  error = newFieldError("name", "INVALID_CATEGORY_NAME", "category name must not be empty")
+	ErrInvalidAttribute     error = newFieldError("attributes", "INVALID_ATTRIBUTE", "invalid attribute")
+	ErrInvalidAttributeType error = newFieldError("attribute_schema", "INVALID_ATTRIBUTE_TYPE", "invalid attribute type")
+)
+
+type SKUUsecase struct {
+	skuRepo       repository.ISKURepo
+	categoryRepo  repository.ICategoryRepo
+	kafkaProducer IProducer
+	logger        myLog.Logger
+}
+
+func NewSKUUsecase(repo repository.ISKURepo, categoryRepo repository.ICategoryRepo, kafkaPr IProducer, logg myLog.Logger) *SKUUsecase {
+	return &SKUUsecase{skuRepo: repo, categoryRepo: categoryRepo, kafkaProducer: kafkaPr, logger: logg}
+}
+
+func (u *SKUUsecase) CreateSKU(ctx context.Context, sku CreateSKUDTO) (SKUDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, skuCreateSpanName)
+	defer span.End()
+
+	newSKU := models.SKU{
+		Name:        strings.TrimSpace(sku.Name),
+		Description: sku.Description,
+		Category:    models.Category{ID: sku.CategoryID},
+		Attributes:  sku.Attributes,
+	}
+
+	category, err := u.validateSKU(ctx, newSKU)
+	if err != nil {
+		return SKUDTO{}, err
+	}
+
+	skuID, err := u.skuRepo.CreateSKU(ctx, newSKU)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return SKUDTO{}, ErrSKUNameTaken
+		}
+
+		return SKUDTO{}, err
+	}
+
+	newSKU.ID = skuID
+	newSKU.Category = category
+
+	u.produceCatalogEvent(eventCatalogCreateType, newSKU)
+
+	return skuToDTO(newSKU), nil
+}
+
+// UpdateSKU changes an active SKU. Fields left unset keep their value, set attributes replace all
+// attributes of the SKU. The attributes are validated against the schema of the resulting category.
+func (u *SKUUsecase) UpdateSKU(ctx context.Context, update UpdateSKUDTO) (SKUDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, skuUpdateSpanName)
+	defer span.End()
+
+	sku, err := u.getActiveSKU(ctx, update.SKUID)
+	if err != nil {
+		return SKUDTO{}, err
+	}
+
+	if update.Name != nil {
+		sku.Name = strings.TrimSpace(*update.Name)
+	}
+
+	if update.Description != nil {
+		sku.Description = *update.Description
+	}
+
+	if update.CategoryID != nil {
+		sku.Category = models.Category{ID: *update.CategoryID}
+	}
+
+	if update.Attributes != nil {
+		sku.Attributes = update.Attributes
+	}
+
+	if sku.Category, err = u.validateSKU(ctx, sku); err != nil {
+		return SKUDTO{}, err
+	}
+
+	if err = u.skuRepo.UpdateSKU(ctx, sku); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return SKUDTO{}, ErrSKUNameTaken
+		}
+
+		if errors.Is(err, repository.ErrNotFound) {
+			return SKUDTO{}, ErrNotFound
+		}
+
+		return SKUDTO{}, err
+	}
+
+	u.produceCatalogEvent(eventCatalogUpdateType, sku)
+
+	return skuToDTO(sku), nil
+}
+
+// ArchiveSKU hides the SKU from the catalog. Archived SKUs keep their stock, but can not be restocked.
+func (u *SKUUsecase) ArchiveSKU(ctx context.Context, skuID models.SKUID) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, skuArchiveSpanName)
+	defer span.End()
+
+	sku, err := u.getActiveSKU(ctx, skuID)
+	if err != nil {
+		return err
+	}
+
+	if err = u.skuRepo.ArchiveSKU(ctx, skuID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrSKUArchived
+		}
+
+		return err
+	}
+
+	sku.Archived = true
+
+	u.produceCatalogEvent(eventCatalogArchiveType, sku)
+
+	return nil
+}
+
+func (u *SKUUsecase) GetSKU(ctx context.Context, skuID models.SKUID) (SKUDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, skuGetSpanName)
+	defer span.End()
+
+	sku, err := u.skuRepo.GetSKU(ctx, skuID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return SKUDTO{}, ErrNotFound
+		}
+
+		return SKUDTO{}, err
+	}
+
+	return skuToDTO(sku), nil
+}
+
+func (u *SKUUsecase) ListSKUs(ctx context.Context, param ListSKUsDTO) (SKUsDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, skuListSpanName)
+	defer span.End()
+
+	if !validPage(param.PageSize, param.CurrentPage) {
+		return SKUsDTO{}, ErrInvalidPage
+	}
+
+	skus, err := u.skuRepo.ListSKUs(ctx, repository.ListSKUsParam{
+		CategoryID:      param.CategoryID,
+		IncludeArchived: param.IncludeArchived,
+		Limit:           param.PageSize,
+		Offset:          param.PageSize * (param.CurrentPage - 1),
+	})
+	if err != nil {
+		return SKUsDTO{}, err
+	}
+
+	totalCount, err := u.skuRepo.CountSKUs(ctx, param.CategoryID, param.IncludeArchived)
+	if err != nil {
+		return SKUsDTO{}, err
+	}
+
+	list := SKUsDTO{
+		SKUs:       make([]SKUDTO, len(skus)),
+		TotalCount: int(totalCount),
+		PageNumber: param.CurrentPage,
+	}
+
+	for i, sku := range skus {
+		list.SKUs[i] = skuToDTO(sku)
+	}
+
+	return list, nil
+}
+
+func (u *SKUUsecase) getActiveSKU(ctx context.Context, skuID models.SKUID) (models.SKU, error) {
+	sku, err := u.skuRepo.GetSKU(ctx, skuID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.SKU{}, ErrNotFound
+		}
+
+		return models.SKU{}, err
+	}
+
+	if sku.Archived {
+		return models.SKU{}, ErrSKUArchived
+	}
+
+	return sku, nil
+}
+
+func (u *SKUUsecase) produceCatalogEvent(eventType string, sku models.SKU) {
+	messageDTO := producer.ProducerMessageDTO{
+		Type:      eventType,
+		Service:   eventService,
+		Timestamp: time.Now(),
+		SKU:       sku.ID,
+		Name:      sku.Name,
+		Category:  sku.Category.Name,
+	}
+
+	u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+}
+
+// CreateCategory adds a category to the tree. Its attributes are added to the ones inherited from the parent.
+func (u *SKUUsecase) CreateCategory(ctx context.Context, category CreateCategoryDTO) (CategoryDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, categoryCreateSpan)
+	defer span.End()
+
+	newCategory := models.Category{
+		ParentID: category.ParentID,
+		Name:     strings.TrimSpace(category.Name),
+		Schema:   category.Schema,
+	}
+
+	if newCategory.Name == "" {
+		return CategoryDTO{}, ErrCategoryInvalidName
+	}
+
+	for name, attrType := range newCategory.Schema {
+		if name == "" || !validAttributeType(attrType) {
+			return CategoryDTO{}, fmt.Errorf("%w: %q", ErrInvalidAttributeType, name)
+		}
+	}
+
+	categoryID, err := u.categoryRepo.CreateCategory(ctx, newCategory)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return CategoryDTO{}, ErrCategoryNameTaken
+		}
+
+		if errors.Is(err, repository.ErrNotFound) {
+			return CategoryDTO{}, ErrCategoryNotFound
+		}
+
+		return CategoryDTO{}, err
+	}
+
+	newCategory.ID = categoryID
+
+	return categoryToDTO(newCategory), nil
+}
+
+func (u *SKUUsecase) ListCategories(ctx context.Context) ([]CategoryDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, categoryListSpan)
+	defer span.End()
+
+	categories, err := u.categoryRepo.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]CategoryDTO, len(categories))
+
+	for i, category := range categories {
+		list[i] = categoryToDTO(category)
+	}
+
+	return list, nil
+}
+
+// validateSKU checks the name of the SKU and its attributes against the schema of its category
+// and returns the category.
+func (u *SKUUsecase) validateSKU(ctx context.Context, sku models.SKU) (models.Category, error) {
+	if sku.Name == "" {
+		return models.Category{}, ErrSKUInvalidName
+	}
+
+	category, err := u.categoryRepo.GetCategory(ctx, sku.Category.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.Category{}, ErrCategoryNotFound
+		}
+
+		return models.Category{}, err
+	}
+
+	if err = validateAttributes(category.Schema, sku.Attributes); err != nil {
+		return models.Category{}, err
+	}
+
+	return category, nil
+}
+
+func validateAttributes(schema models.AttributeSchema, attributes models.Attributes) error {
+	for name, value := range attributes {
+		attrType, ok := schema[name]
+		if !ok {
+			return fmt.Errorf("%w: %q is not defined for the category", ErrInvalidAttribute, name)
+		}
+
+		var valid bool
+
+		switch attrType {
+		case models.AttributeString:
+			_, valid = value.(string)
+		case models.AttributeNumber:
+			_, valid = value.(float64)
+		case models.AttributeBool:
+			_, valid = value.(bool)
+		default:
+			valid = false
+		}
+
+		if !valid {
+			return fmt.Errorf("%w: %q must be a %s", ErrInvalidAttribute, name, attrType)
+		}
+	}
+
+	return nil
+}
+
+func validAttributeType(attrType models.AttributeType) bool {
+	switch attrType {
+	case models.AttributeString, models.AttributeNumber, models.AttributeBool:
+		return true
+	default:
+		return false
+	}
+}
+
+func skuToDTO(sku models.SKU) SKUDTO {
+	return SKUDTO{
+		SKUID:       sku.ID,
+		Name:        sku.Name,
+		Description: sku.Description,
+		Category:    categoryToDTO(sku.Category),
+		Attributes:  sku.Attributes,
+		Archived:    sku.Archived,
+	}
+}
+
+func categoryToDTO(category models.Category) CategoryDTO {
+	return CategoryDTO{
+		ID:       category.ID,
+		ParentID: category.ParentID,
+		Name:     category.Name,
+		Schema:   category.Schema,
+	}
+}
@@ -0,0 +1,444 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+)
+
+var (
+	apparelSchema   = models.AttributeSchema{"size": models.AttributeString, "color": models.AttributeString}
+	accessorySchema = models.AttributeSchema{"color": models.AttributeString}
+)
+
+func getCategory(ctx context.Context, categoryID models.CategoryID) (models.Category, error) {
+	switch categoryID {
+	case 1:
+		return models.Category{ID: 1, Name: "apparel", Schema: apparelSchema}, nil
+	case 2:
+		return models.Category{ID: 2, Name: "accessory", Schema: accessorySchema}, nil
+	}
+
+	return models.Category{}, repository.ErrNotFound
+}
+
+func TestCreateSKU(t *testing.T) {
+	repoMock := repositoryMock.NewISKURepoMock(t)
+	categoryMock := repositoryMock.NewICategoryRepoMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		categoryMock.MinimockFinish()
+	})
+
+	categoryMock.GetCategoryMock.Set(getCategory)
+
+	repoMock.CreateSKUMock.Set(func(ctx context.Context, sku models.SKU) (models.SKUID, error) {
+		if sku.Name == "cup" {
+			return 0, repository.ErrDuplicate
+		}
+
+		return 100000, nil
+	})
+
+	kafkaMock.ProduceMock.Return(nil)
+	logger.InfoMock.Return()
+
+	usecase := NewSKUUsecase(repoMock, categoryMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    CreateSKUDTO
+		want    SKUDTO
+		wantErr error
+	}{
+		{
+			name: testSuccesName,
+			body: CreateSKUDTO{Name: " scarf ", CategoryID: 1, Attributes: models.Attributes{"size": "M"}},
+			want: SKUDTO{
+				SKUID:      100000,
+				Name:       "scarf",
+				Category:   CategoryDTO{ID: 1, Name: "apparel", Schema: apparelSchema},
+				Attributes: models.Attributes{"size": "M"},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorNameTaken",
+			body:    CreateSKUDTO{Name: "cup", CategoryID: 1},
+			want:    SKUDTO{},
+			wantErr: ErrSKUNameTaken,
+		},
+		{
+			name:    "ErrorEmptyName",
+			body:    CreateSKUDTO{Name: " ", CategoryID: 1},
+			want:    SKUDTO{},
+			wantErr: ErrSKUInvalidName,
+		},
+		{
+			name:    "ErrorCategory",
+			body:    CreateSKUDTO{Name: "scarf", CategoryID: 5},
+			want:    SKUDTO{},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:    "ErrorUnknownAttribute",
+			body:    CreateSKUDTO{Name: "scarf", CategoryID: 1, Attributes: models.Attributes{"weight": 1.5}},
+			want:    SKUDTO{},
+			wantErr: ErrInvalidAttribute,
+		},
+		{
+			name:    "ErrorAttributeType",
+			body:    CreateSKUDTO{Name: "scarf", CategoryID: 1, Attributes: models.Attributes{"size": 42.0}},
+			want:    SKUDTO{},
+			wantErr: ErrInvalidAttribute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, err := usecase.CreateSKU(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(sku, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, sku)
+			}
+		})
+	}
+}
+
+func TestUpdateSKU(t *testing.T) {
+	repoMock := repositoryMock.NewISKURepoMock(t)
+	categoryMock := repositoryMock.NewICategoryRepoMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		categoryMock.MinimockFinish()
+	})
+
+	categoryMock.GetCategoryMock.Set(getCategory)
+
+	repoMock.GetSKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.SKU, error) {
+		switch skuID {
+		case 1001:
+			return models.SKU{ID: 1001, Name: "t-shirt", Category: models.Category{ID: 1, Name: "apparel"}}, nil
+		case 2020:
+			return models.SKU{ID: 2020, Name: "cup", Category: models.Category{ID: 2, Name: "accessory"}, Archived: true}, nil
+		}
+
+		return models.SKU{}, repository.ErrNotFound
+	})
+
+	repoMock.UpdateSKUMock.Set(func(ctx context.Context, sku models.SKU) error {
+		if sku.Name == "cup" {
+			return repository.ErrDuplicate
+		}
+
+		return nil
+	})
+
+	kafkaMock.ProduceMock.Return(nil)
+	logger.InfoMock.Return()
+
+	usecase := NewSKUUsecase(repoMock, categoryMock, kafkaMock, logger)
+
+	newName, takenName := "shirt", "cup"
+	newCategory, missingCategory := models.CategoryID(2), models.CategoryID(5)
+
+	tests := []struct {
+		name    string
+		body    UpdateSKUDTO
+		want    SKUDTO
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    UpdateSKUDTO{SKUID: 1001, Name: &newName},
+			want:    SKUDTO{SKUID: 1001, Name: "shirt", Category: CategoryDTO{ID: 1, Name: "apparel", Schema: apparelSchema}},
+			wantErr: nil,
+		},
+		{
+			name: "Category",
+			body: UpdateSKUDTO{SKUID: 1001, CategoryID: &newCategory, Attributes: models.Attributes{"color": "red"}},
+			want: SKUDTO{
+				SKUID:      1001,
+				Name:       "t-shirt",
+				Category:   CategoryDTO{ID: 2, Name: "accessory", Schema: accessorySchema},
+				Attributes: models.Attributes{"color": "red"},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorCategory",
+			body:    UpdateSKUDTO{SKUID: 1001, CategoryID: &missingCategory},
+			want:    SKUDTO{},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:    "ErrorNameTaken",
+			body:    UpdateSKUDTO{SKUID: 1001, Name: &takenName},
+			want:    SKUDTO{},
+			wantErr: ErrSKUNameTaken,
+		},
+		{
+			name:    "ErrorArchived",
+			body:    UpdateSKUDTO{SKUID: 2020, Name: &newName},
+			want:    SKUDTO{},
+			wantErr: ErrSKUArchived,
+		},
+		{
+			name:    "NotFound",
+			body:    UpdateSKUDTO{SKUID: 3033, Name: &newName},
+			want:    SKUDTO{},
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sku, err := usecase.UpdateSKU(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(sku, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, sku)
+			}
+		})
+	}
+}
+
+func TestArchiveSKU(t *testing.T) {
+	repoMock := repositoryMock.NewISKURepoMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	repoMock.GetSKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.SKU, error) {
+		switch skuID {
+		case 1001:
+			return models.SKU{ID: 1001}, nil
+		case 2020:
+			return models.SKU{ID: 2020, Archived: true}, nil
+		case 3033:
+			return models.SKU{}, errSql
+		}
+
+		return models.SKU{}, repository.ErrNotFound
+	})
+
+	repoMock.ArchiveSKUMock.Return(nil)
+	kafkaMock.ProduceMock.Return(nil)
+	logger.InfoMock.Return()
+
+	usecase := NewSKUUsecase(repoMock, repositoryMock.NewICategoryRepoMock(t), kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    models.SKUID
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    1001,
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorArchived",
+			body:    2020,
+			wantErr: ErrSKUArchived,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    3033,
+			wantErr: errSql,
+		},
+		{
+			name:    "NotFound",
+			body:    4044,
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := usecase.ArchiveSKU(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestListSKUs(t *testing.T) {
+	repoMock := repositoryMock.NewISKURepoMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	repoMock.ListSKUsMock.Set(func(ctx context.Context, param repository.ListSKUsParam) ([]models.SKU, error) {
+		if param.CategoryID == 3 {
+			return nil, errSql
+		}
+
+		if param.Limit != 2 || param.Offset != 2 {
+			return nil, nil
+		}
+
+		return []models.SKU{{ID: 1003, Name: "scarf"}, {ID: 1004, Name: "hat"}}, nil
+	})
+	repoMock.CountSKUsMock.Set(func(ctx context.Context, categoryID models.CategoryID, includeArchived bool) (int64, error) {
+		if categoryID == 2 {
+			return 0, errSql
+		}
+
+		if includeArchived {
+			return 7, nil
+		}
+
+		return 5, nil
+	})
+
+	usecase := NewSKUUsecase(repoMock, repositoryMock.NewICategoryRepoMock(t), kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    ListSKUsDTO
+		want    SKUsDTO
+		wantErr error
+	}{
+		{
+			name: testSuccesName,
+			body: ListSKUsDTO{CategoryID: 1, PageSize: 2, CurrentPage: 2},
+			want: SKUsDTO{
+				SKUs:       []SKUDTO{{SKUID: 1003, Name: "scarf"}, {SKUID: 1004, Name: "hat"}},
+				TotalCount: 5,
+				PageNumber: 2,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "SuccessArchived",
+			body:    ListSKUsDTO{CategoryID: 1, IncludeArchived: true, PageSize: 10, CurrentPage: 3},
+			want:    SKUsDTO{SKUs: []SKUDTO{}, TotalCount: 7, PageNumber: 3},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorPage",
+			body:    ListSKUsDTO{PageSize: 0, CurrentPage: 1},
+			want:    SKUsDTO{},
+			wantErr: ErrInvalidPage,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    ListSKUsDTO{CategoryID: 3, PageSize: 2, CurrentPage: 1},
+			want:    SKUsDTO{},
+			wantErr: errSql,
+		},
+		{
+			name:    "ErrorCount",
+			body:    ListSKUsDTO{CategoryID: 2, PageSize: 2, CurrentPage: 1},
+			want:    SKUsDTO{},
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := usecase.ListSKUs(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(list, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, list)
+			}
+		})
+	}
+}
+
+func TestCreateCategory(t *testing.T) {
+	categoryMock := repositoryMock.NewICategoryRepoMock(t)
+
+	t.Cleanup(func() {
+		categoryMock.MinimockFinish()
+	})
+
+	categoryMock.CreateCategoryMock.Set(func(ctx context.Context, category models.Category) (models.CategoryID, error) {
+		switch {
+		case category.Name == "apparel":
+			return 0, repository.ErrDuplicate
+		case category.ParentID == 5:
+			return 0, repository.ErrNotFound
+		}
+
+		return 10, nil
+	})
+
+	usecase := NewSKUUsecase(repositoryMock.NewISKURepoMock(t), categoryMock, mock.NewIProducerMock(t), logMock.NewLoggerMock(t))
+
+	schema := models.AttributeSchema{"length": models.AttributeNumber}
+
+	tests := []struct {
+		name    string
+		body    CreateCategoryDTO
+		want    CategoryDTO
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    CreateCategoryDTO{ParentID: 1, Name: " scarves ", Schema: schema},
+			want:    CategoryDTO{ID: 10, ParentID: 1, Name: "scarves", Schema: schema},
+			wantErr: nil,
+		},
+		{
+			name:    "ErrorNameTaken",
+			body:    CreateCategoryDTO{Name: "apparel"},
+			want:    CategoryDTO{},
+			wantErr: ErrCategoryNameTaken,
+		},
+		{
+			name:    "ErrorParent",
+			body:    CreateCategoryDTO{ParentID: 5, Name: "scarves"},
+			want:    CategoryDTO{},
+			wantErr: ErrCategoryNotFound,
+		},
+		{
+			name:    "ErrorAttributeType",
+			body:    CreateCategoryDTO{Name: "scarves", Schema: models.AttributeSchema{"length": "int"}},
+			want:    CategoryDTO{},
+			wantErr: ErrInvalidAttributeType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, err := usecase.CreateCategory(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(category, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, category)
+			}
+		})
+	}
+}
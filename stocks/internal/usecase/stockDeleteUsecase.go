@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	eventStockDeleteType  = "stock_deleted"
+	eventStockRestoreType = "stock_restored"
+
+	restoreSpanName = "stock-restore-usecase"
+	purgeSpanName   = "stock-purge-usecase"
+)
+
+var ErrRestoreConflict error = newError(KindAlreadyExists, "RESTORE_CONFLICT", "the seller has stocked a location of the deleted stock again")
+
+// DeleteStockBySKU deletes all stock of the SKU of the seller. The stock is kept until it is purged,
+// so the delete can be undone with RestoreStockBySKU. A deleted stock that was not out yet is reported
+// out of stock as well.
+func (u *StockUsecase) DeleteStockBySKU(ctx context.Context, delStock DeleteStockDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, delSpanName)
+	defer span.End()
+
+	deleted, err := u.stockRepo.DeleteStock(ctx, delStock.SKUID, delStock.UserID, time.Now())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return err
+	}
+
+	for _, stock := range deleted {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(stockEvent(eventStockDeleteType, stock), topic, time.Now())))
+
+		// the delete is committed, so the out event needs no threshold and can not fail it
+		if stock.Count > 0 {
+			out := stock
+			out.Count = 0
+
+			u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(stockEvent(eventStockOutType, out), topic, time.Now())))
+		}
+	}
+
+	return nil
+}
+
+// RestoreStockBySKU restores the stock of the SKU of the seller removed by its last delete.
+func (u *StockUsecase) RestoreStockBySKU(ctx context.Context, restore RestoreStockDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, restoreSpanName)
+	defer span.End()
+
+	restored, err := u.stockRepo.RestoreStock(ctx, restore.SKUID, restore.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return ErrNotFound
+		case errors.Is(err, repository.ErrDuplicate):
+			return ErrRestoreConflict
+		default:
+			return err
+		}
+	}
+
+	for _, stock := range restored {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(stockEvent(eventStockRestoreType, stock), topic, time.Now())))
+	}
+
+	return nil
+}
+
+// PurgeDeletedStock removes the stock deleted longer than the retention ago for good.
+func (u *StockUsecase) PurgeDeletedStock(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, purgeSpanName)
+	defer span.End()
+
+	return u.stockRepo.PurgeDeletedStock(ctx, time.Now().Add(-retention))
+}
+
+func stockEvent(eventType string, stock models.Stock) producer.ProducerMessageDTO {
+	return producer.ProducerMessageDTO{
+		Type:      eventType,
+		Service:   eventService,
+		Timestamp: time.Now(),
+		SKU:       stock.SKUID,
+		Count:     stock.Count,
+		Price:     stock.Price,
+		Location:  stock.Location,
+	}
+}
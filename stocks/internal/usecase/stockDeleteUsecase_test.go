@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+	"time"
+)
+
+func TestDeleteStockBySKU(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	repoMock.DeleteStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID,
+		deletedAt time.Time) ([]models.Stock, error) {
+		if userID > 1 {
+			return nil, repository.ErrNotFound
+		}
+
+		return []models.Stock{
+			{ID: 1, SKUID: skuID, UserID: userID, Count: 5, Location: "AG"},
+			{ID: 2, SKUID: skuID, UserID: userID, Count: 3, Location: "BG"},
+			{ID: 3, SKUID: skuID, UserID: userID, Count: 0, Location: "CG"},
+		}, nil
+	})
+
+	var produced []producer.ProducerMessageDTO
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		produced = append(produced, messsageDTO)
+
+		return nil
+	})
+
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name      string
+		body      DeleteStockDTO
+		wantErr   error
+		wantTypes []string
+	}{
+		{
+			name: testSuccesName,
+			body: DeleteStockDTO{
+				UserID: 1,
+				SKUID:  1001,
+			},
+			wantErr: nil,
+			wantTypes: []string{
+				eventStockDeleteType, eventStockOutType,
+				eventStockDeleteType, eventStockOutType,
+				eventStockDeleteType,
+			},
+		},
+		{
+			name: testSqlErrorName,
+			body: DeleteStockDTO{
+				UserID: 2,
+				SKUID:  1001,
+			},
+			wantErr:   ErrNotFound,
+			wantTypes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			produced = nil
+
+			err := usecase.DeleteStockBySKU(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if len(produced) != len(tt.wantTypes) {
+				t.Fatalf("wanted %d events, produced: %d", len(tt.wantTypes), len(produced))
+			}
+
+			for i, msg := range produced {
+				if msg.Type != tt.wantTypes[i] {
+					t.Errorf("wanted: %v, produced: %v", tt.wantTypes[i], msg.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreStockBySKU(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	repoMock.RestoreStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID) ([]models.Stock, error) {
+		switch userID {
+		case 1:
+			return []models.Stock{{ID: 1, SKUID: skuID, UserID: userID, Count: 5, Location: "AG"}}, nil
+		case 2:
+			return nil, repository.ErrDuplicate
+		case 3:
+			return nil, errSql
+		default:
+			return nil, repository.ErrNotFound
+		}
+	})
+
+	var produced []producer.ProducerMessageDTO
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		produced = append(produced, messsageDTO)
+
+		return nil
+	})
+
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name         string
+		body         RestoreStockDTO
+		wantErr      error
+		wantProduced int
+	}{
+		{
+			name:         testSuccesName,
+			body:         RestoreStockDTO{UserID: 1, SKUID: 1001},
+			wantErr:      nil,
+			wantProduced: 1,
+		},
+		{
+			name:    "ErrorLocationStockedAgain",
+			body:    RestoreStockDTO{UserID: 2, SKUID: 1001},
+			wantErr: ErrRestoreConflict,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    RestoreStockDTO{UserID: 3, SKUID: 1001},
+			wantErr: errSql,
+		},
+		{
+			name:    "ErrorNotDeleted",
+			body:    RestoreStockDTO{UserID: 4, SKUID: 1001},
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			produced = nil
+
+			err := usecase.RestoreStockBySKU(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if len(produced) != tt.wantProduced {
+				t.Errorf("wanted %d events, produced: %d", tt.wantProduced, len(produced))
+			}
+
+			if tt.wantProduced > 0 && produced[0].Type != eventStockRestoreType {
+				t.Errorf("wanted: %v, produced: %v", eventStockRestoreType, produced[0].Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	"strings"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	importSpanName = "stock-import-usecase"
+	exportSpanName = "stock-export-usecase"
+
+	exportPageSize = 500
+)
+
+var (
+	ErrImportLocation error = newFieldError("location", "INVALID_LOCATION", "location must not be empty")
+	ErrImportUserID   error = newFieldError("user_id", "INVALID_USER_ID", "user id must be positive")
+	ErrImportCount    error = newFieldError("count", "INVALID_COUNT", "count is out of range")
+
+	ErrImportDryRunChanged error = newFieldError("dry_run", "INVALID_DRY_RUN", "dry_run must be the same in every message of the import")
+)
+
+// ImportStock upserts the rows in one transaction: the count and price of the stock at the location
+// of a row are replaced, a missing stock is created. Invalid rows are reported and skipped, the other rows are still applied.
+// In dry run mode the rows are only validated.
+func (u *StockUsecase) ImportStock(ctx context.Context, param ImportStockDTO) (ImportStockResultDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, importSpanName)
+	defer span.End()
+
+	var result ImportStockResultDTO
+	var messages []producer.ProducerMessageDTO
+
+	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
+		result, messages = ImportStockResultDTO{}, nil
+
+		for _, row := range param.Rows {
+			rowMessages, err := importRow(ctx, repo, row, param.DryRun)
+			if err != nil {
+				if !isImportRowError(err) {
+					return err
+				}
+
+				result.Errors = append(result.Errors, ImportRowErrorDTO{Line: row.Line, SKUID: row.SKUID, Err: err})
+
+				continue
+			}
+
+			result.Imported++
+
+			messages = append(messages, rowMessages...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ImportStockResultDTO{}, err
+	}
+
+	for _, messageDTO := range messages {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+	}
+
+	return result, nil
+}
+
+// ExportStock passes all stock ordered by the stock ID to send, reading it page by page.
+func (u *StockUsecase) ExportStock(ctx context.Context, send func(StockDTO) error) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, exportSpanName)
+	defer span.End()
+
+	var afterID models.StockID
+
+	for {
+		items, err := u.stockRepo.ExportItems(ctx, afterID, exportPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			stockDTO := stockToDTO(item)
+
+			if err = send(stockDTO); err != nil {
+				return err
+			}
+		}
+
+		if len(items) < exportPageSize {
+			return nil
+		}
+
+		afterID = items[len(items)-1].Stock.ID
+	}
+}
+
+// importRow upserts the row and returns the events of the change, none in dry run mode.
+func importRow(ctx context.Context, repo repository.IStockRepo, row ImportStockRowDTO, dryRun bool) ([]producer.ProducerMessageDTO, error) {
+	count, err := models.Uint32ToUint16(row.Count)
+	if err != nil {
+		return nil, ErrImportCount
+	}
+
+	if strings.TrimSpace(row.Location) == "" {
+		return nil, ErrImportLocation
+	}
+
+	if row.UserID < 1 {
+		return nil, ErrImportUserID
+	}
+
+	item, err := repo.GetItemBySKU(ctx, row.SKUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if item.SKU.Archived {
+		return nil, ErrSKUArchived
+	}
+
+	current, found, err := getLocationStock(ctx, repo, row.SKUID, row.UserID, row.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	newItem := models.Stock{
+		ID:       current.ID,
+		Count:    count,
+		Price:    row.Price,
+		Location: row.Location,
+		UserID:   row.UserID,
+		SKUID:    row.SKUID,
+	}
+
+	messageDTO := producer.ProducerMessageDTO{
+		Type:      eventStockChangeType,
+		Service:   eventService,
+		Timestamp: time.Now(),
+		SKU:       newItem.SKUID,
+		Count:     newItem.Count,
+		Price:     newItem.Price,
+	}
+
+	if item.Stock.UserID == 0 {
+		messageDTO.Type = eventSKUCreateType
+	}
+
+	if dryRun {
+		return nil, nil
+	}
+
+	if !found {
+		if err = repo.EnsureSeller(ctx, row.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = putLocationStock(ctx, repo, newItem, found); err != nil {
+		return nil, err
+	}
+
+	messages := []producer.ProducerMessageDTO{messageDTO}
+
+	priceDTO, ok, err := recordPrice(ctx, repo, newItem, current, found, models.PriceSourceImport)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		messages = append(messages, priceDTO)
+	}
+
+	levelDTO, ok, err := levelEvent(ctx, repo, newItem, current.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		messages = append(messages, levelDTO)
+	}
+
+	return messages, nil
+}
+
+// isImportRowError reports whether the error rejects a single row rather than the whole import.
+func isImportRowError(err error) bool {
+	return errors.Is(err, ErrImportCount) || errors.Is(err, ErrImportLocation) || errors.Is(err, ErrImportUserID) ||
+		errors.Is(err, ErrNotFound) || errors.Is(err, ErrSKUArchived)
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+	"time"
+)
+
+func TestImportStock(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	repoMock.GetItemBySKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.Item, error) {
+		switch skuID {
+		case 1001:
+			return models.Item{SKU: models.SKU{ID: 1001}, Stock: models.Stock{ID: 1, SKUID: 1001, Count: 5, UserID: 1}}, nil
+		case 2020:
+			return models.Item{SKU: models.SKU{ID: 2020}}, nil
+		case 3033:
+			return models.Item{SKU: models.SKU{ID: 3033, Archived: true}}, nil
+		case 4044:
+			// a failed query, e.g. of a lost connection, returns no item like a missing SKU
+			return models.Item{}, errSql
+		}
+
+		return models.Item{}, repository.ErrNotFound
+	})
+
+	repoMock.GetLocationStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (models.Stock, error) {
+		if skuID == 1001 && userID == 1 && location == "AG" {
+			return models.Stock{ID: 1, SKUID: 1001, Count: 5, UserID: 1, Location: "AG"}, nil
+		}
+
+		return models.Stock{}, repository.ErrNotFound
+	})
+
+	repoMock.UpdateStockMock.Return(nil)
+	repoMock.AddStockMock.Return(nil)
+	repoMock.AddPriceHistoryMock.Return(nil)
+	repoMock.EnsureSellerMock.Return(nil)
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	var produced int
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		produced++
+
+		return nil
+	})
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	rows := []ImportStockRowDTO{
+		{Line: 2, SKUID: 1001, UserID: 1, Count: 10, Price: 100, Location: "AG"},
+		{Line: 3, SKUID: 2020, UserID: 1, Count: 1, Price: 10, Location: "AG"},
+		{Line: 4, SKUID: 1001, UserID: 2, Count: 1, Price: 10, Location: "AG"},
+		{Line: 5, SKUID: 3033, UserID: 1, Count: 1, Price: 10, Location: "AG"},
+		{Line: 6, SKUID: 5055, UserID: 1, Count: 1, Price: 10, Location: "AG"},
+		{Line: 7, SKUID: 2020, UserID: 1, Count: 70000, Price: 10, Location: "AG"},
+		{Line: 8, SKUID: 2020, UserID: 1, Count: 1, Price: 10, Location: " "},
+	}
+
+	rowErrors := []ImportRowErrorDTO{
+		{Line: 5, SKUID: 3033, Err: ErrSKUArchived},
+		{Line: 6, SKUID: 5055, Err: ErrNotFound},
+		{Line: 7, SKUID: 2020, Err: ErrImportCount},
+		{Line: 8, SKUID: 2020, Err: ErrImportLocation},
+	}
+
+	tests := []struct {
+		name         string
+		body         ImportStockDTO
+		want         ImportStockResultDTO
+		wantProduced int
+		wantErr      error
+	}{
+		{
+			name:         testSuccesName,
+			body:         ImportStockDTO{Rows: rows},
+			want:         ImportStockResultDTO{Imported: 3, Errors: rowErrors},
+			wantProduced: 6,
+			wantErr:      nil,
+		},
+		{
+			name:         "DryRun",
+			body:         ImportStockDTO{Rows: rows, DryRun: true},
+			want:         ImportStockResultDTO{Imported: 3, Errors: rowErrors},
+			wantProduced: 0,
+			wantErr:      nil,
+		},
+		{
+			name: "MaxCount",
+			body: ImportStockDTO{Rows: []ImportStockRowDTO{
+				{Line: 2, SKUID: 1001, UserID: 1, Count: 65535, Location: "AG"},
+				{Line: 3, SKUID: 1001, UserID: 1, Count: 65536, Location: "AG"},
+			}},
+			want:         ImportStockResultDTO{Imported: 1, Errors: []ImportRowErrorDTO{{Line: 3, SKUID: 1001, Err: ErrImportCount}}},
+			wantProduced: 1,
+			wantErr:      nil,
+		},
+		{
+			name:         testSqlErrorName,
+			body:         ImportStockDTO{Rows: append(rows[:1:1], ImportStockRowDTO{Line: 9, SKUID: 4044, UserID: 1, Count: 1, Location: "AG"})},
+			want:         ImportStockResultDTO{},
+			wantProduced: 0,
+			wantErr:      errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			produced = 0
+
+			result, err := usecase.ImportStock(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, result)
+			}
+
+			if produced != tt.wantProduced {
+				t.Errorf("wanted events: %d, respond: %d", tt.wantProduced, produced)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	"stocks/internal/repository"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	listOffersSpanName     = "stock-offers-list-usecase"
+	getOfferSpanName       = "stock-offer-get-usecase"
+	registerSellerSpanName = "stock-seller-register-usecase"
+)
+
+var ErrSellerName error = newFieldError("name", "INVALID_SELLER_NAME", "seller name must not be empty")
+
+// RegisterSeller creates the seller of the user or renames it. A seller is also created
+// without a name by its first stock.
+func (u *StockUsecase) RegisterSeller(ctx context.Context, seller models.Seller) (models.Seller, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, registerSellerSpanName)
+	defer span.End()
+
+	seller.Name = strings.TrimSpace(seller.Name)
+	if seller.Name == "" {
+		return models.Seller{}, ErrSellerName
+	}
+
+	if err := u.stockRepo.UpsertSeller(ctx, seller); err != nil {
+		return models.Seller{}, err
+	}
+
+	return seller, nil
+}
+
+// ListOffers returns the offers of all sellers of the SKU, the cheapest first.
+func (u *StockUsecase) ListOffers(ctx context.Context, param ListOffersDTO) ([]OfferDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, listOffersSpanName)
+	defer span.End()
+
+	_, err := u.stockRepo.GetItemBySKU(ctx, param.SKUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	offers, err := u.stockRepo.ListOffers(ctx, param.SKUID, param.InStockOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]OfferDTO, len(offers))
+
+	for i, offer := range offers {
+		list[i] = OfferDTO{
+			OfferID:  offer.Stock.ID,
+			SKUID:    offer.Stock.SKUID,
+			Seller:   offer.Seller,
+			Price:    offer.Stock.Price,
+			Count:    offer.Stock.Count,
+			Location: offer.Stock.Location,
+		}
+	}
+
+	return list, nil
+}
+
+// GetOffer returns the SKU with the stock of one of its offers.
+func (u *StockUsecase) GetOffer(ctx context.Context, skuID models.SKUID, offerID models.StockID) (StockDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, getOfferSpanName)
+	defer span.End()
+
+	item, err := u.stockRepo.GetOffer(ctx, skuID, offerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return StockDTO{}, ErrNotFound
+		}
+
+		return StockDTO{}, err
+	}
+
+	return stockToDTO(item), nil
+}
+
+func stockToDTO(item models.Item) StockDTO {
+	return StockDTO{
+		OfferID:  item.Stock.ID,
+		SKU:      skuToDTO(item.SKU),
+		Price:    item.Stock.Price,
+		Count:    item.Stock.Count,
+		Location: item.Stock.Location,
+		UserID:   item.Stock.UserID,
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+)
+
+func TestListOffers(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	repoMock.GetItemBySKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.Item, error) {
+		switch skuID {
+		case 1001, 3033:
+			return models.Item{SKU: models.SKU{ID: skuID}}, nil
+		}
+
+		return models.Item{}, repository.ErrNotFound
+	})
+
+	repoMock.ListOffersMock.Set(func(ctx context.Context, skuID models.SKUID, inStockOnly bool) ([]models.Offer, error) {
+		if skuID == 3033 {
+			return nil, errSql
+		}
+
+		return []models.Offer{
+			{Stock: models.Stock{ID: 2, SKUID: 1001, Count: 5, Price: 90, Location: "BG", UserID: 2}, Seller: models.Seller{ID: 2, Name: "shop"}},
+			{Stock: models.Stock{ID: 1, SKUID: 1001, Count: 10, Price: 100, Location: "AG", UserID: 1}, Seller: models.Seller{ID: 1}},
+		}, nil
+	})
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		skuID   models.SKUID
+		want    []OfferDTO
+		wantErr error
+	}{
+		{
+			name:  testSuccesName,
+			skuID: 1001,
+			want: []OfferDTO{
+				{OfferID: 2, SKUID: 1001, Seller: models.Seller{ID: 2, Name: "shop"}, Price: 90, Count: 5, Location: "BG"},
+				{OfferID: 1, SKUID: 1001, Seller: models.Seller{ID: 1}, Price: 100, Count: 10, Location: "AG"},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "NotFound",
+			skuID:   2020,
+			want:    nil,
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    testSqlErrorName,
+			skuID:   3033,
+			want:    nil,
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offers, err := usecase.ListOffers(context.Background(), ListOffersDTO{SKUID: tt.skuID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListOffers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(offers, tt.want) {
+				t.Errorf("ListOffers() = %v, want %v", offers, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"stocks/internal/models"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	eventPriceChangeType = "price_changed"
+
+	schedulePriceSpanName = "stock-price-schedule-usecase"
+	priceHistorySpanName  = "stock-price-history-usecase"
+	applyPricesSpanName   = "stock-price-apply-usecase"
+
+	applyPricesBatchSize = 100
+)
+
+var ErrInvalidEffectiveAt error = newFieldError("effective_at", "INVALID_EFFECTIVE_AT", "effective time must be set")
+
+// SchedulePriceChange stores a price for all stock of the SKU of the seller that is applied at the effective time.
+// A change with an effective time in the past is applied by the next run of the applier.
+func (u *StockUsecase) SchedulePriceChange(ctx context.Context, param SchedulePriceDTO) (models.ScheduledPrice, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, schedulePriceSpanName)
+	defer span.End()
+
+	if param.EffectiveAt.IsZero() {
+		return models.ScheduledPrice{}, ErrInvalidEffectiveAt
+	}
+
+	item, err := u.stockRepo.GetItemBySKU(ctx, param.SKUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.ScheduledPrice{}, ErrNotFound
+		}
+
+		return models.ScheduledPrice{}, err
+	}
+
+	if item.SKU.Archived {
+		return models.ScheduledPrice{}, ErrSKUArchived
+	}
+
+	// A seller without an offer of the SKU has no price to change.
+	offers, err := u.stockRepo.ListOffers(ctx, param.SKUID, false)
+	if err != nil {
+		return models.ScheduledPrice{}, err
+	}
+
+	if !slices.ContainsFunc(offers, func(offer models.Offer) bool { return offer.Seller.ID == param.UserID }) {
+		return models.ScheduledPrice{}, ErrNotFound
+	}
+
+	price := models.ScheduledPrice{
+		SKUID:       param.SKUID,
+		UserID:      param.UserID,
+		Price:       param.Price,
+		EffectiveAt: param.EffectiveAt,
+	}
+
+	price.ID, err = u.stockRepo.SchedulePrice(ctx, price)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.ScheduledPrice{}, ErrNotFound
+		}
+
+		return models.ScheduledPrice{}, err
+	}
+
+	return price, nil
+}
+
+// GetPriceHistory returns a page of the price changes of the SKU, the latest first,
+// and all its scheduled changes that are not applied yet.
+func (u *StockUsecase) GetPriceHistory(ctx context.Context, param GetPriceHistoryDTO) (PriceHistoryDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, priceHistorySpanName)
+	defer span.End()
+
+	if !validPage(param.PageSize, param.CurrentPage) {
+		return PriceHistoryDTO{}, ErrInvalidPage
+	}
+
+	_, err := u.stockRepo.GetItemBySKU(ctx, param.SKUID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return PriceHistoryDTO{}, ErrNotFound
+		}
+
+		return PriceHistoryDTO{}, err
+	}
+
+	changes, err := u.stockRepo.GetPriceHistory(ctx, repository.GetPriceHistoryParam{
+		SKUID:    param.SKUID,
+		Location: param.Location,
+		Limit:    param.PageSize,
+		Offset:   param.PageSize * (param.CurrentPage - 1),
+	})
+	if err != nil {
+		return PriceHistoryDTO{}, err
+	}
+
+	scheduled, err := u.stockRepo.GetPendingPrices(ctx, param.SKUID)
+	if err != nil {
+		return PriceHistoryDTO{}, err
+	}
+
+	return PriceHistoryDTO{Changes: changes, Scheduled: scheduled, PageNumber: param.CurrentPage}, nil
+}
+
+// ApplyDuePriceChanges applies the scheduled price changes that are due in one transaction
+// and returns their number. Changes of the same SKU are applied in the order of their effective time.
+func (u *StockUsecase) ApplyDuePriceChanges(ctx context.Context) (int, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, applyPricesSpanName)
+	defer span.End()
+
+	var applied int
+	var messages []producer.ProducerMessageDTO
+
+	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
+		applied, messages = 0, nil
+
+		now := time.Now()
+
+		due, err := repo.GetDuePrices(ctx, now, applyPricesBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, price := range due {
+			changes, err := repo.SetSKUPrice(ctx, price.SKUID, price.UserID, price.Price)
+			if err != nil {
+				return err
+			}
+
+			for _, change := range changes {
+				change.Source = models.PriceSourceSchedule
+				change.ChangedAt = now
+
+				if err = repo.AddPriceHistory(ctx, change); err != nil {
+					return err
+				}
+
+				messages = append(messages, priceEvent(change))
+			}
+
+			if err = repo.MarkPriceApplied(ctx, price.ID, now); err != nil {
+				return err
+			}
+
+			applied++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, messageDTO := range messages {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+	}
+
+	return applied, nil
+}
+
+// recordPrice stores the change of the price of the stock and returns its price_changed event.
+// It reports false if the price of an existing stock is not changed.
+func recordPrice(ctx context.Context, repo repository.IStockRepo, stock models.Stock, previous models.Stock,
+	found bool, source models.PriceSource) (producer.ProducerMessageDTO, bool, error) {
+	if found && stock.Price == previous.Price {
+		return producer.ProducerMessageDTO{}, false, nil
+	}
+
+	change := models.PriceChange{
+		SKUID:     stock.SKUID,
+		UserID:    stock.UserID,
+		Location:  stock.Location,
+		OldPrice:  previous.Price,
+		Price:     stock.Price,
+		Source:    source,
+		ChangedAt: time.Now(),
+	}
+
+	if err := repo.AddPriceHistory(ctx, change); err != nil {
+		return producer.ProducerMessageDTO{}, false, err
+	}
+
+	return priceEvent(change), true, nil
+}
+
+func priceEvent(change models.PriceChange) producer.ProducerMessageDTO {
+	return producer.ProducerMessageDTO{
+		Type:      eventPriceChangeType,
+		Service:   eventService,
+		Timestamp: change.ChangedAt,
+		SKU:       change.SKUID,
+		Price:     change.Price,
+		OldPrice:  change.OldPrice,
+		Location:  change.Location,
+	}
+}
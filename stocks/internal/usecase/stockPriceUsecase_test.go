@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+	"time"
+)
+
+func TestSchedulePriceChange(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	repoMock.GetItemBySKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.Item, error) {
+		switch skuID {
+		case 1001:
+			return models.Item{SKU: models.SKU{ID: 1001}, Stock: models.Stock{ID: 1, SKUID: 1001, UserID: 1}}, nil
+		case 2020:
+			return models.Item{SKU: models.SKU{ID: 2020}}, nil
+		case 3033:
+			return models.Item{SKU: models.SKU{ID: 3033, Archived: true}, Stock: models.Stock{ID: 3, UserID: 1}}, nil
+		case 4044:
+			return models.Item{SKU: models.SKU{ID: 4044}, Stock: models.Stock{ID: 4, UserID: 1}}, nil
+		}
+
+		return models.Item{}, repository.ErrNotFound
+	})
+
+	repoMock.ListOffersMock.Set(func(ctx context.Context, skuID models.SKUID, inStockOnly bool) ([]models.Offer, error) {
+		if skuID == 2020 {
+			return nil, nil
+		}
+
+		return []models.Offer{{Stock: models.Stock{ID: 1, SKUID: skuID, UserID: 1}, Seller: models.Seller{ID: 1}}}, nil
+	})
+
+	repoMock.SchedulePriceMock.Set(func(ctx context.Context, price models.ScheduledPrice) (int64, error) {
+		if price.SKUID == 4044 {
+			return 0, errSql
+		}
+
+		return 7, nil
+	})
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	effectiveAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		body    SchedulePriceDTO
+		want    models.ScheduledPrice
+		wantErr error
+	}{
+		{
+			name:    testSuccesName,
+			body:    SchedulePriceDTO{SKUID: 1001, UserID: 1, Price: 150, EffectiveAt: effectiveAt},
+			want:    models.ScheduledPrice{ID: 7, SKUID: 1001, UserID: 1, Price: 150, EffectiveAt: effectiveAt},
+			wantErr: nil,
+		},
+		{
+			name:    "NoEffectiveAt",
+			body:    SchedulePriceDTO{SKUID: 1001, UserID: 1, Price: 150},
+			want:    models.ScheduledPrice{},
+			wantErr: ErrInvalidEffectiveAt,
+		},
+		{
+			name:    "NotStocked",
+			body:    SchedulePriceDTO{SKUID: 2020, UserID: 1, Price: 150, EffectiveAt: effectiveAt},
+			want:    models.ScheduledPrice{},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "OtherSeller",
+			body:    SchedulePriceDTO{SKUID: 1001, UserID: 2, Price: 150, EffectiveAt: effectiveAt},
+			want:    models.ScheduledPrice{},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    "ErrorArchived",
+			body:    SchedulePriceDTO{SKUID: 3033, UserID: 1, Price: 150, EffectiveAt: effectiveAt},
+			want:    models.ScheduledPrice{},
+			wantErr: ErrSKUArchived,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    SchedulePriceDTO{SKUID: 4044, UserID: 1, Price: 150, EffectiveAt: effectiveAt},
+			want:    models.ScheduledPrice{},
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := usecase.SchedulePriceChange(context.Background(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SchedulePriceChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(price, tt.want) {
+				t.Errorf("SchedulePriceChange() = %v, want %v", price, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyDuePriceChanges(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	repoMock.GetDuePricesMock.Return([]models.ScheduledPrice{
+		{ID: 1, SKUID: 1001, Price: 150},
+		{ID: 2, SKUID: 2020, Price: 20},
+	}, nil)
+
+	repoMock.SetSKUPriceMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID, price uint32) ([]models.PriceChange, error) {
+		if skuID == 2020 {
+			return nil, nil
+		}
+
+		return []models.PriceChange{
+			{SKUID: skuID, Location: "AG", OldPrice: 100, Price: price},
+			{SKUID: skuID, Location: "BG", OldPrice: 120, Price: price},
+		}, nil
+	})
+
+	var history []models.PriceChange
+
+	repoMock.AddPriceHistoryMock.Set(func(ctx context.Context, change models.PriceChange) error {
+		history = append(history, change)
+
+		return nil
+	})
+
+	var applied []int64
+
+	repoMock.MarkPriceAppliedMock.Set(func(ctx context.Context, id int64, appliedAt time.Time) error {
+		applied = append(applied, id)
+
+		return nil
+	})
+
+	var events []producer.ProducerMessageDTO
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		events = append(events, messsageDTO)
+
+		return nil
+	})
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	count, err := usecase.ApplyDuePriceChanges(context.Background())
+	if err != nil {
+		t.Fatalf("ApplyDuePriceChanges() error = %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("ApplyDuePriceChanges() = %d, want 2", count)
+	}
+
+	if !reflect.DeepEqual(applied, []int64{1, 2}) {
+		t.Errorf("applied = %v, want [1 2]", applied)
+	}
+
+	if len(history) != 2 || history[0].Source != models.PriceSourceSchedule || history[1].OldPrice != 120 {
+		t.Errorf("history = %v", history)
+	}
+
+	if len(events) != 2 || events[0].Type != eventPriceChangeType || events[0].OldPrice != 100 || events[0].Price != 150 {
+		t.Errorf("events = %v", events)
+	}
+}
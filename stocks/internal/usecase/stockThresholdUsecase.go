@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	"strings"
+	"time"
+
+	myLog "stocks/internal/observability/log"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	eventStockLowType = "stock_low"
+	eventStockOutType = "stock_out"
+
+	thresholdSpanName = "stock-threshold-usecase"
+	lowStockSpanName  = "stock-low-list-usecase"
+)
+
+// SetThreshold sets the reorder threshold of the SKU at the location, or for all its locations
+// if the location is empty. A zero threshold removes it. The stock_low event is sent for every stock
+// the new threshold puts at or below its threshold, like a count falling to it.
+func (u *StockUsecase) SetThreshold(ctx context.Context, threshold SetThresholdDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, thresholdSpanName)
+	defer span.End()
+
+	location := strings.TrimSpace(threshold.Location)
+
+	var messages []producer.ProducerMessageDTO
+
+	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
+		messages = nil
+
+		offers, err := repo.ListOffers(ctx, threshold.SKUID, true)
+		if err != nil {
+			return err
+		}
+
+		var stocks []models.Stock
+
+		for _, offer := range offers {
+			if location == "" || offer.Stock.Location == location {
+				stocks = append(stocks, offer.Stock)
+			}
+		}
+
+		previous, err := locationThresholds(ctx, repo, threshold.SKUID, stocks)
+		if err != nil {
+			return err
+		}
+
+		if threshold.Threshold == 0 {
+			err = repo.DeleteThreshold(ctx, threshold.SKUID, location)
+		} else {
+			err = repo.SetThreshold(ctx, models.StockThreshold{
+				SKUID:     threshold.SKUID,
+				Location:  location,
+				Threshold: threshold.Threshold,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		current, err := locationThresholds(ctx, repo, threshold.SKUID, stocks)
+		if err != nil {
+			return err
+		}
+
+		for _, stock := range stocks {
+			if stock.Count > current[stock.Location] || stock.Count <= previous[stock.Location] {
+				continue
+			}
+
+			messageDTO := stockEvent(eventStockLowType, stock)
+			messageDTO.Threshold = current[stock.Location]
+
+			messages = append(messages, messageDTO)
+		}
+
+		return nil
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, messageDTO := range messages {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+	}
+
+	return nil
+}
+
+// locationThresholds returns the threshold of every location of the stocks, 0 for a location without one.
+func locationThresholds(ctx context.Context, repo repository.IStockRepo, skuID models.SKUID,
+	stocks []models.Stock) (map[string]uint16, error) {
+	thresholds := make(map[string]uint16)
+
+	for _, stock := range stocks {
+		if _, ok := thresholds[stock.Location]; ok {
+			continue
+		}
+
+		threshold, err := repo.GetThreshold(ctx, skuID, stock.Location)
+		if err != nil {
+			return nil, err
+		}
+
+		thresholds[stock.Location] = threshold
+	}
+
+	return thresholds, nil
+}
+
+// ListLowStock returns the stock at or below its reorder threshold, the lowest count first.
+func (u *StockUsecase) ListLowStock(ctx context.Context, param ListLowStockDTO) (LowStocksDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, lowStockSpanName)
+	defer span.End()
+
+	if !validPage(param.PageSize, param.CurrentPage) {
+		return LowStocksDTO{}, ErrInvalidPage
+	}
+
+	items, err := u.stockRepo.ListLowStock(ctx, repository.ListLowStockParam{
+		UserID:   param.UserID,
+		Location: param.Location,
+		Limit:    param.PageSize,
+		Offset:   param.PageSize * (param.CurrentPage - 1),
+	})
+	if err != nil {
+		return LowStocksDTO{}, err
+	}
+
+	list := LowStocksDTO{
+		Items:      make([]LowStockDTO, len(items)),
+		PageNumber: param.CurrentPage,
+	}
+
+	for i, item := range items {
+		list.Items[i] = LowStockDTO{
+			Stock:     stockToDTO(item.Item),
+			Threshold: item.Threshold,
+		}
+	}
+
+	return list, nil
+}
+
+// levelEvent returns the stock_out event if the count of the stock fell to zero, or the stock_low event
+// if it fell to its threshold. Only falling across the level is reported, not every change below it.
+func levelEvent(ctx context.Context, repo repository.IStockRepo, stock models.Stock, previous uint16) (producer.ProducerMessageDTO, bool, error) {
+	if stock.Count >= previous {
+		return producer.ProducerMessageDTO{}, false, nil
+	}
+
+	messageDTO := stockEvent(eventStockOutType, stock)
+
+	if stock.Count == 0 {
+		return messageDTO, true, nil
+	}
+
+	threshold, err := repo.GetThreshold(ctx, stock.SKUID, stock.Location)
+	if err != nil {
+		return producer.ProducerMessageDTO{}, false, err
+	}
+
+	if stock.Count > threshold || previous <= threshold {
+		return producer.ProducerMessageDTO{}, false, nil
+	}
+
+	messageDTO.Type = eventStockLowType
+	messageDTO.Threshold = threshold
+
+	return messageDTO, true, nil
+}
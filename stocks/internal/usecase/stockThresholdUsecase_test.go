@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/models"
+	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/producer"
+	"stocks/internal/repository"
+	repositoryMock "stocks/internal/repository/mock"
+	"stocks/internal/usecase/mock"
+	"testing"
+	"time"
+)
+
+func TestSetThreshold(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	var thresholds map[string]uint16
+
+	repoMock.ListOffersMock.Set(func(ctx context.Context, skuID models.SKUID, inStockOnly bool) ([]models.Offer, error) {
+		if skuID != 1001 {
+			return nil, nil
+		}
+
+		return []models.Offer{
+			{Stock: models.Stock{ID: 1, SKUID: 1001, UserID: 1, Count: 3, Location: "AG"}},
+			{Stock: models.Stock{ID: 2, SKUID: 1001, UserID: 2, Count: 8, Location: "AG"}},
+			{Stock: models.Stock{ID: 3, SKUID: 1001, UserID: 1, Count: 2, Location: "BG"}},
+		}, nil
+	})
+
+	repoMock.GetThresholdMock.Set(func(ctx context.Context, skuID models.SKUID, location string) (uint16, error) {
+		return thresholds[location], nil
+	})
+
+	repoMock.SetThresholdMock.Set(func(ctx context.Context, threshold models.StockThreshold) error {
+		switch threshold.SKUID {
+		case 2020:
+			return repository.ErrNotFound
+		case 3033:
+			return errSql
+		}
+
+		if threshold.Location != "AG" {
+			t.Errorf("SetThreshold() location = %q, want %q", threshold.Location, "AG")
+		}
+
+		thresholds[threshold.Location] = threshold.Threshold
+
+		return nil
+	})
+
+	repoMock.DeleteThresholdMock.Set(func(ctx context.Context, skuID models.SKUID, location string) error {
+		delete(thresholds, location)
+
+		return nil
+	})
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	var produced []producer.ProducerMessageDTO
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		produced = append(produced, messsageDTO)
+
+		return nil
+	})
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name       string
+		thresholds map[string]uint16
+		body       SetThresholdDTO
+		wantErr    error
+		wantLow    []uint16
+	}{
+		{
+			name:    testSuccesName,
+			body:    SetThresholdDTO{SKUID: 1001, Location: " AG ", Threshold: 5},
+			wantErr: nil,
+			wantLow: []uint16{3},
+		},
+		{
+			name:       "AlreadyLow",
+			thresholds: map[string]uint16{"AG": 4},
+			body:       SetThresholdDTO{SKUID: 1001, Location: "AG", Threshold: 5},
+			wantErr:    nil,
+		},
+		{
+			name:       "Remove",
+			thresholds: map[string]uint16{"AG": 5},
+			body:       SetThresholdDTO{SKUID: 1001, Location: "AG"},
+			wantErr:    nil,
+		},
+		{
+			name:    "NotFound",
+			body:    SetThresholdDTO{SKUID: 2020, Threshold: 5},
+			wantErr: ErrNotFound,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    SetThresholdDTO{SKUID: 3033, Location: "AG", Threshold: 5},
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thresholds = map[string]uint16{}
+			for location, threshold := range tt.thresholds {
+				thresholds[location] = threshold
+			}
+
+			produced = nil
+
+			err := usecase.SetThreshold(context.Background(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SetThreshold() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(produced) != len(tt.wantLow) {
+				t.Fatalf("SetThreshold() produced %d events, want %d", len(produced), len(tt.wantLow))
+			}
+
+			for i, msg := range produced {
+				if msg.Type != eventStockLowType || msg.Count != tt.wantLow[i] || msg.Threshold != tt.body.Threshold || msg.Location != "AG" {
+					t.Errorf("SetThreshold() event %d = %+v, want %s with count %d", i, msg, eventStockLowType, tt.wantLow[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteStockOut(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+	})
+
+	// the stock at BG is out already, it was reported when its count fell to zero
+	repoMock.DeleteStockMock.Return([]models.Stock{
+		{ID: 1, SKUID: 1001, UserID: 1, Count: 5, Location: "AG"},
+		{ID: 2, SKUID: 1001, UserID: 1, Count: 0, Location: "BG"},
+	}, nil)
+
+	var produced []producer.ProducerMessageDTO
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		produced = append(produced, messsageDTO)
+
+		return nil
+	})
+	logger.InfoMock.Return()
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	if err := usecase.DeleteStockBySKU(t.Context(), DeleteStockDTO{SKUID: 1001, UserID: 1}); err != nil {
+		t.Fatalf("DeleteStockBySKU() error = %v", err)
+	}
+
+	want := []struct {
+		eventType string
+		location  string
+	}{
+		{eventType: eventStockDeleteType, location: "AG"},
+		{eventType: eventStockOutType, location: "AG"},
+		{eventType: eventStockDeleteType, location: "BG"},
+	}
+
+	if len(produced) != len(want) {
+		t.Fatalf("DeleteStockBySKU() produced %d events, want %d", len(produced), len(want))
+	}
+
+	for i, msg := range produced {
+		if msg.Type != want[i].eventType || msg.Location != want[i].location {
+			t.Errorf("DeleteStockBySKU() event %d = %s at %s, want %s at %s", i, msg.Type, msg.Location, want[i].eventType, want[i].location)
+		}
+	}
+
+	if produced[1].Count != 0 {
+		t.Errorf("DeleteStockBySKU() %s count = %d, want 0", eventStockOutType, produced[1].Count)
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"math"
 	"stocks/internal/models"
 	"stocks/internal/producer"
 	"stocks/internal/repository"
@@ -16,6 +17,7 @@ import (
 const (
 	eventSKUCreateType   = "sku_created"
 	eventStockChangeType = "stock_changed"
+	eventTransferType    = "stock_transferred"
 
 	eventService = "stock"
 
@@ -26,11 +28,19 @@ const (
 	delSpanName        = "stock-del-usecase"
 	listSpanName       = "stock-list-usecase"
 	getSpanName        = "stock-get-usecase"
+	getBatchSpanName   = "stock-get-batch-usecase"
+	searchSpanName     = "stock-search-usecase"
+	transferSpanName   = "stock-transfer-usecase"
 )
 
 var (
-	ErrNotFound error = errors.New("not found")
-	ErrUserID   error = errors.New("user id is not matched")
+	ErrNotFound     error = newError(KindNotFound, "NOT_FOUND", "not found")
+	ErrInvalidRange error = newError(KindInvalidArgument, "INVALID_RANGE", "range minimum must not exceed its maximum")
+
+	ErrInvalidTransfer   error = newError(KindInvalidArgument, "INVALID_TRANSFER", "transfer needs a positive count and two different locations")
+	ErrInsufficientStock error = newError(KindFailedPrecondition, "INSUFFICIENT_STOCK", "not enough stock at the source location")
+	ErrTransferOverflow  error = newError(KindFailedPrecondition, "TRANSFER_OVERFLOW", "transfer exceeds the maximum count of the target location")
+	ErrCountOverflow     error = newFieldError("count", "COUNT_OVERFLOW", "count exceeds the maximum count of the location")
 )
 
 //go:generate mkdir -p mock
@@ -63,66 +73,189 @@ func (u *StockUsecase) AddStock(ctx context.Context, stock AddStockDTO) error {
 		Timestamp: time.Now(),
 	}
 
+	var priceDTO producer.ProducerMessageDTO
+	var priceChanged bool
+
 	if err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
 		item, err := repo.GetItemBySKU(ctx, stock.SKUID)
 		if err != nil {
-			if item.Stock.ID == 0 {
+			if errors.Is(err, repository.ErrNotFound) {
 				return ErrNotFound
 			}
 
 			return err
 		}
 
+		if item.SKU.Archived {
+			return ErrSKUArchived
+		}
+
+		current, found, err := getLocationStock(ctx, repo, stock.SKUID, stock.UserID, stock.Location)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			if err = repo.EnsureSeller(ctx, stock.UserID); err != nil {
+				return err
+			}
+		}
+
+		if uint32(current.Count)+uint32(stock.Count) > math.MaxUint16 {
+			return ErrCountOverflow
+		}
+
 		newItem := models.Stock{
-			Count:    item.Stock.Count + stock.Count,
+			ID:       current.ID,
+			Count:    current.Count + stock.Count,
 			Price:    stock.Price,
 			Location: stock.Location,
 			UserID:   stock.UserID,
 			SKUID:    stock.SKUID,
 		}
 
-		switch item.Stock.UserID {
-		case 0:
-			err := repo.AddStock(ctx, newItem)
+		messageDTO.Type = eventStockChangeType
+		if item.Stock.UserID == 0 {
+			messageDTO.Type = eventSKUCreateType
+		}
+
+		messageDTO.SKU = newItem.SKUID
+		messageDTO.Count = newItem.Count
+		messageDTO.Price = newItem.Price
+
+		if err = putLocationStock(ctx, repo, newItem, found); err != nil {
+			return err
+		}
+
+		priceDTO, priceChanged, err = recordPrice(ctx, repo, newItem, current, found, models.PriceSourceUpdate)
+
+		return err
+	}); err != nil {
+		return err
+	}
+
+	u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
+
+	if priceChanged {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(priceDTO, topic, time.Now())))
+	}
+
+	return nil
+}
+
+// TransferStock moves the count of the SKU of the seller from one location to another in one transaction.
+// The stock at the target location is created if it is missing, the source stock is kept even if it is empty.
+func (u *StockUsecase) TransferStock(ctx context.Context, transfer TransferStockDTO) error {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, transferSpanName)
+	defer span.End()
+
+	if transfer.Count == 0 || transfer.FromLocation == "" || transfer.ToLocation == "" ||
+		transfer.FromLocation == transfer.ToLocation {
+		return ErrInvalidTransfer
+	}
+
+	var source models.Stock
+	var levelDTO producer.ProducerMessageDTO
+	var levelChanged bool
+
+	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
+		var err error
+
+		source, err = repo.GetLocationStock(ctx, transfer.SKUID, transfer.UserID, transfer.FromLocation)
+		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				return ErrNotFound
 			}
 
-			messageDTO.Type = eventSKUCreateType
-			messageDTO.SKU = newItem.SKUID
-			messageDTO.Count = newItem.Count
-			messageDTO.Price = newItem.Price
+			return err
+		}
+
+		if source.Count < transfer.Count {
+			return ErrInsufficientStock
+		}
 
+		target, found, err := getLocationStock(ctx, repo, transfer.SKUID, transfer.UserID, transfer.ToLocation)
+		if err != nil {
 			return err
-		case stock.UserID:
-			err := repo.UpdateStock(ctx, newItem)
-			if errors.Is(err, repository.ErrNotFound) {
-				return ErrNotFound
+		}
+
+		if !found {
+			target = models.Stock{
+				Price:    source.Price,
+				Location: transfer.ToLocation,
+				UserID:   transfer.UserID,
+				SKUID:    transfer.SKUID,
 			}
+		}
 
-			messageDTO.Type = eventStockChangeType
-			messageDTO.SKU = newItem.SKUID
-			messageDTO.Count = newItem.Count
-			messageDTO.Price = newItem.Price
+		if uint32(target.Count)+uint32(transfer.Count) > math.MaxUint16 {
+			return ErrTransferOverflow
+		}
 
+		source.Count -= transfer.Count
+		target.Count += transfer.Count
+
+		if err = putLocationStock(ctx, repo, source, true); err != nil {
 			return err
-		default:
-			return ErrUserID
 		}
-	}); err != nil {
+
+		if err = putLocationStock(ctx, repo, target, found); err != nil {
+			return err
+		}
+
+		levelDTO, levelChanged, err = levelEvent(ctx, repo, source, source.Count+transfer.Count)
+
+		return err
+	})
+	if err != nil {
 		return err
 	}
 
+	messageDTO := producer.ProducerMessageDTO{
+		Type:         eventTransferType,
+		Service:      eventService,
+		Timestamp:    time.Now(),
+		SKU:          transfer.SKUID,
+		Count:        transfer.Count,
+		Price:        source.Price,
+		FromLocation: transfer.FromLocation,
+		ToLocation:   transfer.ToLocation,
+	}
+
 	u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(messageDTO, topic, time.Now())))
 
+	if levelChanged {
+		u.logger.Info("kafka", myLog.Error(u.kafkaProducer.Produce(levelDTO, topic, time.Now())))
+	}
+
 	return nil
 }
 
-func (u *StockUsecase) DeleteStockBySKU(ctx context.Context, delStock DeleteStockDTO) error {
-	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, delSpanName)
-	defer span.End()
+// getLocationStock returns the stock of the SKU of the seller at the location and whether it exists.
+func getLocationStock(ctx context.Context, repo repository.IStockRepo, skuID models.SKUID, userID models.UserID,
+	location string) (models.Stock, bool, error) {
+	stock, err := repo.GetLocationStock(ctx, skuID, userID, location)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return models.Stock{}, false, nil
+		}
+
+		return models.Stock{}, false, err
+	}
+
+	return stock, true, nil
+}
+
+// putLocationStock updates the existing stock of a location or adds the stock to a new location.
+func putLocationStock(ctx context.Context, repo repository.IStockRepo, stock models.Stock, exists bool) error {
+	var err error
+
+	if exists {
+		err = repo.UpdateStock(ctx, stock)
+	} else {
+		err = repo.AddStock(ctx, stock)
+	}
 
-	err := u.stockRepo.DeleteStock(ctx, delStock.SKUID, delStock.UserID)
 	if errors.Is(err, repository.ErrNotFound) {
 		return ErrNotFound
 	}
@@ -130,20 +263,43 @@ func (u *StockUsecase) DeleteStockBySKU(ctx context.Context, delStock DeleteStoc
 	return err
 }
 
+// GetStocksByLocation returns a page of the items ordered by the stock ID. The next page is requested
+// with the returned page token; without a token the deprecated current page is used as an offset.
 func (u *StockUsecase) GetStocksByLocation(ctx context.Context, param GetItemByLocDTO) (ItemsByLocDTO, error) {
 	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, listSpanName)
 	defer span.End()
 
 	var items ItemsByLocDTO
 
-	limit := param.PageSize
-	offset := limit * (param.CurrentPage - 1)
+	if param.CurrentPage < 0 {
+		return ItemsByLocDTO{}, ErrInvalidPage
+	}
+
+	token := pageToken{PageNumber: max(param.CurrentPage, 1)}
+
+	if param.PageToken != "" {
+		var err error
+
+		if token, err = decodePageToken(param.PageToken); err != nil {
+			return ItemsByLocDTO{}, err
+		}
+	}
+
+	if !validPage(param.PageSize, token.PageNumber) {
+		return ItemsByLocDTO{}, ErrInvalidPage
+	}
 
 	params := repository.GetStockByLocation{
-		UserID:   param.UserID,
-		Location: param.Location,
-		Limit:    limit,
-		Offset:   offset,
+		UserID:     param.UserID,
+		Location:   param.Location,
+		Limit:      param.PageSize + 1,
+		AfterID:    token.AfterID,
+		CategoryID: param.CategoryID,
+		Attributes: param.Attributes,
+	}
+
+	if param.PageToken == "" {
+		params.Offset = param.PageSize * (token.PageNumber - 1)
 	}
 
 	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
@@ -152,27 +308,32 @@ func (u *StockUsecase) GetStocksByLocation(ctx context.Context, param GetItemByL
 			return err
 		}
 
+		if int64(len(stocksFromRepo)) > param.PageSize {
+			stocksFromRepo = stocksFromRepo[:param.PageSize]
+
+			items.NextPageToken = encodePageToken(pageToken{
+				AfterID:    stocksFromRepo[len(stocksFromRepo)-1].Stock.ID,
+				PageNumber: token.PageNumber + 1,
+			})
+		}
+
 		for _, s := range stocksFromRepo {
-			item := StockDTO{
-				SKU: SKUDTO{
-					SKUID: s.SKU.ID,
-					Name:  s.SKU.Name,
-					Type:  s.SKU.Type,
-				},
-				Price:    s.Stock.Price,
-				Count:    s.Stock.Count,
-				Location: s.Stock.Location,
-				UserID:   s.Stock.UserID,
-			}
+			item := stockToDTO(s)
 
 			items.Stocks = append(items.Stocks, item)
 		}
 
+		totalCount, err := repo.CountItemsByLocation(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		items.TotalCount = int(totalCount)
+
 		return nil
 	})
 
-	items.TotalCount = len(items.Stocks)
-	items.PageNumber = param.CurrentPage
+	items.PageNumber = token.PageNumber
 
 	return items, err
 }
@@ -185,27 +346,101 @@ func (u *StockUsecase) GetItemBySKU(ctx context.Context, sku models.SKUID) (Stoc
 	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
 		item, err := repo.GetItemBySKU(ctx, sku)
 		if err != nil {
-			if item.SKU.ID == 0 {
+			if errors.Is(err, repository.ErrNotFound) {
 				return ErrNotFound
 			} else {
 				return err
 			}
 		}
 
-		stockDTO = StockDTO{
-			SKU: SKUDTO{
-				SKUID: item.SKU.ID,
-				Name:  item.SKU.Name,
-				Type:  item.SKU.Type,
-			},
-			Price:    item.Stock.Price,
-			Count:    item.Stock.Count,
-			Location: item.Stock.Location,
-			UserID:   item.Stock.UserID,
-		}
+		stockDTO = stockToDTO(item)
 
 		return nil
 	})
 
 	return stockDTO, err
 }
+
+// GetItemsBySKUs returns items for all requested SKUs in one lookup. Unknown SKUs are skipped.
+func (u *StockUsecase) GetItemsBySKUs(ctx context.Context, skus []models.SKUID) ([]StockDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, getBatchSpanName)
+	defer span.End()
+
+	items, err := u.stockRepo.GetItemsBySKUs(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	stocks := make([]StockDTO, len(items))
+
+	for i, item := range items {
+		stocks[i] = stockToDTO(item)
+	}
+
+	return stocks, nil
+}
+
+// SearchItems finds the stock of active SKUs by a prefix search over the name and description
+// combined with the category, price, count, availability and location filters. The results are
+// paginated by the page number only, as the relevance order has no key to continue after, so
+// no next page token is returned.
+func (u *StockUsecase) SearchItems(ctx context.Context, param SearchItemsDTO) (ItemsByLocDTO, error) {
+	ctx, span := otel.Tracer(tracingServiceName).Start(ctx, searchSpanName)
+	defer span.End()
+
+	if !validPage(param.PageSize, param.CurrentPage) {
+		return ItemsByLocDTO{}, ErrInvalidPage
+	}
+
+	if invalidRange(param.MinPrice, param.MaxPrice) || invalidRange(param.MinCount, param.MaxCount) {
+		return ItemsByLocDTO{}, ErrInvalidRange
+	}
+
+	params := repository.SearchItemsParam{
+		Query:       param.Query,
+		CategoryID:  param.CategoryID,
+		Type:        param.Type,
+		MinPrice:    param.MinPrice,
+		MaxPrice:    param.MaxPrice,
+		MinCount:    param.MinCount,
+		MaxCount:    param.MaxCount,
+		InStockOnly: param.InStockOnly,
+		Location:    param.Location,
+		Sort:        param.Sort,
+		Descending:  param.Descending,
+		Limit:       param.PageSize,
+		Offset:      param.PageSize * (param.CurrentPage - 1),
+	}
+
+	list := ItemsByLocDTO{PageNumber: param.CurrentPage}
+
+	err := u.trManager.WithTx(ctx, func(repo repository.IStockRepo) error {
+		items, err := repo.SearchItems(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		totalCount, err := repo.CountSearchItems(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		list.Stocks = make([]StockDTO, len(items))
+		list.TotalCount = int(totalCount)
+
+		for i, item := range items {
+			list.Stocks[i] = stockToDTO(item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ItemsByLocDTO{}, err
+	}
+
+	return list, nil
+}
+
+func invalidRange(minValue, maxValue *uint32) bool {
+	return minValue != nil && maxValue != nil && *minValue > *maxValue
+}
@@ -3,11 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
 	"stocks/internal/models"
 	logMock "stocks/internal/observability/log/mock"
+	"stocks/internal/producer"
 	"stocks/internal/repository"
 	repositoryMock "stocks/internal/repository/mock"
 	"stocks/internal/usecase/mock"
+	"time"
 
 	"testing"
 )
@@ -40,15 +43,29 @@ func TestAddStock(t *testing.T) {
 			return models.Item{SKU: models.SKU{ID: 1001}, Stock: models.Stock{UserID: 0}}, nil
 		case 2020:
 			return models.Item{SKU: models.SKU{ID: 2020}, Stock: models.Stock{UserID: 1}}, nil
+		case 4044:
+			return models.Item{SKU: models.SKU{ID: 4044, Archived: true}}, nil
 		}
 
 		return models.Item{Stock: models.Stock{ID: 3033}}, errSql
 	})
 
+	repoMock.GetLocationStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (models.Stock, error) {
+		if skuID == 2020 && userID == 1 {
+			return models.Stock{ID: 2, SKUID: 2020, UserID: 1, Count: 65000}, nil
+		}
+
+		return models.Stock{}, repository.ErrNotFound
+	})
+
 	repoMock.AddStockMock.Return(nil)
 
 	repoMock.UpdateStockMock.Return(nil)
 
+	repoMock.EnsureSellerMock.Return(nil)
+
+	repoMock.AddPriceHistoryMock.Return(nil)
+
 	kafkaMock.ProduceMock.Return(nil)
 
 	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
@@ -93,12 +110,38 @@ func TestAddStock(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "ErrorUserId",
+			name: "UpdateMaxCount",
+			stock: AddStockDTO{
+				SKUID:  2020,
+				UserID: 1,
+				Count:  535,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "ErrorCountOverflow",
+			stock: AddStockDTO{
+				SKUID:  2020,
+				UserID: 1,
+				Count:  536,
+			},
+			wantErr: ErrCountOverflow,
+		},
+		{
+			name: "OtherSeller",
 			stock: AddStockDTO{
 				SKUID:  2020,
 				UserID: 3,
 			},
-			wantErr: ErrUserID,
+			wantErr: nil,
+		},
+		{
+			name: "ErrorArchived",
+			stock: AddStockDTO{
+				SKUID:  4044,
+				UserID: 1,
+			},
+			wantErr: ErrSKUArchived,
 		},
 	}
 
@@ -112,7 +155,7 @@ func TestAddStock(t *testing.T) {
 	}
 }
 
-func TestDeleteStockBySKU(t *testing.T) {
+func TestGetStockByLocation(t *testing.T) {
 	repoMock := repositoryMock.NewIStockRepoMock(t)
 	trxMock := mock.NewIPgTxManagerMock(t)
 	kafkaMock := mock.NewIProducerMock(t)
@@ -123,50 +166,172 @@ func TestDeleteStockBySKU(t *testing.T) {
 		trxMock.MinimockFinish()
 	})
 
-	repoMock.DeleteStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID) (err error) {
-		if userID > 1 {
-			return repository.ErrNotFound
+	repoMock.GetItemsByLocationMock.Set(func(ctx context.Context, param repository.GetStockByLocation) ([]models.Item, error) {
+		if param.UserID > 1 {
+			return []models.Item{}, errSql
+		}
+
+		if param.AfterID == 2 {
+			return []models.Item{
+				{SKU: models.SKU{ID: 3033}, Stock: models.Stock{ID: 3, Location: "AG"}},
+			}, nil
 		}
 
-		return nil
+		return []models.Item{
+			{SKU: models.SKU{ID: 1001}, Stock: models.Stock{ID: 1, Location: "AG"}},
+			{SKU: models.SKU{ID: 2020}, Stock: models.Stock{ID: 2, Location: "AG"}},
+			{SKU: models.SKU{ID: 3033}, Stock: models.Stock{ID: 3, Location: "AG"}},
+		}[:min(param.Limit, 3)], nil
+	})
+
+	repoMock.CountItemsByLocationMock.Return(3, nil)
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
+		return fn(repoMock)
 	})
 
 	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
 
+	nextToken := encodePageToken(pageToken{AfterID: 2, PageNumber: 2})
+
 	tests := []struct {
 		name    string
-		body    DeleteStockDTO
+		body    GetItemByLocDTO
+		want    ItemsByLocDTO
 		wantErr error
 	}{
 		{
 			name: testSuccesName,
-			body: DeleteStockDTO{
-				UserID: 1,
-				SKUID:  1001,
+			body: GetItemByLocDTO{UserID: 1, Location: "AG", PageSize: 2},
+			want: ItemsByLocDTO{
+				Stocks: []StockDTO{
+					{OfferID: 1, SKU: SKUDTO{SKUID: 1001}, Location: "AG"},
+					{OfferID: 2, SKU: SKUDTO{SKUID: 2020}, Location: "AG"},
+				},
+				TotalCount:    3,
+				PageNumber:    1,
+				NextPageToken: nextToken,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "LastPage",
+			body: GetItemByLocDTO{UserID: 1, Location: "AG", PageSize: 2, PageToken: nextToken},
+			want: ItemsByLocDTO{
+				Stocks:     []StockDTO{{OfferID: 3, SKU: SKUDTO{SKUID: 3033}, Location: "AG"}},
+				TotalCount: 3,
+				PageNumber: 2,
 			},
 			wantErr: nil,
 		},
+		{
+			name:    "ErrorPageSize",
+			body:    GetItemByLocDTO{UserID: 1, PageSize: maxPageSize + 1},
+			want:    ItemsByLocDTO{},
+			wantErr: ErrInvalidPage,
+		},
+		{
+			name:    "ErrorCurrentPage",
+			body:    GetItemByLocDTO{UserID: 1, PageSize: 2, CurrentPage: -1},
+			want:    ItemsByLocDTO{},
+			wantErr: ErrInvalidPage,
+		},
+		{
+			name:    "ErrorPageToken",
+			body:    GetItemByLocDTO{UserID: 1, PageSize: 2, PageToken: "page-2"},
+			want:    ItemsByLocDTO{},
+			wantErr: ErrInvalidPageToken,
+		},
 		{
 			name: testSqlErrorName,
-			body: DeleteStockDTO{
-				UserID: 2,
-				SKUID:  1001,
+			body: GetItemByLocDTO{UserID: 2, PageSize: 2},
+			want: ItemsByLocDTO{
+				PageNumber: 1,
+			},
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := usecase.GetStocksByLocation(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(items, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, items)
+			}
+		})
+	}
+}
+
+func TestGetItemBySKU(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	repoMock.GetItemBySKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.Item, error) {
+		if skuID != 1001 {
+			return models.Item{}, repository.ErrNotFound
+		}
+
+		return models.Item{SKU: models.SKU{ID: 1001}}, nil
+	})
+
+	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
+		return fn(repoMock)
+	})
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    models.SKUID
+		want    StockDTO
+		wantErr error
+	}{
+		{
+			name: testSuccesName,
+			body: 1001,
+			want: StockDTO{
+				SKU: SKUDTO{
+					SKUID: 1001,
+				},
 			},
+			wantErr: nil,
+		},
+		{
+			name:    "NotFound",
+			body:    2020,
+			want:    StockDTO{},
 			wantErr: ErrNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := usecase.DeleteStockBySKU(t.Context(), tt.body)
+			item, err := usecase.GetItemBySKU(t.Context(), tt.body)
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("wanted: %v, respond: %v", tt.wantErr.Error(), err)
 			}
+
+			if !reflect.DeepEqual(item, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, item)
+			}
+
+			t.Log(err)
 		})
 	}
 }
 
-func TestGetStockByLocation(t *testing.T) {
+func TestGetItemsBySKUs(t *testing.T) {
 	repoMock := repositoryMock.NewIStockRepoMock(t)
 	trxMock := mock.NewIPgTxManagerMock(t)
 	kafkaMock := mock.NewIProducerMock(t)
@@ -177,22 +342,93 @@ func TestGetStockByLocation(t *testing.T) {
 		trxMock.MinimockFinish()
 	})
 
-	repoMock.GetItemsByLocationMock.Set(func(ctx context.Context, param repository.GetStockByLocation) ([]models.Item, error) {
-		if param.UserID > 1 {
-			return []models.Item{}, errSql
+	repoMock.GetItemsBySKUsMock.Set(func(ctx context.Context, skuIDs []models.SKUID) ([]models.Item, error) {
+		if len(skuIDs) == 0 {
+			return nil, errSql
 		}
 
 		return []models.Item{
-			{
-				SKU: models.SKU{
-					ID: 1001,
-				},
-				Stock: models.Stock{
-					Location: "AG",
-				},
+			{SKU: models.SKU{ID: 1001}, Stock: models.Stock{Count: 10}},
+			{SKU: models.SKU{ID: 2020}},
+		}, nil
+	})
+
+	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
+
+	tests := []struct {
+		name    string
+		body    []models.SKUID
+		want    []StockDTO
+		wantErr error
+	}{
+		{
+			name: testSuccesName,
+			body: []models.SKUID{1001, 2020, 3033},
+			want: []StockDTO{
+				{SKU: SKUDTO{SKUID: 1001}, Count: 10},
+				{SKU: SKUDTO{SKUID: 2020}},
 			},
+			wantErr: nil,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    nil,
+			want:    nil,
+			wantErr: errSql,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := usecase.GetItemsBySKUs(t.Context(), tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
+			}
+
+			if len(items) != len(tt.want) {
+				t.Fatalf("wanted: %v, respond: %v", tt.want, items)
+			}
+
+			for i := range items {
+				if !reflect.DeepEqual(items[i], tt.want[i]) {
+					t.Errorf("wanted: %v, respond: %v", tt.want[i], items[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSearchItems(t *testing.T) {
+	repoMock := repositoryMock.NewIStockRepoMock(t)
+	trxMock := mock.NewIPgTxManagerMock(t)
+	kafkaMock := mock.NewIProducerMock(t)
+	logger := logMock.NewLoggerMock(t)
+
+	t.Cleanup(func() {
+		repoMock.MinimockFinish()
+		trxMock.MinimockFinish()
+	})
+
+	repoMock.SearchItemsMock.Set(func(ctx context.Context, param repository.SearchItemsParam) ([]models.Item, error) {
+		if param.Query == "" {
+			return nil, errSql
+		}
+
+		if param.Offset != 10 {
+			return nil, nil
+		}
+
+		return []models.Item{
+			{SKU: models.SKU{ID: 6066, Name: "hoody"}, Stock: models.Stock{Count: 5, Price: 50}},
 		}, nil
 	})
+	repoMock.CountSearchItemsMock.Set(func(ctx context.Context, param repository.SearchItemsParam) (int64, error) {
+		if param.Query != "hood" {
+			return 0, errSql
+		}
+
+		return 11, nil
+	})
 
 	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
 		return fn(repoMock)
@@ -200,55 +436,59 @@ func TestGetStockByLocation(t *testing.T) {
 
 	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
 
+	minPrice, maxPrice := uint32(100), uint32(10)
+
 	tests := []struct {
 		name    string
-		body    GetItemByLocDTO
+		body    SearchItemsDTO
 		want    ItemsByLocDTO
 		wantErr error
 	}{
 		{
 			name: testSuccesName,
-			body: GetItemByLocDTO{
-				UserID: 1,
-			},
+			body: SearchItemsDTO{Query: "hood", PageSize: 10, CurrentPage: 2},
 			want: ItemsByLocDTO{
-				Stocks:     []StockDTO{},
-				TotalCount: 1,
-				PageNumber: 1,
+				Stocks:     []StockDTO{{SKU: SKUDTO{SKUID: 6066, Name: "hoody"}, Count: 5, Price: 50}},
+				TotalCount: 11,
+				PageNumber: 2,
 			},
 			wantErr: nil,
 		},
 		{
-			name: testSqlErrorName,
-			body: GetItemByLocDTO{
-				UserID: 2,
-			},
-			want: ItemsByLocDTO{
-				Stocks:     []StockDTO{{}},
-				TotalCount: 0,
-				PageNumber: 1,
-			},
+			name:    "ErrorPage",
+			body:    SearchItemsDTO{Query: "hood", PageSize: 10, CurrentPage: 0},
+			want:    ItemsByLocDTO{},
+			wantErr: ErrInvalidPage,
+		},
+		{
+			name:    "ErrorRange",
+			body:    SearchItemsDTO{Query: "hood", MinPrice: &minPrice, MaxPrice: &maxPrice, PageSize: 10, CurrentPage: 1},
+			want:    ItemsByLocDTO{},
+			wantErr: ErrInvalidRange,
+		},
+		{
+			name:    testSqlErrorName,
+			body:    SearchItemsDTO{PageSize: 10, CurrentPage: 1},
+			want:    ItemsByLocDTO{},
 			wantErr: errSql,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			items, err := usecase.GetStocksByLocation(t.Context(), tt.body)
+			items, err := usecase.SearchItems(t.Context(), tt.body)
 			if !errors.Is(err, tt.wantErr) {
-				t.Errorf("wanted: %v, respond: %v", tt.wantErr.Error(), err)
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
 			}
 
-			if items.TotalCount != tt.want.TotalCount {
-				t.Errorf("wanted total count:%d,  respond: %d", items.TotalCount, tt.want.TotalCount)
+			if !reflect.DeepEqual(items, tt.want) {
+				t.Errorf("wanted: %v, respond: %v", tt.want, items)
 			}
-
-			t.Log(err)
 		})
 	}
 }
 
-func TestGetItemBySKU(t *testing.T) {
+func TestTransferStock(t *testing.T) {
 	repoMock := repositoryMock.NewIStockRepoMock(t)
 	trxMock := mock.NewIPgTxManagerMock(t)
 	kafkaMock := mock.NewIProducerMock(t)
@@ -259,56 +499,142 @@ func TestGetItemBySKU(t *testing.T) {
 		trxMock.MinimockFinish()
 	})
 
-	repoMock.GetItemBySKUMock.Set(func(ctx context.Context, skuID models.SKUID) (models.Item, error) {
-		if skuID != 1001 {
-			return models.Item{SKU: models.SKU{}}, errors.New("not found")
+	repoMock.GetLocationStockMock.Set(func(ctx context.Context, skuID models.SKUID, userID models.UserID, location string) (models.Stock, error) {
+		switch {
+		case skuID == 3033:
+			return models.Stock{}, errSql
+		case userID != 1:
+			return models.Stock{}, repository.ErrNotFound
+		case location == "AG":
+			return models.Stock{ID: 1, SKUID: skuID, Count: 10, Price: 100, Location: "AG", UserID: 1}, nil
+		case location == "BG" && skuID == 1001:
+			return models.Stock{ID: 2, SKUID: skuID, Count: 65530, Price: 100, Location: "BG", UserID: 1}, nil
 		}
 
-		return models.Item{SKU: models.SKU{ID: 1001}}, nil
+		return models.Stock{}, repository.ErrNotFound
+	})
+
+	var updated, added []models.Stock
+
+	repoMock.UpdateStockMock.Set(func(ctx context.Context, stock models.Stock) error {
+		updated = append(updated, stock)
+
+		return nil
+	})
+
+	repoMock.AddStockMock.Set(func(ctx context.Context, stock models.Stock) error {
+		added = append(added, stock)
+
+		return nil
 	})
 
 	trxMock.WithTxMock.Set(func(ctx context.Context, fn func(repository.IStockRepo) error) (err error) {
 		return fn(repoMock)
 	})
 
+	repoMock.GetThresholdMock.Return(5, nil)
+
+	var events []string
+
+	kafkaMock.ProduceMock.Set(func(messsageDTO producer.ProducerMessageDTO, topic string, t time.Time) error {
+		events = append(events, messsageDTO.Type)
+
+		return nil
+	})
+	logger.InfoMock.Return()
+
 	usecase := NewStockUsecase(repoMock, trxMock, kafkaMock, logger)
 
 	tests := []struct {
-		name    string
-		body    models.SKUID
-		want    StockDTO
-		wantErr error
+		name        string
+		body        TransferStockDTO
+		wantUpdated []models.Stock
+		wantAdded   []models.Stock
+		wantEvents  []string
+		wantErr     error
 	}{
 		{
 			name: testSuccesName,
-			body: 1001,
-			want: StockDTO{
-				SKU: SKUDTO{
-					SKUID: 1001,
-				},
+			body: TransferStockDTO{SKUID: 2020, UserID: 1, FromLocation: "AG", ToLocation: "CG", Count: 4},
+			wantUpdated: []models.Stock{
+				{ID: 1, SKUID: 2020, Count: 6, Price: 100, Location: "AG", UserID: 1},
 			},
-			wantErr: nil,
+			wantAdded: []models.Stock{
+				{SKUID: 2020, Count: 4, Price: 100, Location: "CG", UserID: 1},
+			},
+			wantEvents: []string{eventTransferType},
+			wantErr:    nil,
+		},
+		{
+			name: "LowStock",
+			body: TransferStockDTO{SKUID: 1001, UserID: 1, FromLocation: "AG", ToLocation: "BG", Count: 5},
+			wantUpdated: []models.Stock{
+				{ID: 1, SKUID: 1001, Count: 5, Price: 100, Location: "AG", UserID: 1},
+				{ID: 2, SKUID: 1001, Count: 65535, Price: 100, Location: "BG", UserID: 1},
+			},
+			wantEvents: []string{eventTransferType, eventStockLowType},
+			wantErr:    nil,
+		},
+		{
+			name: "OutOfStock",
+			body: TransferStockDTO{SKUID: 2020, UserID: 1, FromLocation: "AG", ToLocation: "CG", Count: 10},
+			wantUpdated: []models.Stock{
+				{ID: 1, SKUID: 2020, Count: 0, Price: 100, Location: "AG", UserID: 1},
+			},
+			wantAdded: []models.Stock{
+				{SKUID: 2020, Count: 10, Price: 100, Location: "CG", UserID: 1},
+			},
+			wantEvents: []string{eventTransferType, eventStockOutType},
+			wantErr:    nil,
+		},
+		{
+			name:    "ErrorInsufficientStock",
+			body:    TransferStockDTO{SKUID: 2020, UserID: 1, FromLocation: "AG", ToLocation: "CG", Count: 11},
+			wantErr: ErrInsufficientStock,
+		},
+		{
+			name:    "ErrorOverflow",
+			body:    TransferStockDTO{SKUID: 1001, UserID: 1, FromLocation: "AG", ToLocation: "BG", Count: 10},
+			wantErr: ErrTransferOverflow,
+		},
+		{
+			name:    "ErrorSameLocation",
+			body:    TransferStockDTO{SKUID: 2020, UserID: 1, FromLocation: "AG", ToLocation: "AG", Count: 1},
+			wantErr: ErrInvalidTransfer,
+		},
+		{
+			name:    "OtherSeller",
+			body:    TransferStockDTO{SKUID: 2020, UserID: 2, FromLocation: "AG", ToLocation: "CG", Count: 1},
+			wantErr: ErrNotFound,
 		},
 		{
 			name:    "NotFound",
-			body:    2020,
-			want:    StockDTO{},
+			body:    TransferStockDTO{SKUID: 2020, UserID: 1, FromLocation: "DG", ToLocation: "CG", Count: 1},
 			wantErr: ErrNotFound,
 		},
+		{
+			name:    testSqlErrorName,
+			body:    TransferStockDTO{SKUID: 3033, UserID: 1, FromLocation: "AG", ToLocation: "CG", Count: 1},
+			wantErr: errSql,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			item, err := usecase.GetItemBySKU(t.Context(), tt.body)
+			updated, added, events = nil, nil, nil
+
+			err := usecase.TransferStock(t.Context(), tt.body)
 			if !errors.Is(err, tt.wantErr) {
-				t.Errorf("wanted: %v, respond: %v", tt.wantErr.Error(), err)
+				t.Errorf("wanted: %v, respond: %v", tt.wantErr, err)
 			}
 
-			if item != tt.want {
-				t.Errorf("wanted: %v, respond: %v", tt.want, item)
+			if !reflect.DeepEqual(updated, tt.wantUpdated) || !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("wanted: %v %v, respond: %v %v", tt.wantUpdated, tt.wantAdded, updated, added)
 			}
 
-			t.Log(err)
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Errorf("wanted events: %v, respond: %v", tt.wantEvents, events)
+			}
 		})
 	}
 }
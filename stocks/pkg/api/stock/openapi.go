@@ -0,0 +1,8 @@
+package stock
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI v2 document of the gateway routes, generated from stock.proto by make protoc.
+//
+//go:embed stock.swagger.json
+var OpenAPI []byte